@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
@@ -119,21 +120,184 @@ func (x *_BaseAuction_12_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_BaseAuction_15_list)(nil)
+
+type _BaseAuction_15_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_BaseAuction_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BaseAuction_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BaseAuction_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BaseAuction_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BaseAuction_15_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BaseAuction_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BaseAuction_15_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BaseAuction_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_BaseAuction_17_list)(nil)
+
+type _BaseAuction_17_list struct {
+	list *[]*VestingSchedule
+}
+
+func (x *_BaseAuction_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BaseAuction_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BaseAuction_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BaseAuction_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BaseAuction_17_list) AppendMutable() protoreflect.Value {
+	v := new(VestingSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BaseAuction_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BaseAuction_17_list) NewElement() protoreflect.Value {
+	v := new(VestingSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BaseAuction_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_BaseAuction_23_list)(nil)
+
+type _BaseAuction_23_list struct {
+	list *[]*PayingDenom
+}
+
+func (x *_BaseAuction_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BaseAuction_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BaseAuction_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayingDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BaseAuction_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayingDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BaseAuction_23_list) AppendMutable() protoreflect.Value {
+	v := new(PayingDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BaseAuction_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BaseAuction_23_list) NewElement() protoreflect.Value {
+	v := new(PayingDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BaseAuction_23_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BaseAuction                         protoreflect.MessageDescriptor
-	fd_BaseAuction_id                      protoreflect.FieldDescriptor
-	fd_BaseAuction_type                    protoreflect.FieldDescriptor
-	fd_BaseAuction_auctioneer              protoreflect.FieldDescriptor
-	fd_BaseAuction_selling_reserve_address protoreflect.FieldDescriptor
-	fd_BaseAuction_paying_reserve_address  protoreflect.FieldDescriptor
-	fd_BaseAuction_start_price             protoreflect.FieldDescriptor
-	fd_BaseAuction_selling_coin            protoreflect.FieldDescriptor
-	fd_BaseAuction_paying_coin_denom       protoreflect.FieldDescriptor
-	fd_BaseAuction_vesting_reserve_address protoreflect.FieldDescriptor
-	fd_BaseAuction_vesting_schedules       protoreflect.FieldDescriptor
-	fd_BaseAuction_start_time              protoreflect.FieldDescriptor
-	fd_BaseAuction_end_times               protoreflect.FieldDescriptor
-	fd_BaseAuction_status                  protoreflect.FieldDescriptor
+	md_BaseAuction                          protoreflect.MessageDescriptor
+	fd_BaseAuction_id                       protoreflect.FieldDescriptor
+	fd_BaseAuction_type                     protoreflect.FieldDescriptor
+	fd_BaseAuction_auctioneer               protoreflect.FieldDescriptor
+	fd_BaseAuction_selling_reserve_address  protoreflect.FieldDescriptor
+	fd_BaseAuction_paying_reserve_address   protoreflect.FieldDescriptor
+	fd_BaseAuction_start_price              protoreflect.FieldDescriptor
+	fd_BaseAuction_selling_coin             protoreflect.FieldDescriptor
+	fd_BaseAuction_paying_coin_denom        protoreflect.FieldDescriptor
+	fd_BaseAuction_vesting_reserve_address  protoreflect.FieldDescriptor
+	fd_BaseAuction_vesting_schedules        protoreflect.FieldDescriptor
+	fd_BaseAuction_start_time               protoreflect.FieldDescriptor
+	fd_BaseAuction_end_times                protoreflect.FieldDescriptor
+	fd_BaseAuction_status                   protoreflect.FieldDescriptor
+	fd_BaseAuction_paused_time              protoreflect.FieldDescriptor
+	fd_BaseAuction_bid_fee                  protoreflect.FieldDescriptor
+	fd_BaseAuction_soft_cap                 protoreflect.FieldDescriptor
+	fd_BaseAuction_buyer_vesting_schedules  protoreflect.FieldDescriptor
+	fd_BaseAuction_linear_vesting           protoreflect.FieldDescriptor
+	fd_BaseAuction_auto_release             protoreflect.FieldDescriptor
+	fd_BaseAuction_beneficiary              protoreflect.FieldDescriptor
+	fd_BaseAuction_pending_owner            protoreflect.FieldDescriptor
+	fd_BaseAuction_pending_beneficiary      protoreflect.FieldDescriptor
+	fd_BaseAuction_additional_paying_denoms protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -152,6 +316,16 @@ func init() {
 	fd_BaseAuction_start_time = md_BaseAuction.Fields().ByName("start_time")
 	fd_BaseAuction_end_times = md_BaseAuction.Fields().ByName("end_times")
 	fd_BaseAuction_status = md_BaseAuction.Fields().ByName("status")
+	fd_BaseAuction_paused_time = md_BaseAuction.Fields().ByName("paused_time")
+	fd_BaseAuction_bid_fee = md_BaseAuction.Fields().ByName("bid_fee")
+	fd_BaseAuction_soft_cap = md_BaseAuction.Fields().ByName("soft_cap")
+	fd_BaseAuction_buyer_vesting_schedules = md_BaseAuction.Fields().ByName("buyer_vesting_schedules")
+	fd_BaseAuction_linear_vesting = md_BaseAuction.Fields().ByName("linear_vesting")
+	fd_BaseAuction_auto_release = md_BaseAuction.Fields().ByName("auto_release")
+	fd_BaseAuction_beneficiary = md_BaseAuction.Fields().ByName("beneficiary")
+	fd_BaseAuction_pending_owner = md_BaseAuction.Fields().ByName("pending_owner")
+	fd_BaseAuction_pending_beneficiary = md_BaseAuction.Fields().ByName("pending_beneficiary")
+	fd_BaseAuction_additional_paying_denoms = md_BaseAuction.Fields().ByName("additional_paying_denoms")
 }
 
 var _ protoreflect.Message = (*fastReflection_BaseAuction)(nil)
@@ -297,6 +471,66 @@ func (x *fastReflection_BaseAuction) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.PausedTime != nil {
+		value := protoreflect.ValueOfMessage(x.PausedTime.ProtoReflect())
+		if !f(fd_BaseAuction_paused_time, value) {
+			return
+		}
+	}
+	if len(x.BidFee) != 0 {
+		value := protoreflect.ValueOfList(&_BaseAuction_15_list{list: &x.BidFee})
+		if !f(fd_BaseAuction_bid_fee, value) {
+			return
+		}
+	}
+	if x.SoftCap != "" {
+		value := protoreflect.ValueOfString(x.SoftCap)
+		if !f(fd_BaseAuction_soft_cap, value) {
+			return
+		}
+	}
+	if len(x.BuyerVestingSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_BaseAuction_17_list{list: &x.BuyerVestingSchedules})
+		if !f(fd_BaseAuction_buyer_vesting_schedules, value) {
+			return
+		}
+	}
+	if x.LinearVesting != nil {
+		value := protoreflect.ValueOfMessage(x.LinearVesting.ProtoReflect())
+		if !f(fd_BaseAuction_linear_vesting, value) {
+			return
+		}
+	}
+	if x.AutoRelease != false {
+		value := protoreflect.ValueOfBool(x.AutoRelease)
+		if !f(fd_BaseAuction_auto_release, value) {
+			return
+		}
+	}
+	if x.Beneficiary != "" {
+		value := protoreflect.ValueOfString(x.Beneficiary)
+		if !f(fd_BaseAuction_beneficiary, value) {
+			return
+		}
+	}
+	if x.PendingOwner != "" {
+		value := protoreflect.ValueOfString(x.PendingOwner)
+		if !f(fd_BaseAuction_pending_owner, value) {
+			return
+		}
+	}
+	if x.PendingBeneficiary != "" {
+		value := protoreflect.ValueOfString(x.PendingBeneficiary)
+		if !f(fd_BaseAuction_pending_beneficiary, value) {
+			return
+		}
+	}
+	if len(x.AdditionalPayingDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_BaseAuction_23_list{list: &x.AdditionalPayingDenoms})
+		if !f(fd_BaseAuction_additional_paying_denoms, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -338,6 +572,26 @@ func (x *fastReflection_BaseAuction) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.EndTimes) != 0
 	case "fundraising.fundraising.v1.BaseAuction.status":
 		return x.Status != 0
+	case "fundraising.fundraising.v1.BaseAuction.paused_time":
+		return x.PausedTime != nil
+	case "fundraising.fundraising.v1.BaseAuction.bid_fee":
+		return len(x.BidFee) != 0
+	case "fundraising.fundraising.v1.BaseAuction.soft_cap":
+		return x.SoftCap != ""
+	case "fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules":
+		return len(x.BuyerVestingSchedules) != 0
+	case "fundraising.fundraising.v1.BaseAuction.linear_vesting":
+		return x.LinearVesting != nil
+	case "fundraising.fundraising.v1.BaseAuction.auto_release":
+		return x.AutoRelease != false
+	case "fundraising.fundraising.v1.BaseAuction.beneficiary":
+		return x.Beneficiary != ""
+	case "fundraising.fundraising.v1.BaseAuction.pending_owner":
+		return x.PendingOwner != ""
+	case "fundraising.fundraising.v1.BaseAuction.pending_beneficiary":
+		return x.PendingBeneficiary != ""
+	case "fundraising.fundraising.v1.BaseAuction.additional_paying_denoms":
+		return len(x.AdditionalPayingDenoms) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BaseAuction"))
@@ -380,6 +634,26 @@ func (x *fastReflection_BaseAuction) Clear(fd protoreflect.FieldDescriptor) {
 		x.EndTimes = nil
 	case "fundraising.fundraising.v1.BaseAuction.status":
 		x.Status = 0
+	case "fundraising.fundraising.v1.BaseAuction.paused_time":
+		x.PausedTime = nil
+	case "fundraising.fundraising.v1.BaseAuction.bid_fee":
+		x.BidFee = nil
+	case "fundraising.fundraising.v1.BaseAuction.soft_cap":
+		x.SoftCap = ""
+	case "fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules":
+		x.BuyerVestingSchedules = nil
+	case "fundraising.fundraising.v1.BaseAuction.linear_vesting":
+		x.LinearVesting = nil
+	case "fundraising.fundraising.v1.BaseAuction.auto_release":
+		x.AutoRelease = false
+	case "fundraising.fundraising.v1.BaseAuction.beneficiary":
+		x.Beneficiary = ""
+	case "fundraising.fundraising.v1.BaseAuction.pending_owner":
+		x.PendingOwner = ""
+	case "fundraising.fundraising.v1.BaseAuction.pending_beneficiary":
+		x.PendingBeneficiary = ""
+	case "fundraising.fundraising.v1.BaseAuction.additional_paying_denoms":
+		x.AdditionalPayingDenoms = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BaseAuction"))
@@ -441,6 +715,45 @@ func (x *fastReflection_BaseAuction) Get(descriptor protoreflect.FieldDescriptor
 	case "fundraising.fundraising.v1.BaseAuction.status":
 		value := x.Status
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "fundraising.fundraising.v1.BaseAuction.paused_time":
+		value := x.PausedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BaseAuction.bid_fee":
+		if len(x.BidFee) == 0 {
+			return protoreflect.ValueOfList(&_BaseAuction_15_list{})
+		}
+		listValue := &_BaseAuction_15_list{list: &x.BidFee}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.BaseAuction.soft_cap":
+		value := x.SoftCap
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules":
+		if len(x.BuyerVestingSchedules) == 0 {
+			return protoreflect.ValueOfList(&_BaseAuction_17_list{})
+		}
+		listValue := &_BaseAuction_17_list{list: &x.BuyerVestingSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.BaseAuction.linear_vesting":
+		value := x.LinearVesting
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BaseAuction.auto_release":
+		value := x.AutoRelease
+		return protoreflect.ValueOfBool(value)
+	case "fundraising.fundraising.v1.BaseAuction.beneficiary":
+		value := x.Beneficiary
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BaseAuction.pending_owner":
+		value := x.PendingOwner
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BaseAuction.pending_beneficiary":
+		value := x.PendingBeneficiary
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BaseAuction.additional_paying_denoms":
+		if len(x.AdditionalPayingDenoms) == 0 {
+			return protoreflect.ValueOfList(&_BaseAuction_23_list{})
+		}
+		listValue := &_BaseAuction_23_list{list: &x.AdditionalPayingDenoms}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BaseAuction"))
@@ -491,6 +804,32 @@ func (x *fastReflection_BaseAuction) Set(fd protoreflect.FieldDescriptor, value
 		x.EndTimes = *clv.list
 	case "fundraising.fundraising.v1.BaseAuction.status":
 		x.Status = (AuctionStatus)(value.Enum())
+	case "fundraising.fundraising.v1.BaseAuction.paused_time":
+		x.PausedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fundraising.fundraising.v1.BaseAuction.bid_fee":
+		lv := value.List()
+		clv := lv.(*_BaseAuction_15_list)
+		x.BidFee = *clv.list
+	case "fundraising.fundraising.v1.BaseAuction.soft_cap":
+		x.SoftCap = value.Interface().(string)
+	case "fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules":
+		lv := value.List()
+		clv := lv.(*_BaseAuction_17_list)
+		x.BuyerVestingSchedules = *clv.list
+	case "fundraising.fundraising.v1.BaseAuction.linear_vesting":
+		x.LinearVesting = value.Message().Interface().(*LinearVesting)
+	case "fundraising.fundraising.v1.BaseAuction.auto_release":
+		x.AutoRelease = value.Bool()
+	case "fundraising.fundraising.v1.BaseAuction.beneficiary":
+		x.Beneficiary = value.Interface().(string)
+	case "fundraising.fundraising.v1.BaseAuction.pending_owner":
+		x.PendingOwner = value.Interface().(string)
+	case "fundraising.fundraising.v1.BaseAuction.pending_beneficiary":
+		x.PendingBeneficiary = value.Interface().(string)
+	case "fundraising.fundraising.v1.BaseAuction.additional_paying_denoms":
+		lv := value.List()
+		clv := lv.(*_BaseAuction_23_list)
+		x.AdditionalPayingDenoms = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BaseAuction"))
@@ -533,6 +872,34 @@ func (x *fastReflection_BaseAuction) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_BaseAuction_12_list{list: &x.EndTimes}
 		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.BaseAuction.paused_time":
+		if x.PausedTime == nil {
+			x.PausedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PausedTime.ProtoReflect())
+	case "fundraising.fundraising.v1.BaseAuction.bid_fee":
+		if x.BidFee == nil {
+			x.BidFee = []*v1beta1.Coin{}
+		}
+		value := &_BaseAuction_15_list{list: &x.BidFee}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules":
+		if x.BuyerVestingSchedules == nil {
+			x.BuyerVestingSchedules = []*VestingSchedule{}
+		}
+		value := &_BaseAuction_17_list{list: &x.BuyerVestingSchedules}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.BaseAuction.linear_vesting":
+		if x.LinearVesting == nil {
+			x.LinearVesting = new(LinearVesting)
+		}
+		return protoreflect.ValueOfMessage(x.LinearVesting.ProtoReflect())
+	case "fundraising.fundraising.v1.BaseAuction.additional_paying_denoms":
+		if x.AdditionalPayingDenoms == nil {
+			x.AdditionalPayingDenoms = []*PayingDenom{}
+		}
+		value := &_BaseAuction_23_list{list: &x.AdditionalPayingDenoms}
+		return protoreflect.ValueOfList(value)
 	case "fundraising.fundraising.v1.BaseAuction.id":
 		panic(fmt.Errorf("field id of message fundraising.fundraising.v1.BaseAuction is not mutable"))
 	case "fundraising.fundraising.v1.BaseAuction.type":
@@ -551,6 +918,16 @@ func (x *fastReflection_BaseAuction) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field vesting_reserve_address of message fundraising.fundraising.v1.BaseAuction is not mutable"))
 	case "fundraising.fundraising.v1.BaseAuction.status":
 		panic(fmt.Errorf("field status of message fundraising.fundraising.v1.BaseAuction is not mutable"))
+	case "fundraising.fundraising.v1.BaseAuction.soft_cap":
+		panic(fmt.Errorf("field soft_cap of message fundraising.fundraising.v1.BaseAuction is not mutable"))
+	case "fundraising.fundraising.v1.BaseAuction.auto_release":
+		panic(fmt.Errorf("field auto_release of message fundraising.fundraising.v1.BaseAuction is not mutable"))
+	case "fundraising.fundraising.v1.BaseAuction.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message fundraising.fundraising.v1.BaseAuction is not mutable"))
+	case "fundraising.fundraising.v1.BaseAuction.pending_owner":
+		panic(fmt.Errorf("field pending_owner of message fundraising.fundraising.v1.BaseAuction is not mutable"))
+	case "fundraising.fundraising.v1.BaseAuction.pending_beneficiary":
+		panic(fmt.Errorf("field pending_beneficiary of message fundraising.fundraising.v1.BaseAuction is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BaseAuction"))
@@ -594,6 +971,31 @@ func (x *fastReflection_BaseAuction) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfList(&_BaseAuction_12_list{list: &list})
 	case "fundraising.fundraising.v1.BaseAuction.status":
 		return protoreflect.ValueOfEnum(0)
+	case "fundraising.fundraising.v1.BaseAuction.paused_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BaseAuction.bid_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_BaseAuction_15_list{list: &list})
+	case "fundraising.fundraising.v1.BaseAuction.soft_cap":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules":
+		list := []*VestingSchedule{}
+		return protoreflect.ValueOfList(&_BaseAuction_17_list{list: &list})
+	case "fundraising.fundraising.v1.BaseAuction.linear_vesting":
+		m := new(LinearVesting)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BaseAuction.auto_release":
+		return protoreflect.ValueOfBool(false)
+	case "fundraising.fundraising.v1.BaseAuction.beneficiary":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BaseAuction.pending_owner":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BaseAuction.pending_beneficiary":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BaseAuction.additional_paying_denoms":
+		list := []*PayingDenom{}
+		return protoreflect.ValueOfList(&_BaseAuction_23_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BaseAuction"))
@@ -716,6 +1118,51 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 		if x.Status != 0 {
 			n += 1 + runtime.Sov(uint64(x.Status))
 		}
+		if x.PausedTime != nil {
+			l = options.Size(x.PausedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BidFee) > 0 {
+			for _, e := range x.BidFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SoftCap)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BuyerVestingSchedules) > 0 {
+			for _, e := range x.BuyerVestingSchedules {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LinearVesting != nil {
+			l = options.Size(x.LinearVesting)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoRelease {
+			n += 3
+		}
+		l = len(x.Beneficiary)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingOwner)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingBeneficiary)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AdditionalPayingDenoms) > 0 {
+			for _, e := range x.AdditionalPayingDenoms {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -745,14 +1192,9 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x68
-		}
-		if len(x.EndTimes) > 0 {
-			for iNdEx := len(x.EndTimes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.EndTimes[iNdEx])
+		if len(x.AdditionalPayingDenoms) > 0 {
+			for iNdEx := len(x.AdditionalPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AdditionalPayingDenoms[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -763,11 +1205,52 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x62
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
 			}
 		}
-		if x.StartTime != nil {
-			encoded, err := options.Marshal(x.StartTime)
+		if len(x.PendingBeneficiary) > 0 {
+			i -= len(x.PendingBeneficiary)
+			copy(dAtA[i:], x.PendingBeneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingBeneficiary)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.PendingOwner) > 0 {
+			i -= len(x.PendingOwner)
+			copy(dAtA[i:], x.PendingOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingOwner)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.Beneficiary) > 0 {
+			i -= len(x.Beneficiary)
+			copy(dAtA[i:], x.Beneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beneficiary)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.AutoRelease {
+			i--
+			if x.AutoRelease {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.LinearVesting != nil {
+			encoded, err := options.Marshal(x.LinearVesting)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -778,11 +1261,13 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
-		if len(x.VestingSchedules) > 0 {
-			for iNdEx := len(x.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.VestingSchedules[iNdEx])
+		if len(x.BuyerVestingSchedules) > 0 {
+			for iNdEx := len(x.BuyerVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BuyerVestingSchedules[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -793,25 +1278,38 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x52
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
 			}
 		}
-		if len(x.VestingReserveAddress) > 0 {
-			i -= len(x.VestingReserveAddress)
-			copy(dAtA[i:], x.VestingReserveAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingReserveAddress)))
+		if len(x.SoftCap) > 0 {
+			i -= len(x.SoftCap)
+			copy(dAtA[i:], x.SoftCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SoftCap)))
 			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.PayingCoinDenom) > 0 {
-			i -= len(x.PayingCoinDenom)
-			copy(dAtA[i:], x.PayingCoinDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayingCoinDenom)))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x82
 		}
-		if x.SellingCoin != nil {
-			encoded, err := options.Marshal(x.SellingCoin)
+		if len(x.BidFee) > 0 {
+			for iNdEx := len(x.BidFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BidFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.PausedTime != nil {
+			encoded, err := options.Marshal(x.PausedTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -822,9 +1320,88 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x72
 		}
-		if len(x.StartPrice) > 0 {
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.EndTimes) > 0 {
+			for iNdEx := len(x.EndTimes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EndTimes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.VestingSchedules) > 0 {
+			for iNdEx := len(x.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.VestingReserveAddress) > 0 {
+			i -= len(x.VestingReserveAddress)
+			copy(dAtA[i:], x.VestingReserveAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingReserveAddress)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.PayingCoinDenom) > 0 {
+			i -= len(x.PayingCoinDenom)
+			copy(dAtA[i:], x.PayingCoinDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayingCoinDenom)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.SellingCoin != nil {
+			encoded, err := options.Marshal(x.SellingCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.StartPrice) > 0 {
 			i -= len(x.StartPrice)
 			copy(dAtA[i:], x.StartPrice)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartPrice)))
@@ -1300,136 +1877,2938 @@ func (x *fastReflection_BaseAuction) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PausedTime == nil {
+					x.PausedTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.BidFee = append(x.BidFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BidFee[len(x.BidFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_FixedPriceAuction                        protoreflect.MessageDescriptor
-	fd_FixedPriceAuction_base_auction           protoreflect.FieldDescriptor
-	fd_FixedPriceAuction_remaining_selling_coin protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_fundraising_fundraising_v1_auction_proto_init()
-	md_FixedPriceAuction = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("FixedPriceAuction")
-	fd_FixedPriceAuction_base_auction = md_FixedPriceAuction.Fields().ByName("base_auction")
-	fd_FixedPriceAuction_remaining_selling_coin = md_FixedPriceAuction.Fields().ByName("remaining_selling_coin")
-}
-
-var _ protoreflect.Message = (*fastReflection_FixedPriceAuction)(nil)
-
-type fastReflection_FixedPriceAuction FixedPriceAuction
-
-func (x *FixedPriceAuction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FixedPriceAuction)(x)
-}
-
-func (x *FixedPriceAuction) slowProtoReflect() protoreflect.Message {
-	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_FixedPriceAuction_messageType fastReflection_FixedPriceAuction_messageType
-var _ protoreflect.MessageType = fastReflection_FixedPriceAuction_messageType{}
-
-type fastReflection_FixedPriceAuction_messageType struct{}
-
-func (x fastReflection_FixedPriceAuction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FixedPriceAuction)(nil)
-}
-func (x fastReflection_FixedPriceAuction_messageType) New() protoreflect.Message {
-	return new(fastReflection_FixedPriceAuction)
-}
-func (x fastReflection_FixedPriceAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FixedPriceAuction
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_FixedPriceAuction) Descriptor() protoreflect.MessageDescriptor {
-	return md_FixedPriceAuction
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FixedPriceAuction) Type() protoreflect.MessageType {
-	return _fastReflection_FixedPriceAuction_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FixedPriceAuction) New() protoreflect.Message {
-	return new(fastReflection_FixedPriceAuction)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_FixedPriceAuction) Interface() protoreflect.ProtoMessage {
-	return (*FixedPriceAuction)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_FixedPriceAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseAuction != nil {
-		value := protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
-		if !f(fd_FixedPriceAuction_base_auction, value) {
-			return
-		}
-	}
-	if x.RemainingSellingCoin != nil {
-		value := protoreflect.ValueOfMessage(x.RemainingSellingCoin.ProtoReflect())
-		if !f(fd_FixedPriceAuction_remaining_selling_coin, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SoftCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuyerVestingSchedules = append(x.BuyerVestingSchedules, &VestingSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BuyerVestingSchedules[len(x.BuyerVestingSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LinearVesting == nil {
+					x.LinearVesting = &LinearVesting{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LinearVesting); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRelease", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRelease = bool(v != 0)
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingBeneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingBeneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalPayingDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdditionalPayingDenoms = append(x.AdditionalPayingDenoms, &PayingDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdditionalPayingDenoms[len(x.AdditionalPayingDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PayingDenom       protoreflect.MessageDescriptor
+	fd_PayingDenom_denom protoreflect.FieldDescriptor
+	fd_PayingDenom_ratio protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_proto_init()
+	md_PayingDenom = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("PayingDenom")
+	fd_PayingDenom_denom = md_PayingDenom.Fields().ByName("denom")
+	fd_PayingDenom_ratio = md_PayingDenom.Fields().ByName("ratio")
+}
+
+var _ protoreflect.Message = (*fastReflection_PayingDenom)(nil)
+
+type fastReflection_PayingDenom PayingDenom
+
+func (x *PayingDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PayingDenom)(x)
+}
+
+func (x *PayingDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PayingDenom_messageType fastReflection_PayingDenom_messageType
+var _ protoreflect.MessageType = fastReflection_PayingDenom_messageType{}
+
+type fastReflection_PayingDenom_messageType struct{}
+
+func (x fastReflection_PayingDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PayingDenom)(nil)
+}
+func (x fastReflection_PayingDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_PayingDenom)
+}
+func (x fastReflection_PayingDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PayingDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PayingDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_PayingDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PayingDenom) Type() protoreflect.MessageType {
+	return _fastReflection_PayingDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PayingDenom) New() protoreflect.Message {
+	return new(fastReflection_PayingDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PayingDenom) Interface() protoreflect.ProtoMessage {
+	return (*PayingDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PayingDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PayingDenom_denom, value) {
+			return
+		}
+	}
+	if x.Ratio != "" {
+		value := protoreflect.ValueOfString(x.Ratio)
+		if !f(fd_PayingDenom_ratio, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PayingDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.PayingDenom.denom":
+		return x.Denom != ""
+	case "fundraising.fundraising.v1.PayingDenom.ratio":
+		return x.Ratio != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.PayingDenom"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.PayingDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayingDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.PayingDenom.denom":
+		x.Denom = ""
+	case "fundraising.fundraising.v1.PayingDenom.ratio":
+		x.Ratio = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.PayingDenom"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.PayingDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PayingDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.PayingDenom.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.PayingDenom.ratio":
+		value := x.Ratio
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.PayingDenom"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.PayingDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayingDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.PayingDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "fundraising.fundraising.v1.PayingDenom.ratio":
+		x.Ratio = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.PayingDenom"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.PayingDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayingDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.PayingDenom.denom":
+		panic(fmt.Errorf("field denom of message fundraising.fundraising.v1.PayingDenom is not mutable"))
+	case "fundraising.fundraising.v1.PayingDenom.ratio":
+		panic(fmt.Errorf("field ratio of message fundraising.fundraising.v1.PayingDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.PayingDenom"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.PayingDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PayingDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.PayingDenom.denom":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.PayingDenom.ratio":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.PayingDenom"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.PayingDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PayingDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.PayingDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PayingDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayingDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PayingDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PayingDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PayingDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ratio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PayingDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Ratio) > 0 {
+			i -= len(x.Ratio)
+			copy(dAtA[i:], x.Ratio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ratio)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PayingDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PayingDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PayingDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ratio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FixedPriceAuction                        protoreflect.MessageDescriptor
+	fd_FixedPriceAuction_base_auction           protoreflect.FieldDescriptor
+	fd_FixedPriceAuction_remaining_selling_coin protoreflect.FieldDescriptor
+	fd_FixedPriceAuction_sale_mode              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_proto_init()
+	md_FixedPriceAuction = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("FixedPriceAuction")
+	fd_FixedPriceAuction_base_auction = md_FixedPriceAuction.Fields().ByName("base_auction")
+	fd_FixedPriceAuction_remaining_selling_coin = md_FixedPriceAuction.Fields().ByName("remaining_selling_coin")
+	fd_FixedPriceAuction_sale_mode = md_FixedPriceAuction.Fields().ByName("sale_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_FixedPriceAuction)(nil)
+
+type fastReflection_FixedPriceAuction FixedPriceAuction
+
+func (x *FixedPriceAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FixedPriceAuction)(x)
+}
+
+func (x *FixedPriceAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FixedPriceAuction_messageType fastReflection_FixedPriceAuction_messageType
+var _ protoreflect.MessageType = fastReflection_FixedPriceAuction_messageType{}
+
+type fastReflection_FixedPriceAuction_messageType struct{}
+
+func (x fastReflection_FixedPriceAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FixedPriceAuction)(nil)
+}
+func (x fastReflection_FixedPriceAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_FixedPriceAuction)
+}
+func (x fastReflection_FixedPriceAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FixedPriceAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FixedPriceAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_FixedPriceAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FixedPriceAuction) Type() protoreflect.MessageType {
+	return _fastReflection_FixedPriceAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FixedPriceAuction) New() protoreflect.Message {
+	return new(fastReflection_FixedPriceAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FixedPriceAuction) Interface() protoreflect.ProtoMessage {
+	return (*FixedPriceAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FixedPriceAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseAuction != nil {
+		value := protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
+		if !f(fd_FixedPriceAuction_base_auction, value) {
+			return
+		}
+	}
+	if x.RemainingSellingCoin != nil {
+		value := protoreflect.ValueOfMessage(x.RemainingSellingCoin.ProtoReflect())
+		if !f(fd_FixedPriceAuction_remaining_selling_coin, value) {
+			return
+		}
+	}
+	if x.SaleMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SaleMode))
+		if !f(fd_FixedPriceAuction_sale_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FixedPriceAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+		return x.BaseAuction != nil
+	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+		return x.RemainingSellingCoin != nil
+	case "fundraising.fundraising.v1.FixedPriceAuction.sale_mode":
+		return x.SaleMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPriceAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+		x.BaseAuction = nil
+	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+		x.RemainingSellingCoin = nil
+	case "fundraising.fundraising.v1.FixedPriceAuction.sale_mode":
+		x.SaleMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FixedPriceAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+		value := x.BaseAuction
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+		value := x.RemainingSellingCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.FixedPriceAuction.sale_mode":
+		value := x.SaleMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPriceAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+		x.BaseAuction = value.Message().Interface().(*BaseAuction)
+	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+		x.RemainingSellingCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.FixedPriceAuction.sale_mode":
+		x.SaleMode = (SaleMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPriceAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+		if x.BaseAuction == nil {
+			x.BaseAuction = new(BaseAuction)
+		}
+		return protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
+	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+		if x.RemainingSellingCoin == nil {
+			x.RemainingSellingCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RemainingSellingCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.FixedPriceAuction.sale_mode":
+		panic(fmt.Errorf("field sale_mode of message fundraising.fundraising.v1.FixedPriceAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FixedPriceAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+		m := new(BaseAuction)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.FixedPriceAuction.sale_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FixedPriceAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.FixedPriceAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FixedPriceAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPriceAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FixedPriceAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FixedPriceAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BaseAuction != nil {
+			l = options.Size(x.BaseAuction)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RemainingSellingCoin != nil {
+			l = options.Size(x.RemainingSellingCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SaleMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.SaleMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FixedPriceAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SaleMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SaleMode))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RemainingSellingCoin != nil {
+			encoded, err := options.Marshal(x.RemainingSellingCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BaseAuction != nil {
+			encoded, err := options.Marshal(x.BaseAuction)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FixedPriceAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedPriceAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BaseAuction == nil {
+					x.BaseAuction = &BaseAuction{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAuction); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RemainingSellingCoin == nil {
+					x.RemainingSellingCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingSellingCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SaleMode", wireType)
+				}
+				x.SaleMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SaleMode |= SaleMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BatchAuction                     protoreflect.MessageDescriptor
+	fd_BatchAuction_base_auction        protoreflect.FieldDescriptor
+	fd_BatchAuction_min_bid_price       protoreflect.FieldDescriptor
+	fd_BatchAuction_matched_price       protoreflect.FieldDescriptor
+	fd_BatchAuction_max_extended_round  protoreflect.FieldDescriptor
+	fd_BatchAuction_extended_round_rate protoreflect.FieldDescriptor
+	fd_BatchAuction_bid_cancel_cutoff   protoreflect.FieldDescriptor
+	fd_BatchAuction_marginal_fill_mode  protoreflect.FieldDescriptor
+	fd_BatchAuction_pricing_mode        protoreflect.FieldDescriptor
+	fd_BatchAuction_sealed_bid_config   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_proto_init()
+	md_BatchAuction = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("BatchAuction")
+	fd_BatchAuction_base_auction = md_BatchAuction.Fields().ByName("base_auction")
+	fd_BatchAuction_min_bid_price = md_BatchAuction.Fields().ByName("min_bid_price")
+	fd_BatchAuction_matched_price = md_BatchAuction.Fields().ByName("matched_price")
+	fd_BatchAuction_max_extended_round = md_BatchAuction.Fields().ByName("max_extended_round")
+	fd_BatchAuction_extended_round_rate = md_BatchAuction.Fields().ByName("extended_round_rate")
+	fd_BatchAuction_bid_cancel_cutoff = md_BatchAuction.Fields().ByName("bid_cancel_cutoff")
+	fd_BatchAuction_marginal_fill_mode = md_BatchAuction.Fields().ByName("marginal_fill_mode")
+	fd_BatchAuction_pricing_mode = md_BatchAuction.Fields().ByName("pricing_mode")
+	fd_BatchAuction_sealed_bid_config = md_BatchAuction.Fields().ByName("sealed_bid_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchAuction)(nil)
+
+type fastReflection_BatchAuction BatchAuction
+
+func (x *BatchAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchAuction)(x)
+}
+
+func (x *BatchAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchAuction_messageType fastReflection_BatchAuction_messageType
+var _ protoreflect.MessageType = fastReflection_BatchAuction_messageType{}
+
+type fastReflection_BatchAuction_messageType struct{}
+
+func (x fastReflection_BatchAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchAuction)(nil)
+}
+func (x fastReflection_BatchAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchAuction)
+}
+func (x fastReflection_BatchAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BatchAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BatchAuction) Type() protoreflect.MessageType {
+	return _fastReflection_BatchAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BatchAuction) New() protoreflect.Message {
+	return new(fastReflection_BatchAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BatchAuction) Interface() protoreflect.ProtoMessage {
+	return (*BatchAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BatchAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseAuction != nil {
+		value := protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
+		if !f(fd_BatchAuction_base_auction, value) {
+			return
+		}
+	}
+	if x.MinBidPrice != "" {
+		value := protoreflect.ValueOfString(x.MinBidPrice)
+		if !f(fd_BatchAuction_min_bid_price, value) {
+			return
+		}
+	}
+	if x.MatchedPrice != "" {
+		value := protoreflect.ValueOfString(x.MatchedPrice)
+		if !f(fd_BatchAuction_matched_price, value) {
+			return
+		}
+	}
+	if x.MaxExtendedRound != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxExtendedRound)
+		if !f(fd_BatchAuction_max_extended_round, value) {
+			return
+		}
+	}
+	if x.ExtendedRoundRate != "" {
+		value := protoreflect.ValueOfString(x.ExtendedRoundRate)
+		if !f(fd_BatchAuction_extended_round_rate, value) {
+			return
+		}
+	}
+	if x.BidCancelCutoff != nil {
+		value := protoreflect.ValueOfMessage(x.BidCancelCutoff.ProtoReflect())
+		if !f(fd_BatchAuction_bid_cancel_cutoff, value) {
+			return
+		}
+	}
+	if x.MarginalFillMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.MarginalFillMode))
+		if !f(fd_BatchAuction_marginal_fill_mode, value) {
+			return
+		}
+	}
+	if x.PricingMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PricingMode))
+		if !f(fd_BatchAuction_pricing_mode, value) {
+			return
+		}
+	}
+	if x.SealedBidConfig != nil {
+		value := protoreflect.ValueOfMessage(x.SealedBidConfig.ProtoReflect())
+		if !f(fd_BatchAuction_sealed_bid_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BatchAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BatchAuction.base_auction":
+		return x.BaseAuction != nil
+	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
+		return x.MinBidPrice != ""
+	case "fundraising.fundraising.v1.BatchAuction.matched_price":
+		return x.MatchedPrice != ""
+	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
+		return x.MaxExtendedRound != uint32(0)
+	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+		return x.ExtendedRoundRate != ""
+	case "fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff":
+		return x.BidCancelCutoff != nil
+	case "fundraising.fundraising.v1.BatchAuction.marginal_fill_mode":
+		return x.MarginalFillMode != 0
+	case "fundraising.fundraising.v1.BatchAuction.pricing_mode":
+		return x.PricingMode != 0
+	case "fundraising.fundraising.v1.BatchAuction.sealed_bid_config":
+		return x.SealedBidConfig != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BatchAuction.base_auction":
+		x.BaseAuction = nil
+	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
+		x.MinBidPrice = ""
+	case "fundraising.fundraising.v1.BatchAuction.matched_price":
+		x.MatchedPrice = ""
+	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
+		x.MaxExtendedRound = uint32(0)
+	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+		x.ExtendedRoundRate = ""
+	case "fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff":
+		x.BidCancelCutoff = nil
+	case "fundraising.fundraising.v1.BatchAuction.marginal_fill_mode":
+		x.MarginalFillMode = 0
+	case "fundraising.fundraising.v1.BatchAuction.pricing_mode":
+		x.PricingMode = 0
+	case "fundraising.fundraising.v1.BatchAuction.sealed_bid_config":
+		x.SealedBidConfig = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BatchAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.BatchAuction.base_auction":
+		value := x.BaseAuction
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
+		value := x.MinBidPrice
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BatchAuction.matched_price":
+		value := x.MatchedPrice
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
+		value := x.MaxExtendedRound
+		return protoreflect.ValueOfUint32(value)
+	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+		value := x.ExtendedRoundRate
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff":
+		value := x.BidCancelCutoff
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.marginal_fill_mode":
+		value := x.MarginalFillMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "fundraising.fundraising.v1.BatchAuction.pricing_mode":
+		value := x.PricingMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "fundraising.fundraising.v1.BatchAuction.sealed_bid_config":
+		value := x.SealedBidConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BatchAuction.base_auction":
+		x.BaseAuction = value.Message().Interface().(*BaseAuction)
+	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
+		x.MinBidPrice = value.Interface().(string)
+	case "fundraising.fundraising.v1.BatchAuction.matched_price":
+		x.MatchedPrice = value.Interface().(string)
+	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
+		x.MaxExtendedRound = uint32(value.Uint())
+	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+		x.ExtendedRoundRate = value.Interface().(string)
+	case "fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff":
+		x.BidCancelCutoff = value.Message().Interface().(*durationpb.Duration)
+	case "fundraising.fundraising.v1.BatchAuction.marginal_fill_mode":
+		x.MarginalFillMode = (MarginalFillMode)(value.Enum())
+	case "fundraising.fundraising.v1.BatchAuction.pricing_mode":
+		x.PricingMode = (PricingMode)(value.Enum())
+	case "fundraising.fundraising.v1.BatchAuction.sealed_bid_config":
+		x.SealedBidConfig = value.Message().Interface().(*SealedBidConfig)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BatchAuction.base_auction":
+		if x.BaseAuction == nil {
+			x.BaseAuction = new(BaseAuction)
+		}
+		return protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff":
+		if x.BidCancelCutoff == nil {
+			x.BidCancelCutoff = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.BidCancelCutoff.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.sealed_bid_config":
+		if x.SealedBidConfig == nil {
+			x.SealedBidConfig = new(SealedBidConfig)
+		}
+		return protoreflect.ValueOfMessage(x.SealedBidConfig.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
+		panic(fmt.Errorf("field min_bid_price of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+	case "fundraising.fundraising.v1.BatchAuction.matched_price":
+		panic(fmt.Errorf("field matched_price of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
+		panic(fmt.Errorf("field max_extended_round of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+		panic(fmt.Errorf("field extended_round_rate of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+	case "fundraising.fundraising.v1.BatchAuction.marginal_fill_mode":
+		panic(fmt.Errorf("field marginal_fill_mode of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+	case "fundraising.fundraising.v1.BatchAuction.pricing_mode":
+		panic(fmt.Errorf("field pricing_mode of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BatchAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BatchAuction.base_auction":
+		m := new(BaseAuction)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BatchAuction.matched_price":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BatchAuction.marginal_fill_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "fundraising.fundraising.v1.BatchAuction.pricing_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "fundraising.fundraising.v1.BatchAuction.sealed_bid_config":
+		m := new(SealedBidConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BatchAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.BatchAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BatchAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BatchAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BatchAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BaseAuction != nil {
+			l = options.Size(x.BaseAuction)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinBidPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MatchedPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxExtendedRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxExtendedRound))
+		}
+		l = len(x.ExtendedRoundRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BidCancelCutoff != nil {
+			l = options.Size(x.BidCancelCutoff)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarginalFillMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarginalFillMode))
+		}
+		if x.PricingMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.PricingMode))
+		}
+		if x.SealedBidConfig != nil {
+			l = options.Size(x.SealedBidConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BatchAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SealedBidConfig != nil {
+			encoded, err := options.Marshal(x.SealedBidConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.PricingMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PricingMode))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MarginalFillMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarginalFillMode))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BidCancelCutoff != nil {
+			encoded, err := options.Marshal(x.BidCancelCutoff)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ExtendedRoundRate) > 0 {
+			i -= len(x.ExtendedRoundRate)
+			copy(dAtA[i:], x.ExtendedRoundRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtendedRoundRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.MaxExtendedRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExtendedRound))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MatchedPrice) > 0 {
+			i -= len(x.MatchedPrice)
+			copy(dAtA[i:], x.MatchedPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MatchedPrice)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinBidPrice) > 0 {
+			i -= len(x.MinBidPrice)
+			copy(dAtA[i:], x.MinBidPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBidPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BaseAuction != nil {
+			encoded, err := options.Marshal(x.BaseAuction)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BatchAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BaseAuction == nil {
+					x.BaseAuction = &BaseAuction{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAuction); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBidPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MatchedPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+				}
+				x.MaxExtendedRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExtendedRound |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtendedRoundRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidCancelCutoff", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BidCancelCutoff == nil {
+					x.BidCancelCutoff = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BidCancelCutoff); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarginalFillMode", wireType)
+				}
+				x.MarginalFillMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarginalFillMode |= MarginalFillMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricingMode", wireType)
+				}
+				x.PricingMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PricingMode |= PricingMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SealedBidConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SealedBidConfig == nil {
+					x.SealedBidConfig = &SealedBidConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SealedBidConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SealedBidConfig                    protoreflect.MessageDescriptor
+	fd_SealedBidConfig_reveal_period      protoreflect.FieldDescriptor
+	fd_SealedBidConfig_forfeit_unrevealed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_proto_init()
+	md_SealedBidConfig = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("SealedBidConfig")
+	fd_SealedBidConfig_reveal_period = md_SealedBidConfig.Fields().ByName("reveal_period")
+	fd_SealedBidConfig_forfeit_unrevealed = md_SealedBidConfig.Fields().ByName("forfeit_unrevealed")
+}
+
+var _ protoreflect.Message = (*fastReflection_SealedBidConfig)(nil)
+
+type fastReflection_SealedBidConfig SealedBidConfig
+
+func (x *SealedBidConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SealedBidConfig)(x)
+}
+
+func (x *SealedBidConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SealedBidConfig_messageType fastReflection_SealedBidConfig_messageType
+var _ protoreflect.MessageType = fastReflection_SealedBidConfig_messageType{}
+
+type fastReflection_SealedBidConfig_messageType struct{}
+
+func (x fastReflection_SealedBidConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SealedBidConfig)(nil)
+}
+func (x fastReflection_SealedBidConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_SealedBidConfig)
+}
+func (x fastReflection_SealedBidConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SealedBidConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SealedBidConfig) Type() protoreflect.MessageType {
+	return _fastReflection_SealedBidConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SealedBidConfig) New() protoreflect.Message {
+	return new(fastReflection_SealedBidConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SealedBidConfig) Interface() protoreflect.ProtoMessage {
+	return (*SealedBidConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SealedBidConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RevealPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.RevealPeriod.ProtoReflect())
+		if !f(fd_SealedBidConfig_reveal_period, value) {
+			return
+		}
+	}
+	if x.ForfeitUnrevealed != false {
+		value := protoreflect.ValueOfBool(x.ForfeitUnrevealed)
+		if !f(fd_SealedBidConfig_forfeit_unrevealed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SealedBidConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.SealedBidConfig.reveal_period":
+		return x.RevealPeriod != nil
+	case "fundraising.fundraising.v1.SealedBidConfig.forfeit_unrevealed":
+		return x.ForfeitUnrevealed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.SealedBidConfig"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.SealedBidConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.SealedBidConfig.reveal_period":
+		x.RevealPeriod = nil
+	case "fundraising.fundraising.v1.SealedBidConfig.forfeit_unrevealed":
+		x.ForfeitUnrevealed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.SealedBidConfig"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.SealedBidConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SealedBidConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.SealedBidConfig.reveal_period":
+		value := x.RevealPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.SealedBidConfig.forfeit_unrevealed":
+		value := x.ForfeitUnrevealed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.SealedBidConfig"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.SealedBidConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.SealedBidConfig.reveal_period":
+		x.RevealPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "fundraising.fundraising.v1.SealedBidConfig.forfeit_unrevealed":
+		x.ForfeitUnrevealed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.SealedBidConfig"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.SealedBidConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.SealedBidConfig.reveal_period":
+		if x.RevealPeriod == nil {
+			x.RevealPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RevealPeriod.ProtoReflect())
+	case "fundraising.fundraising.v1.SealedBidConfig.forfeit_unrevealed":
+		panic(fmt.Errorf("field forfeit_unrevealed of message fundraising.fundraising.v1.SealedBidConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.SealedBidConfig"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.SealedBidConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SealedBidConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.SealedBidConfig.reveal_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.SealedBidConfig.forfeit_unrevealed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.SealedBidConfig"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.SealedBidConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SealedBidConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.SealedBidConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SealedBidConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SealedBidConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SealedBidConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SealedBidConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RevealPeriod != nil {
+			l = options.Size(x.RevealPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ForfeitUnrevealed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ForfeitUnrevealed {
+			i--
+			if x.ForfeitUnrevealed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RevealPeriod != nil {
+			encoded, err := options.Marshal(x.RevealPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevealPeriod == nil {
+					x.RevealPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevealPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForfeitUnrevealed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ForfeitUnrevealed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DutchAuction                        protoreflect.MessageDescriptor
+	fd_DutchAuction_base_auction           protoreflect.FieldDescriptor
+	fd_DutchAuction_floor_price            protoreflect.FieldDescriptor
+	fd_DutchAuction_decay_interval         protoreflect.FieldDescriptor
+	fd_DutchAuction_remaining_selling_coin protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_proto_init()
+	md_DutchAuction = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("DutchAuction")
+	fd_DutchAuction_base_auction = md_DutchAuction.Fields().ByName("base_auction")
+	fd_DutchAuction_floor_price = md_DutchAuction.Fields().ByName("floor_price")
+	fd_DutchAuction_decay_interval = md_DutchAuction.Fields().ByName("decay_interval")
+	fd_DutchAuction_remaining_selling_coin = md_DutchAuction.Fields().ByName("remaining_selling_coin")
+}
+
+var _ protoreflect.Message = (*fastReflection_DutchAuction)(nil)
+
+type fastReflection_DutchAuction DutchAuction
+
+func (x *DutchAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DutchAuction)(x)
+}
+
+func (x *DutchAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DutchAuction_messageType fastReflection_DutchAuction_messageType
+var _ protoreflect.MessageType = fastReflection_DutchAuction_messageType{}
+
+type fastReflection_DutchAuction_messageType struct{}
+
+func (x fastReflection_DutchAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DutchAuction)(nil)
+}
+func (x fastReflection_DutchAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_DutchAuction)
+}
+func (x fastReflection_DutchAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DutchAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DutchAuction) Type() protoreflect.MessageType {
+	return _fastReflection_DutchAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DutchAuction) New() protoreflect.Message {
+	return new(fastReflection_DutchAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DutchAuction) Interface() protoreflect.ProtoMessage {
+	return (*DutchAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DutchAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseAuction != nil {
+		value := protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
+		if !f(fd_DutchAuction_base_auction, value) {
+			return
+		}
+	}
+	if x.FloorPrice != "" {
+		value := protoreflect.ValueOfString(x.FloorPrice)
+		if !f(fd_DutchAuction_floor_price, value) {
+			return
+		}
+	}
+	if x.DecayInterval != nil {
+		value := protoreflect.ValueOfMessage(x.DecayInterval.ProtoReflect())
+		if !f(fd_DutchAuction_decay_interval, value) {
+			return
+		}
+	}
+	if x.RemainingSellingCoin != nil {
+		value := protoreflect.ValueOfMessage(x.RemainingSellingCoin.ProtoReflect())
+		if !f(fd_DutchAuction_remaining_selling_coin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
 // distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
 // member fields of a oneof, and proto2 scalar fields are nullable. Such
@@ -1438,17 +4817,21 @@ func (x *fastReflection_FixedPriceAuction) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FixedPriceAuction) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DutchAuction) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+	case "fundraising.fundraising.v1.DutchAuction.base_auction":
 		return x.BaseAuction != nil
-	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+	case "fundraising.fundraising.v1.DutchAuction.floor_price":
+		return x.FloorPrice != ""
+	case "fundraising.fundraising.v1.DutchAuction.decay_interval":
+		return x.DecayInterval != nil
+	case "fundraising.fundraising.v1.DutchAuction.remaining_selling_coin":
 		return x.RemainingSellingCoin != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.DutchAuction"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.DutchAuction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1458,17 +4841,21 @@ func (x *fastReflection_FixedPriceAuction) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FixedPriceAuction) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DutchAuction) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+	case "fundraising.fundraising.v1.DutchAuction.base_auction":
 		x.BaseAuction = nil
-	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+	case "fundraising.fundraising.v1.DutchAuction.floor_price":
+		x.FloorPrice = ""
+	case "fundraising.fundraising.v1.DutchAuction.decay_interval":
+		x.DecayInterval = nil
+	case "fundraising.fundraising.v1.DutchAuction.remaining_selling_coin":
 		x.RemainingSellingCoin = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.DutchAuction"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.DutchAuction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1478,19 +4865,25 @@ func (x *fastReflection_FixedPriceAuction) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FixedPriceAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DutchAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+	case "fundraising.fundraising.v1.DutchAuction.base_auction":
 		value := x.BaseAuction
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+	case "fundraising.fundraising.v1.DutchAuction.floor_price":
+		value := x.FloorPrice
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.DutchAuction.decay_interval":
+		value := x.DecayInterval
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.DutchAuction.remaining_selling_coin":
 		value := x.RemainingSellingCoin
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.DutchAuction"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.DutchAuction does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1504,17 +4897,21 @@ func (x *fastReflection_FixedPriceAuction) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FixedPriceAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DutchAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+	case "fundraising.fundraising.v1.DutchAuction.base_auction":
 		x.BaseAuction = value.Message().Interface().(*BaseAuction)
-	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+	case "fundraising.fundraising.v1.DutchAuction.floor_price":
+		x.FloorPrice = value.Interface().(string)
+	case "fundraising.fundraising.v1.DutchAuction.decay_interval":
+		x.DecayInterval = value.Message().Interface().(*durationpb.Duration)
+	case "fundraising.fundraising.v1.DutchAuction.remaining_selling_coin":
 		x.RemainingSellingCoin = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.DutchAuction"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.DutchAuction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1528,52 +4925,64 @@ func (x *fastReflection_FixedPriceAuction) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FixedPriceAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DutchAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+	case "fundraising.fundraising.v1.DutchAuction.base_auction":
 		if x.BaseAuction == nil {
 			x.BaseAuction = new(BaseAuction)
 		}
 		return protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
-	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+	case "fundraising.fundraising.v1.DutchAuction.decay_interval":
+		if x.DecayInterval == nil {
+			x.DecayInterval = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DecayInterval.ProtoReflect())
+	case "fundraising.fundraising.v1.DutchAuction.remaining_selling_coin":
 		if x.RemainingSellingCoin == nil {
 			x.RemainingSellingCoin = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.RemainingSellingCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.DutchAuction.floor_price":
+		panic(fmt.Errorf("field floor_price of message fundraising.fundraising.v1.DutchAuction is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.DutchAuction"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.DutchAuction does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FixedPriceAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DutchAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.FixedPriceAuction.base_auction":
+	case "fundraising.fundraising.v1.DutchAuction.base_auction":
 		m := new(BaseAuction)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin":
+	case "fundraising.fundraising.v1.DutchAuction.floor_price":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.DutchAuction.decay_interval":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.DutchAuction.remaining_selling_coin":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.FixedPriceAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.DutchAuction"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.FixedPriceAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.DutchAuction does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FixedPriceAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DutchAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.FixedPriceAuction", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.DutchAuction", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1581,7 +4990,7 @@ func (x *fastReflection_FixedPriceAuction) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FixedPriceAuction) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DutchAuction) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1592,7 +5001,7 @@ func (x *fastReflection_FixedPriceAuction) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FixedPriceAuction) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DutchAuction) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1604,7 +5013,7 @@ func (x *fastReflection_FixedPriceAuction) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FixedPriceAuction) IsValid() bool {
+func (x *fastReflection_DutchAuction) IsValid() bool {
 	return x != nil
 }
 
@@ -1614,9 +5023,9 @@ func (x *fastReflection_FixedPriceAuction) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DutchAuction) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FixedPriceAuction)
+		x := input.Message.Interface().(*DutchAuction)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1632,6 +5041,14 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.BaseAuction)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.FloorPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecayInterval != nil {
+			l = options.Size(x.DecayInterval)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.RemainingSellingCoin != nil {
 			l = options.Size(x.RemainingSellingCoin)
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -1646,7 +5063,7 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FixedPriceAuction)
+		x := input.Message.Interface().(*DutchAuction)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1677,6 +5094,27 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if x.DecayInterval != nil {
+			encoded, err := options.Marshal(x.DecayInterval)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.FloorPrice) > 0 {
+			i -= len(x.FloorPrice)
+			copy(dAtA[i:], x.FloorPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FloorPrice)))
+			i--
 			dAtA[i] = 0x12
 		}
 		if x.BaseAuction != nil {
@@ -1704,7 +5142,7 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FixedPriceAuction)
+		x := input.Message.Interface().(*DutchAuction)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1732,19 +5170,87 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedPriceAuction: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BaseAuction == nil {
+					x.BaseAuction = &BaseAuction{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAuction); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FloorPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayInterval", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1771,14 +5277,14 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BaseAuction == nil {
-					x.BaseAuction = &BaseAuction{}
+				if x.DecayInterval == nil {
+					x.DecayInterval = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAuction); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecayInterval); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
 				}
@@ -1850,34 +5356,28 @@ func (x *fastReflection_FixedPriceAuction) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_BatchAuction                     protoreflect.MessageDescriptor
-	fd_BatchAuction_base_auction        protoreflect.FieldDescriptor
-	fd_BatchAuction_min_bid_price       protoreflect.FieldDescriptor
-	fd_BatchAuction_matched_price       protoreflect.FieldDescriptor
-	fd_BatchAuction_max_extended_round  protoreflect.FieldDescriptor
-	fd_BatchAuction_extended_round_rate protoreflect.FieldDescriptor
+	md_VestingSchedule              protoreflect.MessageDescriptor
+	fd_VestingSchedule_release_time protoreflect.FieldDescriptor
+	fd_VestingSchedule_weight       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_auction_proto_init()
-	md_BatchAuction = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("BatchAuction")
-	fd_BatchAuction_base_auction = md_BatchAuction.Fields().ByName("base_auction")
-	fd_BatchAuction_min_bid_price = md_BatchAuction.Fields().ByName("min_bid_price")
-	fd_BatchAuction_matched_price = md_BatchAuction.Fields().ByName("matched_price")
-	fd_BatchAuction_max_extended_round = md_BatchAuction.Fields().ByName("max_extended_round")
-	fd_BatchAuction_extended_round_rate = md_BatchAuction.Fields().ByName("extended_round_rate")
+	md_VestingSchedule = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("VestingSchedule")
+	fd_VestingSchedule_release_time = md_VestingSchedule.Fields().ByName("release_time")
+	fd_VestingSchedule_weight = md_VestingSchedule.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_BatchAuction)(nil)
+var _ protoreflect.Message = (*fastReflection_VestingSchedule)(nil)
 
-type fastReflection_BatchAuction BatchAuction
+type fastReflection_VestingSchedule VestingSchedule
 
-func (x *BatchAuction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BatchAuction)(x)
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(x)
 }
 
-func (x *BatchAuction) slowProtoReflect() protoreflect.Message {
-	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[2]
+func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,43 +5388,43 @@ func (x *BatchAuction) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BatchAuction_messageType fastReflection_BatchAuction_messageType
-var _ protoreflect.MessageType = fastReflection_BatchAuction_messageType{}
+var _fastReflection_VestingSchedule_messageType fastReflection_VestingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_VestingSchedule_messageType{}
 
-type fastReflection_BatchAuction_messageType struct{}
+type fastReflection_VestingSchedule_messageType struct{}
 
-func (x fastReflection_BatchAuction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BatchAuction)(nil)
+func (x fastReflection_VestingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(nil)
 }
-func (x fastReflection_BatchAuction_messageType) New() protoreflect.Message {
-	return new(fastReflection_BatchAuction)
+func (x fastReflection_VestingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
 }
-func (x fastReflection_BatchAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BatchAuction
+func (x fastReflection_VestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BatchAuction) Descriptor() protoreflect.MessageDescriptor {
-	return md_BatchAuction
+func (x *fastReflection_VestingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BatchAuction) Type() protoreflect.MessageType {
-	return _fastReflection_BatchAuction_messageType
+func (x *fastReflection_VestingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_VestingSchedule_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BatchAuction) New() protoreflect.Message {
-	return new(fastReflection_BatchAuction)
+func (x *fastReflection_VestingSchedule) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BatchAuction) Interface() protoreflect.ProtoMessage {
-	return (*BatchAuction)(x)
+func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*VestingSchedule)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1932,34 +5432,16 @@ func (x *fastReflection_BatchAuction) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BatchAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseAuction != nil {
-		value := protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
-		if !f(fd_BatchAuction_base_auction, value) {
-			return
-		}
-	}
-	if x.MinBidPrice != "" {
-		value := protoreflect.ValueOfString(x.MinBidPrice)
-		if !f(fd_BatchAuction_min_bid_price, value) {
-			return
-		}
-	}
-	if x.MatchedPrice != "" {
-		value := protoreflect.ValueOfString(x.MatchedPrice)
-		if !f(fd_BatchAuction_matched_price, value) {
-			return
-		}
-	}
-	if x.MaxExtendedRound != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxExtendedRound)
-		if !f(fd_BatchAuction_max_extended_round, value) {
+func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReleaseTime != nil {
+		value := protoreflect.ValueOfMessage(x.ReleaseTime.ProtoReflect())
+		if !f(fd_VestingSchedule_release_time, value) {
 			return
 		}
 	}
-	if x.ExtendedRoundRate != "" {
-		value := protoreflect.ValueOfString(x.ExtendedRoundRate)
-		if !f(fd_BatchAuction_extended_round_rate, value) {
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_VestingSchedule_weight, value) {
 			return
 		}
 	}
@@ -1976,23 +5458,17 @@ func (x *fastReflection_BatchAuction) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BatchAuction) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.BatchAuction.base_auction":
-		return x.BaseAuction != nil
-	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
-		return x.MinBidPrice != ""
-	case "fundraising.fundraising.v1.BatchAuction.matched_price":
-		return x.MatchedPrice != ""
-	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
-		return x.MaxExtendedRound != uint32(0)
-	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
-		return x.ExtendedRoundRate != ""
+	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+		return x.ReleaseTime != nil
+	case "fundraising.fundraising.v1.VestingSchedule.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2002,23 +5478,17 @@ func (x *fastReflection_BatchAuction) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BatchAuction) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.BatchAuction.base_auction":
-		x.BaseAuction = nil
-	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
-		x.MinBidPrice = ""
-	case "fundraising.fundraising.v1.BatchAuction.matched_price":
-		x.MatchedPrice = ""
-	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
-		x.MaxExtendedRound = uint32(0)
-	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
-		x.ExtendedRoundRate = ""
+	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+		x.ReleaseTime = nil
+	case "fundraising.fundraising.v1.VestingSchedule.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2028,28 +5498,19 @@ func (x *fastReflection_BatchAuction) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BatchAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.BatchAuction.base_auction":
-		value := x.BaseAuction
+	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+		value := x.ReleaseTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
-		value := x.MinBidPrice
-		return protoreflect.ValueOfString(value)
-	case "fundraising.fundraising.v1.BatchAuction.matched_price":
-		value := x.MatchedPrice
-		return protoreflect.ValueOfString(value)
-	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
-		value := x.MaxExtendedRound
-		return protoreflect.ValueOfUint32(value)
-	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
-		value := x.ExtendedRoundRate
+	case "fundraising.fundraising.v1.VestingSchedule.weight":
+		value := x.Weight
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2063,23 +5524,17 @@ func (x *fastReflection_BatchAuction) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BatchAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.BatchAuction.base_auction":
-		x.BaseAuction = value.Message().Interface().(*BaseAuction)
-	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
-		x.MinBidPrice = value.Interface().(string)
-	case "fundraising.fundraising.v1.BatchAuction.matched_price":
-		x.MatchedPrice = value.Interface().(string)
-	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
-		x.MaxExtendedRound = uint32(value.Uint())
-	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
-		x.ExtendedRoundRate = value.Interface().(string)
+	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+		x.ReleaseTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fundraising.fundraising.v1.VestingSchedule.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2093,60 +5548,48 @@ func (x *fastReflection_BatchAuction) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BatchAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.BatchAuction.base_auction":
-		if x.BaseAuction == nil {
-			x.BaseAuction = new(BaseAuction)
+	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+		if x.ReleaseTime == nil {
+			x.ReleaseTime = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.BaseAuction.ProtoReflect())
-	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
-		panic(fmt.Errorf("field min_bid_price of message fundraising.fundraising.v1.BatchAuction is not mutable"))
-	case "fundraising.fundraising.v1.BatchAuction.matched_price":
-		panic(fmt.Errorf("field matched_price of message fundraising.fundraising.v1.BatchAuction is not mutable"))
-	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
-		panic(fmt.Errorf("field max_extended_round of message fundraising.fundraising.v1.BatchAuction is not mutable"))
-	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
-		panic(fmt.Errorf("field extended_round_rate of message fundraising.fundraising.v1.BatchAuction is not mutable"))
+		return protoreflect.ValueOfMessage(x.ReleaseTime.ProtoReflect())
+	case "fundraising.fundraising.v1.VestingSchedule.weight":
+		panic(fmt.Errorf("field weight of message fundraising.fundraising.v1.VestingSchedule is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BatchAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.BatchAuction.base_auction":
-		m := new(BaseAuction)
+	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "fundraising.fundraising.v1.BatchAuction.min_bid_price":
-		return protoreflect.ValueOfString("")
-	case "fundraising.fundraising.v1.BatchAuction.matched_price":
-		return protoreflect.ValueOfString("")
-	case "fundraising.fundraising.v1.BatchAuction.max_extended_round":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "fundraising.fundraising.v1.BatchAuction.extended_round_rate":
+	case "fundraising.fundraising.v1.VestingSchedule.weight":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BatchAuction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.BatchAuction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BatchAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.BatchAuction", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.VestingSchedule", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2154,7 +5597,7 @@ func (x *fastReflection_BatchAuction) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BatchAuction) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2165,7 +5608,7 @@ func (x *fastReflection_BatchAuction) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BatchAuction) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2177,7 +5620,7 @@ func (x *fastReflection_BatchAuction) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BatchAuction) IsValid() bool {
+func (x *fastReflection_VestingSchedule) IsValid() bool {
 	return x != nil
 }
 
@@ -2187,9 +5630,9 @@ func (x *fastReflection_BatchAuction) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BatchAuction)
+		x := input.Message.Interface().(*VestingSchedule)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2201,22 +5644,11 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BaseAuction != nil {
-			l = options.Size(x.BaseAuction)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MinBidPrice)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MatchedPrice)
-		if l > 0 {
+		if x.ReleaseTime != nil {
+			l = options.Size(x.ReleaseTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxExtendedRound != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxExtendedRound))
-		}
-		l = len(x.ExtendedRoundRate)
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2230,7 +5662,7 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BatchAuction)
+		x := input.Message.Interface().(*VestingSchedule)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2249,34 +5681,15 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExtendedRoundRate) > 0 {
-			i -= len(x.ExtendedRoundRate)
-			copy(dAtA[i:], x.ExtendedRoundRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtendedRoundRate)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.MaxExtendedRound != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExtendedRound))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.MatchedPrice) > 0 {
-			i -= len(x.MatchedPrice)
-			copy(dAtA[i:], x.MatchedPrice)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MatchedPrice)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.MinBidPrice) > 0 {
-			i -= len(x.MinBidPrice)
-			copy(dAtA[i:], x.MinBidPrice)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBidPrice)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.BaseAuction != nil {
-			encoded, err := options.Marshal(x.BaseAuction)
+		if x.ReleaseTime != nil {
+			encoded, err := options.Marshal(x.ReleaseTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2300,7 +5713,7 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BatchAuction)
+		x := input.Message.Interface().(*VestingSchedule)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2332,15 +5745,15 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2367,99 +5780,16 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BaseAuction == nil {
-					x.BaseAuction = &BaseAuction{}
+				if x.ReleaseTime == nil {
+					x.ReleaseTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAuction); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleaseTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MinBidPrice = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MatchedPrice = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
-				}
-				x.MaxExtendedRound = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxExtendedRound |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2487,7 +5817,7 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExtendedRoundRate = string(dAtA[iNdEx:postIndex])
+				x.Weight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2525,28 +5855,30 @@ func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_VestingSchedule              protoreflect.MessageDescriptor
-	fd_VestingSchedule_release_time protoreflect.FieldDescriptor
-	fd_VestingSchedule_weight       protoreflect.FieldDescriptor
+	md_LinearVesting            protoreflect.MessageDescriptor
+	fd_LinearVesting_start_time protoreflect.FieldDescriptor
+	fd_LinearVesting_end_time   protoreflect.FieldDescriptor
+	fd_LinearVesting_cliff_time protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_auction_proto_init()
-	md_VestingSchedule = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("VestingSchedule")
-	fd_VestingSchedule_release_time = md_VestingSchedule.Fields().ByName("release_time")
-	fd_VestingSchedule_weight = md_VestingSchedule.Fields().ByName("weight")
+	md_LinearVesting = File_fundraising_fundraising_v1_auction_proto.Messages().ByName("LinearVesting")
+	fd_LinearVesting_start_time = md_LinearVesting.Fields().ByName("start_time")
+	fd_LinearVesting_end_time = md_LinearVesting.Fields().ByName("end_time")
+	fd_LinearVesting_cliff_time = md_LinearVesting.Fields().ByName("cliff_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_VestingSchedule)(nil)
+var _ protoreflect.Message = (*fastReflection_LinearVesting)(nil)
 
-type fastReflection_VestingSchedule VestingSchedule
+type fastReflection_LinearVesting LinearVesting
 
-func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VestingSchedule)(x)
+func (x *LinearVesting) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinearVesting)(x)
 }
 
-func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
-	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[3]
+func (x *LinearVesting) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,43 +5889,43 @@ func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VestingSchedule_messageType fastReflection_VestingSchedule_messageType
-var _ protoreflect.MessageType = fastReflection_VestingSchedule_messageType{}
+var _fastReflection_LinearVesting_messageType fastReflection_LinearVesting_messageType
+var _ protoreflect.MessageType = fastReflection_LinearVesting_messageType{}
 
-type fastReflection_VestingSchedule_messageType struct{}
+type fastReflection_LinearVesting_messageType struct{}
 
-func (x fastReflection_VestingSchedule_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VestingSchedule)(nil)
+func (x fastReflection_LinearVesting_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinearVesting)(nil)
 }
-func (x fastReflection_VestingSchedule_messageType) New() protoreflect.Message {
-	return new(fastReflection_VestingSchedule)
+func (x fastReflection_LinearVesting_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinearVesting)
 }
-func (x fastReflection_VestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VestingSchedule
+func (x fastReflection_LinearVesting_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinearVesting
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VestingSchedule) Descriptor() protoreflect.MessageDescriptor {
-	return md_VestingSchedule
+func (x *fastReflection_LinearVesting) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinearVesting
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VestingSchedule) Type() protoreflect.MessageType {
-	return _fastReflection_VestingSchedule_messageType
+func (x *fastReflection_LinearVesting) Type() protoreflect.MessageType {
+	return _fastReflection_LinearVesting_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VestingSchedule) New() protoreflect.Message {
-	return new(fastReflection_VestingSchedule)
+func (x *fastReflection_LinearVesting) New() protoreflect.Message {
+	return new(fastReflection_LinearVesting)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
-	return (*VestingSchedule)(x)
+func (x *fastReflection_LinearVesting) Interface() protoreflect.ProtoMessage {
+	return (*LinearVesting)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2601,16 +5933,22 @@ func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ReleaseTime != nil {
-		value := protoreflect.ValueOfMessage(x.ReleaseTime.ProtoReflect())
-		if !f(fd_VestingSchedule_release_time, value) {
+func (x *fastReflection_LinearVesting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_LinearVesting_start_time, value) {
 			return
 		}
 	}
-	if x.Weight != "" {
-		value := protoreflect.ValueOfString(x.Weight)
-		if !f(fd_VestingSchedule_weight, value) {
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_LinearVesting_end_time, value) {
+			return
+		}
+	}
+	if x.CliffTime != nil {
+		value := protoreflect.ValueOfMessage(x.CliffTime.ProtoReflect())
+		if !f(fd_LinearVesting_cliff_time, value) {
 			return
 		}
 	}
@@ -2627,17 +5965,19 @@ func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LinearVesting) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.VestingSchedule.release_time":
-		return x.ReleaseTime != nil
-	case "fundraising.fundraising.v1.VestingSchedule.weight":
-		return x.Weight != ""
+	case "fundraising.fundraising.v1.LinearVesting.start_time":
+		return x.StartTime != nil
+	case "fundraising.fundraising.v1.LinearVesting.end_time":
+		return x.EndTime != nil
+	case "fundraising.fundraising.v1.LinearVesting.cliff_time":
+		return x.CliffTime != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.LinearVesting"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.LinearVesting does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2647,17 +5987,19 @@ func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LinearVesting) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.VestingSchedule.release_time":
-		x.ReleaseTime = nil
-	case "fundraising.fundraising.v1.VestingSchedule.weight":
-		x.Weight = ""
+	case "fundraising.fundraising.v1.LinearVesting.start_time":
+		x.StartTime = nil
+	case "fundraising.fundraising.v1.LinearVesting.end_time":
+		x.EndTime = nil
+	case "fundraising.fundraising.v1.LinearVesting.cliff_time":
+		x.CliffTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.LinearVesting"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.LinearVesting does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2667,19 +6009,22 @@ func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LinearVesting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.VestingSchedule.release_time":
-		value := x.ReleaseTime
+	case "fundraising.fundraising.v1.LinearVesting.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.LinearVesting.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.LinearVesting.cliff_time":
+		value := x.CliffTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "fundraising.fundraising.v1.VestingSchedule.weight":
-		value := x.Weight
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.LinearVesting"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.LinearVesting does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2693,17 +6038,19 @@ func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LinearVesting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.VestingSchedule.release_time":
-		x.ReleaseTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "fundraising.fundraising.v1.VestingSchedule.weight":
-		x.Weight = value.Interface().(string)
+	case "fundraising.fundraising.v1.LinearVesting.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fundraising.fundraising.v1.LinearVesting.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fundraising.fundraising.v1.LinearVesting.cliff_time":
+		x.CliffTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.LinearVesting"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.LinearVesting does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2717,48 +6064,60 @@ func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LinearVesting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.VestingSchedule.release_time":
-		if x.ReleaseTime == nil {
-			x.ReleaseTime = new(timestamppb.Timestamp)
+	case "fundraising.fundraising.v1.LinearVesting.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.ReleaseTime.ProtoReflect())
-	case "fundraising.fundraising.v1.VestingSchedule.weight":
-		panic(fmt.Errorf("field weight of message fundraising.fundraising.v1.VestingSchedule is not mutable"))
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "fundraising.fundraising.v1.LinearVesting.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "fundraising.fundraising.v1.LinearVesting.cliff_time":
+		if x.CliffTime == nil {
+			x.CliffTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CliffTime.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.LinearVesting"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.LinearVesting does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LinearVesting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.VestingSchedule.release_time":
+	case "fundraising.fundraising.v1.LinearVesting.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.LinearVesting.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.LinearVesting.cliff_time":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "fundraising.fundraising.v1.VestingSchedule.weight":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.LinearVesting"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.LinearVesting does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LinearVesting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.VestingSchedule", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.LinearVesting", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2766,7 +6125,7 @@ func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LinearVesting) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2777,7 +6136,7 @@ func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LinearVesting) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2789,7 +6148,7 @@ func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VestingSchedule) IsValid() bool {
+func (x *fastReflection_LinearVesting) IsValid() bool {
 	return x != nil
 }
 
@@ -2799,9 +6158,9 @@ func (x *fastReflection_VestingSchedule) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LinearVesting) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VestingSchedule)
+		x := input.Message.Interface().(*LinearVesting)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2813,12 +6172,16 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ReleaseTime != nil {
-			l = options.Size(x.ReleaseTime)
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Weight)
-		if l > 0 {
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CliffTime != nil {
+			l = options.Size(x.CliffTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2831,7 +6194,7 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VestingSchedule)
+		x := input.Message.Interface().(*LinearVesting)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2850,15 +6213,36 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Weight) > 0 {
-			i -= len(x.Weight)
-			copy(dAtA[i:], x.Weight)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+		if x.CliffTime != nil {
+			encoded, err := options.Marshal(x.CliffTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ReleaseTime != nil {
-			encoded, err := options.Marshal(x.ReleaseTime)
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2882,7 +6266,7 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VestingSchedule)
+		x := input.Message.Interface().(*LinearVesting)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2914,15 +6298,15 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinearVesting: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinearVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2949,18 +6333,18 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ReleaseTime == nil {
-					x.ReleaseTime = &timestamppb.Timestamp{}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleaseTime); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2970,23 +6354,63 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Weight = string(dAtA[iNdEx:postIndex])
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CliffTime == nil {
+					x.CliffTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CliffTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3046,6 +6470,8 @@ const (
 	AuctionType_AUCTION_TYPE_FIXED_PRICE AuctionType = 1
 	// AUCTION_TYPE_BATCH defines the batch auction type
 	AuctionType_AUCTION_TYPE_BATCH AuctionType = 2
+	// AUCTION_TYPE_DUTCH defines the dutch auction type
+	AuctionType_AUCTION_TYPE_DUTCH AuctionType = 3
 )
 
 // Enum value maps for AuctionType.
@@ -3054,11 +6480,13 @@ var (
 		0: "AUCTION_TYPE_UNSPECIFIED",
 		1: "AUCTION_TYPE_FIXED_PRICE",
 		2: "AUCTION_TYPE_BATCH",
+		3: "AUCTION_TYPE_DUTCH",
 	}
 	AuctionType_value = map[string]int32{
 		"AUCTION_TYPE_UNSPECIFIED": 0,
 		"AUCTION_TYPE_FIXED_PRICE": 1,
 		"AUCTION_TYPE_BATCH":       2,
+		"AUCTION_TYPE_DUTCH":       3,
 	}
 )
 
@@ -3089,6 +6517,165 @@ func (AuctionType) EnumDescriptor() ([]byte, []int) {
 	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{0}
 }
 
+// MarginalFillMode enumerates the ways to fill the bids at the matched price of
+// a batch auction when their demand exceeds the remaining selling coin.
+type MarginalFillMode int32
+
+const (
+	// MARGINAL_FILL_MODE_UNSPECIFIED doesn't fill the bids at the price partially,
+	// so the auction is matched at a higher price instead
+	MarginalFillMode_MARGINAL_FILL_MODE_UNSPECIFIED MarginalFillMode = 0
+	// MARGINAL_FILL_MODE_PRO_RATA fills the bids at the price pro rata to their
+	// demand
+	MarginalFillMode_MARGINAL_FILL_MODE_PRO_RATA MarginalFillMode = 1
+	// MARGINAL_FILL_MODE_BID_ID fills the bids at the price in the order of the
+	// bid id
+	MarginalFillMode_MARGINAL_FILL_MODE_BID_ID MarginalFillMode = 2
+)
+
+// Enum value maps for MarginalFillMode.
+var (
+	MarginalFillMode_name = map[int32]string{
+		0: "MARGINAL_FILL_MODE_UNSPECIFIED",
+		1: "MARGINAL_FILL_MODE_PRO_RATA",
+		2: "MARGINAL_FILL_MODE_BID_ID",
+	}
+	MarginalFillMode_value = map[string]int32{
+		"MARGINAL_FILL_MODE_UNSPECIFIED": 0,
+		"MARGINAL_FILL_MODE_PRO_RATA":    1,
+		"MARGINAL_FILL_MODE_BID_ID":      2,
+	}
+)
+
+func (x MarginalFillMode) Enum() *MarginalFillMode {
+	p := new(MarginalFillMode)
+	*p = x
+	return p
+}
+
+func (x MarginalFillMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarginalFillMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_fundraising_fundraising_v1_auction_proto_enumTypes[1].Descriptor()
+}
+
+func (MarginalFillMode) Type() protoreflect.EnumType {
+	return &file_fundraising_fundraising_v1_auction_proto_enumTypes[1]
+}
+
+func (x MarginalFillMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarginalFillMode.Descriptor instead.
+func (MarginalFillMode) EnumDescriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{1}
+}
+
+// PricingMode enumerates the ways to price the matched bids of a batch auction.
+type PricingMode int32
+
+const (
+	// PRICING_MODE_UNSPECIFIED makes every matched bid pay the matched price
+	PricingMode_PRICING_MODE_UNSPECIFIED PricingMode = 0
+	// PRICING_MODE_DISCRIMINATORY makes every matched bid pay its own bid price
+	PricingMode_PRICING_MODE_DISCRIMINATORY PricingMode = 1
+)
+
+// Enum value maps for PricingMode.
+var (
+	PricingMode_name = map[int32]string{
+		0: "PRICING_MODE_UNSPECIFIED",
+		1: "PRICING_MODE_DISCRIMINATORY",
+	}
+	PricingMode_value = map[string]int32{
+		"PRICING_MODE_UNSPECIFIED":    0,
+		"PRICING_MODE_DISCRIMINATORY": 1,
+	}
+)
+
+func (x PricingMode) Enum() *PricingMode {
+	p := new(PricingMode)
+	*p = x
+	return p
+}
+
+func (x PricingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PricingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_fundraising_fundraising_v1_auction_proto_enumTypes[2].Descriptor()
+}
+
+func (PricingMode) Type() protoreflect.EnumType {
+	return &file_fundraising_fundraising_v1_auction_proto_enumTypes[2]
+}
+
+func (x PricingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PricingMode.Descriptor instead.
+func (PricingMode) EnumDescriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{2}
+}
+
+// SaleMode enumerates the ways to allocate the selling coin of a fixed price
+// auction.
+type SaleMode int32
+
+const (
+	// SALE_MODE_UNSPECIFIED fills the bids in the order they are placed until
+	// the selling coin runs out
+	SaleMode_SALE_MODE_UNSPECIFIED SaleMode = 0
+	// SALE_MODE_OVERFLOW accepts bids for any amount and allocates the selling
+	// coin pro rata to the bid amounts when the auction ends, refunding the
+	// excess paying coin
+	SaleMode_SALE_MODE_OVERFLOW SaleMode = 1
+)
+
+// Enum value maps for SaleMode.
+var (
+	SaleMode_name = map[int32]string{
+		0: "SALE_MODE_UNSPECIFIED",
+		1: "SALE_MODE_OVERFLOW",
+	}
+	SaleMode_value = map[string]int32{
+		"SALE_MODE_UNSPECIFIED": 0,
+		"SALE_MODE_OVERFLOW":    1,
+	}
+)
+
+func (x SaleMode) Enum() *SaleMode {
+	p := new(SaleMode)
+	*p = x
+	return p
+}
+
+func (x SaleMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SaleMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_fundraising_fundraising_v1_auction_proto_enumTypes[3].Descriptor()
+}
+
+func (SaleMode) Type() protoreflect.EnumType {
+	return &file_fundraising_fundraising_v1_auction_proto_enumTypes[3]
+}
+
+func (x SaleMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SaleMode.Descriptor instead.
+func (SaleMode) EnumDescriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{3}
+}
+
 // AuctionStatus enumerates the valid status of an auction.
 type AuctionStatus int32
 
@@ -3105,6 +6692,13 @@ const (
 	AuctionStatus_AUCTION_STATUS_FINISHED AuctionStatus = 4
 	// AUCTION_STATUS_CANCELLED defines the cancelled auction status
 	AuctionStatus_AUCTION_STATUS_CANCELLED AuctionStatus = 5
+	// AUCTION_STATUS_PAUSED defines the paused auction status
+	AuctionStatus_AUCTION_STATUS_PAUSED AuctionStatus = 6
+	// AUCTION_STATUS_FAILED defines the failed auction status
+	AuctionStatus_AUCTION_STATUS_FAILED AuctionStatus = 7
+	// AUCTION_STATUS_REVEALING defines the status of a sealed-bid auction whose
+	// committed bids are being revealed
+	AuctionStatus_AUCTION_STATUS_REVEALING AuctionStatus = 8
 )
 
 // Enum value maps for AuctionStatus.
@@ -3116,6 +6710,9 @@ var (
 		3: "AUCTION_STATUS_VESTING",
 		4: "AUCTION_STATUS_FINISHED",
 		5: "AUCTION_STATUS_CANCELLED",
+		6: "AUCTION_STATUS_PAUSED",
+		7: "AUCTION_STATUS_FAILED",
+		8: "AUCTION_STATUS_REVEALING",
 	}
 	AuctionStatus_value = map[string]int32{
 		"AUCTION_STATUS_UNSPECIFIED": 0,
@@ -3124,6 +6721,9 @@ var (
 		"AUCTION_STATUS_VESTING":     3,
 		"AUCTION_STATUS_FINISHED":    4,
 		"AUCTION_STATUS_CANCELLED":   5,
+		"AUCTION_STATUS_PAUSED":      6,
+		"AUCTION_STATUS_FAILED":      7,
+		"AUCTION_STATUS_REVEALING":   8,
 	}
 )
 
@@ -3138,11 +6738,11 @@ func (x AuctionStatus) String() string {
 }
 
 func (AuctionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_fundraising_fundraising_v1_auction_proto_enumTypes[1].Descriptor()
+	return file_fundraising_fundraising_v1_auction_proto_enumTypes[4].Descriptor()
 }
 
 func (AuctionStatus) Type() protoreflect.EnumType {
-	return &file_fundraising_fundraising_v1_auction_proto_enumTypes[1]
+	return &file_fundraising_fundraising_v1_auction_proto_enumTypes[4]
 }
 
 func (x AuctionStatus) Number() protoreflect.EnumNumber {
@@ -3151,7 +6751,7 @@ func (x AuctionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuctionStatus.Descriptor instead.
 func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{1}
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{4}
 }
 
 // BaseAuction defines a base auction type. It contains all the necessary fields
@@ -3195,6 +6795,40 @@ type BaseAuction struct {
 	EndTimes []*timestamppb.Timestamp `protobuf:"bytes,12,rep,name=end_times,json=endTimes,proto3" json:"end_times,omitempty"`
 	// status specifies the auction status
 	Status AuctionStatus `protobuf:"varint,13,opt,name=status,proto3,enum=fundraising.fundraising.v1.AuctionStatus" json:"status,omitempty"`
+	// paused_time specifies the time when the auction is paused
+	// it is only set while the auction is paused
+	PausedTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=paused_time,json=pausedTime,proto3" json:"paused_time,omitempty"`
+	// bid_fee specifies the fee that a bidder pays to the auctioneer for each
+	// bid placed; it is optional
+	BidFee []*v1beta1.Coin `protobuf:"bytes,15,rep,name=bid_fee,json=bidFee,proto3" json:"bid_fee,omitempty"`
+	// soft_cap specifies the minimum total paying amount that the auction must
+	// raise; the auction fails and all the coins are refunded otherwise
+	SoftCap string `protobuf:"bytes,16,opt,name=soft_cap,json=softCap,proto3" json:"soft_cap,omitempty"`
+	// buyer_vesting_schedules specifies the vesting schedules of the selling
+	// coin allocated to the bidders; the selling coin is sent to the bidders
+	// directly when it is empty
+	BuyerVestingSchedules []*VestingSchedule `protobuf:"bytes,17,rep,name=buyer_vesting_schedules,json=buyerVestingSchedules,proto3" json:"buyer_vesting_schedules,omitempty"`
+	// linear_vesting specifies the continuous vesting of the paying coin; it is
+	// mutually exclusive with vesting_schedules
+	LinearVesting *LinearVesting `protobuf:"bytes,18,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+	// auto_release specifies whether the vested paying coin is released to the
+	// auctioneer by the begin blocker; otherwise it is claimed by the auctioneer
+	// with MsgClaimVestedProceeds
+	AutoRelease bool `protobuf:"varint,19,opt,name=auto_release,json=autoRelease,proto3" json:"auto_release,omitempty"`
+	// beneficiary specifies the bech32-encoded address that receives the paying
+	// coin and the unsold selling coin of the auction; it is the auctioneer when
+	// it is empty
+	Beneficiary string `protobuf:"bytes,20,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// pending_owner specifies the bech32-encoded address that is proposed to
+	// take over the auction and is yet to accept the ownership
+	PendingOwner string `protobuf:"bytes,21,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// pending_beneficiary specifies the bech32-encoded beneficiary that is set
+	// when the pending owner accepts the ownership
+	PendingBeneficiary string `protobuf:"bytes,22,opt,name=pending_beneficiary,json=pendingBeneficiary,proto3" json:"pending_beneficiary,omitempty"`
+	// additional_paying_denoms specifies the denoms that bidders can pay with
+	// besides the paying coin denom; the bids in them are matched in the paying
+	// coin denom by their conversion ratios
+	AdditionalPayingDenoms []*PayingDenom `protobuf:"bytes,23,rep,name=additional_paying_denoms,json=additionalPayingDenoms,proto3" json:"additional_paying_denoms,omitempty"`
 }
 
 func (x *BaseAuction) Reset() {
@@ -3217,95 +6851,213 @@ func (*BaseAuction) Descriptor() ([]byte, []int) {
 	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *BaseAuction) GetId() uint64 {
+func (x *BaseAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BaseAuction) GetType_() AuctionType {
+	if x != nil {
+		return x.Type_
+	}
+	return AuctionType_AUCTION_TYPE_UNSPECIFIED
+}
+
+func (x *BaseAuction) GetAuctioneer() string {
+	if x != nil {
+		return x.Auctioneer
+	}
+	return ""
+}
+
+func (x *BaseAuction) GetSellingReserveAddress() string {
+	if x != nil {
+		return x.SellingReserveAddress
+	}
+	return ""
+}
+
+func (x *BaseAuction) GetPayingReserveAddress() string {
+	if x != nil {
+		return x.PayingReserveAddress
+	}
+	return ""
+}
+
+func (x *BaseAuction) GetStartPrice() string {
+	if x != nil {
+		return x.StartPrice
+	}
+	return ""
+}
+
+func (x *BaseAuction) GetSellingCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.SellingCoin
+	}
+	return nil
+}
+
+func (x *BaseAuction) GetPayingCoinDenom() string {
+	if x != nil {
+		return x.PayingCoinDenom
+	}
+	return ""
+}
+
+func (x *BaseAuction) GetVestingReserveAddress() string {
+	if x != nil {
+		return x.VestingReserveAddress
+	}
+	return ""
+}
+
+func (x *BaseAuction) GetVestingSchedules() []*VestingSchedule {
+	if x != nil {
+		return x.VestingSchedules
+	}
+	return nil
+}
+
+func (x *BaseAuction) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BaseAuction) GetEndTimes() []*timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.EndTimes
 	}
-	return 0
+	return nil
 }
 
-func (x *BaseAuction) GetType_() AuctionType {
+func (x *BaseAuction) GetStatus() AuctionStatus {
 	if x != nil {
-		return x.Type_
+		return x.Status
 	}
-	return AuctionType_AUCTION_TYPE_UNSPECIFIED
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
 }
 
-func (x *BaseAuction) GetAuctioneer() string {
+func (x *BaseAuction) GetPausedTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Auctioneer
+		return x.PausedTime
 	}
-	return ""
+	return nil
 }
 
-func (x *BaseAuction) GetSellingReserveAddress() string {
+func (x *BaseAuction) GetBidFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.SellingReserveAddress
+		return x.BidFee
 	}
-	return ""
+	return nil
 }
 
-func (x *BaseAuction) GetPayingReserveAddress() string {
+func (x *BaseAuction) GetSoftCap() string {
 	if x != nil {
-		return x.PayingReserveAddress
+		return x.SoftCap
 	}
 	return ""
 }
 
-func (x *BaseAuction) GetStartPrice() string {
+func (x *BaseAuction) GetBuyerVestingSchedules() []*VestingSchedule {
 	if x != nil {
-		return x.StartPrice
+		return x.BuyerVestingSchedules
 	}
-	return ""
+	return nil
 }
 
-func (x *BaseAuction) GetSellingCoin() *v1beta1.Coin {
+func (x *BaseAuction) GetLinearVesting() *LinearVesting {
 	if x != nil {
-		return x.SellingCoin
+		return x.LinearVesting
 	}
 	return nil
 }
 
-func (x *BaseAuction) GetPayingCoinDenom() string {
+func (x *BaseAuction) GetAutoRelease() bool {
 	if x != nil {
-		return x.PayingCoinDenom
+		return x.AutoRelease
+	}
+	return false
+}
+
+func (x *BaseAuction) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
 	}
 	return ""
 }
 
-func (x *BaseAuction) GetVestingReserveAddress() string {
+func (x *BaseAuction) GetPendingOwner() string {
 	if x != nil {
-		return x.VestingReserveAddress
+		return x.PendingOwner
 	}
 	return ""
 }
 
-func (x *BaseAuction) GetVestingSchedules() []*VestingSchedule {
+func (x *BaseAuction) GetPendingBeneficiary() string {
 	if x != nil {
-		return x.VestingSchedules
+		return x.PendingBeneficiary
 	}
-	return nil
+	return ""
 }
 
-func (x *BaseAuction) GetStartTime() *timestamppb.Timestamp {
+func (x *BaseAuction) GetAdditionalPayingDenoms() []*PayingDenom {
 	if x != nil {
-		return x.StartTime
+		return x.AdditionalPayingDenoms
 	}
 	return nil
 }
 
-func (x *BaseAuction) GetEndTimes() []*timestamppb.Timestamp {
+// PayingDenom defines a denom that an auction accepts in addition to its
+// paying coin denom.
+type PayingDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom specifies the denom of the paying coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ratio specifies the amount of the paying coin denom that one unit of the
+	// denom is worth
+	Ratio string `protobuf:"bytes,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (x *PayingDenom) Reset() {
+	*x = PayingDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayingDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayingDenom) ProtoMessage() {}
+
+// Deprecated: Use PayingDenom.ProtoReflect.Descriptor instead.
+func (*PayingDenom) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayingDenom) GetDenom() string {
 	if x != nil {
-		return x.EndTimes
+		return x.Denom
 	}
-	return nil
+	return ""
 }
 
-func (x *BaseAuction) GetStatus() AuctionStatus {
+func (x *PayingDenom) GetRatio() string {
 	if x != nil {
-		return x.Status
+		return x.Ratio
 	}
-	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
+	return ""
 }
 
 // FixedPriceAuction defines the fixed price auction type. It is the most
@@ -3319,12 +7071,14 @@ type FixedPriceAuction struct {
 	BaseAuction *BaseAuction `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3" json:"base_auction,omitempty"`
 	// remaining_coin specifies the remaining amount of selling coin to sell
 	RemainingSellingCoin *v1beta1.Coin `protobuf:"bytes,2,opt,name=remaining_selling_coin,json=remainingSellingCoin,proto3" json:"remaining_selling_coin,omitempty"`
+	// sale_mode specifies how the selling coin is allocated to the bidders
+	SaleMode SaleMode `protobuf:"varint,3,opt,name=sale_mode,json=saleMode,proto3,enum=fundraising.fundraising.v1.SaleMode" json:"sale_mode,omitempty"`
 }
 
 func (x *FixedPriceAuction) Reset() {
 	*x = FixedPriceAuction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[1]
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3338,7 +7092,7 @@ func (*FixedPriceAuction) ProtoMessage() {}
 
 // Deprecated: Use FixedPriceAuction.ProtoReflect.Descriptor instead.
 func (*FixedPriceAuction) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{1}
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FixedPriceAuction) GetBaseAuction() *BaseAuction {
@@ -3355,6 +7109,13 @@ func (x *FixedPriceAuction) GetRemainingSellingCoin() *v1beta1.Coin {
 	return nil
 }
 
+func (x *FixedPriceAuction) GetSaleMode() SaleMode {
+	if x != nil {
+		return x.SaleMode
+	}
+	return SaleMode_SALE_MODE_UNSPECIFIED
+}
+
 // BatchAuction defines a batch auction type. It allows bidders to participate
 // in the auction by placing their limit orders with a bid price they are
 // willing to bid within the auction period. They can place multiple bids with
@@ -3376,12 +7137,23 @@ type BatchAuction struct {
 	// extended_round_rate specifies the rate that decides if the auction needs
 	// another round
 	ExtendedRoundRate string `protobuf:"bytes,5,opt,name=extended_round_rate,json=extendedRoundRate,proto3" json:"extended_round_rate,omitempty"`
+	// bid_cancel_cutoff specifies the period before the end time in which
+	// bidders are not allowed to cancel their bids
+	BidCancelCutoff *durationpb.Duration `protobuf:"bytes,6,opt,name=bid_cancel_cutoff,json=bidCancelCutoff,proto3" json:"bid_cancel_cutoff,omitempty"`
+	// marginal_fill_mode specifies how the bids at the matched price are filled
+	// when their demand exceeds the remaining selling coin
+	MarginalFillMode MarginalFillMode `protobuf:"varint,7,opt,name=marginal_fill_mode,json=marginalFillMode,proto3,enum=fundraising.fundraising.v1.MarginalFillMode" json:"marginal_fill_mode,omitempty"`
+	// pricing_mode specifies the price that the matched bids pay
+	PricingMode PricingMode `protobuf:"varint,8,opt,name=pricing_mode,json=pricingMode,proto3,enum=fundraising.fundraising.v1.PricingMode" json:"pricing_mode,omitempty"`
+	// sealed_bid_config makes the auction accept only sealed bids that are
+	// revealed after the end time; the bids are public if it is not set
+	SealedBidConfig *SealedBidConfig `protobuf:"bytes,9,opt,name=sealed_bid_config,json=sealedBidConfig,proto3" json:"sealed_bid_config,omitempty"`
 }
 
 func (x *BatchAuction) Reset() {
 	*x = BatchAuction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[2]
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3395,7 +7167,7 @@ func (*BatchAuction) ProtoMessage() {}
 
 // Deprecated: Use BatchAuction.ProtoReflect.Descriptor instead.
 func (*BatchAuction) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{2}
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BatchAuction) GetBaseAuction() *BaseAuction {
@@ -3433,6 +7205,151 @@ func (x *BatchAuction) GetExtendedRoundRate() string {
 	return ""
 }
 
+func (x *BatchAuction) GetBidCancelCutoff() *durationpb.Duration {
+	if x != nil {
+		return x.BidCancelCutoff
+	}
+	return nil
+}
+
+func (x *BatchAuction) GetMarginalFillMode() MarginalFillMode {
+	if x != nil {
+		return x.MarginalFillMode
+	}
+	return MarginalFillMode_MARGINAL_FILL_MODE_UNSPECIFIED
+}
+
+func (x *BatchAuction) GetPricingMode() PricingMode {
+	if x != nil {
+		return x.PricingMode
+	}
+	return PricingMode_PRICING_MODE_UNSPECIFIED
+}
+
+func (x *BatchAuction) GetSealedBidConfig() *SealedBidConfig {
+	if x != nil {
+		return x.SealedBidConfig
+	}
+	return nil
+}
+
+// SealedBidConfig defines the reveal phase of a sealed-bid batch auction.
+type SealedBidConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reveal_period specifies the duration after the end time during which the
+	// committed bids can be revealed
+	RevealPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=reveal_period,json=revealPeriod,proto3" json:"reveal_period,omitempty"`
+	// forfeit_unrevealed specifies whether the collateral of the commitments that
+	// are not revealed goes to the beneficiary instead of being refunded
+	ForfeitUnrevealed bool `protobuf:"varint,2,opt,name=forfeit_unrevealed,json=forfeitUnrevealed,proto3" json:"forfeit_unrevealed,omitempty"`
+}
+
+func (x *SealedBidConfig) Reset() {
+	*x = SealedBidConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedBidConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedBidConfig) ProtoMessage() {}
+
+// Deprecated: Use SealedBidConfig.ProtoReflect.Descriptor instead.
+func (*SealedBidConfig) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SealedBidConfig) GetRevealPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.RevealPeriod
+	}
+	return nil
+}
+
+func (x *SealedBidConfig) GetForfeitUnrevealed() bool {
+	if x != nil {
+		return x.ForfeitUnrevealed
+	}
+	return false
+}
+
+// DutchAuction defines a descending price auction type. The price starts at
+// the start price and decays towards the floor price over the auction period.
+// A bid is filled instantly at the current price as long as the bidder is
+// willing to pay it and there is remaining selling coin to sell.
+type DutchAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAuction *BaseAuction `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3" json:"base_auction,omitempty"`
+	// floor_price specifies the lowest price that the auction price decays to
+	FloorPrice string `protobuf:"bytes,2,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	// decay_interval specifies the interval between price drops.
+	// The price decays linearly when it is zero; otherwise, it drops stepwise
+	// at every interval.
+	DecayInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=decay_interval,json=decayInterval,proto3" json:"decay_interval,omitempty"`
+	// remaining_selling_coin specifies the remaining amount of selling coin to
+	// sell
+	RemainingSellingCoin *v1beta1.Coin `protobuf:"bytes,4,opt,name=remaining_selling_coin,json=remainingSellingCoin,proto3" json:"remaining_selling_coin,omitempty"`
+}
+
+func (x *DutchAuction) Reset() {
+	*x = DutchAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DutchAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DutchAuction) ProtoMessage() {}
+
+// Deprecated: Use DutchAuction.ProtoReflect.Descriptor instead.
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DutchAuction) GetBaseAuction() *BaseAuction {
+	if x != nil {
+		return x.BaseAuction
+	}
+	return nil
+}
+
+func (x *DutchAuction) GetFloorPrice() string {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return ""
+}
+
+func (x *DutchAuction) GetDecayInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DecayInterval
+	}
+	return nil
+}
+
+func (x *DutchAuction) GetRemainingSellingCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.RemainingSellingCoin
+	}
+	return nil
+}
+
 // VestingSchedule defines the vesting schedule for the owner of an auction.
 type VestingSchedule struct {
 	state         protoimpl.MessageState
@@ -3448,7 +7365,7 @@ type VestingSchedule struct {
 func (x *VestingSchedule) Reset() {
 	*x = VestingSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[3]
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3462,7 +7379,7 @@ func (*VestingSchedule) ProtoMessage() {}
 
 // Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
 func (*VestingSchedule) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{3}
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *VestingSchedule) GetReleaseTime() *timestamppb.Timestamp {
@@ -3479,6 +7396,64 @@ func (x *VestingSchedule) GetWeight() string {
 	return ""
 }
 
+// LinearVesting defines the continuous vesting of the paying coin. The paying
+// coin vests linearly from start_time to end_time and nothing is released
+// before cliff_time.
+type LinearVesting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_time specifies the time at which the paying coin starts vesting
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time specifies the time at which all the paying coin is vested
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// cliff_time specifies the time before which nothing is released; it is
+	// optional
+	CliffTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+}
+
+func (x *LinearVesting) Reset() {
+	*x = LinearVesting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_auction_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinearVesting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearVesting) ProtoMessage() {}
+
+// Deprecated: Use LinearVesting.ProtoReflect.Descriptor instead.
+func (*LinearVesting) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LinearVesting) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LinearVesting) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *LinearVesting) GetCliffTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CliffTime
+	}
+	return nil
+}
+
 var File_fundraising_fundraising_v1_auction_proto protoreflect.FileDescriptor
 
 var file_fundraising_fundraising_v1_auction_proto_rawDesc = []byte{
@@ -3492,9 +7467,11 @@ var file_fundraising_fundraising_v1_auction_proto_rawDesc = []byte{
 	0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x06, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x0c, 0x0a, 0x0b,
 	0x42, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64,
@@ -3547,93 +7524,259 @@ var file_fundraising_fundraising_v1_auction_proto_rawDesc = []byte{
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66,
 	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
 	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a,
-	0x10, 0x88, 0xa0, 0x1f, 0x00, 0xca, 0xb4, 0x2d, 0x08, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x22, 0xee, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
-	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xd0, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x62, 0x61,
-	0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x16, 0x72, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x2f, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x27, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x69, 0x6e, 0x3a, 0x04, 0x88, 0xa0,
-	0x1f, 0x00, 0x22, 0xa6, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x41, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x7a, 0x0a, 0x07, 0x62, 0x69, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x62, 0x69, 0x64, 0x46, 0x65, 0x65, 0x12, 0x46,
+	0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x73,
+	0x6f, 0x66, 0x74, 0x43, 0x61, 0x70, 0x12, 0x69, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x50, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x75, 0x6e, 0x64,
 	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x04, 0xd0, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x69, 0x64,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x67,
+	0x0a, 0x18, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x3a, 0x10, 0x88, 0xa0, 0x1f, 0x00, 0xca, 0xb4, 0x2d,
+	0x08, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x22, 0x6c, 0x0a, 0x0b, 0x50, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x47,
+	0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xb1, 0x02, 0x0a, 0x11, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a,
+	0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xd0, 0xde,
+	0x1f, 0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x80, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x2f, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x14, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x69, 0x6e, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x61, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x73, 0x61, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xf8, 0x05, 0x0a, 0x0c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0c,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xd0, 0xde, 0x1f,
+	0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x69, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
 	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x74, 0x65,
-	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x10, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x61, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa5, 0x01, 0x0a, 0x0f,
-	0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
-	0x47, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x61, 0x0a, 0x13, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x2a, 0xac, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x4e, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43,
-	0x45, 0x10, 0x01, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c,
-	0x0a, 0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
-	0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x04, 0x88, 0xa3,
-	0x1e, 0x00, 0x2a, 0xdf, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x1a, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x69, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x55,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41,
-	0x4e, 0x44, 0x42, 0x59, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x42, 0x79,
-	0x12, 0x34, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x18, 0x8a, 0x9d,
-	0x20, 0x14, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47,
-	0x10, 0x03, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17,
-	0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
-	0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x41,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
-	0x10, 0x05, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x1a, 0x04,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4f,
+	0x0a, 0x11, 0x62, 0x69, 0x64, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x75, 0x74,
+	0x6f, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f,
+	0x62, 0x69, 0x64, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x12,
+	0x5a, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x6c,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x46, 0x69, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x57, 0x0a, 0x11, 0x73, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0f, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x42, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0d, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x5f,
+	0x75, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x65, 0x64, 0x22, 0x89, 0x03, 0x0a, 0x0c, 0x44, 0x75, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e,
+	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0xd0, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x64, 0x65,
+	0x63, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x79, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x80, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x2f, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x69, 0x6e, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22,
+	0xa5, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x54, 0x69, 0x6d, 0x65, 0x2a, 0xda, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x10, 0x01, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a,
+	0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x55,
+	0x54, 0x43, 0x48, 0x10, 0x03, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x75, 0x74, 0x63, 0x68, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x2a, 0xce, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x46, 0x69,
+	0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x1e, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e,
+	0x41, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x4e, 0x6f, 0x6e, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x41, 0x4c,
+	0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x5f, 0x52,
+	0x41, 0x54, 0x41, 0x10, 0x01, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x52, 0x61,
+	0x74, 0x61, 0x12, 0x38, 0x0a, 0x19, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x46,
+	0x49, 0x4c, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10,
+	0x02, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x46,
+	0x69, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x69, 0x64, 0x49, 0x64, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0x89, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x34, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x3e, 0x0a, 0x1b, 0x50, 0x52, 0x49, 0x43,
+	0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x52, 0x49, 0x4d,
+	0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x1a, 0x1d, 0x8a, 0x9d, 0x20, 0x19, 0x50,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x73, 0x63, 0x72, 0x69,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x70,
+	0x0a, 0x08, 0x53, 0x61, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x15, 0x53, 0x41,
+	0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x53, 0x61, 0x6c, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x53, 0x41, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c,
+	0x4f, 0x57, 0x10, 0x01, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x53, 0x61, 0x6c, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x2a, 0x81, 0x04, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x34, 0x0a, 0x1a, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x69, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44,
+	0x42, 0x59, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x12, 0x34,
+	0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03,
+	0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x41, 0x55,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e,
+	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15,
+	0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x06, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x15, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x1a, 0x17, 0x8a, 0x9d, 0x20,
+	0x13, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x49, 0x4e, 0x47,
+	0x10, 0x08, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x1a, 0x04,
 	0x88, 0xa3, 0x1e, 0x00, 0x42, 0x88, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e,
 	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
 	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
@@ -3666,34 +7809,59 @@ func file_fundraising_fundraising_v1_auction_proto_rawDescGZIP() []byte {
 	return file_fundraising_fundraising_v1_auction_proto_rawDescData
 }
 
-var file_fundraising_fundraising_v1_auction_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_fundraising_fundraising_v1_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_fundraising_fundraising_v1_auction_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_fundraising_fundraising_v1_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_fundraising_fundraising_v1_auction_proto_goTypes = []interface{}{
 	(AuctionType)(0),              // 0: fundraising.fundraising.v1.AuctionType
-	(AuctionStatus)(0),            // 1: fundraising.fundraising.v1.AuctionStatus
-	(*BaseAuction)(nil),           // 2: fundraising.fundraising.v1.BaseAuction
-	(*FixedPriceAuction)(nil),     // 3: fundraising.fundraising.v1.FixedPriceAuction
-	(*BatchAuction)(nil),          // 4: fundraising.fundraising.v1.BatchAuction
-	(*VestingSchedule)(nil),       // 5: fundraising.fundraising.v1.VestingSchedule
-	(*v1beta1.Coin)(nil),          // 6: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(MarginalFillMode)(0),         // 1: fundraising.fundraising.v1.MarginalFillMode
+	(PricingMode)(0),              // 2: fundraising.fundraising.v1.PricingMode
+	(SaleMode)(0),                 // 3: fundraising.fundraising.v1.SaleMode
+	(AuctionStatus)(0),            // 4: fundraising.fundraising.v1.AuctionStatus
+	(*BaseAuction)(nil),           // 5: fundraising.fundraising.v1.BaseAuction
+	(*PayingDenom)(nil),           // 6: fundraising.fundraising.v1.PayingDenom
+	(*FixedPriceAuction)(nil),     // 7: fundraising.fundraising.v1.FixedPriceAuction
+	(*BatchAuction)(nil),          // 8: fundraising.fundraising.v1.BatchAuction
+	(*SealedBidConfig)(nil),       // 9: fundraising.fundraising.v1.SealedBidConfig
+	(*DutchAuction)(nil),          // 10: fundraising.fundraising.v1.DutchAuction
+	(*VestingSchedule)(nil),       // 11: fundraising.fundraising.v1.VestingSchedule
+	(*LinearVesting)(nil),         // 12: fundraising.fundraising.v1.LinearVesting
+	(*v1beta1.Coin)(nil),          // 13: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
 }
 var file_fundraising_fundraising_v1_auction_proto_depIdxs = []int32{
 	0,  // 0: fundraising.fundraising.v1.BaseAuction.type:type_name -> fundraising.fundraising.v1.AuctionType
-	6,  // 1: fundraising.fundraising.v1.BaseAuction.selling_coin:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 2: fundraising.fundraising.v1.BaseAuction.vesting_schedules:type_name -> fundraising.fundraising.v1.VestingSchedule
-	7,  // 3: fundraising.fundraising.v1.BaseAuction.start_time:type_name -> google.protobuf.Timestamp
-	7,  // 4: fundraising.fundraising.v1.BaseAuction.end_times:type_name -> google.protobuf.Timestamp
-	1,  // 5: fundraising.fundraising.v1.BaseAuction.status:type_name -> fundraising.fundraising.v1.AuctionStatus
-	2,  // 6: fundraising.fundraising.v1.FixedPriceAuction.base_auction:type_name -> fundraising.fundraising.v1.BaseAuction
-	6,  // 7: fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin:type_name -> cosmos.base.v1beta1.Coin
-	2,  // 8: fundraising.fundraising.v1.BatchAuction.base_auction:type_name -> fundraising.fundraising.v1.BaseAuction
-	7,  // 9: fundraising.fundraising.v1.VestingSchedule.release_time:type_name -> google.protobuf.Timestamp
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	13, // 1: fundraising.fundraising.v1.BaseAuction.selling_coin:type_name -> cosmos.base.v1beta1.Coin
+	11, // 2: fundraising.fundraising.v1.BaseAuction.vesting_schedules:type_name -> fundraising.fundraising.v1.VestingSchedule
+	14, // 3: fundraising.fundraising.v1.BaseAuction.start_time:type_name -> google.protobuf.Timestamp
+	14, // 4: fundraising.fundraising.v1.BaseAuction.end_times:type_name -> google.protobuf.Timestamp
+	4,  // 5: fundraising.fundraising.v1.BaseAuction.status:type_name -> fundraising.fundraising.v1.AuctionStatus
+	14, // 6: fundraising.fundraising.v1.BaseAuction.paused_time:type_name -> google.protobuf.Timestamp
+	13, // 7: fundraising.fundraising.v1.BaseAuction.bid_fee:type_name -> cosmos.base.v1beta1.Coin
+	11, // 8: fundraising.fundraising.v1.BaseAuction.buyer_vesting_schedules:type_name -> fundraising.fundraising.v1.VestingSchedule
+	12, // 9: fundraising.fundraising.v1.BaseAuction.linear_vesting:type_name -> fundraising.fundraising.v1.LinearVesting
+	6,  // 10: fundraising.fundraising.v1.BaseAuction.additional_paying_denoms:type_name -> fundraising.fundraising.v1.PayingDenom
+	5,  // 11: fundraising.fundraising.v1.FixedPriceAuction.base_auction:type_name -> fundraising.fundraising.v1.BaseAuction
+	13, // 12: fundraising.fundraising.v1.FixedPriceAuction.remaining_selling_coin:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 13: fundraising.fundraising.v1.FixedPriceAuction.sale_mode:type_name -> fundraising.fundraising.v1.SaleMode
+	5,  // 14: fundraising.fundraising.v1.BatchAuction.base_auction:type_name -> fundraising.fundraising.v1.BaseAuction
+	15, // 15: fundraising.fundraising.v1.BatchAuction.bid_cancel_cutoff:type_name -> google.protobuf.Duration
+	1,  // 16: fundraising.fundraising.v1.BatchAuction.marginal_fill_mode:type_name -> fundraising.fundraising.v1.MarginalFillMode
+	2,  // 17: fundraising.fundraising.v1.BatchAuction.pricing_mode:type_name -> fundraising.fundraising.v1.PricingMode
+	9,  // 18: fundraising.fundraising.v1.BatchAuction.sealed_bid_config:type_name -> fundraising.fundraising.v1.SealedBidConfig
+	15, // 19: fundraising.fundraising.v1.SealedBidConfig.reveal_period:type_name -> google.protobuf.Duration
+	5,  // 20: fundraising.fundraising.v1.DutchAuction.base_auction:type_name -> fundraising.fundraising.v1.BaseAuction
+	15, // 21: fundraising.fundraising.v1.DutchAuction.decay_interval:type_name -> google.protobuf.Duration
+	13, // 22: fundraising.fundraising.v1.DutchAuction.remaining_selling_coin:type_name -> cosmos.base.v1beta1.Coin
+	14, // 23: fundraising.fundraising.v1.VestingSchedule.release_time:type_name -> google.protobuf.Timestamp
+	14, // 24: fundraising.fundraising.v1.LinearVesting.start_time:type_name -> google.protobuf.Timestamp
+	14, // 25: fundraising.fundraising.v1.LinearVesting.end_time:type_name -> google.protobuf.Timestamp
+	14, // 26: fundraising.fundraising.v1.LinearVesting.cliff_time:type_name -> google.protobuf.Timestamp
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_fundraising_fundraising_v1_auction_proto_init() }
@@ -3715,7 +7883,7 @@ func file_fundraising_fundraising_v1_auction_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_auction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FixedPriceAuction); i {
+			switch v := v.(*PayingDenom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,7 +7895,7 @@ func file_fundraising_fundraising_v1_auction_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_auction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchAuction); i {
+			switch v := v.(*FixedPriceAuction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,6 +7907,42 @@ func file_fundraising_fundraising_v1_auction_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_auction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_auction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedBidConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_auction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DutchAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_auction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VestingSchedule); i {
 			case 0:
 				return &v.state
@@ -3750,14 +7954,26 @@ func file_fundraising_fundraising_v1_auction_proto_init() {
 				return nil
 			}
 		}
+		file_fundraising_fundraising_v1_auction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearVesting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fundraising_fundraising_v1_auction_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,2888 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package fundraisingv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_AuctionResult_7_list)(nil)
+
+type _AuctionResult_7_list struct {
+	list *[]*BidderAllocation
+}
+
+func (x *_AuctionResult_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AuctionResult_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AuctionResult_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BidderAllocation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AuctionResult_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BidderAllocation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AuctionResult_7_list) AppendMutable() protoreflect.Value {
+	v := new(BidderAllocation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AuctionResult_7_list) NewElement() protoreflect.Value {
+	v := new(BidderAllocation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AuctionResult_10_list)(nil)
+
+type _AuctionResult_10_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_AuctionResult_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AuctionResult_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AuctionResult_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AuctionResult_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AuctionResult_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AuctionResult_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AuctionResult_11_list)(nil)
+
+type _AuctionResult_11_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_AuctionResult_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AuctionResult_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AuctionResult_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AuctionResult_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AuctionResult_11_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AuctionResult_11_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AuctionResult_12_list)(nil)
+
+type _AuctionResult_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_AuctionResult_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AuctionResult_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AuctionResult_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AuctionResult_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AuctionResult_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AuctionResult_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AuctionResult_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AuctionResult                    protoreflect.MessageDescriptor
+	fd_AuctionResult_auction_id         protoreflect.FieldDescriptor
+	fd_AuctionResult_matched_price      protoreflect.FieldDescriptor
+	fd_AuctionResult_matched_len        protoreflect.FieldDescriptor
+	fd_AuctionResult_sold_coin          protoreflect.FieldDescriptor
+	fd_AuctionResult_paid_coin          protoreflect.FieldDescriptor
+	fd_AuctionResult_refunded_coin      protoreflect.FieldDescriptor
+	fd_AuctionResult_bidder_allocations protoreflect.FieldDescriptor
+	fd_AuctionResult_closed_time        protoreflect.FieldDescriptor
+	fd_AuctionResult_vested_coin        protoreflect.FieldDescriptor
+	fd_AuctionResult_paid_coins         protoreflect.FieldDescriptor
+	fd_AuctionResult_refunded_coins     protoreflect.FieldDescriptor
+	fd_AuctionResult_vested_coins       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_result_proto_init()
+	md_AuctionResult = File_fundraising_fundraising_v1_auction_result_proto.Messages().ByName("AuctionResult")
+	fd_AuctionResult_auction_id = md_AuctionResult.Fields().ByName("auction_id")
+	fd_AuctionResult_matched_price = md_AuctionResult.Fields().ByName("matched_price")
+	fd_AuctionResult_matched_len = md_AuctionResult.Fields().ByName("matched_len")
+	fd_AuctionResult_sold_coin = md_AuctionResult.Fields().ByName("sold_coin")
+	fd_AuctionResult_paid_coin = md_AuctionResult.Fields().ByName("paid_coin")
+	fd_AuctionResult_refunded_coin = md_AuctionResult.Fields().ByName("refunded_coin")
+	fd_AuctionResult_bidder_allocations = md_AuctionResult.Fields().ByName("bidder_allocations")
+	fd_AuctionResult_closed_time = md_AuctionResult.Fields().ByName("closed_time")
+	fd_AuctionResult_vested_coin = md_AuctionResult.Fields().ByName("vested_coin")
+	fd_AuctionResult_paid_coins = md_AuctionResult.Fields().ByName("paid_coins")
+	fd_AuctionResult_refunded_coins = md_AuctionResult.Fields().ByName("refunded_coins")
+	fd_AuctionResult_vested_coins = md_AuctionResult.Fields().ByName("vested_coins")
+}
+
+var _ protoreflect.Message = (*fastReflection_AuctionResult)(nil)
+
+type fastReflection_AuctionResult AuctionResult
+
+func (x *AuctionResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AuctionResult)(x)
+}
+
+func (x *AuctionResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AuctionResult_messageType fastReflection_AuctionResult_messageType
+var _ protoreflect.MessageType = fastReflection_AuctionResult_messageType{}
+
+type fastReflection_AuctionResult_messageType struct{}
+
+func (x fastReflection_AuctionResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AuctionResult)(nil)
+}
+func (x fastReflection_AuctionResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_AuctionResult)
+}
+func (x fastReflection_AuctionResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuctionResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AuctionResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuctionResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AuctionResult) Type() protoreflect.MessageType {
+	return _fastReflection_AuctionResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AuctionResult) New() protoreflect.Message {
+	return new(fastReflection_AuctionResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AuctionResult) Interface() protoreflect.ProtoMessage {
+	return (*AuctionResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AuctionResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_AuctionResult_auction_id, value) {
+			return
+		}
+	}
+	if x.MatchedPrice != "" {
+		value := protoreflect.ValueOfString(x.MatchedPrice)
+		if !f(fd_AuctionResult_matched_price, value) {
+			return
+		}
+	}
+	if x.MatchedLen != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MatchedLen)
+		if !f(fd_AuctionResult_matched_len, value) {
+			return
+		}
+	}
+	if x.SoldCoin != nil {
+		value := protoreflect.ValueOfMessage(x.SoldCoin.ProtoReflect())
+		if !f(fd_AuctionResult_sold_coin, value) {
+			return
+		}
+	}
+	if x.PaidCoin != nil {
+		value := protoreflect.ValueOfMessage(x.PaidCoin.ProtoReflect())
+		if !f(fd_AuctionResult_paid_coin, value) {
+			return
+		}
+	}
+	if x.RefundedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.RefundedCoin.ProtoReflect())
+		if !f(fd_AuctionResult_refunded_coin, value) {
+			return
+		}
+	}
+	if len(x.BidderAllocations) != 0 {
+		value := protoreflect.ValueOfList(&_AuctionResult_7_list{list: &x.BidderAllocations})
+		if !f(fd_AuctionResult_bidder_allocations, value) {
+			return
+		}
+	}
+	if x.ClosedTime != nil {
+		value := protoreflect.ValueOfMessage(x.ClosedTime.ProtoReflect())
+		if !f(fd_AuctionResult_closed_time, value) {
+			return
+		}
+	}
+	if x.VestedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.VestedCoin.ProtoReflect())
+		if !f(fd_AuctionResult_vested_coin, value) {
+			return
+		}
+	}
+	if len(x.PaidCoins) != 0 {
+		value := protoreflect.ValueOfList(&_AuctionResult_10_list{list: &x.PaidCoins})
+		if !f(fd_AuctionResult_paid_coins, value) {
+			return
+		}
+	}
+	if len(x.RefundedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_AuctionResult_11_list{list: &x.RefundedCoins})
+		if !f(fd_AuctionResult_refunded_coins, value) {
+			return
+		}
+	}
+	if len(x.VestedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_AuctionResult_12_list{list: &x.VestedCoins})
+		if !f(fd_AuctionResult_vested_coins, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AuctionResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.AuctionResult.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.AuctionResult.matched_price":
+		return x.MatchedPrice != ""
+	case "fundraising.fundraising.v1.AuctionResult.matched_len":
+		return x.MatchedLen != int64(0)
+	case "fundraising.fundraising.v1.AuctionResult.sold_coin":
+		return x.SoldCoin != nil
+	case "fundraising.fundraising.v1.AuctionResult.paid_coin":
+		return x.PaidCoin != nil
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coin":
+		return x.RefundedCoin != nil
+	case "fundraising.fundraising.v1.AuctionResult.bidder_allocations":
+		return len(x.BidderAllocations) != 0
+	case "fundraising.fundraising.v1.AuctionResult.closed_time":
+		return x.ClosedTime != nil
+	case "fundraising.fundraising.v1.AuctionResult.vested_coin":
+		return x.VestedCoin != nil
+	case "fundraising.fundraising.v1.AuctionResult.paid_coins":
+		return len(x.PaidCoins) != 0
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coins":
+		return len(x.RefundedCoins) != 0
+	case "fundraising.fundraising.v1.AuctionResult.vested_coins":
+		return len(x.VestedCoins) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.AuctionResult"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.AuctionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.AuctionResult.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.AuctionResult.matched_price":
+		x.MatchedPrice = ""
+	case "fundraising.fundraising.v1.AuctionResult.matched_len":
+		x.MatchedLen = int64(0)
+	case "fundraising.fundraising.v1.AuctionResult.sold_coin":
+		x.SoldCoin = nil
+	case "fundraising.fundraising.v1.AuctionResult.paid_coin":
+		x.PaidCoin = nil
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coin":
+		x.RefundedCoin = nil
+	case "fundraising.fundraising.v1.AuctionResult.bidder_allocations":
+		x.BidderAllocations = nil
+	case "fundraising.fundraising.v1.AuctionResult.closed_time":
+		x.ClosedTime = nil
+	case "fundraising.fundraising.v1.AuctionResult.vested_coin":
+		x.VestedCoin = nil
+	case "fundraising.fundraising.v1.AuctionResult.paid_coins":
+		x.PaidCoins = nil
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coins":
+		x.RefundedCoins = nil
+	case "fundraising.fundraising.v1.AuctionResult.vested_coins":
+		x.VestedCoins = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.AuctionResult"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.AuctionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AuctionResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.AuctionResult.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.AuctionResult.matched_price":
+		value := x.MatchedPrice
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.AuctionResult.matched_len":
+		value := x.MatchedLen
+		return protoreflect.ValueOfInt64(value)
+	case "fundraising.fundraising.v1.AuctionResult.sold_coin":
+		value := x.SoldCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.paid_coin":
+		value := x.PaidCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coin":
+		value := x.RefundedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.bidder_allocations":
+		if len(x.BidderAllocations) == 0 {
+			return protoreflect.ValueOfList(&_AuctionResult_7_list{})
+		}
+		listValue := &_AuctionResult_7_list{list: &x.BidderAllocations}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.AuctionResult.closed_time":
+		value := x.ClosedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.vested_coin":
+		value := x.VestedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.paid_coins":
+		if len(x.PaidCoins) == 0 {
+			return protoreflect.ValueOfList(&_AuctionResult_10_list{})
+		}
+		listValue := &_AuctionResult_10_list{list: &x.PaidCoins}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coins":
+		if len(x.RefundedCoins) == 0 {
+			return protoreflect.ValueOfList(&_AuctionResult_11_list{})
+		}
+		listValue := &_AuctionResult_11_list{list: &x.RefundedCoins}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.AuctionResult.vested_coins":
+		if len(x.VestedCoins) == 0 {
+			return protoreflect.ValueOfList(&_AuctionResult_12_list{})
+		}
+		listValue := &_AuctionResult_12_list{list: &x.VestedCoins}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.AuctionResult"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.AuctionResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.AuctionResult.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.AuctionResult.matched_price":
+		x.MatchedPrice = value.Interface().(string)
+	case "fundraising.fundraising.v1.AuctionResult.matched_len":
+		x.MatchedLen = value.Int()
+	case "fundraising.fundraising.v1.AuctionResult.sold_coin":
+		x.SoldCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.AuctionResult.paid_coin":
+		x.PaidCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coin":
+		x.RefundedCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.AuctionResult.bidder_allocations":
+		lv := value.List()
+		clv := lv.(*_AuctionResult_7_list)
+		x.BidderAllocations = *clv.list
+	case "fundraising.fundraising.v1.AuctionResult.closed_time":
+		x.ClosedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fundraising.fundraising.v1.AuctionResult.vested_coin":
+		x.VestedCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.AuctionResult.paid_coins":
+		lv := value.List()
+		clv := lv.(*_AuctionResult_10_list)
+		x.PaidCoins = *clv.list
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coins":
+		lv := value.List()
+		clv := lv.(*_AuctionResult_11_list)
+		x.RefundedCoins = *clv.list
+	case "fundraising.fundraising.v1.AuctionResult.vested_coins":
+		lv := value.List()
+		clv := lv.(*_AuctionResult_12_list)
+		x.VestedCoins = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.AuctionResult"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.AuctionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.AuctionResult.sold_coin":
+		if x.SoldCoin == nil {
+			x.SoldCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SoldCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.paid_coin":
+		if x.PaidCoin == nil {
+			x.PaidCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PaidCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coin":
+		if x.RefundedCoin == nil {
+			x.RefundedCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RefundedCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.bidder_allocations":
+		if x.BidderAllocations == nil {
+			x.BidderAllocations = []*BidderAllocation{}
+		}
+		value := &_AuctionResult_7_list{list: &x.BidderAllocations}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.AuctionResult.closed_time":
+		if x.ClosedTime == nil {
+			x.ClosedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ClosedTime.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.vested_coin":
+		if x.VestedCoin == nil {
+			x.VestedCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.VestedCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.paid_coins":
+		if x.PaidCoins == nil {
+			x.PaidCoins = []*v1beta1.Coin{}
+		}
+		value := &_AuctionResult_10_list{list: &x.PaidCoins}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coins":
+		if x.RefundedCoins == nil {
+			x.RefundedCoins = []*v1beta1.Coin{}
+		}
+		value := &_AuctionResult_11_list{list: &x.RefundedCoins}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.AuctionResult.vested_coins":
+		if x.VestedCoins == nil {
+			x.VestedCoins = []*v1beta1.Coin{}
+		}
+		value := &_AuctionResult_12_list{list: &x.VestedCoins}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.AuctionResult.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.AuctionResult is not mutable"))
+	case "fundraising.fundraising.v1.AuctionResult.matched_price":
+		panic(fmt.Errorf("field matched_price of message fundraising.fundraising.v1.AuctionResult is not mutable"))
+	case "fundraising.fundraising.v1.AuctionResult.matched_len":
+		panic(fmt.Errorf("field matched_len of message fundraising.fundraising.v1.AuctionResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.AuctionResult"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.AuctionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AuctionResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.AuctionResult.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.AuctionResult.matched_price":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.AuctionResult.matched_len":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "fundraising.fundraising.v1.AuctionResult.sold_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.paid_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.bidder_allocations":
+		list := []*BidderAllocation{}
+		return protoreflect.ValueOfList(&_AuctionResult_7_list{list: &list})
+	case "fundraising.fundraising.v1.AuctionResult.closed_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.vested_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.AuctionResult.paid_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_AuctionResult_10_list{list: &list})
+	case "fundraising.fundraising.v1.AuctionResult.refunded_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_AuctionResult_11_list{list: &list})
+	case "fundraising.fundraising.v1.AuctionResult.vested_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_AuctionResult_12_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.AuctionResult"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.AuctionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AuctionResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.AuctionResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AuctionResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AuctionResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AuctionResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AuctionResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		l = len(x.MatchedPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MatchedLen != 0 {
+			n += 1 + runtime.Sov(uint64(x.MatchedLen))
+		}
+		if x.SoldCoin != nil {
+			l = options.Size(x.SoldCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PaidCoin != nil {
+			l = options.Size(x.PaidCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RefundedCoin != nil {
+			l = options.Size(x.RefundedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BidderAllocations) > 0 {
+			for _, e := range x.BidderAllocations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ClosedTime != nil {
+			l = options.Size(x.ClosedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VestedCoin != nil {
+			l = options.Size(x.VestedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PaidCoins) > 0 {
+			for _, e := range x.PaidCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RefundedCoins) > 0 {
+			for _, e := range x.RefundedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VestedCoins) > 0 {
+			for _, e := range x.VestedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AuctionResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VestedCoins) > 0 {
+			for iNdEx := len(x.VestedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.RefundedCoins) > 0 {
+			for iNdEx := len(x.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.PaidCoins) > 0 {
+			for iNdEx := len(x.PaidCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PaidCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.VestedCoin != nil {
+			encoded, err := options.Marshal(x.VestedCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.ClosedTime != nil {
+			encoded, err := options.Marshal(x.ClosedTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BidderAllocations) > 0 {
+			for iNdEx := len(x.BidderAllocations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BidderAllocations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.RefundedCoin != nil {
+			encoded, err := options.Marshal(x.RefundedCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.PaidCoin != nil {
+			encoded, err := options.Marshal(x.PaidCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SoldCoin != nil {
+			encoded, err := options.Marshal(x.SoldCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MatchedLen != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MatchedLen))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MatchedPrice) > 0 {
+			i -= len(x.MatchedPrice)
+			copy(dAtA[i:], x.MatchedPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MatchedPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AuctionResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MatchedPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedLen", wireType)
+				}
+				x.MatchedLen = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MatchedLen |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoldCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SoldCoin == nil {
+					x.SoldCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SoldCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PaidCoin == nil {
+					x.PaidCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PaidCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RefundedCoin == nil {
+					x.RefundedCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidderAllocations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BidderAllocations = append(x.BidderAllocations, &BidderAllocation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BidderAllocations[len(x.BidderAllocations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ClosedTime == nil {
+					x.ClosedTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClosedTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestedCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VestedCoin == nil {
+					x.VestedCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PaidCoins = append(x.PaidCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PaidCoins[len(x.PaidCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundedCoins = append(x.RefundedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundedCoins[len(x.RefundedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestedCoins = append(x.VestedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestedCoins[len(x.VestedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BidderAllocation_5_list)(nil)
+
+type _BidderAllocation_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_BidderAllocation_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BidderAllocation_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BidderAllocation_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BidderAllocation_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BidderAllocation_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BidderAllocation_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BidderAllocation_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BidderAllocation_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_BidderAllocation_6_list)(nil)
+
+type _BidderAllocation_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_BidderAllocation_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BidderAllocation_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BidderAllocation_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BidderAllocation_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BidderAllocation_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BidderAllocation_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BidderAllocation_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BidderAllocation_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BidderAllocation                protoreflect.MessageDescriptor
+	fd_BidderAllocation_bidder         protoreflect.FieldDescriptor
+	fd_BidderAllocation_allocated_coin protoreflect.FieldDescriptor
+	fd_BidderAllocation_paid_coin      protoreflect.FieldDescriptor
+	fd_BidderAllocation_refunded_coin  protoreflect.FieldDescriptor
+	fd_BidderAllocation_paid_coins     protoreflect.FieldDescriptor
+	fd_BidderAllocation_refunded_coins protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_auction_result_proto_init()
+	md_BidderAllocation = File_fundraising_fundraising_v1_auction_result_proto.Messages().ByName("BidderAllocation")
+	fd_BidderAllocation_bidder = md_BidderAllocation.Fields().ByName("bidder")
+	fd_BidderAllocation_allocated_coin = md_BidderAllocation.Fields().ByName("allocated_coin")
+	fd_BidderAllocation_paid_coin = md_BidderAllocation.Fields().ByName("paid_coin")
+	fd_BidderAllocation_refunded_coin = md_BidderAllocation.Fields().ByName("refunded_coin")
+	fd_BidderAllocation_paid_coins = md_BidderAllocation.Fields().ByName("paid_coins")
+	fd_BidderAllocation_refunded_coins = md_BidderAllocation.Fields().ByName("refunded_coins")
+}
+
+var _ protoreflect.Message = (*fastReflection_BidderAllocation)(nil)
+
+type fastReflection_BidderAllocation BidderAllocation
+
+func (x *BidderAllocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BidderAllocation)(x)
+}
+
+func (x *BidderAllocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_auction_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BidderAllocation_messageType fastReflection_BidderAllocation_messageType
+var _ protoreflect.MessageType = fastReflection_BidderAllocation_messageType{}
+
+type fastReflection_BidderAllocation_messageType struct{}
+
+func (x fastReflection_BidderAllocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BidderAllocation)(nil)
+}
+func (x fastReflection_BidderAllocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_BidderAllocation)
+}
+func (x fastReflection_BidderAllocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BidderAllocation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BidderAllocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_BidderAllocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BidderAllocation) Type() protoreflect.MessageType {
+	return _fastReflection_BidderAllocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BidderAllocation) New() protoreflect.Message {
+	return new(fastReflection_BidderAllocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BidderAllocation) Interface() protoreflect.ProtoMessage {
+	return (*BidderAllocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BidderAllocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_BidderAllocation_bidder, value) {
+			return
+		}
+	}
+	if x.AllocatedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.AllocatedCoin.ProtoReflect())
+		if !f(fd_BidderAllocation_allocated_coin, value) {
+			return
+		}
+	}
+	if x.PaidCoin != nil {
+		value := protoreflect.ValueOfMessage(x.PaidCoin.ProtoReflect())
+		if !f(fd_BidderAllocation_paid_coin, value) {
+			return
+		}
+	}
+	if x.RefundedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.RefundedCoin.ProtoReflect())
+		if !f(fd_BidderAllocation_refunded_coin, value) {
+			return
+		}
+	}
+	if len(x.PaidCoins) != 0 {
+		value := protoreflect.ValueOfList(&_BidderAllocation_5_list{list: &x.PaidCoins})
+		if !f(fd_BidderAllocation_paid_coins, value) {
+			return
+		}
+	}
+	if len(x.RefundedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_BidderAllocation_6_list{list: &x.RefundedCoins})
+		if !f(fd_BidderAllocation_refunded_coins, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BidderAllocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidderAllocation.bidder":
+		return x.Bidder != ""
+	case "fundraising.fundraising.v1.BidderAllocation.allocated_coin":
+		return x.AllocatedCoin != nil
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coin":
+		return x.PaidCoin != nil
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coin":
+		return x.RefundedCoin != nil
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coins":
+		return len(x.PaidCoins) != 0
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coins":
+		return len(x.RefundedCoins) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidderAllocation"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidderAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidderAllocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidderAllocation.bidder":
+		x.Bidder = ""
+	case "fundraising.fundraising.v1.BidderAllocation.allocated_coin":
+		x.AllocatedCoin = nil
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coin":
+		x.PaidCoin = nil
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coin":
+		x.RefundedCoin = nil
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coins":
+		x.PaidCoins = nil
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coins":
+		x.RefundedCoins = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidderAllocation"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidderAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BidderAllocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.BidderAllocation.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BidderAllocation.allocated_coin":
+		value := x.AllocatedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coin":
+		value := x.PaidCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coin":
+		value := x.RefundedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coins":
+		if len(x.PaidCoins) == 0 {
+			return protoreflect.ValueOfList(&_BidderAllocation_5_list{})
+		}
+		listValue := &_BidderAllocation_5_list{list: &x.PaidCoins}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coins":
+		if len(x.RefundedCoins) == 0 {
+			return protoreflect.ValueOfList(&_BidderAllocation_6_list{})
+		}
+		listValue := &_BidderAllocation_6_list{list: &x.RefundedCoins}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidderAllocation"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidderAllocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidderAllocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidderAllocation.bidder":
+		x.Bidder = value.Interface().(string)
+	case "fundraising.fundraising.v1.BidderAllocation.allocated_coin":
+		x.AllocatedCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coin":
+		x.PaidCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coin":
+		x.RefundedCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coins":
+		lv := value.List()
+		clv := lv.(*_BidderAllocation_5_list)
+		x.PaidCoins = *clv.list
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coins":
+		lv := value.List()
+		clv := lv.(*_BidderAllocation_6_list)
+		x.RefundedCoins = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidderAllocation"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidderAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidderAllocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidderAllocation.allocated_coin":
+		if x.AllocatedCoin == nil {
+			x.AllocatedCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.AllocatedCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coin":
+		if x.PaidCoin == nil {
+			x.PaidCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PaidCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coin":
+		if x.RefundedCoin == nil {
+			x.RefundedCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RefundedCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coins":
+		if x.PaidCoins == nil {
+			x.PaidCoins = []*v1beta1.Coin{}
+		}
+		value := &_BidderAllocation_5_list{list: &x.PaidCoins}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coins":
+		if x.RefundedCoins == nil {
+			x.RefundedCoins = []*v1beta1.Coin{}
+		}
+		value := &_BidderAllocation_6_list{list: &x.RefundedCoins}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.BidderAllocation.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.BidderAllocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidderAllocation"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidderAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BidderAllocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidderAllocation.bidder":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BidderAllocation.allocated_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.BidderAllocation.paid_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_BidderAllocation_5_list{list: &list})
+	case "fundraising.fundraising.v1.BidderAllocation.refunded_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_BidderAllocation_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidderAllocation"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidderAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BidderAllocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.BidderAllocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BidderAllocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidderAllocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BidderAllocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BidderAllocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BidderAllocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AllocatedCoin != nil {
+			l = options.Size(x.AllocatedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PaidCoin != nil {
+			l = options.Size(x.PaidCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RefundedCoin != nil {
+			l = options.Size(x.RefundedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PaidCoins) > 0 {
+			for _, e := range x.PaidCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RefundedCoins) > 0 {
+			for _, e := range x.RefundedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BidderAllocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RefundedCoins) > 0 {
+			for iNdEx := len(x.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.PaidCoins) > 0 {
+			for iNdEx := len(x.PaidCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PaidCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.RefundedCoin != nil {
+			encoded, err := options.Marshal(x.RefundedCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.PaidCoin != nil {
+			encoded, err := options.Marshal(x.PaidCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.AllocatedCoin != nil {
+			encoded, err := options.Marshal(x.AllocatedCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BidderAllocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BidderAllocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BidderAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllocatedCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AllocatedCoin == nil {
+					x.AllocatedCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllocatedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PaidCoin == nil {
+					x.PaidCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PaidCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RefundedCoin == nil {
+					x.RefundedCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PaidCoins = append(x.PaidCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PaidCoins[len(x.PaidCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundedCoins = append(x.RefundedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundedCoins[len(x.RefundedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fundraising/fundraising/v1/auction_result.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuctionResult defines the final matching result of an auction that is
+// stored when the auction is closed.
+type AuctionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// matched_price specifies the clearing price of the auction
+	MatchedPrice string `protobuf:"bytes,2,opt,name=matched_price,json=matchedPrice,proto3" json:"matched_price,omitempty"`
+	// matched_len specifies the number of the matched bids
+	MatchedLen int64 `protobuf:"varint,3,opt,name=matched_len,json=matchedLen,proto3" json:"matched_len,omitempty"`
+	// sold_coin specifies the total amount of the selling coin sold to the bidders
+	SoldCoin *v1beta1.Coin `protobuf:"bytes,4,opt,name=sold_coin,json=soldCoin,proto3" json:"sold_coin,omitempty"`
+	// paid_coin specifies the total amount of the paying coin paid by the
+	// bidders; the coins of the additional paying denoms are counted at their
+	// conversion ratios
+	PaidCoin *v1beta1.Coin `protobuf:"bytes,5,opt,name=paid_coin,json=paidCoin,proto3" json:"paid_coin,omitempty"`
+	// refunded_coin specifies the total amount of the paying coin refunded to
+	// the bidders; the coins of the additional paying denoms are counted at their
+	// conversion ratios
+	RefundedCoin *v1beta1.Coin `protobuf:"bytes,6,opt,name=refunded_coin,json=refundedCoin,proto3" json:"refunded_coin,omitempty"`
+	// bidder_allocations specifies the settlement of each bidder sorted by the bidder address
+	BidderAllocations []*BidderAllocation `protobuf:"bytes,7,rep,name=bidder_allocations,json=bidderAllocations,proto3" json:"bidder_allocations,omitempty"`
+	// closed_time specifies the time when the auction is closed
+	ClosedTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=closed_time,json=closedTime,proto3" json:"closed_time,omitempty"`
+	// vested_coin specifies the paying coin of the paying coin denom moved into
+	// the vesting reserve account to be released by the vesting queues; it is
+	// zero without vesting
+	VestedCoin *v1beta1.Coin `protobuf:"bytes,9,opt,name=vested_coin,json=vestedCoin,proto3" json:"vested_coin,omitempty"`
+	// paid_coins specifies the paying coins paid by the bidders in each denom
+	// that the auction accepts
+	PaidCoins []*v1beta1.Coin `protobuf:"bytes,10,rep,name=paid_coins,json=paidCoins,proto3" json:"paid_coins,omitempty"`
+	// refunded_coins specifies the paying coins refunded to the bidders in each
+	// denom that the auction accepts
+	RefundedCoins []*v1beta1.Coin `protobuf:"bytes,11,rep,name=refunded_coins,json=refundedCoins,proto3" json:"refunded_coins,omitempty"`
+	// vested_coins specifies the paying coins moved into the vesting reserve
+	// account in each denom that the auction accepts
+	VestedCoins []*v1beta1.Coin `protobuf:"bytes,12,rep,name=vested_coins,json=vestedCoins,proto3" json:"vested_coins,omitempty"`
+}
+
+func (x *AuctionResult) Reset() {
+	*x = AuctionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_auction_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionResult) ProtoMessage() {}
+
+// Deprecated: Use AuctionResult.ProtoReflect.Descriptor instead.
+func (*AuctionResult) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuctionResult) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *AuctionResult) GetMatchedPrice() string {
+	if x != nil {
+		return x.MatchedPrice
+	}
+	return ""
+}
+
+func (x *AuctionResult) GetMatchedLen() int64 {
+	if x != nil {
+		return x.MatchedLen
+	}
+	return 0
+}
+
+func (x *AuctionResult) GetSoldCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.SoldCoin
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetPaidCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.PaidCoin
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetRefundedCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.RefundedCoin
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetBidderAllocations() []*BidderAllocation {
+	if x != nil {
+		return x.BidderAllocations
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetClosedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedTime
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetVestedCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.VestedCoin
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetPaidCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.PaidCoins
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetRefundedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.RefundedCoins
+	}
+	return nil
+}
+
+func (x *AuctionResult) GetVestedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.VestedCoins
+	}
+	return nil
+}
+
+// BidderAllocation defines the settlement of a bidder in an auction.
+type BidderAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// allocated_coin specifies the selling coin allocated to the bidder
+	AllocatedCoin *v1beta1.Coin `protobuf:"bytes,2,opt,name=allocated_coin,json=allocatedCoin,proto3" json:"allocated_coin,omitempty"`
+	// paid_coin specifies the paying coin paid by the bidder for the allocated
+	// coin; the coins of the additional paying denoms are counted at their
+	// conversion ratios
+	PaidCoin *v1beta1.Coin `protobuf:"bytes,3,opt,name=paid_coin,json=paidCoin,proto3" json:"paid_coin,omitempty"`
+	// refunded_coin specifies the paying coin refunded to the bidder; the coins
+	// of the additional paying denoms are counted at their conversion ratios
+	RefundedCoin *v1beta1.Coin `protobuf:"bytes,4,opt,name=refunded_coin,json=refundedCoin,proto3" json:"refunded_coin,omitempty"`
+	// paid_coins specifies the paying coins paid by the bidder in each denom
+	// that the bidder reserved
+	PaidCoins []*v1beta1.Coin `protobuf:"bytes,5,rep,name=paid_coins,json=paidCoins,proto3" json:"paid_coins,omitempty"`
+	// refunded_coins specifies the paying coins refunded to the bidder in each
+	// denom that the bidder reserved
+	RefundedCoins []*v1beta1.Coin `protobuf:"bytes,6,rep,name=refunded_coins,json=refundedCoins,proto3" json:"refunded_coins,omitempty"`
+}
+
+func (x *BidderAllocation) Reset() {
+	*x = BidderAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_auction_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidderAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidderAllocation) ProtoMessage() {}
+
+// Deprecated: Use BidderAllocation.ProtoReflect.Descriptor instead.
+func (*BidderAllocation) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_auction_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BidderAllocation) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *BidderAllocation) GetAllocatedCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.AllocatedCoin
+	}
+	return nil
+}
+
+func (x *BidderAllocation) GetPaidCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.PaidCoin
+	}
+	return nil
+}
+
+func (x *BidderAllocation) GetRefundedCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.RefundedCoin
+	}
+	return nil
+}
+
+func (x *BidderAllocation) GetPaidCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.PaidCoins
+	}
+	return nil
+}
+
+func (x *BidderAllocation) GetRefundedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.RefundedCoins
+	}
+	return nil
+}
+
+var File_fundraising_fundraising_v1_auction_result_proto protoreflect.FileDescriptor
+
+var file_fundraising_fundraising_v1_auction_result_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x1a, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x88, 0x08, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x09,
+	0x73, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12,
+	0x41, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x70, 0x61, 0x69, 0x64, 0x43, 0x6f,
+	0x69, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x66, 0x0a,
+	0x12, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x11, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x45, 0x0a, 0x0b, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x76, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x69,
+	0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x70, 0x61, 0x69, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x0e,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0b, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xad, 0x04,
+	0x0a, 0x10, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69,
+	0x6e, 0x12, 0x41, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x70, 0x61, 0x69, 0x64,
+	0x43, 0x6f, 0x69, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12,
+	0x80, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x70, 0x61, 0x69, 0x64, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x42, 0x8e, 0x02,
+	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x42, 0x12, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fundraising_fundraising_v1_auction_result_proto_rawDescOnce sync.Once
+	file_fundraising_fundraising_v1_auction_result_proto_rawDescData = file_fundraising_fundraising_v1_auction_result_proto_rawDesc
+)
+
+func file_fundraising_fundraising_v1_auction_result_proto_rawDescGZIP() []byte {
+	file_fundraising_fundraising_v1_auction_result_proto_rawDescOnce.Do(func() {
+		file_fundraising_fundraising_v1_auction_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_fundraising_fundraising_v1_auction_result_proto_rawDescData)
+	})
+	return file_fundraising_fundraising_v1_auction_result_proto_rawDescData
+}
+
+var file_fundraising_fundraising_v1_auction_result_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fundraising_fundraising_v1_auction_result_proto_goTypes = []interface{}{
+	(*AuctionResult)(nil),         // 0: fundraising.fundraising.v1.AuctionResult
+	(*BidderAllocation)(nil),      // 1: fundraising.fundraising.v1.BidderAllocation
+	(*v1beta1.Coin)(nil),          // 2: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_fundraising_fundraising_v1_auction_result_proto_depIdxs = []int32{
+	2,  // 0: fundraising.fundraising.v1.AuctionResult.sold_coin:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 1: fundraising.fundraising.v1.AuctionResult.paid_coin:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 2: fundraising.fundraising.v1.AuctionResult.refunded_coin:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 3: fundraising.fundraising.v1.AuctionResult.bidder_allocations:type_name -> fundraising.fundraising.v1.BidderAllocation
+	3,  // 4: fundraising.fundraising.v1.AuctionResult.closed_time:type_name -> google.protobuf.Timestamp
+	2,  // 5: fundraising.fundraising.v1.AuctionResult.vested_coin:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 6: fundraising.fundraising.v1.AuctionResult.paid_coins:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 7: fundraising.fundraising.v1.AuctionResult.refunded_coins:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 8: fundraising.fundraising.v1.AuctionResult.vested_coins:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 9: fundraising.fundraising.v1.BidderAllocation.allocated_coin:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 10: fundraising.fundraising.v1.BidderAllocation.paid_coin:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 11: fundraising.fundraising.v1.BidderAllocation.refunded_coin:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 12: fundraising.fundraising.v1.BidderAllocation.paid_coins:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 13: fundraising.fundraising.v1.BidderAllocation.refunded_coins:type_name -> cosmos.base.v1beta1.Coin
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_fundraising_fundraising_v1_auction_result_proto_init() }
+func file_fundraising_fundraising_v1_auction_result_proto_init() {
+	if File_fundraising_fundraising_v1_auction_result_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fundraising_fundraising_v1_auction_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_auction_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidderAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fundraising_fundraising_v1_auction_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fundraising_fundraising_v1_auction_result_proto_goTypes,
+		DependencyIndexes: file_fundraising_fundraising_v1_auction_result_proto_depIdxs,
+		MessageInfos:      file_fundraising_fundraising_v1_auction_result_proto_msgTypes,
+	}.Build()
+	File_fundraising_fundraising_v1_auction_result_proto = out.File
+	file_fundraising_fundraising_v1_auction_result_proto_rawDesc = nil
+	file_fundraising_fundraising_v1_auction_result_proto_goTypes = nil
+	file_fundraising_fundraising_v1_auction_result_proto_depIdxs = nil
+}
@@ -16,15 +16,68 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Bid_8_list)(nil)
+
+type _Bid_8_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Bid_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bid_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bid_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bid_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bid_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bid_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bid_8_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bid_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Bid            protoreflect.MessageDescriptor
-	fd_Bid_auction_id protoreflect.FieldDescriptor
-	fd_Bid_bidder     protoreflect.FieldDescriptor
-	fd_Bid_id         protoreflect.FieldDescriptor
-	fd_Bid_type       protoreflect.FieldDescriptor
-	fd_Bid_price      protoreflect.FieldDescriptor
-	fd_Bid_coin       protoreflect.FieldDescriptor
-	fd_Bid_is_matched protoreflect.FieldDescriptor
+	md_Bid              protoreflect.MessageDescriptor
+	fd_Bid_auction_id   protoreflect.FieldDescriptor
+	fd_Bid_bidder       protoreflect.FieldDescriptor
+	fd_Bid_id           protoreflect.FieldDescriptor
+	fd_Bid_type         protoreflect.FieldDescriptor
+	fd_Bid_price        protoreflect.FieldDescriptor
+	fd_Bid_coin         protoreflect.FieldDescriptor
+	fd_Bid_is_matched   protoreflect.FieldDescriptor
+	fd_Bid_escrowed_fee protoreflect.FieldDescriptor
+	fd_Bid_paying_denom protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +90,8 @@ func init() {
 	fd_Bid_price = md_Bid.Fields().ByName("price")
 	fd_Bid_coin = md_Bid.Fields().ByName("coin")
 	fd_Bid_is_matched = md_Bid.Fields().ByName("is_matched")
+	fd_Bid_escrowed_fee = md_Bid.Fields().ByName("escrowed_fee")
+	fd_Bid_paying_denom = md_Bid.Fields().ByName("paying_denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_Bid)(nil)
@@ -146,6 +201,18 @@ func (x *fastReflection_Bid) Range(f func(protoreflect.FieldDescriptor, protoref
 			return
 		}
 	}
+	if len(x.EscrowedFee) != 0 {
+		value := protoreflect.ValueOfList(&_Bid_8_list{list: &x.EscrowedFee})
+		if !f(fd_Bid_escrowed_fee, value) {
+			return
+		}
+	}
+	if x.PayingDenom != "" {
+		value := protoreflect.ValueOfString(x.PayingDenom)
+		if !f(fd_Bid_paying_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -175,6 +242,10 @@ func (x *fastReflection_Bid) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Coin != nil
 	case "fundraising.fundraising.v1.Bid.is_matched":
 		return x.IsMatched != false
+	case "fundraising.fundraising.v1.Bid.escrowed_fee":
+		return len(x.EscrowedFee) != 0
+	case "fundraising.fundraising.v1.Bid.paying_denom":
+		return x.PayingDenom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Bid"))
@@ -205,6 +276,10 @@ func (x *fastReflection_Bid) Clear(fd protoreflect.FieldDescriptor) {
 		x.Coin = nil
 	case "fundraising.fundraising.v1.Bid.is_matched":
 		x.IsMatched = false
+	case "fundraising.fundraising.v1.Bid.escrowed_fee":
+		x.EscrowedFee = nil
+	case "fundraising.fundraising.v1.Bid.paying_denom":
+		x.PayingDenom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Bid"))
@@ -242,6 +317,15 @@ func (x *fastReflection_Bid) Get(descriptor protoreflect.FieldDescriptor) protor
 	case "fundraising.fundraising.v1.Bid.is_matched":
 		value := x.IsMatched
 		return protoreflect.ValueOfBool(value)
+	case "fundraising.fundraising.v1.Bid.escrowed_fee":
+		if len(x.EscrowedFee) == 0 {
+			return protoreflect.ValueOfList(&_Bid_8_list{})
+		}
+		listValue := &_Bid_8_list{list: &x.EscrowedFee}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.Bid.paying_denom":
+		value := x.PayingDenom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Bid"))
@@ -276,6 +360,12 @@ func (x *fastReflection_Bid) Set(fd protoreflect.FieldDescriptor, value protoref
 		x.Coin = value.Message().Interface().(*v1beta1.Coin)
 	case "fundraising.fundraising.v1.Bid.is_matched":
 		x.IsMatched = value.Bool()
+	case "fundraising.fundraising.v1.Bid.escrowed_fee":
+		lv := value.List()
+		clv := lv.(*_Bid_8_list)
+		x.EscrowedFee = *clv.list
+	case "fundraising.fundraising.v1.Bid.paying_denom":
+		x.PayingDenom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Bid"))
@@ -301,6 +391,12 @@ func (x *fastReflection_Bid) Mutable(fd protoreflect.FieldDescriptor) protorefle
 			x.Coin = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Coin.ProtoReflect())
+	case "fundraising.fundraising.v1.Bid.escrowed_fee":
+		if x.EscrowedFee == nil {
+			x.EscrowedFee = []*v1beta1.Coin{}
+		}
+		value := &_Bid_8_list{list: &x.EscrowedFee}
+		return protoreflect.ValueOfList(value)
 	case "fundraising.fundraising.v1.Bid.auction_id":
 		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.Bid is not mutable"))
 	case "fundraising.fundraising.v1.Bid.bidder":
@@ -313,6 +409,8 @@ func (x *fastReflection_Bid) Mutable(fd protoreflect.FieldDescriptor) protorefle
 		panic(fmt.Errorf("field price of message fundraising.fundraising.v1.Bid is not mutable"))
 	case "fundraising.fundraising.v1.Bid.is_matched":
 		panic(fmt.Errorf("field is_matched of message fundraising.fundraising.v1.Bid is not mutable"))
+	case "fundraising.fundraising.v1.Bid.paying_denom":
+		panic(fmt.Errorf("field paying_denom of message fundraising.fundraising.v1.Bid is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Bid"))
@@ -341,6 +439,11 @@ func (x *fastReflection_Bid) NewField(fd protoreflect.FieldDescriptor) protorefl
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "fundraising.fundraising.v1.Bid.is_matched":
 		return protoreflect.ValueOfBool(false)
+	case "fundraising.fundraising.v1.Bid.escrowed_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Bid_8_list{list: &list})
+	case "fundraising.fundraising.v1.Bid.paying_denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Bid"))
@@ -434,6 +537,16 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 		if x.IsMatched {
 			n += 2
 		}
+		if len(x.EscrowedFee) > 0 {
+			for _, e := range x.EscrowedFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PayingDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -463,6 +576,29 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PayingDenom) > 0 {
+			i -= len(x.PayingDenom)
+			copy(dAtA[i:], x.PayingDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayingDenom)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.EscrowedFee) > 0 {
+			for iNdEx := len(x.EscrowedFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EscrowedFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
 		if x.IsMatched {
 			i--
 			if x.IsMatched {
@@ -742,6 +878,72 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.IsMatched = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowedFee = append(x.EscrowedFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowedFee[len(x.EscrowedFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayingDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayingDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -777,160 +979,832 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: fundraising/fundraising/v1/bid.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// BidType enumerates the valid types of a bid.
-type BidType int32
-
-const (
-	// BID_TYPE_UNSPECIFIED defines the default bid type
-	BidType_BID_TYPE_UNSPECIFIED BidType = 0
-	// BID_TYPE_FIXED_PRICE defines a bid type for a fixed price auction type
-	BidType_BID_TYPE_FIXED_PRICE BidType = 1
-	// BID_TYPE_BATCH_WORTH defines a bid type for How-Much-Worth-to-Buy of a
-	// batch auction
-	BidType_BID_TYPE_BATCH_WORTH BidType = 2
-	// BID_TYPE_BATCH_MANY defines a bid type for How-Many-Coins-to-Buy of a batch
-	// auction
-	BidType_BID_TYPE_BATCH_MANY BidType = 3
-)
-
-// Enum value maps for BidType.
 var (
-	BidType_name = map[int32]string{
-		0: "BID_TYPE_UNSPECIFIED",
-		1: "BID_TYPE_FIXED_PRICE",
-		2: "BID_TYPE_BATCH_WORTH",
-		3: "BID_TYPE_BATCH_MANY",
-	}
-	BidType_value = map[string]int32{
-		"BID_TYPE_UNSPECIFIED": 0,
-		"BID_TYPE_FIXED_PRICE": 1,
-		"BID_TYPE_BATCH_WORTH": 2,
-		"BID_TYPE_BATCH_MANY":  3,
-	}
+	md_BidCommitment            protoreflect.MessageDescriptor
+	fd_BidCommitment_auction_id protoreflect.FieldDescriptor
+	fd_BidCommitment_id         protoreflect.FieldDescriptor
+	fd_BidCommitment_bidder     protoreflect.FieldDescriptor
+	fd_BidCommitment_hash       protoreflect.FieldDescriptor
+	fd_BidCommitment_collateral protoreflect.FieldDescriptor
 )
 
-func (x BidType) Enum() *BidType {
-	p := new(BidType)
-	*p = x
-	return p
-}
-
-func (x BidType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func init() {
+	file_fundraising_fundraising_v1_bid_proto_init()
+	md_BidCommitment = File_fundraising_fundraising_v1_bid_proto.Messages().ByName("BidCommitment")
+	fd_BidCommitment_auction_id = md_BidCommitment.Fields().ByName("auction_id")
+	fd_BidCommitment_id = md_BidCommitment.Fields().ByName("id")
+	fd_BidCommitment_bidder = md_BidCommitment.Fields().ByName("bidder")
+	fd_BidCommitment_hash = md_BidCommitment.Fields().ByName("hash")
+	fd_BidCommitment_collateral = md_BidCommitment.Fields().ByName("collateral")
 }
 
-func (BidType) Descriptor() protoreflect.EnumDescriptor {
-	return file_fundraising_fundraising_v1_bid_proto_enumTypes[0].Descriptor()
-}
+var _ protoreflect.Message = (*fastReflection_BidCommitment)(nil)
 
-func (BidType) Type() protoreflect.EnumType {
-	return &file_fundraising_fundraising_v1_bid_proto_enumTypes[0]
-}
+type fastReflection_BidCommitment BidCommitment
 
-func (x BidType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *BidCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BidCommitment)(x)
 }
 
-// Deprecated: Use BidType.Descriptor instead.
-func (BidType) EnumDescriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_bid_proto_rawDescGZIP(), []int{0}
+func (x *BidCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_bid_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-// AddressType enumerates the available types of a address.
-type AddressType int32
-
-const (
-	// the 32 bytes length address type of ADR 028.
-	AddressType_ADDRESS_TYPE_32_BYTES AddressType = 0
-	// the default 20 bytes length address type.
-	AddressType_ADDRESS_TYPE_20_BYTES AddressType = 1
-)
+var _fastReflection_BidCommitment_messageType fastReflection_BidCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_BidCommitment_messageType{}
 
-// Enum value maps for AddressType.
-var (
-	AddressType_name = map[int32]string{
-		0: "ADDRESS_TYPE_32_BYTES",
-		1: "ADDRESS_TYPE_20_BYTES",
-	}
-	AddressType_value = map[string]int32{
-		"ADDRESS_TYPE_32_BYTES": 0,
-		"ADDRESS_TYPE_20_BYTES": 1,
-	}
-)
+type fastReflection_BidCommitment_messageType struct{}
 
-func (x AddressType) Enum() *AddressType {
-	p := new(AddressType)
-	*p = x
-	return p
+func (x fastReflection_BidCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BidCommitment)(nil)
 }
-
-func (x AddressType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x fastReflection_BidCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_BidCommitment)
 }
-
-func (AddressType) Descriptor() protoreflect.EnumDescriptor {
-	return file_fundraising_fundraising_v1_bid_proto_enumTypes[1].Descriptor()
+func (x fastReflection_BidCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BidCommitment
 }
 
-func (AddressType) Type() protoreflect.EnumType {
-	return &file_fundraising_fundraising_v1_bid_proto_enumTypes[1]
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BidCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_BidCommitment
 }
 
-func (x AddressType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BidCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_BidCommitment_messageType
 }
 
-// Deprecated: Use AddressType.Descriptor instead.
-func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_bid_proto_rawDescGZIP(), []int{1}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BidCommitment) New() protoreflect.Message {
+	return new(fastReflection_BidCommitment)
 }
 
-// Bid defines a standard bid for an auction.
-type Bid struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// auction_id specifies the id of the auction
-	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	// bidder specifies the bech32-encoded address that bids for the auction
-	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
-	// id specifies an index of a bid for the bidder
-	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
-	// is how-many-coins
-	Type_ BidType `protobuf:"varint,4,opt,name=type,proto3,enum=fundraising.fundraising.v1.BidType" json:"type,omitempty"`
-	// price specifies the bid price in which price the bidder places the bid
-	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
-	// coin specifies the amount of coin that the bidder bids
-	// for a fixed price auction, the denom is of the paying coin.
-	// for a batch auction of how-much-worth, the denom is of the paying coin.
-	// for a batch auction of how-many-coins, the denom is of the selling coin.
-	Coin *v1beta1.Coin `protobuf:"bytes,6,opt,name=coin,proto3" json:"coin,omitempty"`
-	// is_matched specifies the bid that is a winning bid and enables the bidder
-	// to purchase the selling coin
-	IsMatched bool `protobuf:"varint,7,opt,name=is_matched,json=isMatched,proto3" json:"is_matched,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BidCommitment) Interface() protoreflect.ProtoMessage {
+	return (*BidCommitment)(x)
 }
 
-func (x *Bid) Reset() {
-	*x = Bid{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_bid_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BidCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_BidCommitment_auction_id, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BidCommitment_id, value) {
+			return
+		}
+	}
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_BidCommitment_bidder, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_BidCommitment_hash, value) {
+			return
+		}
+	}
+	if x.Collateral != nil {
+		value := protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+		if !f(fd_BidCommitment_collateral, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BidCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidCommitment.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.BidCommitment.id":
+		return x.Id != uint64(0)
+	case "fundraising.fundraising.v1.BidCommitment.bidder":
+		return x.Bidder != ""
+	case "fundraising.fundraising.v1.BidCommitment.hash":
+		return len(x.Hash) != 0
+	case "fundraising.fundraising.v1.BidCommitment.collateral":
+		return x.Collateral != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidCommitment"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidCommitment.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.BidCommitment.id":
+		x.Id = uint64(0)
+	case "fundraising.fundraising.v1.BidCommitment.bidder":
+		x.Bidder = ""
+	case "fundraising.fundraising.v1.BidCommitment.hash":
+		x.Hash = nil
+	case "fundraising.fundraising.v1.BidCommitment.collateral":
+		x.Collateral = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidCommitment"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BidCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.BidCommitment.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.BidCommitment.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.BidCommitment.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BidCommitment.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	case "fundraising.fundraising.v1.BidCommitment.collateral":
+		value := x.Collateral
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidCommitment"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidCommitment.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.BidCommitment.id":
+		x.Id = value.Uint()
+	case "fundraising.fundraising.v1.BidCommitment.bidder":
+		x.Bidder = value.Interface().(string)
+	case "fundraising.fundraising.v1.BidCommitment.hash":
+		x.Hash = value.Bytes()
+	case "fundraising.fundraising.v1.BidCommitment.collateral":
+		x.Collateral = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidCommitment"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidCommitment.collateral":
+		if x.Collateral == nil {
+			x.Collateral = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+	case "fundraising.fundraising.v1.BidCommitment.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.BidCommitment is not mutable"))
+	case "fundraising.fundraising.v1.BidCommitment.id":
+		panic(fmt.Errorf("field id of message fundraising.fundraising.v1.BidCommitment is not mutable"))
+	case "fundraising.fundraising.v1.BidCommitment.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.BidCommitment is not mutable"))
+	case "fundraising.fundraising.v1.BidCommitment.hash":
+		panic(fmt.Errorf("field hash of message fundraising.fundraising.v1.BidCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidCommitment"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BidCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidCommitment.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.BidCommitment.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.BidCommitment.bidder":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BidCommitment.hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "fundraising.fundraising.v1.BidCommitment.collateral":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidCommitment"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BidCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.BidCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BidCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BidCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BidCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BidCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Collateral != nil {
+			l = options.Size(x.Collateral)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BidCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Collateral != nil {
+			encoded, err := options.Marshal(x.Collateral)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BidCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BidCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Collateral == nil {
+					x.Collateral = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collateral); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fundraising/fundraising/v1/bid.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BidType enumerates the valid types of a bid.
+type BidType int32
+
+const (
+	// BID_TYPE_UNSPECIFIED defines the default bid type
+	BidType_BID_TYPE_UNSPECIFIED BidType = 0
+	// BID_TYPE_FIXED_PRICE defines a bid type for a fixed price auction type
+	BidType_BID_TYPE_FIXED_PRICE BidType = 1
+	// BID_TYPE_BATCH_WORTH defines a bid type for How-Much-Worth-to-Buy of a
+	// batch auction
+	BidType_BID_TYPE_BATCH_WORTH BidType = 2
+	// BID_TYPE_BATCH_MANY defines a bid type for How-Many-Coins-to-Buy of a batch
+	// auction
+	BidType_BID_TYPE_BATCH_MANY BidType = 3
+	// BID_TYPE_DUTCH defines a bid type for a dutch auction type
+	BidType_BID_TYPE_DUTCH BidType = 4
+)
+
+// Enum value maps for BidType.
+var (
+	BidType_name = map[int32]string{
+		0: "BID_TYPE_UNSPECIFIED",
+		1: "BID_TYPE_FIXED_PRICE",
+		2: "BID_TYPE_BATCH_WORTH",
+		3: "BID_TYPE_BATCH_MANY",
+		4: "BID_TYPE_DUTCH",
+	}
+	BidType_value = map[string]int32{
+		"BID_TYPE_UNSPECIFIED": 0,
+		"BID_TYPE_FIXED_PRICE": 1,
+		"BID_TYPE_BATCH_WORTH": 2,
+		"BID_TYPE_BATCH_MANY":  3,
+		"BID_TYPE_DUTCH":       4,
+	}
+)
+
+func (x BidType) Enum() *BidType {
+	p := new(BidType)
+	*p = x
+	return p
+}
+
+func (x BidType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BidType) Descriptor() protoreflect.EnumDescriptor {
+	return file_fundraising_fundraising_v1_bid_proto_enumTypes[0].Descriptor()
+}
+
+func (BidType) Type() protoreflect.EnumType {
+	return &file_fundraising_fundraising_v1_bid_proto_enumTypes[0]
+}
+
+func (x BidType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BidType.Descriptor instead.
+func (BidType) EnumDescriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_bid_proto_rawDescGZIP(), []int{0}
+}
+
+// AddressType enumerates the available types of a address.
+type AddressType int32
+
+const (
+	// the 32 bytes length address type of ADR 028.
+	AddressType_ADDRESS_TYPE_32_BYTES AddressType = 0
+	// the default 20 bytes length address type.
+	AddressType_ADDRESS_TYPE_20_BYTES AddressType = 1
+)
+
+// Enum value maps for AddressType.
+var (
+	AddressType_name = map[int32]string{
+		0: "ADDRESS_TYPE_32_BYTES",
+		1: "ADDRESS_TYPE_20_BYTES",
+	}
+	AddressType_value = map[string]int32{
+		"ADDRESS_TYPE_32_BYTES": 0,
+		"ADDRESS_TYPE_20_BYTES": 1,
+	}
+)
+
+func (x AddressType) Enum() *AddressType {
+	p := new(AddressType)
+	*p = x
+	return p
+}
+
+func (x AddressType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressType) Descriptor() protoreflect.EnumDescriptor {
+	return file_fundraising_fundraising_v1_bid_proto_enumTypes[1].Descriptor()
+}
+
+func (AddressType) Type() protoreflect.EnumType {
+	return &file_fundraising_fundraising_v1_bid_proto_enumTypes[1]
+}
+
+func (x AddressType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressType.Descriptor instead.
+func (AddressType) EnumDescriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_bid_proto_rawDescGZIP(), []int{1}
+}
+
+// Bid defines a standard bid for an auction.
+type Bid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that bids for the auction
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// id specifies an index of a bid for the bidder
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
+	// is how-many-coins, 4 is dutch
+	Type_ BidType `protobuf:"varint,4,opt,name=type,proto3,enum=fundraising.fundraising.v1.BidType" json:"type,omitempty"`
+	// price specifies the bid price in which price the bidder places the bid
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// coin specifies the amount of coin that the bidder bids
+	// for a fixed price auction, the denom is of the paying coin.
+	// for a batch auction of how-much-worth, the denom is of the paying coin.
+	// for a batch auction of how-many-coins, the denom is of the selling coin.
+	// for a dutch auction, the denom is either of the paying or selling coin.
+	Coin *v1beta1.Coin `protobuf:"bytes,6,opt,name=coin,proto3" json:"coin,omitempty"`
+	// is_matched specifies the bid that is a winning bid and enables the bidder
+	// to purchase the selling coin
+	IsMatched bool `protobuf:"varint,7,opt,name=is_matched,json=isMatched,proto3" json:"is_matched,omitempty"`
+	// escrowed_fee specifies the place bid fee held in the module account until
+	// the batch auction is closed; it is refunded if the bid is not matched
+	EscrowedFee []*v1beta1.Coin `protobuf:"bytes,8,rep,name=escrowed_fee,json=escrowedFee,proto3" json:"escrowed_fee,omitempty"`
+	// paying_denom specifies the additional paying denom of the auction that the
+	// bid reserves; it is empty when the bid reserves the paying coin denom
+	PayingDenom string `protobuf:"bytes,9,opt,name=paying_denom,json=payingDenom,proto3" json:"paying_denom,omitempty"`
+}
+
+func (x *Bid) Reset() {
+	*x = Bid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_bid_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
@@ -994,6 +1868,95 @@ func (x *Bid) GetIsMatched() bool {
 	return false
 }
 
+func (x *Bid) GetEscrowedFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.EscrowedFee
+	}
+	return nil
+}
+
+func (x *Bid) GetPayingDenom() string {
+	if x != nil {
+		return x.PayingDenom
+	}
+	return ""
+}
+
+// BidCommitment defines a sealed bid committed for a sealed-bid batch auction.
+// The bid stays hidden until the bidder reveals it during the reveal phase.
+type BidCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// id specifies the index of the commitment within the auction
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// bidder specifies the bech32-encoded address that committed the bid
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// hash specifies the SHA-256 hash of the sealed bid and its salt
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// collateral specifies the paying coin deposited with the commitment; it
+	// must cover the paying coin that the revealed bid reserves
+	Collateral *v1beta1.Coin `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+}
+
+func (x *BidCommitment) Reset() {
+	*x = BidCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_bid_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidCommitment) ProtoMessage() {}
+
+// Deprecated: Use BidCommitment.ProtoReflect.Descriptor instead.
+func (*BidCommitment) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_bid_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BidCommitment) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *BidCommitment) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BidCommitment) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *BidCommitment) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BidCommitment) GetCollateral() *v1beta1.Coin {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
+}
+
 var File_fundraising_fundraising_v1_bid_proto protoreflect.FileDescriptor
 
 var file_fundraising_fundraising_v1_bid_proto_rawDesc = []byte{
@@ -1007,7 +1970,7 @@ var file_fundraising_fundraising_v1_bid_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x02, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x04, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x1d,
 	0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a,
 	0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
@@ -1029,45 +1992,71 @@ var file_fundraising_fundraising_v1_bid_proto_rawDesc = []byte{
 	0x6e, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
 	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x1d, 0x0a,
 	0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0,
-	0x1f, 0x00, 0x2a, 0xca, 0x01, 0x0a, 0x07, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28,
-	0x0a, 0x14, 0x42, 0x49, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0e, 0x8a, 0x9d, 0x20, 0x0a, 0x42, 0x69,
-	0x64, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x69, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x42, 0x49, 0x44, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45,
-	0x10, 0x01, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x42, 0x49, 0x44,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x57, 0x4f, 0x52, 0x54,
-	0x48, 0x10, 0x02, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x13, 0x42, 0x49,
-	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x41, 0x4e,
-	0x59, 0x10, 0x03, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x6e, 0x79, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a,
-	0x79, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31,
-	0x0a, 0x15, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x33,
-	0x32, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x00, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x31, 0x0a, 0x15, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x32, 0x30, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x01, 0x1a, 0x16, 0x8a, 0x9d,
-	0x20, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x32, 0x30, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0x84, 0x02, 0x0a, 0x1e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
-	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x42,
-	0x69, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
-	0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e,
-	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64,
-	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa,
-	0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x84, 0x01, 0x0a,
+	0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64,
+	0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xd0, 0x01, 0x0a,
+	0x0d, 0x42, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a,
+	0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x44, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a,
+	0xf0, 0x01, 0x0a, 0x07, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x14, 0x42,
+	0x49, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0e, 0x8a, 0x9d, 0x20, 0x0a, 0x42, 0x69, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x4e, 0x69, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x42, 0x49, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x1a,
+	0x15, 0x8a, 0x9d, 0x20, 0x11, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x42, 0x49, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x57, 0x4f, 0x52, 0x54, 0x48, 0x10, 0x02,
+	0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x57, 0x6f, 0x72, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x13, 0x42, 0x49, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x10, 0x03,
+	0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x42, 0x49, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x55, 0x54, 0x43, 0x48, 0x10, 0x04, 0x1a, 0x10, 0x8a, 0x9d, 0x20, 0x0c,
+	0x42, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x75, 0x74, 0x63, 0x68, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0x79, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x31, 0x0a, 0x15, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x33, 0x32, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x00, 0x1a, 0x16, 0x8a, 0x9d,
+	0x20, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x33, 0x32, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x32, 0x30, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x01, 0x1a,
+	0x16, 0x8a, 0x9d, 0x20, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x32, 0x30, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0x84, 0x02,
+	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x42, 0x08, 0x42, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46,
+	0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x46,
 	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64,
-	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1083,21 +2072,24 @@ func file_fundraising_fundraising_v1_bid_proto_rawDescGZIP() []byte {
 }
 
 var file_fundraising_fundraising_v1_bid_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_fundraising_fundraising_v1_bid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fundraising_fundraising_v1_bid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_fundraising_fundraising_v1_bid_proto_goTypes = []interface{}{
-	(BidType)(0),         // 0: fundraising.fundraising.v1.BidType
-	(AddressType)(0),     // 1: fundraising.fundraising.v1.AddressType
-	(*Bid)(nil),          // 2: fundraising.fundraising.v1.Bid
-	(*v1beta1.Coin)(nil), // 3: cosmos.base.v1beta1.Coin
+	(BidType)(0),          // 0: fundraising.fundraising.v1.BidType
+	(AddressType)(0),      // 1: fundraising.fundraising.v1.AddressType
+	(*Bid)(nil),           // 2: fundraising.fundraising.v1.Bid
+	(*BidCommitment)(nil), // 3: fundraising.fundraising.v1.BidCommitment
+	(*v1beta1.Coin)(nil),  // 4: cosmos.base.v1beta1.Coin
 }
 var file_fundraising_fundraising_v1_bid_proto_depIdxs = []int32{
 	0, // 0: fundraising.fundraising.v1.Bid.type:type_name -> fundraising.fundraising.v1.BidType
-	3, // 1: fundraising.fundraising.v1.Bid.coin:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 1: fundraising.fundraising.v1.Bid.coin:type_name -> cosmos.base.v1beta1.Coin
+	4, // 2: fundraising.fundraising.v1.Bid.escrowed_fee:type_name -> cosmos.base.v1beta1.Coin
+	4, // 3: fundraising.fundraising.v1.BidCommitment.collateral:type_name -> cosmos.base.v1beta1.Coin
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_fundraising_fundraising_v1_bid_proto_init() }
@@ -1118,6 +2110,18 @@ func file_fundraising_fundraising_v1_bid_proto_init() {
 				return nil
 			}
 		}
+		file_fundraising_fundraising_v1_bid_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1125,7 +2129,7 @@ func file_fundraising_fundraising_v1_bid_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fundraising_fundraising_v1_bid_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -118,11 +118,110 @@ func (x *_Params_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]string
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedPayingDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]string
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field BlockedSellingDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                      protoreflect.MessageDescriptor
-	fd_Params_auction_creation_fee protoreflect.FieldDescriptor
-	fd_Params_place_bid_fee        protoreflect.FieldDescriptor
-	fd_Params_extended_period      protoreflect.FieldDescriptor
+	md_Params                          protoreflect.MessageDescriptor
+	fd_Params_auction_creation_fee     protoreflect.FieldDescriptor
+	fd_Params_place_bid_fee            protoreflect.FieldDescriptor
+	fd_Params_extended_period          protoreflect.FieldDescriptor
+	fd_Params_allowed_paying_denoms    protoreflect.FieldDescriptor
+	fd_Params_blocked_selling_denoms   protoreflect.FieldDescriptor
+	fd_Params_settlement_fee_rate      protoreflect.FieldDescriptor
+	fd_Params_fee_treasury_rate        protoreflect.FieldDescriptor
+	fd_Params_fee_burn_rate            protoreflect.FieldDescriptor
+	fd_Params_fee_treasury_address     protoreflect.FieldDescriptor
+	fd_Params_refund_unmatched_bid_fee protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -131,6 +230,13 @@ func init() {
 	fd_Params_auction_creation_fee = md_Params.Fields().ByName("auction_creation_fee")
 	fd_Params_place_bid_fee = md_Params.Fields().ByName("place_bid_fee")
 	fd_Params_extended_period = md_Params.Fields().ByName("extended_period")
+	fd_Params_allowed_paying_denoms = md_Params.Fields().ByName("allowed_paying_denoms")
+	fd_Params_blocked_selling_denoms = md_Params.Fields().ByName("blocked_selling_denoms")
+	fd_Params_settlement_fee_rate = md_Params.Fields().ByName("settlement_fee_rate")
+	fd_Params_fee_treasury_rate = md_Params.Fields().ByName("fee_treasury_rate")
+	fd_Params_fee_burn_rate = md_Params.Fields().ByName("fee_burn_rate")
+	fd_Params_fee_treasury_address = md_Params.Fields().ByName("fee_treasury_address")
+	fd_Params_refund_unmatched_bid_fee = md_Params.Fields().ByName("refund_unmatched_bid_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -216,6 +322,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.AllowedPayingDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.AllowedPayingDenoms})
+		if !f(fd_Params_allowed_paying_denoms, value) {
+			return
+		}
+	}
+	if len(x.BlockedSellingDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.BlockedSellingDenoms})
+		if !f(fd_Params_blocked_selling_denoms, value) {
+			return
+		}
+	}
+	if x.SettlementFeeRate != "" {
+		value := protoreflect.ValueOfString(x.SettlementFeeRate)
+		if !f(fd_Params_settlement_fee_rate, value) {
+			return
+		}
+	}
+	if x.FeeTreasuryRate != "" {
+		value := protoreflect.ValueOfString(x.FeeTreasuryRate)
+		if !f(fd_Params_fee_treasury_rate, value) {
+			return
+		}
+	}
+	if x.FeeBurnRate != "" {
+		value := protoreflect.ValueOfString(x.FeeBurnRate)
+		if !f(fd_Params_fee_burn_rate, value) {
+			return
+		}
+	}
+	if x.FeeTreasuryAddress != "" {
+		value := protoreflect.ValueOfString(x.FeeTreasuryAddress)
+		if !f(fd_Params_fee_treasury_address, value) {
+			return
+		}
+	}
+	if x.RefundUnmatchedBidFee != false {
+		value := protoreflect.ValueOfBool(x.RefundUnmatchedBidFee)
+		if !f(fd_Params_refund_unmatched_bid_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -237,6 +385,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.PlaceBidFee) != 0
 	case "fundraising.fundraising.v1.Params.extended_period":
 		return x.ExtendedPeriod != uint32(0)
+	case "fundraising.fundraising.v1.Params.allowed_paying_denoms":
+		return len(x.AllowedPayingDenoms) != 0
+	case "fundraising.fundraising.v1.Params.blocked_selling_denoms":
+		return len(x.BlockedSellingDenoms) != 0
+	case "fundraising.fundraising.v1.Params.settlement_fee_rate":
+		return x.SettlementFeeRate != ""
+	case "fundraising.fundraising.v1.Params.fee_treasury_rate":
+		return x.FeeTreasuryRate != ""
+	case "fundraising.fundraising.v1.Params.fee_burn_rate":
+		return x.FeeBurnRate != ""
+	case "fundraising.fundraising.v1.Params.fee_treasury_address":
+		return x.FeeTreasuryAddress != ""
+	case "fundraising.fundraising.v1.Params.refund_unmatched_bid_fee":
+		return x.RefundUnmatchedBidFee != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Params"))
@@ -259,6 +421,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.PlaceBidFee = nil
 	case "fundraising.fundraising.v1.Params.extended_period":
 		x.ExtendedPeriod = uint32(0)
+	case "fundraising.fundraising.v1.Params.allowed_paying_denoms":
+		x.AllowedPayingDenoms = nil
+	case "fundraising.fundraising.v1.Params.blocked_selling_denoms":
+		x.BlockedSellingDenoms = nil
+	case "fundraising.fundraising.v1.Params.settlement_fee_rate":
+		x.SettlementFeeRate = ""
+	case "fundraising.fundraising.v1.Params.fee_treasury_rate":
+		x.FeeTreasuryRate = ""
+	case "fundraising.fundraising.v1.Params.fee_burn_rate":
+		x.FeeBurnRate = ""
+	case "fundraising.fundraising.v1.Params.fee_treasury_address":
+		x.FeeTreasuryAddress = ""
+	case "fundraising.fundraising.v1.Params.refund_unmatched_bid_fee":
+		x.RefundUnmatchedBidFee = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Params"))
@@ -290,6 +466,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "fundraising.fundraising.v1.Params.extended_period":
 		value := x.ExtendedPeriod
 		return protoreflect.ValueOfUint32(value)
+	case "fundraising.fundraising.v1.Params.allowed_paying_denoms":
+		if len(x.AllowedPayingDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.AllowedPayingDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.Params.blocked_selling_denoms":
+		if len(x.BlockedSellingDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.BlockedSellingDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.Params.settlement_fee_rate":
+		value := x.SettlementFeeRate
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.Params.fee_treasury_rate":
+		value := x.FeeTreasuryRate
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.Params.fee_burn_rate":
+		value := x.FeeBurnRate
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.Params.fee_treasury_address":
+		value := x.FeeTreasuryAddress
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.Params.refund_unmatched_bid_fee":
+		value := x.RefundUnmatchedBidFee
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Params"))
@@ -320,6 +523,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.PlaceBidFee = *clv.list
 	case "fundraising.fundraising.v1.Params.extended_period":
 		x.ExtendedPeriod = uint32(value.Uint())
+	case "fundraising.fundraising.v1.Params.allowed_paying_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.AllowedPayingDenoms = *clv.list
+	case "fundraising.fundraising.v1.Params.blocked_selling_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.BlockedSellingDenoms = *clv.list
+	case "fundraising.fundraising.v1.Params.settlement_fee_rate":
+		x.SettlementFeeRate = value.Interface().(string)
+	case "fundraising.fundraising.v1.Params.fee_treasury_rate":
+		x.FeeTreasuryRate = value.Interface().(string)
+	case "fundraising.fundraising.v1.Params.fee_burn_rate":
+		x.FeeBurnRate = value.Interface().(string)
+	case "fundraising.fundraising.v1.Params.fee_treasury_address":
+		x.FeeTreasuryAddress = value.Interface().(string)
+	case "fundraising.fundraising.v1.Params.refund_unmatched_bid_fee":
+		x.RefundUnmatchedBidFee = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Params"))
@@ -352,8 +573,30 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_2_list{list: &x.PlaceBidFee}
 		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.Params.allowed_paying_denoms":
+		if x.AllowedPayingDenoms == nil {
+			x.AllowedPayingDenoms = []string{}
+		}
+		value := &_Params_4_list{list: &x.AllowedPayingDenoms}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.Params.blocked_selling_denoms":
+		if x.BlockedSellingDenoms == nil {
+			x.BlockedSellingDenoms = []string{}
+		}
+		value := &_Params_5_list{list: &x.BlockedSellingDenoms}
+		return protoreflect.ValueOfList(value)
 	case "fundraising.fundraising.v1.Params.extended_period":
 		panic(fmt.Errorf("field extended_period of message fundraising.fundraising.v1.Params is not mutable"))
+	case "fundraising.fundraising.v1.Params.settlement_fee_rate":
+		panic(fmt.Errorf("field settlement_fee_rate of message fundraising.fundraising.v1.Params is not mutable"))
+	case "fundraising.fundraising.v1.Params.fee_treasury_rate":
+		panic(fmt.Errorf("field fee_treasury_rate of message fundraising.fundraising.v1.Params is not mutable"))
+	case "fundraising.fundraising.v1.Params.fee_burn_rate":
+		panic(fmt.Errorf("field fee_burn_rate of message fundraising.fundraising.v1.Params is not mutable"))
+	case "fundraising.fundraising.v1.Params.fee_treasury_address":
+		panic(fmt.Errorf("field fee_treasury_address of message fundraising.fundraising.v1.Params is not mutable"))
+	case "fundraising.fundraising.v1.Params.refund_unmatched_bid_fee":
+		panic(fmt.Errorf("field refund_unmatched_bid_fee of message fundraising.fundraising.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Params"))
@@ -375,6 +618,22 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
 	case "fundraising.fundraising.v1.Params.extended_period":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "fundraising.fundraising.v1.Params.allowed_paying_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "fundraising.fundraising.v1.Params.blocked_selling_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "fundraising.fundraising.v1.Params.settlement_fee_rate":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.Params.fee_treasury_rate":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.Params.fee_burn_rate":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.Params.fee_treasury_address":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.Params.refund_unmatched_bid_fee":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.Params"))
@@ -459,6 +718,37 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ExtendedPeriod != 0 {
 			n += 1 + runtime.Sov(uint64(x.ExtendedPeriod))
 		}
+		if len(x.AllowedPayingDenoms) > 0 {
+			for _, s := range x.AllowedPayingDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockedSellingDenoms) > 0 {
+			for _, s := range x.BlockedSellingDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SettlementFeeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeTreasuryRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeBurnRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeTreasuryAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RefundUnmatchedBidFee {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -488,6 +778,62 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RefundUnmatchedBidFee {
+			i--
+			if x.RefundUnmatchedBidFee {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.FeeTreasuryAddress) > 0 {
+			i -= len(x.FeeTreasuryAddress)
+			copy(dAtA[i:], x.FeeTreasuryAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeTreasuryAddress)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.FeeBurnRate) > 0 {
+			i -= len(x.FeeBurnRate)
+			copy(dAtA[i:], x.FeeBurnRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeBurnRate)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.FeeTreasuryRate) > 0 {
+			i -= len(x.FeeTreasuryRate)
+			copy(dAtA[i:], x.FeeTreasuryRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeTreasuryRate)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.SettlementFeeRate) > 0 {
+			i -= len(x.SettlementFeeRate)
+			copy(dAtA[i:], x.SettlementFeeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SettlementFeeRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.BlockedSellingDenoms) > 0 {
+			for iNdEx := len(x.BlockedSellingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlockedSellingDenoms[iNdEx])
+				copy(dAtA[i:], x.BlockedSellingDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockedSellingDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AllowedPayingDenoms) > 0 {
+			for iNdEx := len(x.AllowedPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedPayingDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedPayingDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedPayingDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.ExtendedPeriod != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExtendedPeriod))
 			i--
@@ -661,6 +1007,218 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedPayingDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedPayingDenoms = append(x.AllowedPayingDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedSellingDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockedSellingDenoms = append(x.BlockedSellingDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementFeeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SettlementFeeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTreasuryRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeTreasuryRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeBurnRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTreasuryAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeTreasuryAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundUnmatchedBidFee", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RefundUnmatchedBidFee = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -726,6 +1284,28 @@ type Params struct {
 	// extended_period specifies the extended period that determines how long
 	// the extended auction round lasts
 	ExtendedPeriod uint32 `protobuf:"varint,3,opt,name=extended_period,json=extendedPeriod,proto3" json:"extended_period,omitempty"`
+	// allowed_paying_denoms specifies the denoms that an auction can be paid
+	// with; any denom is allowed when it is empty
+	AllowedPayingDenoms []string `protobuf:"bytes,4,rep,name=allowed_paying_denoms,json=allowedPayingDenoms,proto3" json:"allowed_paying_denoms,omitempty"`
+	// blocked_selling_denoms specifies the denoms that can't be sold in an
+	// auction, such as the staking denom
+	BlockedSellingDenoms []string `protobuf:"bytes,5,rep,name=blocked_selling_denoms,json=blockedSellingDenoms,proto3" json:"blocked_selling_denoms,omitempty"`
+	// settlement_fee_rate specifies the rate of the raised paying coin that is
+	// charged as a fee when an auction is settled
+	SettlementFeeRate string `protobuf:"bytes,6,opt,name=settlement_fee_rate,json=settlementFeeRate,proto3" json:"settlement_fee_rate,omitempty"`
+	// fee_treasury_rate specifies the rate of the collected fees that is sent
+	// to fee_treasury_address
+	FeeTreasuryRate string `protobuf:"bytes,7,opt,name=fee_treasury_rate,json=feeTreasuryRate,proto3" json:"fee_treasury_rate,omitempty"`
+	// fee_burn_rate specifies the rate of the collected fees that is burned;
+	// the rest of the collected fees goes to the community pool
+	FeeBurnRate string `protobuf:"bytes,8,opt,name=fee_burn_rate,json=feeBurnRate,proto3" json:"fee_burn_rate,omitempty"`
+	// fee_treasury_address specifies the bech32-encoded address of the treasury
+	// that receives the fee_treasury_rate of the collected fees
+	FeeTreasuryAddress string `protobuf:"bytes,9,opt,name=fee_treasury_address,json=feeTreasuryAddress,proto3" json:"fee_treasury_address,omitempty"`
+	// refund_unmatched_bid_fee specifies whether the place bid fees of batch
+	// auctions are escrowed in the module account until the auction is closed,
+	// so that the fees of the unmatched bids are refunded to the bidders
+	RefundUnmatchedBidFee bool `protobuf:"varint,10,opt,name=refund_unmatched_bid_fee,json=refundUnmatchedBidFee,proto3" json:"refund_unmatched_bid_fee,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -769,6 +1349,55 @@ func (x *Params) GetExtendedPeriod() uint32 {
 	return 0
 }
 
+func (x *Params) GetAllowedPayingDenoms() []string {
+	if x != nil {
+		return x.AllowedPayingDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetBlockedSellingDenoms() []string {
+	if x != nil {
+		return x.BlockedSellingDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetSettlementFeeRate() string {
+	if x != nil {
+		return x.SettlementFeeRate
+	}
+	return ""
+}
+
+func (x *Params) GetFeeTreasuryRate() string {
+	if x != nil {
+		return x.FeeTreasuryRate
+	}
+	return ""
+}
+
+func (x *Params) GetFeeBurnRate() string {
+	if x != nil {
+		return x.FeeBurnRate
+	}
+	return ""
+}
+
+func (x *Params) GetFeeTreasuryAddress() string {
+	if x != nil {
+		return x.FeeTreasuryAddress
+	}
+	return ""
+}
+
+func (x *Params) GetRefundUnmatchedBidFee() bool {
+	if x != nil {
+		return x.RefundUnmatchedBidFee
+	}
+	return false
+}
+
 var File_fundraising_fundraising_v1_params_proto protoreflect.FileDescriptor
 
 var file_fundraising_fundraising_v1_params_proto_rawDesc = []byte{
@@ -782,7 +1411,7 @@ var file_fundraising_fundraising_v1_params_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x02, 0x0a, 0x06, 0x50, 0x61,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x07, 0x0a, 0x06, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
@@ -803,27 +1432,59 @@ var file_fundraising_fundraising_v1_params_proto_rawDesc = []byte{
 	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x46,
 	0x65, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70,
 	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x29, 0xe8, 0xa0, 0x1f,
-	0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2f, 0x78, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x87, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66,
-	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64,
-	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02,
-	0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e,
-	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a,
-	0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x50, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x61, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4a,
+	0x0a, 0x14, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x66, 0x65, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62,
+	0x69, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x69, 0x64,
+	0x46, 0x65, 0x65, 0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x78, 0x2f, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x87,
+	0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
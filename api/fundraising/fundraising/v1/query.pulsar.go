@@ -4,6 +4,7 @@ package fundraisingv1
 import (
 	_ "cosmossdk.io/api/amino"
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -810,29 +811,23 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryAllAuctionRequest            protoreflect.MessageDescriptor
-	fd_QueryAllAuctionRequest_status     protoreflect.FieldDescriptor
-	fd_QueryAllAuctionRequest_type       protoreflect.FieldDescriptor
-	fd_QueryAllAuctionRequest_pagination protoreflect.FieldDescriptor
+	md_QueryAllowedDenomsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllAuctionRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAuctionRequest")
-	fd_QueryAllAuctionRequest_status = md_QueryAllAuctionRequest.Fields().ByName("status")
-	fd_QueryAllAuctionRequest_type = md_QueryAllAuctionRequest.Fields().ByName("type")
-	fd_QueryAllAuctionRequest_pagination = md_QueryAllAuctionRequest.Fields().ByName("pagination")
+	md_QueryAllowedDenomsRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllowedDenomsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllAuctionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllowedDenomsRequest)(nil)
 
-type fastReflection_QueryAllAuctionRequest QueryAllAuctionRequest
+type fastReflection_QueryAllowedDenomsRequest QueryAllowedDenomsRequest
 
-func (x *QueryAllAuctionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllAuctionRequest)(x)
+func (x *QueryAllowedDenomsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowedDenomsRequest)(x)
 }
 
-func (x *QueryAllAuctionRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllowedDenomsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,43 +839,43 @@ func (x *QueryAllAuctionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllAuctionRequest_messageType fastReflection_QueryAllAuctionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllAuctionRequest_messageType{}
+var _fastReflection_QueryAllowedDenomsRequest_messageType fastReflection_QueryAllowedDenomsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowedDenomsRequest_messageType{}
 
-type fastReflection_QueryAllAuctionRequest_messageType struct{}
+type fastReflection_QueryAllowedDenomsRequest_messageType struct{}
 
-func (x fastReflection_QueryAllAuctionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllAuctionRequest)(nil)
+func (x fastReflection_QueryAllowedDenomsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowedDenomsRequest)(nil)
 }
-func (x fastReflection_QueryAllAuctionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAuctionRequest)
+func (x fastReflection_QueryAllowedDenomsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowedDenomsRequest)
 }
-func (x fastReflection_QueryAllAuctionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAuctionRequest
+func (x fastReflection_QueryAllowedDenomsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowedDenomsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllAuctionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAuctionRequest
+func (x *fastReflection_QueryAllowedDenomsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowedDenomsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllAuctionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllAuctionRequest_messageType
+func (x *fastReflection_QueryAllowedDenomsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowedDenomsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllAuctionRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAuctionRequest)
+func (x *fastReflection_QueryAllowedDenomsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowedDenomsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllAuctionRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllAuctionRequest)(x)
+func (x *fastReflection_QueryAllowedDenomsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowedDenomsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -888,25 +883,7 @@ func (x *fastReflection_QueryAllAuctionRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllAuctionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != "" {
-		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_QueryAllAuctionRequest_status, value) {
-			return
-		}
-	}
-	if x.Type_ != "" {
-		value := protoreflect.ValueOfString(x.Type_)
-		if !f(fd_QueryAllAuctionRequest_type, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllAuctionRequest_pagination, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryAllowedDenomsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -920,19 +897,13 @@ func (x *fastReflection_QueryAllAuctionRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllAuctionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowedDenomsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
-		return x.Status != ""
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
-		return x.Type_ != ""
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -942,19 +913,13 @@ func (x *fastReflection_QueryAllAuctionRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowedDenomsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
-		x.Status = ""
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
-		x.Type_ = ""
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -964,22 +929,13 @@ func (x *fastReflection_QueryAllAuctionRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllAuctionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowedDenomsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
-		value := x.Status
-		return protoreflect.ValueOfString(value)
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
-		value := x.Type_
-		return protoreflect.ValueOfString(value)
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -993,19 +949,13 @@ func (x *fastReflection_QueryAllAuctionRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowedDenomsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
-		x.Status = value.Interface().(string)
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
-		x.Type_ = value.Interface().(string)
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1019,52 +969,36 @@ func (x *fastReflection_QueryAllAuctionRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowedDenomsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
-		panic(fmt.Errorf("field status of message fundraising.fundraising.v1.QueryAllAuctionRequest is not mutable"))
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
-		panic(fmt.Errorf("field type of message fundraising.fundraising.v1.QueryAllAuctionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllAuctionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowedDenomsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
-		return protoreflect.ValueOfString("")
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
-		return protoreflect.ValueOfString("")
-	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllAuctionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowedDenomsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAuctionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllowedDenomsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1072,7 +1006,7 @@ func (x *fastReflection_QueryAllAuctionRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllAuctionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowedDenomsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1083,7 +1017,7 @@ func (x *fastReflection_QueryAllAuctionRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowedDenomsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1095,7 +1029,7 @@ func (x *fastReflection_QueryAllAuctionRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllAuctionRequest) IsValid() bool {
+func (x *fastReflection_QueryAllowedDenomsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1105,9 +1039,9 @@ func (x *fastReflection_QueryAllAuctionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowedDenomsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllAuctionRequest)
+		x := input.Message.Interface().(*QueryAllowedDenomsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1119,18 +1053,6 @@ func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.Status)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Type_)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1141,7 +1063,7 @@ func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAuctionRequest)
+		x := input.Message.Interface().(*QueryAllowedDenomsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1160,34 +1082,6 @@ func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Type_) > 0 {
-			i -= len(x.Type_)
-			copy(dAtA[i:], x.Type_)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Status) > 0 {
-			i -= len(x.Status)
-			copy(dAtA[i:], x.Status)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1199,7 +1093,7 @@ func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAuctionRequest)
+		x := input.Message.Interface().(*QueryAllowedDenomsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1231,126 +1125,26 @@ func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowedDenomsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Status = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Type_ = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -1372,79 +1166,120 @@ func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllAuctionResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryAllowedDenomsResponse_1_list)(nil)
 
-type _QueryAllAuctionResponse_1_list struct {
-	list *[]*anypb.Any
+type _QueryAllowedDenomsResponse_1_list struct {
+	list *[]string
 }
 
-func (x *_QueryAllAuctionResponse_1_list) Len() int {
+func (x *_QueryAllowedDenomsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryAllAuctionResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_QueryAllowedDenomsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_QueryAllAuctionResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+func (x *_QueryAllowedDenomsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryAllAuctionResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+func (x *_QueryAllowedDenomsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryAllAuctionResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_QueryAllowedDenomsResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryAllowedDenomsResponse at list field AllowedPayingDenoms as it is not of Message kind"))
 }
 
-func (x *_QueryAllAuctionResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+func (x *_QueryAllowedDenomsResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllowedDenomsResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryAllowedDenomsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAllowedDenomsResponse_2_list)(nil)
+
+type _QueryAllowedDenomsResponse_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryAllowedDenomsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllowedDenomsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryAllowedDenomsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllowedDenomsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllowedDenomsResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryAllowedDenomsResponse at list field BlockedSellingDenoms as it is not of Message kind"))
+}
+
+func (x *_QueryAllowedDenomsResponse_2_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryAllAuctionResponse_1_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_QueryAllowedDenomsResponse_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_QueryAllAuctionResponse_1_list) IsValid() bool {
+func (x *_QueryAllowedDenomsResponse_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryAllAuctionResponse            protoreflect.MessageDescriptor
-	fd_QueryAllAuctionResponse_auction    protoreflect.FieldDescriptor
-	fd_QueryAllAuctionResponse_pagination protoreflect.FieldDescriptor
+	md_QueryAllowedDenomsResponse                        protoreflect.MessageDescriptor
+	fd_QueryAllowedDenomsResponse_allowed_paying_denoms  protoreflect.FieldDescriptor
+	fd_QueryAllowedDenomsResponse_blocked_selling_denoms protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllAuctionResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAuctionResponse")
-	fd_QueryAllAuctionResponse_auction = md_QueryAllAuctionResponse.Fields().ByName("auction")
-	fd_QueryAllAuctionResponse_pagination = md_QueryAllAuctionResponse.Fields().ByName("pagination")
+	md_QueryAllowedDenomsResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllowedDenomsResponse")
+	fd_QueryAllowedDenomsResponse_allowed_paying_denoms = md_QueryAllowedDenomsResponse.Fields().ByName("allowed_paying_denoms")
+	fd_QueryAllowedDenomsResponse_blocked_selling_denoms = md_QueryAllowedDenomsResponse.Fields().ByName("blocked_selling_denoms")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllAuctionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllowedDenomsResponse)(nil)
 
-type fastReflection_QueryAllAuctionResponse QueryAllAuctionResponse
+type fastReflection_QueryAllowedDenomsResponse QueryAllowedDenomsResponse
 
-func (x *QueryAllAuctionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllAuctionResponse)(x)
+func (x *QueryAllowedDenomsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowedDenomsResponse)(x)
 }
 
-func (x *QueryAllAuctionResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllowedDenomsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1456,43 +1291,43 @@ func (x *QueryAllAuctionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllAuctionResponse_messageType fastReflection_QueryAllAuctionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllAuctionResponse_messageType{}
+var _fastReflection_QueryAllowedDenomsResponse_messageType fastReflection_QueryAllowedDenomsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowedDenomsResponse_messageType{}
 
-type fastReflection_QueryAllAuctionResponse_messageType struct{}
+type fastReflection_QueryAllowedDenomsResponse_messageType struct{}
 
-func (x fastReflection_QueryAllAuctionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllAuctionResponse)(nil)
+func (x fastReflection_QueryAllowedDenomsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowedDenomsResponse)(nil)
 }
-func (x fastReflection_QueryAllAuctionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAuctionResponse)
+func (x fastReflection_QueryAllowedDenomsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowedDenomsResponse)
 }
-func (x fastReflection_QueryAllAuctionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAuctionResponse
+func (x fastReflection_QueryAllowedDenomsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowedDenomsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllAuctionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAuctionResponse
+func (x *fastReflection_QueryAllowedDenomsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowedDenomsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllAuctionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllAuctionResponse_messageType
+func (x *fastReflection_QueryAllowedDenomsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowedDenomsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllAuctionResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAuctionResponse)
+func (x *fastReflection_QueryAllowedDenomsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowedDenomsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllAuctionResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllAuctionResponse)(x)
+func (x *fastReflection_QueryAllowedDenomsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowedDenomsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1500,16 +1335,16 @@ func (x *fastReflection_QueryAllAuctionResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllAuctionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Auction) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllAuctionResponse_1_list{list: &x.Auction})
-		if !f(fd_QueryAllAuctionResponse_auction, value) {
+func (x *fastReflection_QueryAllowedDenomsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AllowedPayingDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllowedDenomsResponse_1_list{list: &x.AllowedPayingDenoms})
+		if !f(fd_QueryAllowedDenomsResponse_allowed_paying_denoms, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllAuctionResponse_pagination, value) {
+	if len(x.BlockedSellingDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllowedDenomsResponse_2_list{list: &x.BlockedSellingDenoms})
+		if !f(fd_QueryAllowedDenomsResponse_blocked_selling_denoms, value) {
 			return
 		}
 	}
@@ -1526,17 +1361,17 @@ func (x *fastReflection_QueryAllAuctionResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllAuctionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllowedDenomsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
-		return len(x.Auction) != 0
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
-		return x.Pagination != nil
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.allowed_paying_denoms":
+		return len(x.AllowedPayingDenoms) != 0
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.blocked_selling_denoms":
+		return len(x.BlockedSellingDenoms) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1546,17 +1381,17 @@ func (x *fastReflection_QueryAllAuctionResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllowedDenomsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
-		x.Auction = nil
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
-		x.Pagination = nil
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.allowed_paying_denoms":
+		x.AllowedPayingDenoms = nil
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.blocked_selling_denoms":
+		x.BlockedSellingDenoms = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1566,22 +1401,25 @@ func (x *fastReflection_QueryAllAuctionResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllAuctionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowedDenomsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
-		if len(x.Auction) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllAuctionResponse_1_list{})
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.allowed_paying_denoms":
+		if len(x.AllowedPayingDenoms) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllowedDenomsResponse_1_list{})
 		}
-		listValue := &_QueryAllAuctionResponse_1_list{list: &x.Auction}
+		listValue := &_QueryAllowedDenomsResponse_1_list{list: &x.AllowedPayingDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.blocked_selling_denoms":
+		if len(x.BlockedSellingDenoms) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllowedDenomsResponse_2_list{})
+		}
+		listValue := &_QueryAllowedDenomsResponse_2_list{list: &x.BlockedSellingDenoms}
 		return protoreflect.ValueOfList(listValue)
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1595,19 +1433,21 @@ func (x *fastReflection_QueryAllAuctionResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllowedDenomsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.allowed_paying_denoms":
 		lv := value.List()
-		clv := lv.(*_QueryAllAuctionResponse_1_list)
-		x.Auction = *clv.list
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+		clv := lv.(*_QueryAllowedDenomsResponse_1_list)
+		x.AllowedPayingDenoms = *clv.list
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.blocked_selling_denoms":
+		lv := value.List()
+		clv := lv.(*_QueryAllowedDenomsResponse_2_list)
+		x.BlockedSellingDenoms = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1621,53 +1461,54 @@ func (x *fastReflection_QueryAllAuctionResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowedDenomsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
-		if x.Auction == nil {
-			x.Auction = []*anypb.Any{}
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.allowed_paying_denoms":
+		if x.AllowedPayingDenoms == nil {
+			x.AllowedPayingDenoms = []string{}
 		}
-		value := &_QueryAllAuctionResponse_1_list{list: &x.Auction}
+		value := &_QueryAllowedDenomsResponse_1_list{list: &x.AllowedPayingDenoms}
 		return protoreflect.ValueOfList(value)
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.blocked_selling_denoms":
+		if x.BlockedSellingDenoms == nil {
+			x.BlockedSellingDenoms = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		value := &_QueryAllowedDenomsResponse_2_list{list: &x.BlockedSellingDenoms}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllAuctionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllowedDenomsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_QueryAllAuctionResponse_1_list{list: &list})
-	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.allowed_paying_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryAllowedDenomsResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryAllowedDenomsResponse.blocked_selling_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryAllowedDenomsResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllowedDenomsResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllowedDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllAuctionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllowedDenomsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAuctionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllowedDenomsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1675,7 +1516,7 @@ func (x *fastReflection_QueryAllAuctionResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllAuctionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllowedDenomsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1686,7 +1527,7 @@ func (x *fastReflection_QueryAllAuctionResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAuctionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllowedDenomsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1698,7 +1539,7 @@ func (x *fastReflection_QueryAllAuctionResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllAuctionResponse) IsValid() bool {
+func (x *fastReflection_QueryAllowedDenomsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1708,9 +1549,9 @@ func (x *fastReflection_QueryAllAuctionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllowedDenomsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllAuctionResponse)
+		x := input.Message.Interface().(*QueryAllowedDenomsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1722,15 +1563,17 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Auction) > 0 {
-			for _, e := range x.Auction {
-				l = options.Size(e)
+		if len(x.AllowedPayingDenoms) > 0 {
+			for _, s := range x.AllowedPayingDenoms {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.BlockedSellingDenoms) > 0 {
+			for _, s := range x.BlockedSellingDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1742,7 +1585,7 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAuctionResponse)
+		x := input.Message.Interface().(*QueryAllowedDenomsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1761,32 +1604,20 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.BlockedSellingDenoms) > 0 {
+			for iNdEx := len(x.BlockedSellingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlockedSellingDenoms[iNdEx])
+				copy(dAtA[i:], x.BlockedSellingDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockedSellingDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.Auction) > 0 {
-			for iNdEx := len(x.Auction) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Auction[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.AllowedPayingDenoms) > 0 {
+			for iNdEx := len(x.AllowedPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedPayingDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedPayingDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedPayingDenoms[iNdEx])))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -1802,7 +1633,7 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAuctionResponse)
+		x := input.Message.Interface().(*QueryAllowedDenomsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1834,17 +1665,17 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowedDenomsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedPayingDenoms", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1854,31 +1685,29 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Auction = append(x.Auction, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auction[len(x.Auction)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.AllowedPayingDenoms = append(x.AllowedPayingDenoms, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedSellingDenoms", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1888,27 +1717,23 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.BlockedSellingDenoms = append(x.BlockedSellingDenoms, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1946,25 +1771,29 @@ func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryGetAuctionRequest            protoreflect.MessageDescriptor
-	fd_QueryGetAuctionRequest_auction_id protoreflect.FieldDescriptor
+	md_QueryAllAuctionRequest            protoreflect.MessageDescriptor
+	fd_QueryAllAuctionRequest_status     protoreflect.FieldDescriptor
+	fd_QueryAllAuctionRequest_type       protoreflect.FieldDescriptor
+	fd_QueryAllAuctionRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryGetAuctionRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAuctionRequest")
-	fd_QueryGetAuctionRequest_auction_id = md_QueryGetAuctionRequest.Fields().ByName("auction_id")
+	md_QueryAllAuctionRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAuctionRequest")
+	fd_QueryAllAuctionRequest_status = md_QueryAllAuctionRequest.Fields().ByName("status")
+	fd_QueryAllAuctionRequest_type = md_QueryAllAuctionRequest.Fields().ByName("type")
+	fd_QueryAllAuctionRequest_pagination = md_QueryAllAuctionRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetAuctionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllAuctionRequest)(nil)
 
-type fastReflection_QueryGetAuctionRequest QueryGetAuctionRequest
+type fastReflection_QueryAllAuctionRequest QueryAllAuctionRequest
 
-func (x *QueryGetAuctionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetAuctionRequest)(x)
-}
+func (x *QueryAllAuctionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllAuctionRequest)(x)
+}
 
-func (x *QueryGetAuctionRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllAuctionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1976,43 +1805,43 @@ func (x *QueryGetAuctionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetAuctionRequest_messageType fastReflection_QueryGetAuctionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetAuctionRequest_messageType{}
+var _fastReflection_QueryAllAuctionRequest_messageType fastReflection_QueryAllAuctionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllAuctionRequest_messageType{}
 
-type fastReflection_QueryGetAuctionRequest_messageType struct{}
+type fastReflection_QueryAllAuctionRequest_messageType struct{}
 
-func (x fastReflection_QueryGetAuctionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetAuctionRequest)(nil)
+func (x fastReflection_QueryAllAuctionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllAuctionRequest)(nil)
 }
-func (x fastReflection_QueryGetAuctionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAuctionRequest)
+func (x fastReflection_QueryAllAuctionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAuctionRequest)
 }
-func (x fastReflection_QueryGetAuctionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAuctionRequest
+func (x fastReflection_QueryAllAuctionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAuctionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetAuctionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAuctionRequest
+func (x *fastReflection_QueryAllAuctionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAuctionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetAuctionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetAuctionRequest_messageType
+func (x *fastReflection_QueryAllAuctionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllAuctionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetAuctionRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAuctionRequest)
+func (x *fastReflection_QueryAllAuctionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAuctionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetAuctionRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetAuctionRequest)(x)
+func (x *fastReflection_QueryAllAuctionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllAuctionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2020,10 +1849,22 @@ func (x *fastReflection_QueryGetAuctionRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetAuctionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AuctionId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AuctionId)
-		if !f(fd_QueryGetAuctionRequest_auction_id, value) {
+func (x *fastReflection_QueryAllAuctionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_QueryAllAuctionRequest_status, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_QueryAllAuctionRequest_type, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllAuctionRequest_pagination, value) {
 			return
 		}
 	}
@@ -2040,15 +1881,19 @@ func (x *fastReflection_QueryGetAuctionRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetAuctionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllAuctionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
-		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
+		return x.Status != ""
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
+		return x.Type_ != ""
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2058,15 +1903,19 @@ func (x *fastReflection_QueryGetAuctionRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllAuctionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
-		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
+		x.Status = ""
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
+		x.Type_ = ""
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2076,16 +1925,22 @@ func (x *fastReflection_QueryGetAuctionRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetAuctionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllAuctionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
-		value := x.AuctionId
-		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2099,15 +1954,19 @@ func (x *fastReflection_QueryGetAuctionRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllAuctionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
-		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
+		x.Status = value.Interface().(string)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
+		x.Type_ = value.Interface().(string)
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2121,40 +1980,52 @@ func (x *fastReflection_QueryGetAuctionRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllAuctionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
-		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryGetAuctionRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
+		panic(fmt.Errorf("field status of message fundraising.fundraising.v1.QueryAllAuctionRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
+		panic(fmt.Errorf("field type of message fundraising.fundraising.v1.QueryAllAuctionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetAuctionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllAuctionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.status":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.type":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QueryAllAuctionRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetAuctionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllAuctionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAuctionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAuctionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2162,7 +2033,7 @@ func (x *fastReflection_QueryGetAuctionRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetAuctionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllAuctionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2173,7 +2044,7 @@ func (x *fastReflection_QueryGetAuctionRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllAuctionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2185,7 +2056,7 @@ func (x *fastReflection_QueryGetAuctionRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetAuctionRequest) IsValid() bool {
+func (x *fastReflection_QueryAllAuctionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2195,9 +2066,9 @@ func (x *fastReflection_QueryGetAuctionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllAuctionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetAuctionRequest)
+		x := input.Message.Interface().(*QueryAllAuctionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2209,8 +2080,17 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.AuctionId != 0 {
-			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2222,7 +2102,7 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAuctionRequest)
+		x := input.Message.Interface().(*QueryAllAuctionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2241,10 +2121,33 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AuctionId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1a
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2257,7 +2160,7 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAuctionRequest)
+		x := input.Message.Interface().(*QueryAllAuctionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2289,17 +2192,17 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				x.AuctionId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2309,39 +2212,120 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AuctionId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
 		Marshal:           marshal,
 		Unmarshal:         unmarshal,
 		Merge:             nil,
@@ -2349,26 +2333,79 @@ func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_QueryAllAuctionResponse_1_list)(nil)
+
+type _QueryAllAuctionResponse_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_QueryAllAuctionResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllAuctionResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllAuctionResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllAuctionResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllAuctionResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllAuctionResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllAuctionResponse_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllAuctionResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryGetAuctionResponse         protoreflect.MessageDescriptor
-	fd_QueryGetAuctionResponse_auction protoreflect.FieldDescriptor
+	md_QueryAllAuctionResponse            protoreflect.MessageDescriptor
+	fd_QueryAllAuctionResponse_auction    protoreflect.FieldDescriptor
+	fd_QueryAllAuctionResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryGetAuctionResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAuctionResponse")
-	fd_QueryGetAuctionResponse_auction = md_QueryGetAuctionResponse.Fields().ByName("auction")
+	md_QueryAllAuctionResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAuctionResponse")
+	fd_QueryAllAuctionResponse_auction = md_QueryAllAuctionResponse.Fields().ByName("auction")
+	fd_QueryAllAuctionResponse_pagination = md_QueryAllAuctionResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetAuctionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllAuctionResponse)(nil)
 
-type fastReflection_QueryGetAuctionResponse QueryGetAuctionResponse
+type fastReflection_QueryAllAuctionResponse QueryAllAuctionResponse
 
-func (x *QueryGetAuctionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetAuctionResponse)(x)
+func (x *QueryAllAuctionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllAuctionResponse)(x)
 }
 
-func (x *QueryGetAuctionResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllAuctionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2380,43 +2417,43 @@ func (x *QueryGetAuctionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetAuctionResponse_messageType fastReflection_QueryGetAuctionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetAuctionResponse_messageType{}
+var _fastReflection_QueryAllAuctionResponse_messageType fastReflection_QueryAllAuctionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllAuctionResponse_messageType{}
 
-type fastReflection_QueryGetAuctionResponse_messageType struct{}
+type fastReflection_QueryAllAuctionResponse_messageType struct{}
 
-func (x fastReflection_QueryGetAuctionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetAuctionResponse)(nil)
+func (x fastReflection_QueryAllAuctionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllAuctionResponse)(nil)
 }
-func (x fastReflection_QueryGetAuctionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAuctionResponse)
+func (x fastReflection_QueryAllAuctionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAuctionResponse)
 }
-func (x fastReflection_QueryGetAuctionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAuctionResponse
+func (x fastReflection_QueryAllAuctionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAuctionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetAuctionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAuctionResponse
+func (x *fastReflection_QueryAllAuctionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAuctionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetAuctionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetAuctionResponse_messageType
+func (x *fastReflection_QueryAllAuctionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllAuctionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetAuctionResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAuctionResponse)
+func (x *fastReflection_QueryAllAuctionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAuctionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetAuctionResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetAuctionResponse)(x)
+func (x *fastReflection_QueryAllAuctionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllAuctionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2424,10 +2461,16 @@ func (x *fastReflection_QueryGetAuctionResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetAuctionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Auction != nil {
-		value := protoreflect.ValueOfMessage(x.Auction.ProtoReflect())
-		if !f(fd_QueryGetAuctionResponse_auction, value) {
+func (x *fastReflection_QueryAllAuctionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Auction) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllAuctionResponse_1_list{list: &x.Auction})
+		if !f(fd_QueryAllAuctionResponse_auction, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllAuctionResponse_pagination, value) {
 			return
 		}
 	}
@@ -2444,15 +2487,17 @@ func (x *fastReflection_QueryGetAuctionResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetAuctionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllAuctionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
-		return x.Auction != nil
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
+		return len(x.Auction) != 0
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2462,15 +2507,17 @@ func (x *fastReflection_QueryGetAuctionResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllAuctionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
 		x.Auction = nil
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2480,16 +2527,22 @@ func (x *fastReflection_QueryGetAuctionResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetAuctionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllAuctionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
-		value := x.Auction
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
+		if len(x.Auction) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllAuctionResponse_1_list{})
+		}
+		listValue := &_QueryAllAuctionResponse_1_list{list: &x.Auction}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2503,15 +2556,19 @@ func (x *fastReflection_QueryGetAuctionResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllAuctionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
-		x.Auction = value.Message().Interface().(*anypb.Any)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
+		lv := value.List()
+		clv := lv.(*_QueryAllAuctionResponse_1_list)
+		x.Auction = *clv.list
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2525,44 +2582,53 @@ func (x *fastReflection_QueryGetAuctionResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllAuctionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
 		if x.Auction == nil {
-			x.Auction = new(anypb.Any)
+			x.Auction = []*anypb.Any{}
 		}
-		return protoreflect.ValueOfMessage(x.Auction.ProtoReflect())
+		value := &_QueryAllAuctionResponse_1_list{list: &x.Auction}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetAuctionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllAuctionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
-		m := new(anypb.Any)
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.auction":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_QueryAllAuctionResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryAllAuctionResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetAuctionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllAuctionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAuctionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAuctionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2570,7 +2636,7 @@ func (x *fastReflection_QueryGetAuctionResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetAuctionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllAuctionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2581,7 +2647,7 @@ func (x *fastReflection_QueryGetAuctionResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAuctionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllAuctionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2593,7 +2659,7 @@ func (x *fastReflection_QueryGetAuctionResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetAuctionResponse) IsValid() bool {
+func (x *fastReflection_QueryAllAuctionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2603,9 +2669,9 @@ func (x *fastReflection_QueryGetAuctionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllAuctionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetAuctionResponse)
+		x := input.Message.Interface().(*QueryAllAuctionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2617,8 +2683,14 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Auction != nil {
-			l = options.Size(x.Auction)
+		if len(x.Auction) > 0 {
+			for _, e := range x.Auction {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2631,7 +2703,7 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAuctionResponse)
+		x := input.Message.Interface().(*QueryAllAuctionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2650,8 +2722,8 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Auction != nil {
-			encoded, err := options.Marshal(x.Auction)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2662,7 +2734,23 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Auction) > 0 {
+			for iNdEx := len(x.Auction) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Auction[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2675,7 +2763,7 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAuctionResponse)
+		x := input.Message.Interface().(*QueryAllAuctionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2707,10 +2795,10 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2742,10 +2830,44 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Auction == nil {
-					x.Auction = &anypb.Any{}
+				x.Auction = append(x.Auction, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auction[len(x.Auction)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auction); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2785,27 +2907,25 @@ func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryAllAllowedBidderRequest            protoreflect.MessageDescriptor
-	fd_QueryAllAllowedBidderRequest_auction_id protoreflect.FieldDescriptor
-	fd_QueryAllAllowedBidderRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGetAuctionRequest            protoreflect.MessageDescriptor
+	fd_QueryGetAuctionRequest_auction_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllAllowedBidderRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAllowedBidderRequest")
-	fd_QueryAllAllowedBidderRequest_auction_id = md_QueryAllAllowedBidderRequest.Fields().ByName("auction_id")
-	fd_QueryAllAllowedBidderRequest_pagination = md_QueryAllAllowedBidderRequest.Fields().ByName("pagination")
+	md_QueryGetAuctionRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAuctionRequest")
+	fd_QueryGetAuctionRequest_auction_id = md_QueryGetAuctionRequest.Fields().ByName("auction_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllAllowedBidderRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetAuctionRequest)(nil)
 
-type fastReflection_QueryAllAllowedBidderRequest QueryAllAllowedBidderRequest
+type fastReflection_QueryGetAuctionRequest QueryGetAuctionRequest
 
-func (x *QueryAllAllowedBidderRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllAllowedBidderRequest)(x)
+func (x *QueryGetAuctionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAuctionRequest)(x)
 }
 
-func (x *QueryAllAllowedBidderRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetAuctionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2817,43 +2937,43 @@ func (x *QueryAllAllowedBidderRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllAllowedBidderRequest_messageType fastReflection_QueryAllAllowedBidderRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllAllowedBidderRequest_messageType{}
+var _fastReflection_QueryGetAuctionRequest_messageType fastReflection_QueryGetAuctionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAuctionRequest_messageType{}
 
-type fastReflection_QueryAllAllowedBidderRequest_messageType struct{}
+type fastReflection_QueryGetAuctionRequest_messageType struct{}
 
-func (x fastReflection_QueryAllAllowedBidderRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllAllowedBidderRequest)(nil)
+func (x fastReflection_QueryGetAuctionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAuctionRequest)(nil)
 }
-func (x fastReflection_QueryAllAllowedBidderRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAllowedBidderRequest)
+func (x fastReflection_QueryGetAuctionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAuctionRequest)
 }
-func (x fastReflection_QueryAllAllowedBidderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAllowedBidderRequest
+func (x fastReflection_QueryGetAuctionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAuctionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAllowedBidderRequest
+func (x *fastReflection_QueryGetAuctionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAuctionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllAllowedBidderRequest_messageType
+func (x *fastReflection_QueryGetAuctionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAuctionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllAllowedBidderRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAllowedBidderRequest)
+func (x *fastReflection_QueryGetAuctionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAuctionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllAllowedBidderRequest)(x)
+func (x *fastReflection_QueryGetAuctionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAuctionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2861,16 +2981,10 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGetAuctionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.AuctionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.AuctionId)
-		if !f(fd_QueryAllAllowedBidderRequest_auction_id, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllAllowedBidderRequest_pagination, value) {
+		if !f(fd_QueryGetAuctionRequest_auction_id, value) {
 			return
 		}
 	}
@@ -2887,17 +3001,15 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetAuctionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
 		return x.AuctionId != uint64(0)
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2907,17 +3019,15 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetAuctionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
 		x.AuctionId = uint64(0)
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2927,19 +3037,16 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAuctionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
 		value := x.AuctionId
 		return protoreflect.ValueOfUint64(value)
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2953,17 +3060,15 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetAuctionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
 		x.AuctionId = value.Uint()
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2977,48 +3082,40 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAuctionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
-		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAllAllowedBidderRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryGetAuctionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllAllowedBidderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAuctionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryGetAuctionRequest.auction_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllAllowedBidderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetAuctionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAllowedBidderRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAuctionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3026,7 +3123,7 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllAllowedBidderRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetAuctionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3037,7 +3134,7 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetAuctionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3049,7 +3146,7 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllAllowedBidderRequest) IsValid() bool {
+func (x *fastReflection_QueryGetAuctionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3059,9 +3156,9 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetAuctionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllAllowedBidderRequest)
+		x := input.Message.Interface().(*QueryGetAuctionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3076,10 +3173,6 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 		if x.AuctionId != 0 {
 			n += 1 + runtime.Sov(uint64(x.AuctionId))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3090,7 +3183,7 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAllowedBidderRequest)
+		x := input.Message.Interface().(*QueryGetAuctionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3109,20 +3202,6 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if x.AuctionId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
 			i--
@@ -3139,7 +3218,7 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAllowedBidderRequest)
+		x := input.Message.Interface().(*QueryGetAuctionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3171,10 +3250,10 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3196,42 +3275,6 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 						break
 					}
 				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3267,79 +3310,26 @@ func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllAllowedBidderResponse_1_list)(nil)
-
-type _QueryAllAllowedBidderResponse_1_list struct {
-	list *[]*AllowedBidder
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AllowedBidder)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AllowedBidder)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(AllowedBidder)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) NewElement() protoreflect.Value {
-	v := new(AllowedBidder)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllAllowedBidderResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryAllAllowedBidderResponse                protoreflect.MessageDescriptor
-	fd_QueryAllAllowedBidderResponse_allowed_bidder protoreflect.FieldDescriptor
-	fd_QueryAllAllowedBidderResponse_pagination     protoreflect.FieldDescriptor
+	md_QueryGetAuctionResponse         protoreflect.MessageDescriptor
+	fd_QueryGetAuctionResponse_auction protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllAllowedBidderResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAllowedBidderResponse")
-	fd_QueryAllAllowedBidderResponse_allowed_bidder = md_QueryAllAllowedBidderResponse.Fields().ByName("allowed_bidder")
-	fd_QueryAllAllowedBidderResponse_pagination = md_QueryAllAllowedBidderResponse.Fields().ByName("pagination")
+	md_QueryGetAuctionResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAuctionResponse")
+	fd_QueryGetAuctionResponse_auction = md_QueryGetAuctionResponse.Fields().ByName("auction")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllAllowedBidderResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetAuctionResponse)(nil)
 
-type fastReflection_QueryAllAllowedBidderResponse QueryAllAllowedBidderResponse
+type fastReflection_QueryGetAuctionResponse QueryGetAuctionResponse
 
-func (x *QueryAllAllowedBidderResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllAllowedBidderResponse)(x)
+func (x *QueryGetAuctionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAuctionResponse)(x)
 }
 
-func (x *QueryAllAllowedBidderResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetAuctionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3351,43 +3341,43 @@ func (x *QueryAllAllowedBidderResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllAllowedBidderResponse_messageType fastReflection_QueryAllAllowedBidderResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllAllowedBidderResponse_messageType{}
+var _fastReflection_QueryGetAuctionResponse_messageType fastReflection_QueryGetAuctionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAuctionResponse_messageType{}
 
-type fastReflection_QueryAllAllowedBidderResponse_messageType struct{}
+type fastReflection_QueryGetAuctionResponse_messageType struct{}
 
-func (x fastReflection_QueryAllAllowedBidderResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllAllowedBidderResponse)(nil)
+func (x fastReflection_QueryGetAuctionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAuctionResponse)(nil)
 }
-func (x fastReflection_QueryAllAllowedBidderResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAllowedBidderResponse)
+func (x fastReflection_QueryGetAuctionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAuctionResponse)
 }
-func (x fastReflection_QueryAllAllowedBidderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAllowedBidderResponse
+func (x fastReflection_QueryGetAuctionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAuctionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllAllowedBidderResponse
+func (x *fastReflection_QueryGetAuctionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAuctionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllAllowedBidderResponse_messageType
+func (x *fastReflection_QueryGetAuctionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAuctionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllAllowedBidderResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllAllowedBidderResponse)
+func (x *fastReflection_QueryGetAuctionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAuctionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllAllowedBidderResponse)(x)
+func (x *fastReflection_QueryGetAuctionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAuctionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3395,16 +3385,10 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AllowedBidder) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllAllowedBidderResponse_1_list{list: &x.AllowedBidder})
-		if !f(fd_QueryAllAllowedBidderResponse_allowed_bidder, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllAllowedBidderResponse_pagination, value) {
+func (x *fastReflection_QueryGetAuctionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Auction != nil {
+		value := protoreflect.ValueOfMessage(x.Auction.ProtoReflect())
+		if !f(fd_QueryGetAuctionResponse_auction, value) {
 			return
 		}
 	}
@@ -3421,17 +3405,15 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetAuctionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
-		return len(x.AllowedBidder) != 0
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
-		return x.Pagination != nil
+	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+		return x.Auction != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3441,17 +3423,15 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetAuctionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
-		x.AllowedBidder = nil
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
-		x.Pagination = nil
+	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+		x.Auction = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3461,22 +3441,16 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAuctionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
-		if len(x.AllowedBidder) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllAllowedBidderResponse_1_list{})
-		}
-		listValue := &_QueryAllAllowedBidderResponse_1_list{list: &x.AllowedBidder}
-		return protoreflect.ValueOfList(listValue)
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
-		value := x.Pagination
+	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+		value := x.Auction
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3490,19 +3464,15 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetAuctionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
-		lv := value.List()
-		clv := lv.(*_QueryAllAllowedBidderResponse_1_list)
-		x.AllowedBidder = *clv.list
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+		x.Auction = value.Message().Interface().(*anypb.Any)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3516,53 +3486,44 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAuctionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
-		if x.AllowedBidder == nil {
-			x.AllowedBidder = []*AllowedBidder{}
-		}
-		value := &_QueryAllAllowedBidderResponse_1_list{list: &x.AllowedBidder}
-		return protoreflect.ValueOfList(value)
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+		if x.Auction == nil {
+			x.Auction = new(anypb.Any)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Auction.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllAllowedBidderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetAuctionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
-		list := []*AllowedBidder{}
-		return protoreflect.ValueOfList(&_QueryAllAllowedBidderResponse_1_list{list: &list})
-	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryGetAuctionResponse.auction":
+		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAuctionResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAuctionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllAllowedBidderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetAuctionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAllowedBidderResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAuctionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3570,7 +3531,7 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllAllowedBidderResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetAuctionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3581,7 +3542,7 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllAllowedBidderResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetAuctionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3593,7 +3554,7 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllAllowedBidderResponse) IsValid() bool {
+func (x *fastReflection_QueryGetAuctionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3603,9 +3564,9 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetAuctionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllAllowedBidderResponse)
+		x := input.Message.Interface().(*QueryGetAuctionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3617,14 +3578,8 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if len(x.AllowedBidder) > 0 {
-			for _, e := range x.AllowedBidder {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Auction != nil {
+			l = options.Size(x.Auction)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3637,7 +3592,7 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAllowedBidderResponse)
+		x := input.Message.Interface().(*QueryGetAuctionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3656,8 +3611,8 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Auction != nil {
+			encoded, err := options.Marshal(x.Auction)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3668,23 +3623,7 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.AllowedBidder) > 0 {
-			for iNdEx := len(x.AllowedBidder) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AllowedBidder[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3697,7 +3636,7 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllAllowedBidderResponse)
+		x := input.Message.Interface().(*QueryGetAuctionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3729,49 +3668,15 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AllowedBidder = append(x.AllowedBidder, &AllowedBidder{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedBidder[len(x.AllowedBidder)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3798,10 +3703,10 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Auction == nil {
+					x.Auction = &anypb.Any{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auction); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3841,27 +3746,25 @@ func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryGetAllowedBidderRequest            protoreflect.MessageDescriptor
-	fd_QueryGetAllowedBidderRequest_auction_id protoreflect.FieldDescriptor
-	fd_QueryGetAllowedBidderRequest_bidder     protoreflect.FieldDescriptor
+	md_QueryCollectedFeesRequest            protoreflect.MessageDescriptor
+	fd_QueryCollectedFeesRequest_auction_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryGetAllowedBidderRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAllowedBidderRequest")
-	fd_QueryGetAllowedBidderRequest_auction_id = md_QueryGetAllowedBidderRequest.Fields().ByName("auction_id")
-	fd_QueryGetAllowedBidderRequest_bidder = md_QueryGetAllowedBidderRequest.Fields().ByName("bidder")
+	md_QueryCollectedFeesRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryCollectedFeesRequest")
+	fd_QueryCollectedFeesRequest_auction_id = md_QueryCollectedFeesRequest.Fields().ByName("auction_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetAllowedBidderRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCollectedFeesRequest)(nil)
 
-type fastReflection_QueryGetAllowedBidderRequest QueryGetAllowedBidderRequest
+type fastReflection_QueryCollectedFeesRequest QueryCollectedFeesRequest
 
-func (x *QueryGetAllowedBidderRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetAllowedBidderRequest)(x)
+func (x *QueryCollectedFeesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollectedFeesRequest)(x)
 }
 
-func (x *QueryGetAllowedBidderRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCollectedFeesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3873,43 +3776,43 @@ func (x *QueryGetAllowedBidderRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetAllowedBidderRequest_messageType fastReflection_QueryGetAllowedBidderRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetAllowedBidderRequest_messageType{}
+var _fastReflection_QueryCollectedFeesRequest_messageType fastReflection_QueryCollectedFeesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollectedFeesRequest_messageType{}
 
-type fastReflection_QueryGetAllowedBidderRequest_messageType struct{}
+type fastReflection_QueryCollectedFeesRequest_messageType struct{}
 
-func (x fastReflection_QueryGetAllowedBidderRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetAllowedBidderRequest)(nil)
+func (x fastReflection_QueryCollectedFeesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollectedFeesRequest)(nil)
 }
-func (x fastReflection_QueryGetAllowedBidderRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAllowedBidderRequest)
+func (x fastReflection_QueryCollectedFeesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollectedFeesRequest)
 }
-func (x fastReflection_QueryGetAllowedBidderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAllowedBidderRequest
+func (x fastReflection_QueryCollectedFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollectedFeesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAllowedBidderRequest
+func (x *fastReflection_QueryCollectedFeesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollectedFeesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetAllowedBidderRequest_messageType
+func (x *fastReflection_QueryCollectedFeesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollectedFeesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetAllowedBidderRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAllowedBidderRequest)
+func (x *fastReflection_QueryCollectedFeesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCollectedFeesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetAllowedBidderRequest)(x)
+func (x *fastReflection_QueryCollectedFeesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollectedFeesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3917,16 +3820,10 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCollectedFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.AuctionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.AuctionId)
-		if !f(fd_QueryGetAllowedBidderRequest_auction_id, value) {
-			return
-		}
-	}
-	if x.Bidder != "" {
-		value := protoreflect.ValueOfString(x.Bidder)
-		if !f(fd_QueryGetAllowedBidderRequest_bidder, value) {
+		if !f(fd_QueryCollectedFeesRequest_auction_id, value) {
 			return
 		}
 	}
@@ -3943,17 +3840,15 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCollectedFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryCollectedFeesRequest.auction_id":
 		return x.AuctionId != uint64(0)
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
-		return x.Bidder != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3963,17 +3858,15 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCollectedFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryCollectedFeesRequest.auction_id":
 		x.AuctionId = uint64(0)
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
-		x.Bidder = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3983,19 +3876,16 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollectedFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryCollectedFeesRequest.auction_id":
 		value := x.AuctionId
 		return protoreflect.ValueOfUint64(value)
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
-		value := x.Bidder
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4009,17 +3899,15 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCollectedFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryCollectedFeesRequest.auction_id":
 		x.AuctionId = value.Uint()
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
-		x.Bidder = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4033,44 +3921,40 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollectedFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
-		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryGetAllowedBidderRequest is not mutable"))
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
-		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryGetAllowedBidderRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryCollectedFeesRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryCollectedFeesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetAllowedBidderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollectedFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryCollectedFeesRequest.auction_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetAllowedBidderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCollectedFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAllowedBidderRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryCollectedFeesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4078,7 +3962,7 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetAllowedBidderRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCollectedFeesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4089,7 +3973,7 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCollectedFeesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4101,7 +3985,7 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetAllowedBidderRequest) IsValid() bool {
+func (x *fastReflection_QueryCollectedFeesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4111,9 +3995,9 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCollectedFeesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetAllowedBidderRequest)
+		x := input.Message.Interface().(*QueryCollectedFeesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4128,10 +4012,6 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 		if x.AuctionId != 0 {
 			n += 1 + runtime.Sov(uint64(x.AuctionId))
 		}
-		l = len(x.Bidder)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4142,7 +4022,7 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAllowedBidderRequest)
+		x := input.Message.Interface().(*QueryCollectedFeesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4161,13 +4041,6 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Bidder) > 0 {
-			i -= len(x.Bidder)
-			copy(dAtA[i:], x.Bidder)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if x.AuctionId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
 			i--
@@ -4184,7 +4057,7 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAllowedBidderRequest)
+		x := input.Message.Interface().(*QueryCollectedFeesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4216,10 +4089,10 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollectedFeesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollectedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4241,45 +4114,13 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 						break
 					}
 				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Bidder = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -4309,25 +4150,25 @@ func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryGetAllowedBidderResponse                protoreflect.MessageDescriptor
-	fd_QueryGetAllowedBidderResponse_allowed_bidder protoreflect.FieldDescriptor
+	md_QueryCollectedFeesResponse                protoreflect.MessageDescriptor
+	fd_QueryCollectedFeesResponse_collected_fees protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryGetAllowedBidderResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAllowedBidderResponse")
-	fd_QueryGetAllowedBidderResponse_allowed_bidder = md_QueryGetAllowedBidderResponse.Fields().ByName("allowed_bidder")
+	md_QueryCollectedFeesResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryCollectedFeesResponse")
+	fd_QueryCollectedFeesResponse_collected_fees = md_QueryCollectedFeesResponse.Fields().ByName("collected_fees")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetAllowedBidderResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCollectedFeesResponse)(nil)
 
-type fastReflection_QueryGetAllowedBidderResponse QueryGetAllowedBidderResponse
+type fastReflection_QueryCollectedFeesResponse QueryCollectedFeesResponse
 
-func (x *QueryGetAllowedBidderResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetAllowedBidderResponse)(x)
+func (x *QueryCollectedFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollectedFeesResponse)(x)
 }
 
-func (x *QueryGetAllowedBidderResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCollectedFeesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4339,43 +4180,43 @@ func (x *QueryGetAllowedBidderResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetAllowedBidderResponse_messageType fastReflection_QueryGetAllowedBidderResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetAllowedBidderResponse_messageType{}
+var _fastReflection_QueryCollectedFeesResponse_messageType fastReflection_QueryCollectedFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollectedFeesResponse_messageType{}
 
-type fastReflection_QueryGetAllowedBidderResponse_messageType struct{}
+type fastReflection_QueryCollectedFeesResponse_messageType struct{}
 
-func (x fastReflection_QueryGetAllowedBidderResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetAllowedBidderResponse)(nil)
+func (x fastReflection_QueryCollectedFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollectedFeesResponse)(nil)
 }
-func (x fastReflection_QueryGetAllowedBidderResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAllowedBidderResponse)
+func (x fastReflection_QueryCollectedFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollectedFeesResponse)
 }
-func (x fastReflection_QueryGetAllowedBidderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAllowedBidderResponse
+func (x fastReflection_QueryCollectedFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollectedFeesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetAllowedBidderResponse
+func (x *fastReflection_QueryCollectedFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollectedFeesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetAllowedBidderResponse_messageType
+func (x *fastReflection_QueryCollectedFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollectedFeesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetAllowedBidderResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetAllowedBidderResponse)
+func (x *fastReflection_QueryCollectedFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCollectedFeesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetAllowedBidderResponse)(x)
+func (x *fastReflection_QueryCollectedFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollectedFeesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4383,10 +4224,10 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AllowedBidder != nil {
-		value := protoreflect.ValueOfMessage(x.AllowedBidder.ProtoReflect())
-		if !f(fd_QueryGetAllowedBidderResponse_allowed_bidder, value) {
+func (x *fastReflection_QueryCollectedFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CollectedFees != nil {
+		value := protoreflect.ValueOfMessage(x.CollectedFees.ProtoReflect())
+		if !f(fd_QueryCollectedFeesResponse_collected_fees, value) {
 			return
 		}
 	}
@@ -4403,15 +4244,15 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCollectedFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
-		return x.AllowedBidder != nil
+	case "fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees":
+		return x.CollectedFees != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4421,15 +4262,15 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCollectedFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
-		x.AllowedBidder = nil
+	case "fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees":
+		x.CollectedFees = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4439,16 +4280,16 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollectedFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
-		value := x.AllowedBidder
+	case "fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees":
+		value := x.CollectedFees
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4462,15 +4303,15 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCollectedFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
-		x.AllowedBidder = value.Message().Interface().(*AllowedBidder)
+	case "fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees":
+		x.CollectedFees = value.Message().Interface().(*CollectedFees)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4484,44 +4325,44 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollectedFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
-		if x.AllowedBidder == nil {
-			x.AllowedBidder = new(AllowedBidder)
+	case "fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees":
+		if x.CollectedFees == nil {
+			x.CollectedFees = new(CollectedFees)
 		}
-		return protoreflect.ValueOfMessage(x.AllowedBidder.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.CollectedFees.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetAllowedBidderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollectedFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
-		m := new(AllowedBidder)
+	case "fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees":
+		m := new(CollectedFees)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryCollectedFeesResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryCollectedFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetAllowedBidderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCollectedFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAllowedBidderResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryCollectedFeesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4529,7 +4370,7 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetAllowedBidderResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCollectedFeesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4540,7 +4381,7 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetAllowedBidderResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCollectedFeesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4552,7 +4393,7 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetAllowedBidderResponse) IsValid() bool {
+func (x *fastReflection_QueryCollectedFeesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4562,9 +4403,9 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCollectedFeesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetAllowedBidderResponse)
+		x := input.Message.Interface().(*QueryCollectedFeesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4576,8 +4417,8 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if x.AllowedBidder != nil {
-			l = options.Size(x.AllowedBidder)
+		if x.CollectedFees != nil {
+			l = options.Size(x.CollectedFees)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4590,7 +4431,7 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAllowedBidderResponse)
+		x := input.Message.Interface().(*QueryCollectedFeesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4609,8 +4450,8 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AllowedBidder != nil {
-			encoded, err := options.Marshal(x.AllowedBidder)
+		if x.CollectedFees != nil {
+			encoded, err := options.Marshal(x.CollectedFees)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4634,7 +4475,7 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetAllowedBidderResponse)
+		x := input.Message.Interface().(*QueryCollectedFeesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4666,15 +4507,15 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollectedFeesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollectedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4701,10 +4542,10 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.AllowedBidder == nil {
-					x.AllowedBidder = &AllowedBidder{}
+				if x.CollectedFees == nil {
+					x.CollectedFees = &CollectedFees{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedBidder); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollectedFees); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4744,27 +4585,25 @@ func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryGetBidRequest            protoreflect.MessageDescriptor
-	fd_QueryGetBidRequest_auction_id protoreflect.FieldDescriptor
-	fd_QueryGetBidRequest_bid_id     protoreflect.FieldDescriptor
+	md_QueryAuctionResultRequest            protoreflect.MessageDescriptor
+	fd_QueryAuctionResultRequest_auction_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryGetBidRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetBidRequest")
-	fd_QueryGetBidRequest_auction_id = md_QueryGetBidRequest.Fields().ByName("auction_id")
-	fd_QueryGetBidRequest_bid_id = md_QueryGetBidRequest.Fields().ByName("bid_id")
+	md_QueryAuctionResultRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAuctionResultRequest")
+	fd_QueryAuctionResultRequest_auction_id = md_QueryAuctionResultRequest.Fields().ByName("auction_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetBidRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAuctionResultRequest)(nil)
 
-type fastReflection_QueryGetBidRequest QueryGetBidRequest
+type fastReflection_QueryAuctionResultRequest QueryAuctionResultRequest
 
-func (x *QueryGetBidRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetBidRequest)(x)
+func (x *QueryAuctionResultRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAuctionResultRequest)(x)
 }
 
-func (x *QueryGetBidRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAuctionResultRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4776,43 +4615,43 @@ func (x *QueryGetBidRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetBidRequest_messageType fastReflection_QueryGetBidRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetBidRequest_messageType{}
+var _fastReflection_QueryAuctionResultRequest_messageType fastReflection_QueryAuctionResultRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAuctionResultRequest_messageType{}
 
-type fastReflection_QueryGetBidRequest_messageType struct{}
+type fastReflection_QueryAuctionResultRequest_messageType struct{}
 
-func (x fastReflection_QueryGetBidRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetBidRequest)(nil)
+func (x fastReflection_QueryAuctionResultRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAuctionResultRequest)(nil)
 }
-func (x fastReflection_QueryGetBidRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetBidRequest)
+func (x fastReflection_QueryAuctionResultRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAuctionResultRequest)
 }
-func (x fastReflection_QueryGetBidRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetBidRequest
+func (x fastReflection_QueryAuctionResultRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAuctionResultRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetBidRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetBidRequest
+func (x *fastReflection_QueryAuctionResultRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAuctionResultRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetBidRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetBidRequest_messageType
+func (x *fastReflection_QueryAuctionResultRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAuctionResultRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetBidRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetBidRequest)
+func (x *fastReflection_QueryAuctionResultRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAuctionResultRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetBidRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetBidRequest)(x)
+func (x *fastReflection_QueryAuctionResultRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAuctionResultRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4820,16 +4659,10 @@ func (x *fastReflection_QueryGetBidRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetBidRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryAuctionResultRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.AuctionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.AuctionId)
-		if !f(fd_QueryGetBidRequest_auction_id, value) {
-			return
-		}
-	}
-	if x.BidId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BidId)
-		if !f(fd_QueryGetBidRequest_bid_id, value) {
+		if !f(fd_QueryAuctionResultRequest_auction_id, value) {
 			return
 		}
 	}
@@ -4846,17 +4679,15 @@ func (x *fastReflection_QueryGetBidRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetBidRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAuctionResultRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryAuctionResultRequest.auction_id":
 		return x.AuctionId != uint64(0)
-	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
-		return x.BidId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4866,17 +4697,15 @@ func (x *fastReflection_QueryGetBidRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAuctionResultRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryAuctionResultRequest.auction_id":
 		x.AuctionId = uint64(0)
-	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
-		x.BidId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4886,19 +4715,16 @@ func (x *fastReflection_QueryGetBidRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetBidRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAuctionResultRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryAuctionResultRequest.auction_id":
 		value := x.AuctionId
 		return protoreflect.ValueOfUint64(value)
-	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
-		value := x.BidId
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4912,17 +4738,15 @@ func (x *fastReflection_QueryGetBidRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAuctionResultRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryAuctionResultRequest.auction_id":
 		x.AuctionId = value.Uint()
-	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
-		x.BidId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4936,44 +4760,40 @@ func (x *fastReflection_QueryGetBidRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAuctionResultRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
-		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryGetBidRequest is not mutable"))
-	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
-		panic(fmt.Errorf("field bid_id of message fundraising.fundraising.v1.QueryGetBidRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryAuctionResultRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAuctionResultRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetBidRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAuctionResultRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+	case "fundraising.fundraising.v1.QueryAuctionResultRequest.auction_id":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetBidRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAuctionResultRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetBidRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAuctionResultRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4981,7 +4801,7 @@ func (x *fastReflection_QueryGetBidRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetBidRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAuctionResultRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4992,7 +4812,7 @@ func (x *fastReflection_QueryGetBidRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAuctionResultRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5004,7 +4824,7 @@ func (x *fastReflection_QueryGetBidRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetBidRequest) IsValid() bool {
+func (x *fastReflection_QueryAuctionResultRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5014,9 +4834,9 @@ func (x *fastReflection_QueryGetBidRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAuctionResultRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetBidRequest)
+		x := input.Message.Interface().(*QueryAuctionResultRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5031,9 +4851,6 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 		if x.AuctionId != 0 {
 			n += 1 + runtime.Sov(uint64(x.AuctionId))
 		}
-		if x.BidId != 0 {
-			n += 1 + runtime.Sov(uint64(x.BidId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5044,7 +4861,7 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetBidRequest)
+		x := input.Message.Interface().(*QueryAuctionResultRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5063,11 +4880,6 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BidId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BidId))
-			i--
-			dAtA[i] = 0x10
-		}
 		if x.AuctionId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
 			i--
@@ -5084,7 +4896,7 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetBidRequest)
+		x := input.Message.Interface().(*QueryAuctionResultRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5116,10 +4928,10 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAuctionResultRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAuctionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5141,25 +4953,6 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
-				}
-				x.BidId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BidId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5196,25 +4989,25 @@ func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryGetBidResponse     protoreflect.MessageDescriptor
-	fd_QueryGetBidResponse_bid protoreflect.FieldDescriptor
+	md_QueryAuctionResultResponse                protoreflect.MessageDescriptor
+	fd_QueryAuctionResultResponse_auction_result protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryGetBidResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetBidResponse")
-	fd_QueryGetBidResponse_bid = md_QueryGetBidResponse.Fields().ByName("bid")
+	md_QueryAuctionResultResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAuctionResultResponse")
+	fd_QueryAuctionResultResponse_auction_result = md_QueryAuctionResultResponse.Fields().ByName("auction_result")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetBidResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAuctionResultResponse)(nil)
 
-type fastReflection_QueryGetBidResponse QueryGetBidResponse
+type fastReflection_QueryAuctionResultResponse QueryAuctionResultResponse
 
-func (x *QueryGetBidResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetBidResponse)(x)
+func (x *QueryAuctionResultResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAuctionResultResponse)(x)
 }
 
-func (x *QueryGetBidResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAuctionResultResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5226,43 +5019,43 @@ func (x *QueryGetBidResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetBidResponse_messageType fastReflection_QueryGetBidResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetBidResponse_messageType{}
+var _fastReflection_QueryAuctionResultResponse_messageType fastReflection_QueryAuctionResultResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAuctionResultResponse_messageType{}
 
-type fastReflection_QueryGetBidResponse_messageType struct{}
+type fastReflection_QueryAuctionResultResponse_messageType struct{}
 
-func (x fastReflection_QueryGetBidResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetBidResponse)(nil)
+func (x fastReflection_QueryAuctionResultResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAuctionResultResponse)(nil)
 }
-func (x fastReflection_QueryGetBidResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetBidResponse)
+func (x fastReflection_QueryAuctionResultResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAuctionResultResponse)
 }
-func (x fastReflection_QueryGetBidResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetBidResponse
+func (x fastReflection_QueryAuctionResultResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAuctionResultResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetBidResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetBidResponse
+func (x *fastReflection_QueryAuctionResultResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAuctionResultResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetBidResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetBidResponse_messageType
+func (x *fastReflection_QueryAuctionResultResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAuctionResultResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetBidResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetBidResponse)
+func (x *fastReflection_QueryAuctionResultResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAuctionResultResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetBidResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetBidResponse)(x)
+func (x *fastReflection_QueryAuctionResultResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAuctionResultResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5270,10 +5063,10 @@ func (x *fastReflection_QueryGetBidResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetBidResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Bid != nil {
-		value := protoreflect.ValueOfMessage(x.Bid.ProtoReflect())
-		if !f(fd_QueryGetBidResponse_bid, value) {
+func (x *fastReflection_QueryAuctionResultResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionResult != nil {
+		value := protoreflect.ValueOfMessage(x.AuctionResult.ProtoReflect())
+		if !f(fd_QueryAuctionResultResponse_auction_result, value) {
 			return
 		}
 	}
@@ -5290,15 +5083,15 @@ func (x *fastReflection_QueryGetBidResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetBidResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAuctionResultResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
-		return x.Bid != nil
+	case "fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result":
+		return x.AuctionResult != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5308,15 +5101,15 @@ func (x *fastReflection_QueryGetBidResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAuctionResultResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
-		x.Bid = nil
+	case "fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result":
+		x.AuctionResult = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5326,16 +5119,16 @@ func (x *fastReflection_QueryGetBidResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetBidResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAuctionResultResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
-		value := x.Bid
+	case "fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result":
+		value := x.AuctionResult
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5349,15 +5142,15 @@ func (x *fastReflection_QueryGetBidResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAuctionResultResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
-		x.Bid = value.Message().Interface().(*Bid)
+	case "fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result":
+		x.AuctionResult = value.Message().Interface().(*AuctionResult)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5371,44 +5164,44 @@ func (x *fastReflection_QueryGetBidResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAuctionResultResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
-		if x.Bid == nil {
-			x.Bid = new(Bid)
+	case "fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result":
+		if x.AuctionResult == nil {
+			x.AuctionResult = new(AuctionResult)
 		}
-		return protoreflect.ValueOfMessage(x.Bid.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.AuctionResult.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetBidResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAuctionResultResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
-		m := new(Bid)
+	case "fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result":
+		m := new(AuctionResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAuctionResultResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAuctionResultResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetBidResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAuctionResultResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetBidResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAuctionResultResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5416,7 +5209,7 @@ func (x *fastReflection_QueryGetBidResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetBidResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAuctionResultResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5427,7 +5220,7 @@ func (x *fastReflection_QueryGetBidResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetBidResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAuctionResultResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5439,7 +5232,7 @@ func (x *fastReflection_QueryGetBidResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetBidResponse) IsValid() bool {
+func (x *fastReflection_QueryAuctionResultResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5449,9 +5242,9 @@ func (x *fastReflection_QueryGetBidResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAuctionResultResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetBidResponse)
+		x := input.Message.Interface().(*QueryAuctionResultResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5463,8 +5256,8 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Bid != nil {
-			l = options.Size(x.Bid)
+		if x.AuctionResult != nil {
+			l = options.Size(x.AuctionResult)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5477,7 +5270,7 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetBidResponse)
+		x := input.Message.Interface().(*QueryAuctionResultResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5496,8 +5289,8 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Bid != nil {
-			encoded, err := options.Marshal(x.Bid)
+		if x.AuctionResult != nil {
+			encoded, err := options.Marshal(x.AuctionResult)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5521,7 +5314,7 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetBidResponse)
+		x := input.Message.Interface().(*QueryAuctionResultResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5553,15 +5346,15 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAuctionResultResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAuctionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionResult", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5588,10 +5381,10 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Bid == nil {
-					x.Bid = &Bid{}
+				if x.AuctionResult == nil {
+					x.AuctionResult = &AuctionResult{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bid); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuctionResult); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5631,31 +5424,27 @@ func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryAllBidRequest            protoreflect.MessageDescriptor
-	fd_QueryAllBidRequest_auction_id protoreflect.FieldDescriptor
-	fd_QueryAllBidRequest_bidder     protoreflect.FieldDescriptor
-	fd_QueryAllBidRequest_is_matched protoreflect.FieldDescriptor
-	fd_QueryAllBidRequest_pagination protoreflect.FieldDescriptor
+	md_QueryBidderAllocationRequest            protoreflect.MessageDescriptor
+	fd_QueryBidderAllocationRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryBidderAllocationRequest_bidder     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllBidRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllBidRequest")
-	fd_QueryAllBidRequest_auction_id = md_QueryAllBidRequest.Fields().ByName("auction_id")
-	fd_QueryAllBidRequest_bidder = md_QueryAllBidRequest.Fields().ByName("bidder")
-	fd_QueryAllBidRequest_is_matched = md_QueryAllBidRequest.Fields().ByName("is_matched")
-	fd_QueryAllBidRequest_pagination = md_QueryAllBidRequest.Fields().ByName("pagination")
+	md_QueryBidderAllocationRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBidderAllocationRequest")
+	fd_QueryBidderAllocationRequest_auction_id = md_QueryBidderAllocationRequest.Fields().ByName("auction_id")
+	fd_QueryBidderAllocationRequest_bidder = md_QueryBidderAllocationRequest.Fields().ByName("bidder")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllBidRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBidderAllocationRequest)(nil)
 
-type fastReflection_QueryAllBidRequest QueryAllBidRequest
+type fastReflection_QueryBidderAllocationRequest QueryBidderAllocationRequest
 
-func (x *QueryAllBidRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllBidRequest)(x)
+func (x *QueryBidderAllocationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBidderAllocationRequest)(x)
 }
 
-func (x *QueryAllBidRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryBidderAllocationRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5667,43 +5456,43 @@ func (x *QueryAllBidRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllBidRequest_messageType fastReflection_QueryAllBidRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllBidRequest_messageType{}
+var _fastReflection_QueryBidderAllocationRequest_messageType fastReflection_QueryBidderAllocationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBidderAllocationRequest_messageType{}
 
-type fastReflection_QueryAllBidRequest_messageType struct{}
+type fastReflection_QueryBidderAllocationRequest_messageType struct{}
 
-func (x fastReflection_QueryAllBidRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllBidRequest)(nil)
+func (x fastReflection_QueryBidderAllocationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBidderAllocationRequest)(nil)
 }
-func (x fastReflection_QueryAllBidRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllBidRequest)
+func (x fastReflection_QueryBidderAllocationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBidderAllocationRequest)
 }
-func (x fastReflection_QueryAllBidRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllBidRequest
+func (x fastReflection_QueryBidderAllocationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidderAllocationRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllBidRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllBidRequest
+func (x *fastReflection_QueryBidderAllocationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidderAllocationRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllBidRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllBidRequest_messageType
+func (x *fastReflection_QueryBidderAllocationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBidderAllocationRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllBidRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllBidRequest)
+func (x *fastReflection_QueryBidderAllocationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBidderAllocationRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllBidRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllBidRequest)(x)
+func (x *fastReflection_QueryBidderAllocationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBidderAllocationRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5711,28 +5500,16 @@ func (x *fastReflection_QueryAllBidRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllBidRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryBidderAllocationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.AuctionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.AuctionId)
-		if !f(fd_QueryAllBidRequest_auction_id, value) {
+		if !f(fd_QueryBidderAllocationRequest_auction_id, value) {
 			return
 		}
 	}
 	if x.Bidder != "" {
 		value := protoreflect.ValueOfString(x.Bidder)
-		if !f(fd_QueryAllBidRequest_bidder, value) {
-			return
-		}
-	}
-	if x.IsMatched != "" {
-		value := protoreflect.ValueOfString(x.IsMatched)
-		if !f(fd_QueryAllBidRequest_is_matched, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllBidRequest_pagination, value) {
+		if !f(fd_QueryBidderAllocationRequest_bidder, value) {
 			return
 		}
 	}
@@ -5749,21 +5526,17 @@ func (x *fastReflection_QueryAllBidRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllBidRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBidderAllocationRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.auction_id":
 		return x.AuctionId != uint64(0)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.bidder":
 		return x.Bidder != ""
-	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
-		return x.IsMatched != ""
-	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5773,21 +5546,17 @@ func (x *fastReflection_QueryAllBidRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBidderAllocationRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.auction_id":
 		x.AuctionId = uint64(0)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.bidder":
 		x.Bidder = ""
-	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
-		x.IsMatched = ""
-	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5797,25 +5566,19 @@ func (x *fastReflection_QueryAllBidRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllBidRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBidderAllocationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.auction_id":
 		value := x.AuctionId
 		return protoreflect.ValueOfUint64(value)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.bidder":
 		value := x.Bidder
 		return protoreflect.ValueOfString(value)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
-		value := x.IsMatched
-		return protoreflect.ValueOfString(value)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5829,21 +5592,17 @@ func (x *fastReflection_QueryAllBidRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBidderAllocationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.auction_id":
 		x.AuctionId = value.Uint()
-	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.bidder":
 		x.Bidder = value.Interface().(string)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
-		x.IsMatched = value.Interface().(string)
-	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5857,56 +5616,44 @@ func (x *fastReflection_QueryAllBidRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBidderAllocationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
-		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAllBidRequest is not mutable"))
-	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
-		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryAllBidRequest is not mutable"))
-	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
-		panic(fmt.Errorf("field is_matched of message fundraising.fundraising.v1.QueryAllBidRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryBidderAllocationRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryBidderAllocationRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllBidRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBidderAllocationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.auction_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
-		return protoreflect.ValueOfString("")
-	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+	case "fundraising.fundraising.v1.QueryBidderAllocationRequest.bidder":
 		return protoreflect.ValueOfString("")
-	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllBidRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBidderAllocationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllBidRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBidderAllocationRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5914,7 +5661,7 @@ func (x *fastReflection_QueryAllBidRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllBidRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBidderAllocationRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5925,7 +5672,7 @@ func (x *fastReflection_QueryAllBidRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBidderAllocationRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5937,7 +5684,7 @@ func (x *fastReflection_QueryAllBidRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllBidRequest) IsValid() bool {
+func (x *fastReflection_QueryBidderAllocationRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5947,9 +5694,9 @@ func (x *fastReflection_QueryAllBidRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBidderAllocationRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllBidRequest)
+		x := input.Message.Interface().(*QueryBidderAllocationRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5968,14 +5715,6 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.IsMatched)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5986,7 +5725,7 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllBidRequest)
+		x := input.Message.Interface().(*QueryBidderAllocationRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6005,27 +5744,6 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.IsMatched) > 0 {
-			i -= len(x.IsMatched)
-			copy(dAtA[i:], x.IsMatched)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IsMatched)))
-			i--
-			dAtA[i] = 0x1a
-		}
 		if len(x.Bidder) > 0 {
 			i -= len(x.Bidder)
 			copy(dAtA[i:], x.Bidder)
@@ -6049,7 +5767,7 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllBidRequest)
+		x := input.Message.Interface().(*QueryBidderAllocationRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6081,10 +5799,10 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidderAllocationRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidderAllocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6138,74 +5856,6 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Bidder = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.IsMatched = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6241,79 +5891,26 @@ func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllBidResponse_1_list)(nil)
-
-type _QueryAllBidResponse_1_list struct {
-	list *[]*Bid
-}
-
-func (x *_QueryAllBidResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAllBidResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAllBidResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Bid)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAllBidResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Bid)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAllBidResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Bid)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllBidResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAllBidResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Bid)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllBidResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryAllBidResponse            protoreflect.MessageDescriptor
-	fd_QueryAllBidResponse_bid        protoreflect.FieldDescriptor
-	fd_QueryAllBidResponse_pagination protoreflect.FieldDescriptor
-)
+var (
+	md_QueryBidderAllocationResponse                   protoreflect.MessageDescriptor
+	fd_QueryBidderAllocationResponse_bidder_allocation protoreflect.FieldDescriptor
+)
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllBidResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllBidResponse")
-	fd_QueryAllBidResponse_bid = md_QueryAllBidResponse.Fields().ByName("bid")
-	fd_QueryAllBidResponse_pagination = md_QueryAllBidResponse.Fields().ByName("pagination")
+	md_QueryBidderAllocationResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBidderAllocationResponse")
+	fd_QueryBidderAllocationResponse_bidder_allocation = md_QueryBidderAllocationResponse.Fields().ByName("bidder_allocation")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllBidResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBidderAllocationResponse)(nil)
 
-type fastReflection_QueryAllBidResponse QueryAllBidResponse
+type fastReflection_QueryBidderAllocationResponse QueryBidderAllocationResponse
 
-func (x *QueryAllBidResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllBidResponse)(x)
+func (x *QueryBidderAllocationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBidderAllocationResponse)(x)
 }
 
-func (x *QueryAllBidResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryBidderAllocationResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6325,43 +5922,43 @@ func (x *QueryAllBidResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllBidResponse_messageType fastReflection_QueryAllBidResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllBidResponse_messageType{}
+var _fastReflection_QueryBidderAllocationResponse_messageType fastReflection_QueryBidderAllocationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBidderAllocationResponse_messageType{}
 
-type fastReflection_QueryAllBidResponse_messageType struct{}
+type fastReflection_QueryBidderAllocationResponse_messageType struct{}
 
-func (x fastReflection_QueryAllBidResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllBidResponse)(nil)
+func (x fastReflection_QueryBidderAllocationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBidderAllocationResponse)(nil)
 }
-func (x fastReflection_QueryAllBidResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllBidResponse)
+func (x fastReflection_QueryBidderAllocationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBidderAllocationResponse)
 }
-func (x fastReflection_QueryAllBidResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllBidResponse
+func (x fastReflection_QueryBidderAllocationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidderAllocationResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllBidResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllBidResponse
+func (x *fastReflection_QueryBidderAllocationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidderAllocationResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllBidResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllBidResponse_messageType
+func (x *fastReflection_QueryBidderAllocationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBidderAllocationResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllBidResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllBidResponse)
+func (x *fastReflection_QueryBidderAllocationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBidderAllocationResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllBidResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllBidResponse)(x)
+func (x *fastReflection_QueryBidderAllocationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBidderAllocationResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6369,16 +5966,10 @@ func (x *fastReflection_QueryAllBidResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllBidResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Bid) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllBidResponse_1_list{list: &x.Bid})
-		if !f(fd_QueryAllBidResponse_bid, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllBidResponse_pagination, value) {
+func (x *fastReflection_QueryBidderAllocationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BidderAllocation != nil {
+		value := protoreflect.ValueOfMessage(x.BidderAllocation.ProtoReflect())
+		if !f(fd_QueryBidderAllocationResponse_bidder_allocation, value) {
 			return
 		}
 	}
@@ -6395,17 +5986,15 @@ func (x *fastReflection_QueryAllBidResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllBidResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBidderAllocationResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
-		return len(x.Bid) != 0
-	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
-		return x.Pagination != nil
+	case "fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation":
+		return x.BidderAllocation != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6415,17 +6004,15 @@ func (x *fastReflection_QueryAllBidResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBidderAllocationResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
-		x.Bid = nil
-	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
-		x.Pagination = nil
+	case "fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation":
+		x.BidderAllocation = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6435,22 +6022,16 @@ func (x *fastReflection_QueryAllBidResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllBidResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBidderAllocationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
-		if len(x.Bid) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllBidResponse_1_list{})
-		}
-		listValue := &_QueryAllBidResponse_1_list{list: &x.Bid}
-		return protoreflect.ValueOfList(listValue)
-	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
-		value := x.Pagination
+	case "fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation":
+		value := x.BidderAllocation
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6464,19 +6045,15 @@ func (x *fastReflection_QueryAllBidResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBidderAllocationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
-		lv := value.List()
-		clv := lv.(*_QueryAllBidResponse_1_list)
-		x.Bid = *clv.list
-	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation":
+		x.BidderAllocation = value.Message().Interface().(*BidderAllocation)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6490,53 +6067,44 @@ func (x *fastReflection_QueryAllBidResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBidderAllocationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
-		if x.Bid == nil {
-			x.Bid = []*Bid{}
-		}
-		value := &_QueryAllBidResponse_1_list{list: &x.Bid}
-		return protoreflect.ValueOfList(value)
-	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation":
+		if x.BidderAllocation == nil {
+			x.BidderAllocation = new(BidderAllocation)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.BidderAllocation.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllBidResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBidderAllocationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
-		list := []*Bid{}
-		return protoreflect.ValueOfList(&_QueryAllBidResponse_1_list{list: &list})
-	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation":
+		m := new(BidderAllocation)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidderAllocationResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidderAllocationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllBidResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBidderAllocationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllBidResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBidderAllocationResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6544,7 +6112,7 @@ func (x *fastReflection_QueryAllBidResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllBidResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBidderAllocationResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6555,7 +6123,7 @@ func (x *fastReflection_QueryAllBidResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllBidResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBidderAllocationResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6567,7 +6135,7 @@ func (x *fastReflection_QueryAllBidResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllBidResponse) IsValid() bool {
+func (x *fastReflection_QueryBidderAllocationResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6577,9 +6145,9 @@ func (x *fastReflection_QueryAllBidResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBidderAllocationResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllBidResponse)
+		x := input.Message.Interface().(*QueryBidderAllocationResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6591,14 +6159,8 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Bid) > 0 {
-			for _, e := range x.Bid {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.BidderAllocation != nil {
+			l = options.Size(x.BidderAllocation)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6611,7 +6173,7 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllBidResponse)
+		x := input.Message.Interface().(*QueryBidderAllocationResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6630,8 +6192,8 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.BidderAllocation != nil {
+			encoded, err := options.Marshal(x.BidderAllocation)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6642,23 +6204,7 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Bid) > 0 {
-			for iNdEx := len(x.Bid) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Bid[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6671,7 +6217,7 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllBidResponse)
+		x := input.Message.Interface().(*QueryBidderAllocationResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6703,49 +6249,15 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidderAllocationResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidderAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Bid = append(x.Bid, &Bid{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bid[len(x.Bid)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidderAllocation", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6772,10 +6284,10 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.BidderAllocation == nil {
+					x.BidderAllocation = &BidderAllocation{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BidderAllocation); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6815,27 +6327,27 @@ func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryAllVestingQueueRequest            protoreflect.MessageDescriptor
-	fd_QueryAllVestingQueueRequest_auction_id protoreflect.FieldDescriptor
-	fd_QueryAllVestingQueueRequest_pagination protoreflect.FieldDescriptor
+	md_QueryBuyerVestingRequest            protoreflect.MessageDescriptor
+	fd_QueryBuyerVestingRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryBuyerVestingRequest_bidder     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllVestingQueueRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllVestingQueueRequest")
-	fd_QueryAllVestingQueueRequest_auction_id = md_QueryAllVestingQueueRequest.Fields().ByName("auction_id")
-	fd_QueryAllVestingQueueRequest_pagination = md_QueryAllVestingQueueRequest.Fields().ByName("pagination")
+	md_QueryBuyerVestingRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBuyerVestingRequest")
+	fd_QueryBuyerVestingRequest_auction_id = md_QueryBuyerVestingRequest.Fields().ByName("auction_id")
+	fd_QueryBuyerVestingRequest_bidder = md_QueryBuyerVestingRequest.Fields().ByName("bidder")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllVestingQueueRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBuyerVestingRequest)(nil)
 
-type fastReflection_QueryAllVestingQueueRequest QueryAllVestingQueueRequest
+type fastReflection_QueryBuyerVestingRequest QueryBuyerVestingRequest
 
-func (x *QueryAllVestingQueueRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllVestingQueueRequest)(x)
+func (x *QueryBuyerVestingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBuyerVestingRequest)(x)
 }
 
-func (x *QueryAllVestingQueueRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryBuyerVestingRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6847,43 +6359,43 @@ func (x *QueryAllVestingQueueRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllVestingQueueRequest_messageType fastReflection_QueryAllVestingQueueRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllVestingQueueRequest_messageType{}
+var _fastReflection_QueryBuyerVestingRequest_messageType fastReflection_QueryBuyerVestingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBuyerVestingRequest_messageType{}
 
-type fastReflection_QueryAllVestingQueueRequest_messageType struct{}
+type fastReflection_QueryBuyerVestingRequest_messageType struct{}
 
-func (x fastReflection_QueryAllVestingQueueRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllVestingQueueRequest)(nil)
+func (x fastReflection_QueryBuyerVestingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBuyerVestingRequest)(nil)
 }
-func (x fastReflection_QueryAllVestingQueueRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllVestingQueueRequest)
+func (x fastReflection_QueryBuyerVestingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBuyerVestingRequest)
 }
-func (x fastReflection_QueryAllVestingQueueRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllVestingQueueRequest
+func (x fastReflection_QueryBuyerVestingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBuyerVestingRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllVestingQueueRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllVestingQueueRequest
+func (x *fastReflection_QueryBuyerVestingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBuyerVestingRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllVestingQueueRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllVestingQueueRequest_messageType
+func (x *fastReflection_QueryBuyerVestingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBuyerVestingRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllVestingQueueRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllVestingQueueRequest)
+func (x *fastReflection_QueryBuyerVestingRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBuyerVestingRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllVestingQueueRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllVestingQueueRequest)(x)
+func (x *fastReflection_QueryBuyerVestingRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBuyerVestingRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6891,16 +6403,16 @@ func (x *fastReflection_QueryAllVestingQueueRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllVestingQueueRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryBuyerVestingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.AuctionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.AuctionId)
-		if !f(fd_QueryAllVestingQueueRequest_auction_id, value) {
+		if !f(fd_QueryBuyerVestingRequest_auction_id, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllVestingQueueRequest_pagination, value) {
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_QueryBuyerVestingRequest_bidder, value) {
 			return
 		}
 	}
@@ -6917,17 +6429,17 @@ func (x *fastReflection_QueryAllVestingQueueRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllVestingQueueRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBuyerVestingRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.auction_id":
 		return x.AuctionId != uint64(0)
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
-		return x.Pagination != nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.bidder":
+		return x.Bidder != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6937,17 +6449,17 @@ func (x *fastReflection_QueryAllVestingQueueRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBuyerVestingRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.auction_id":
 		x.AuctionId = uint64(0)
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
-		x.Pagination = nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.bidder":
+		x.Bidder = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6957,19 +6469,19 @@ func (x *fastReflection_QueryAllVestingQueueRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllVestingQueueRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBuyerVestingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.auction_id":
 		value := x.AuctionId
 		return protoreflect.ValueOfUint64(value)
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6983,17 +6495,17 @@ func (x *fastReflection_QueryAllVestingQueueRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBuyerVestingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.auction_id":
 		x.AuctionId = value.Uint()
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.bidder":
+		x.Bidder = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7007,48 +6519,44 @@ func (x *fastReflection_QueryAllVestingQueueRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBuyerVestingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
-		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAllVestingQueueRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryBuyerVestingRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryBuyerVestingRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllVestingQueueRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBuyerVestingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.auction_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingRequest.bidder":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingRequest"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllVestingQueueRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBuyerVestingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllVestingQueueRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBuyerVestingRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7056,7 +6564,7 @@ func (x *fastReflection_QueryAllVestingQueueRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllVestingQueueRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBuyerVestingRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7067,7 +6575,7 @@ func (x *fastReflection_QueryAllVestingQueueRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBuyerVestingRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7079,7 +6587,7 @@ func (x *fastReflection_QueryAllVestingQueueRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllVestingQueueRequest) IsValid() bool {
+func (x *fastReflection_QueryBuyerVestingRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7089,9 +6597,9 @@ func (x *fastReflection_QueryAllVestingQueueRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBuyerVestingRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllVestingQueueRequest)
+		x := input.Message.Interface().(*QueryBuyerVestingRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7106,8 +6614,8 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 		if x.AuctionId != 0 {
 			n += 1 + runtime.Sov(uint64(x.AuctionId))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Bidder)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7120,7 +6628,7 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllVestingQueueRequest)
+		x := input.Message.Interface().(*QueryBuyerVestingRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7139,17 +6647,10 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -7169,7 +6670,7 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllVestingQueueRequest)
+		x := input.Message.Interface().(*QueryBuyerVestingRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7201,10 +6702,10 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBuyerVestingRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBuyerVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7228,9 +6729,9 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7240,27 +6741,23 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7297,79 +6794,32 @@ func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllVestingQueueResponse_1_list)(nil)
-
-type _QueryAllVestingQueueResponse_1_list struct {
-	list *[]*VestingQueue
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VestingQueue)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VestingQueue)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(VestingQueue)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) NewElement() protoreflect.Value {
-	v := new(VestingQueue)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllVestingQueueResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryAllVestingQueueResponse              protoreflect.MessageDescriptor
-	fd_QueryAllVestingQueueResponse_vestingQueue protoreflect.FieldDescriptor
-	fd_QueryAllVestingQueueResponse_pagination   protoreflect.FieldDescriptor
+	md_QueryBuyerVestingResponse                protoreflect.MessageDescriptor
+	fd_QueryBuyerVestingResponse_buyer_vesting  protoreflect.FieldDescriptor
+	fd_QueryBuyerVestingResponse_locked_coin    protoreflect.FieldDescriptor
+	fd_QueryBuyerVestingResponse_unlocked_coin  protoreflect.FieldDescriptor
+	fd_QueryBuyerVestingResponse_claimable_coin protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_fundraising_fundraising_v1_query_proto_init()
-	md_QueryAllVestingQueueResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllVestingQueueResponse")
-	fd_QueryAllVestingQueueResponse_vestingQueue = md_QueryAllVestingQueueResponse.Fields().ByName("vestingQueue")
-	fd_QueryAllVestingQueueResponse_pagination = md_QueryAllVestingQueueResponse.Fields().ByName("pagination")
+	md_QueryBuyerVestingResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBuyerVestingResponse")
+	fd_QueryBuyerVestingResponse_buyer_vesting = md_QueryBuyerVestingResponse.Fields().ByName("buyer_vesting")
+	fd_QueryBuyerVestingResponse_locked_coin = md_QueryBuyerVestingResponse.Fields().ByName("locked_coin")
+	fd_QueryBuyerVestingResponse_unlocked_coin = md_QueryBuyerVestingResponse.Fields().ByName("unlocked_coin")
+	fd_QueryBuyerVestingResponse_claimable_coin = md_QueryBuyerVestingResponse.Fields().ByName("claimable_coin")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllVestingQueueResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBuyerVestingResponse)(nil)
 
-type fastReflection_QueryAllVestingQueueResponse QueryAllVestingQueueResponse
+type fastReflection_QueryBuyerVestingResponse QueryBuyerVestingResponse
 
-func (x *QueryAllVestingQueueResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllVestingQueueResponse)(x)
+func (x *QueryBuyerVestingResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBuyerVestingResponse)(x)
 }
 
-func (x *QueryAllVestingQueueResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryBuyerVestingResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7381,43 +6831,43 @@ func (x *QueryAllVestingQueueResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllVestingQueueResponse_messageType fastReflection_QueryAllVestingQueueResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllVestingQueueResponse_messageType{}
+var _fastReflection_QueryBuyerVestingResponse_messageType fastReflection_QueryBuyerVestingResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBuyerVestingResponse_messageType{}
 
-type fastReflection_QueryAllVestingQueueResponse_messageType struct{}
+type fastReflection_QueryBuyerVestingResponse_messageType struct{}
 
-func (x fastReflection_QueryAllVestingQueueResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllVestingQueueResponse)(nil)
+func (x fastReflection_QueryBuyerVestingResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBuyerVestingResponse)(nil)
 }
-func (x fastReflection_QueryAllVestingQueueResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllVestingQueueResponse)
+func (x fastReflection_QueryBuyerVestingResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBuyerVestingResponse)
 }
-func (x fastReflection_QueryAllVestingQueueResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllVestingQueueResponse
+func (x fastReflection_QueryBuyerVestingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBuyerVestingResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllVestingQueueResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllVestingQueueResponse
+func (x *fastReflection_QueryBuyerVestingResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBuyerVestingResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllVestingQueueResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllVestingQueueResponse_messageType
+func (x *fastReflection_QueryBuyerVestingResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBuyerVestingResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllVestingQueueResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllVestingQueueResponse)
+func (x *fastReflection_QueryBuyerVestingResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBuyerVestingResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllVestingQueueResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllVestingQueueResponse)(x)
+func (x *fastReflection_QueryBuyerVestingResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBuyerVestingResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7425,16 +6875,28 @@ func (x *fastReflection_QueryAllVestingQueueResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllVestingQueueResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.VestingQueue) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllVestingQueueResponse_1_list{list: &x.VestingQueue})
-		if !f(fd_QueryAllVestingQueueResponse_vestingQueue, value) {
+func (x *fastReflection_QueryBuyerVestingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BuyerVesting != nil {
+		value := protoreflect.ValueOfMessage(x.BuyerVesting.ProtoReflect())
+		if !f(fd_QueryBuyerVestingResponse_buyer_vesting, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllVestingQueueResponse_pagination, value) {
+	if x.LockedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.LockedCoin.ProtoReflect())
+		if !f(fd_QueryBuyerVestingResponse_locked_coin, value) {
+			return
+		}
+	}
+	if x.UnlockedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.UnlockedCoin.ProtoReflect())
+		if !f(fd_QueryBuyerVestingResponse_unlocked_coin, value) {
+			return
+		}
+	}
+	if x.ClaimableCoin != nil {
+		value := protoreflect.ValueOfMessage(x.ClaimableCoin.ProtoReflect())
+		if !f(fd_QueryBuyerVestingResponse_claimable_coin, value) {
 			return
 		}
 	}
@@ -7451,17 +6913,21 @@ func (x *fastReflection_QueryAllVestingQueueResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllVestingQueueResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBuyerVestingResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
-		return len(x.VestingQueue) != 0
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
-		return x.Pagination != nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting":
+		return x.BuyerVesting != nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin":
+		return x.LockedCoin != nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin":
+		return x.UnlockedCoin != nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin":
+		return x.ClaimableCoin != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7471,17 +6937,21 @@ func (x *fastReflection_QueryAllVestingQueueResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBuyerVestingResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
-		x.VestingQueue = nil
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
-		x.Pagination = nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting":
+		x.BuyerVesting = nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin":
+		x.LockedCoin = nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin":
+		x.UnlockedCoin = nil
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin":
+		x.ClaimableCoin = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7491,22 +6961,25 @@ func (x *fastReflection_QueryAllVestingQueueResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllVestingQueueResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBuyerVestingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
-		if len(x.VestingQueue) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllVestingQueueResponse_1_list{})
-		}
-		listValue := &_QueryAllVestingQueueResponse_1_list{list: &x.VestingQueue}
-		return protoreflect.ValueOfList(listValue)
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
-		value := x.Pagination
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting":
+		value := x.BuyerVesting
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin":
+		value := x.LockedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin":
+		value := x.UnlockedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin":
+		value := x.ClaimableCoin
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7520,19 +6993,21 @@ func (x *fastReflection_QueryAllVestingQueueResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBuyerVestingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
-		lv := value.List()
-		clv := lv.(*_QueryAllVestingQueueResponse_1_list)
-		x.VestingQueue = *clv.list
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting":
+		x.BuyerVesting = value.Message().Interface().(*BuyerVesting)
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin":
+		x.LockedCoin = value.Message().Interface().(*v1beta11.Coin)
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin":
+		x.UnlockedCoin = value.Message().Interface().(*v1beta11.Coin)
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin":
+		x.ClaimableCoin = value.Message().Interface().(*v1beta11.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7546,53 +7021,68 @@ func (x *fastReflection_QueryAllVestingQueueResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBuyerVestingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
-		if x.VestingQueue == nil {
-			x.VestingQueue = []*VestingQueue{}
-		}
-		value := &_QueryAllVestingQueueResponse_1_list{list: &x.VestingQueue}
-		return protoreflect.ValueOfList(value)
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting":
+		if x.BuyerVesting == nil {
+			x.BuyerVesting = new(BuyerVesting)
+		}
+		return protoreflect.ValueOfMessage(x.BuyerVesting.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin":
+		if x.LockedCoin == nil {
+			x.LockedCoin = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.LockedCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin":
+		if x.UnlockedCoin == nil {
+			x.UnlockedCoin = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.UnlockedCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin":
+		if x.ClaimableCoin == nil {
+			x.ClaimableCoin = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ClaimableCoin.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllVestingQueueResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBuyerVestingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
-		list := []*VestingQueue{}
-		return protoreflect.ValueOfList(&_QueryAllVestingQueueResponse_1_list{list: &list})
-	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting":
+		m := new(BuyerVesting)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin":
+		m := new(v1beta11.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBuyerVestingResponse"))
 		}
-		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBuyerVestingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllVestingQueueResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBuyerVestingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllVestingQueueResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBuyerVestingResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7600,7 +7090,7 @@ func (x *fastReflection_QueryAllVestingQueueResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllVestingQueueResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBuyerVestingResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7611,7 +7101,7 @@ func (x *fastReflection_QueryAllVestingQueueResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllVestingQueueResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBuyerVestingResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7623,7 +7113,7 @@ func (x *fastReflection_QueryAllVestingQueueResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllVestingQueueResponse) IsValid() bool {
+func (x *fastReflection_QueryBuyerVestingResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7633,9 +7123,9 @@ func (x *fastReflection_QueryAllVestingQueueResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBuyerVestingResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllVestingQueueResponse)
+		x := input.Message.Interface().(*QueryBuyerVestingResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7647,14 +7137,20 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if len(x.VestingQueue) > 0 {
-			for _, e := range x.VestingQueue {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.BuyerVesting != nil {
+			l = options.Size(x.BuyerVesting)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.LockedCoin != nil {
+			l = options.Size(x.LockedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnlockedCoin != nil {
+			l = options.Size(x.UnlockedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ClaimableCoin != nil {
+			l = options.Size(x.ClaimableCoin)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7667,7 +7163,7 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllVestingQueueResponse)
+		x := input.Message.Interface().(*QueryBuyerVestingResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7686,8 +7182,36 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.ClaimableCoin != nil {
+			encoded, err := options.Marshal(x.ClaimableCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.UnlockedCoin != nil {
+			encoded, err := options.Marshal(x.UnlockedCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LockedCoin != nil {
+			encoded, err := options.Marshal(x.LockedCoin)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7700,21 +7224,19 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.VestingQueue) > 0 {
-			for iNdEx := len(x.VestingQueue) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.VestingQueue[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.BuyerVesting != nil {
+			encoded, err := options.Marshal(x.BuyerVesting)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7727,7 +7249,7 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllVestingQueueResponse)
+		x := input.Message.Interface().(*QueryBuyerVestingResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7759,15 +7281,15 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBuyerVestingResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBuyerVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingQueue", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyerVesting", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7794,14 +7316,16 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VestingQueue = append(x.VestingQueue, &VestingQueue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingQueue[len(x.VestingQueue)-1]); err != nil {
+				if x.BuyerVesting == nil {
+					x.BuyerVesting = &BuyerVesting{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BuyerVesting); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedCoin", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7828,10 +7352,82 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.LockedCoin == nil {
+					x.LockedCoin = &v1beta11.Coin{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnlockedCoin == nil {
+					x.UnlockedCoin = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnlockedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimableCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ClaimableCoin == nil {
+					x.ClaimableCoin = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClaimableCoin); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7870,251 +7466,10661 @@ func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: fundraising/fundraising/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QuerySimulateMatchingRequest            protoreflect.MessageDescriptor
+	fd_QuerySimulateMatchingRequest_auction_id protoreflect.FieldDescriptor
+	fd_QuerySimulateMatchingRequest_bidder     protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QuerySimulateMatchingRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QuerySimulateMatchingRequest")
+	fd_QuerySimulateMatchingRequest_auction_id = md_QuerySimulateMatchingRequest.Fields().ByName("auction_id")
+	fd_QuerySimulateMatchingRequest_bidder = md_QuerySimulateMatchingRequest.Fields().ByName("bidder")
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{0}
-}
+var _ protoreflect.Message = (*fastReflection_QuerySimulateMatchingRequest)(nil)
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_QuerySimulateMatchingRequest QuerySimulateMatchingRequest
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (x *QuerySimulateMatchingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateMatchingRequest)(x)
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[1]
+func (x *QuerySimulateMatchingRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryParamsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryParamsResponse) ProtoMessage() {}
+var _fastReflection_QuerySimulateMatchingRequest_messageType fastReflection_QuerySimulateMatchingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateMatchingRequest_messageType{}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{1}
-}
+type fastReflection_QuerySimulateMatchingRequest_messageType struct{}
 
-func (x *QueryParamsResponse) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+func (x fastReflection_QuerySimulateMatchingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateMatchingRequest)(nil)
 }
-
-// QueryAllAuctionRequest is request type for the Query/Auctions RPC method.
-type QueryAllAuctionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status     string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Type_      string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (x fastReflection_QuerySimulateMatchingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateMatchingRequest)
 }
-
-func (x *QueryAllAuctionRequest) Reset() {
-	*x = QueryAllAuctionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_QuerySimulateMatchingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateMatchingRequest
 }
 
-func (x *QueryAllAuctionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateMatchingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateMatchingRequest
 }
 
-func (*QueryAllAuctionRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllAuctionRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllAuctionRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{2}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateMatchingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateMatchingRequest_messageType
 }
 
-func (x *QueryAllAuctionRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateMatchingRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateMatchingRequest)
 }
 
-func (x *QueryAllAuctionRequest) GetType_() string {
-	if x != nil {
-		return x.Type_
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateMatchingRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateMatchingRequest)(x)
 }
 
-func (x *QueryAllAuctionRequest) GetPagination() *v1beta1.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateMatchingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QuerySimulateMatchingRequest_auction_id, value) {
+			return
+		}
+	}
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_QuerySimulateMatchingRequest_bidder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateMatchingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.bidder":
+		return x.Bidder != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.bidder":
+		x.Bidder = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateMatchingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.bidder":
+		x.Bidder = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QuerySimulateMatchingRequest is not mutable"))
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QuerySimulateMatchingRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateMatchingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QuerySimulateMatchingRequest.bidder":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateMatchingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QuerySimulateMatchingRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateMatchingRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateMatchingRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateMatchingRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateMatchingRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateMatchingRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateMatchingRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateMatchingRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateMatchingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateMatchingResponse                   protoreflect.MessageDescriptor
+	fd_QuerySimulateMatchingResponse_matched_price     protoreflect.FieldDescriptor
+	fd_QuerySimulateMatchingResponse_matched_amount    protoreflect.FieldDescriptor
+	fd_QuerySimulateMatchingResponse_matched_len       protoreflect.FieldDescriptor
+	fd_QuerySimulateMatchingResponse_bidder_allocation protoreflect.FieldDescriptor
+	fd_QuerySimulateMatchingResponse_pricing_mode      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QuerySimulateMatchingResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QuerySimulateMatchingResponse")
+	fd_QuerySimulateMatchingResponse_matched_price = md_QuerySimulateMatchingResponse.Fields().ByName("matched_price")
+	fd_QuerySimulateMatchingResponse_matched_amount = md_QuerySimulateMatchingResponse.Fields().ByName("matched_amount")
+	fd_QuerySimulateMatchingResponse_matched_len = md_QuerySimulateMatchingResponse.Fields().ByName("matched_len")
+	fd_QuerySimulateMatchingResponse_bidder_allocation = md_QuerySimulateMatchingResponse.Fields().ByName("bidder_allocation")
+	fd_QuerySimulateMatchingResponse_pricing_mode = md_QuerySimulateMatchingResponse.Fields().ByName("pricing_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateMatchingResponse)(nil)
+
+type fastReflection_QuerySimulateMatchingResponse QuerySimulateMatchingResponse
+
+func (x *QuerySimulateMatchingResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateMatchingResponse)(x)
+}
+
+func (x *QuerySimulateMatchingResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateMatchingResponse_messageType fastReflection_QuerySimulateMatchingResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateMatchingResponse_messageType{}
+
+type fastReflection_QuerySimulateMatchingResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateMatchingResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateMatchingResponse)(nil)
+}
+func (x fastReflection_QuerySimulateMatchingResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateMatchingResponse)
+}
+func (x fastReflection_QuerySimulateMatchingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateMatchingResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateMatchingResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateMatchingResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateMatchingResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateMatchingResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateMatchingResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateMatchingResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateMatchingResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateMatchingResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateMatchingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MatchedPrice != "" {
+		value := protoreflect.ValueOfString(x.MatchedPrice)
+		if !f(fd_QuerySimulateMatchingResponse_matched_price, value) {
+			return
+		}
+	}
+	if x.MatchedAmount != "" {
+		value := protoreflect.ValueOfString(x.MatchedAmount)
+		if !f(fd_QuerySimulateMatchingResponse_matched_amount, value) {
+			return
+		}
+	}
+	if x.MatchedLen != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MatchedLen)
+		if !f(fd_QuerySimulateMatchingResponse_matched_len, value) {
+			return
+		}
+	}
+	if x.BidderAllocation != nil {
+		value := protoreflect.ValueOfMessage(x.BidderAllocation.ProtoReflect())
+		if !f(fd_QuerySimulateMatchingResponse_bidder_allocation, value) {
+			return
+		}
+	}
+	if x.PricingMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PricingMode))
+		if !f(fd_QuerySimulateMatchingResponse_pricing_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateMatchingResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_price":
+		return x.MatchedPrice != ""
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_amount":
+		return x.MatchedAmount != ""
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_len":
+		return x.MatchedLen != int64(0)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation":
+		return x.BidderAllocation != nil
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode":
+		return x.PricingMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_price":
+		x.MatchedPrice = ""
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_amount":
+		x.MatchedAmount = ""
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_len":
+		x.MatchedLen = int64(0)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation":
+		x.BidderAllocation = nil
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode":
+		x.PricingMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateMatchingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_price":
+		value := x.MatchedPrice
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_amount":
+		value := x.MatchedAmount
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_len":
+		value := x.MatchedLen
+		return protoreflect.ValueOfInt64(value)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation":
+		value := x.BidderAllocation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode":
+		value := x.PricingMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_price":
+		x.MatchedPrice = value.Interface().(string)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_amount":
+		x.MatchedAmount = value.Interface().(string)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_len":
+		x.MatchedLen = value.Int()
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation":
+		x.BidderAllocation = value.Message().Interface().(*BidderAllocation)
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode":
+		x.PricingMode = (PricingMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation":
+		if x.BidderAllocation == nil {
+			x.BidderAllocation = new(BidderAllocation)
+		}
+		return protoreflect.ValueOfMessage(x.BidderAllocation.ProtoReflect())
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_price":
+		panic(fmt.Errorf("field matched_price of message fundraising.fundraising.v1.QuerySimulateMatchingResponse is not mutable"))
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_amount":
+		panic(fmt.Errorf("field matched_amount of message fundraising.fundraising.v1.QuerySimulateMatchingResponse is not mutable"))
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_len":
+		panic(fmt.Errorf("field matched_len of message fundraising.fundraising.v1.QuerySimulateMatchingResponse is not mutable"))
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode":
+		panic(fmt.Errorf("field pricing_mode of message fundraising.fundraising.v1.QuerySimulateMatchingResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateMatchingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_price":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_amount":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.matched_len":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation":
+		m := new(BidderAllocation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QuerySimulateMatchingResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QuerySimulateMatchingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateMatchingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QuerySimulateMatchingResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateMatchingResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateMatchingResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateMatchingResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateMatchingResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateMatchingResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MatchedPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MatchedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MatchedLen != 0 {
+			n += 1 + runtime.Sov(uint64(x.MatchedLen))
+		}
+		if x.BidderAllocation != nil {
+			l = options.Size(x.BidderAllocation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PricingMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.PricingMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateMatchingResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PricingMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PricingMode))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BidderAllocation != nil {
+			encoded, err := options.Marshal(x.BidderAllocation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MatchedLen != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MatchedLen))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MatchedAmount) > 0 {
+			i -= len(x.MatchedAmount)
+			copy(dAtA[i:], x.MatchedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MatchedAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MatchedPrice) > 0 {
+			i -= len(x.MatchedPrice)
+			copy(dAtA[i:], x.MatchedPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MatchedPrice)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateMatchingResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateMatchingResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateMatchingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MatchedPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MatchedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedLen", wireType)
+				}
+				x.MatchedLen = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MatchedLen |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidderAllocation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BidderAllocation == nil {
+					x.BidderAllocation = &BidderAllocation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BidderAllocation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricingMode", wireType)
+				}
+				x.PricingMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PricingMode |= PricingMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBidBookRequest            protoreflect.MessageDescriptor
+	fd_QueryBidBookRequest_auction_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryBidBookRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBidBookRequest")
+	fd_QueryBidBookRequest_auction_id = md_QueryBidBookRequest.Fields().ByName("auction_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBidBookRequest)(nil)
+
+type fastReflection_QueryBidBookRequest QueryBidBookRequest
+
+func (x *QueryBidBookRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBidBookRequest)(x)
+}
+
+func (x *QueryBidBookRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBidBookRequest_messageType fastReflection_QueryBidBookRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBidBookRequest_messageType{}
+
+type fastReflection_QueryBidBookRequest_messageType struct{}
+
+func (x fastReflection_QueryBidBookRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBidBookRequest)(nil)
+}
+func (x fastReflection_QueryBidBookRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBidBookRequest)
+}
+func (x fastReflection_QueryBidBookRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidBookRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBidBookRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidBookRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBidBookRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBidBookRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBidBookRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBidBookRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBidBookRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBidBookRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBidBookRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QueryBidBookRequest_auction_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBidBookRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookRequest.auction_id":
+		x.AuctionId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBidBookRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookRequest.auction_id":
+		x.AuctionId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryBidBookRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBidBookRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBidBookRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBidBookRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBidBookRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBidBookRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBidBookRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBidBookRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidBookRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidBookRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidBookRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryBidBookResponse_2_list)(nil)
+
+type _QueryBidBookResponse_2_list struct {
+	list *[]*BidBookLevel
+}
+
+func (x *_QueryBidBookResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBidBookResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBidBookResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BidBookLevel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBidBookResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BidBookLevel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBidBookResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(BidBookLevel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBidBookResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBidBookResponse_2_list) NewElement() protoreflect.Value {
+	v := new(BidBookLevel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBidBookResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryBidBookResponse              protoreflect.MessageDescriptor
+	fd_QueryBidBookResponse_selling_coin protoreflect.FieldDescriptor
+	fd_QueryBidBookResponse_levels       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryBidBookResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBidBookResponse")
+	fd_QueryBidBookResponse_selling_coin = md_QueryBidBookResponse.Fields().ByName("selling_coin")
+	fd_QueryBidBookResponse_levels = md_QueryBidBookResponse.Fields().ByName("levels")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBidBookResponse)(nil)
+
+type fastReflection_QueryBidBookResponse QueryBidBookResponse
+
+func (x *QueryBidBookResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBidBookResponse)(x)
+}
+
+func (x *QueryBidBookResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBidBookResponse_messageType fastReflection_QueryBidBookResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBidBookResponse_messageType{}
+
+type fastReflection_QueryBidBookResponse_messageType struct{}
+
+func (x fastReflection_QueryBidBookResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBidBookResponse)(nil)
+}
+func (x fastReflection_QueryBidBookResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBidBookResponse)
+}
+func (x fastReflection_QueryBidBookResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidBookResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBidBookResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidBookResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBidBookResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBidBookResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBidBookResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBidBookResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBidBookResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBidBookResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBidBookResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SellingCoin != nil {
+		value := protoreflect.ValueOfMessage(x.SellingCoin.ProtoReflect())
+		if !f(fd_QueryBidBookResponse_selling_coin, value) {
+			return
+		}
+	}
+	if len(x.Levels) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBidBookResponse_2_list{list: &x.Levels})
+		if !f(fd_QueryBidBookResponse_levels, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBidBookResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookResponse.selling_coin":
+		return x.SellingCoin != nil
+	case "fundraising.fundraising.v1.QueryBidBookResponse.levels":
+		return len(x.Levels) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookResponse.selling_coin":
+		x.SellingCoin = nil
+	case "fundraising.fundraising.v1.QueryBidBookResponse.levels":
+		x.Levels = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBidBookResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookResponse.selling_coin":
+		value := x.SellingCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBidBookResponse.levels":
+		if len(x.Levels) == 0 {
+			return protoreflect.ValueOfList(&_QueryBidBookResponse_2_list{})
+		}
+		listValue := &_QueryBidBookResponse_2_list{list: &x.Levels}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookResponse.selling_coin":
+		x.SellingCoin = value.Message().Interface().(*v1beta11.Coin)
+	case "fundraising.fundraising.v1.QueryBidBookResponse.levels":
+		lv := value.List()
+		clv := lv.(*_QueryBidBookResponse_2_list)
+		x.Levels = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookResponse.selling_coin":
+		if x.SellingCoin == nil {
+			x.SellingCoin = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SellingCoin.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBidBookResponse.levels":
+		if x.Levels == nil {
+			x.Levels = []*BidBookLevel{}
+		}
+		value := &_QueryBidBookResponse_2_list{list: &x.Levels}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBidBookResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidBookResponse.selling_coin":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBidBookResponse.levels":
+		list := []*BidBookLevel{}
+		return protoreflect.ValueOfList(&_QueryBidBookResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidBookResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBidBookResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBidBookResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBidBookResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidBookResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBidBookResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBidBookResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBidBookResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SellingCoin != nil {
+			l = options.Size(x.SellingCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Levels) > 0 {
+			for _, e := range x.Levels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidBookResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Levels) > 0 {
+			for iNdEx := len(x.Levels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Levels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.SellingCoin != nil {
+			encoded, err := options.Marshal(x.SellingCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidBookResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidBookResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SellingCoin == nil {
+					x.SellingCoin = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SellingCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Levels = append(x.Levels, &BidBookLevel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Levels[len(x.Levels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BidBookLevel                   protoreflect.MessageDescriptor
+	fd_BidBookLevel_price             protoreflect.FieldDescriptor
+	fd_BidBookLevel_cumulative_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_BidBookLevel = File_fundraising_fundraising_v1_query_proto.Messages().ByName("BidBookLevel")
+	fd_BidBookLevel_price = md_BidBookLevel.Fields().ByName("price")
+	fd_BidBookLevel_cumulative_amount = md_BidBookLevel.Fields().ByName("cumulative_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_BidBookLevel)(nil)
+
+type fastReflection_BidBookLevel BidBookLevel
+
+func (x *BidBookLevel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BidBookLevel)(x)
+}
+
+func (x *BidBookLevel) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BidBookLevel_messageType fastReflection_BidBookLevel_messageType
+var _ protoreflect.MessageType = fastReflection_BidBookLevel_messageType{}
+
+type fastReflection_BidBookLevel_messageType struct{}
+
+func (x fastReflection_BidBookLevel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BidBookLevel)(nil)
+}
+func (x fastReflection_BidBookLevel_messageType) New() protoreflect.Message {
+	return new(fastReflection_BidBookLevel)
+}
+func (x fastReflection_BidBookLevel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BidBookLevel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BidBookLevel) Descriptor() protoreflect.MessageDescriptor {
+	return md_BidBookLevel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BidBookLevel) Type() protoreflect.MessageType {
+	return _fastReflection_BidBookLevel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BidBookLevel) New() protoreflect.Message {
+	return new(fastReflection_BidBookLevel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BidBookLevel) Interface() protoreflect.ProtoMessage {
+	return (*BidBookLevel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BidBookLevel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_BidBookLevel_price, value) {
+			return
+		}
+	}
+	if x.CumulativeAmount != "" {
+		value := protoreflect.ValueOfString(x.CumulativeAmount)
+		if !f(fd_BidBookLevel_cumulative_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BidBookLevel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidBookLevel.price":
+		return x.Price != ""
+	case "fundraising.fundraising.v1.BidBookLevel.cumulative_amount":
+		return x.CumulativeAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidBookLevel"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidBookLevel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidBookLevel.price":
+		x.Price = ""
+	case "fundraising.fundraising.v1.BidBookLevel.cumulative_amount":
+		x.CumulativeAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidBookLevel"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BidBookLevel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.BidBookLevel.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.BidBookLevel.cumulative_amount":
+		value := x.CumulativeAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidBookLevel"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidBookLevel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidBookLevel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidBookLevel.price":
+		x.Price = value.Interface().(string)
+	case "fundraising.fundraising.v1.BidBookLevel.cumulative_amount":
+		x.CumulativeAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidBookLevel"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidBookLevel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidBookLevel.price":
+		panic(fmt.Errorf("field price of message fundraising.fundraising.v1.BidBookLevel is not mutable"))
+	case "fundraising.fundraising.v1.BidBookLevel.cumulative_amount":
+		panic(fmt.Errorf("field cumulative_amount of message fundraising.fundraising.v1.BidBookLevel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidBookLevel"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BidBookLevel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.BidBookLevel.price":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.BidBookLevel.cumulative_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.BidBookLevel"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.BidBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BidBookLevel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.BidBookLevel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BidBookLevel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BidBookLevel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BidBookLevel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BidBookLevel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BidBookLevel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BidBookLevel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CumulativeAmount) > 0 {
+			i -= len(x.CumulativeAmount)
+			copy(dAtA[i:], x.CumulativeAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BidBookLevel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BidBookLevel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BidBookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAllAllowedBidderRequest            protoreflect.MessageDescriptor
+	fd_QueryAllAllowedBidderRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryAllAllowedBidderRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllAllowedBidderRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAllowedBidderRequest")
+	fd_QueryAllAllowedBidderRequest_auction_id = md_QueryAllAllowedBidderRequest.Fields().ByName("auction_id")
+	fd_QueryAllAllowedBidderRequest_pagination = md_QueryAllAllowedBidderRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllAllowedBidderRequest)(nil)
+
+type fastReflection_QueryAllAllowedBidderRequest QueryAllAllowedBidderRequest
+
+func (x *QueryAllAllowedBidderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllAllowedBidderRequest)(x)
+}
+
+func (x *QueryAllAllowedBidderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllAllowedBidderRequest_messageType fastReflection_QueryAllAllowedBidderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllAllowedBidderRequest_messageType{}
+
+type fastReflection_QueryAllAllowedBidderRequest_messageType struct{}
+
+func (x fastReflection_QueryAllAllowedBidderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllAllowedBidderRequest)(nil)
+}
+func (x fastReflection_QueryAllAllowedBidderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAllowedBidderRequest)
+}
+func (x fastReflection_QueryAllAllowedBidderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAllowedBidderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAllowedBidderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllAllowedBidderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllAllowedBidderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAllowedBidderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllAllowedBidderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QueryAllAllowedBidderRequest_auction_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllAllowedBidderRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAllAllowedBidderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllAllowedBidderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllAllowedBidderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAllowedBidderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllAllowedBidderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllAllowedBidderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllAllowedBidderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllAllowedBidderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllAllowedBidderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllAllowedBidderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllAllowedBidderResponse_1_list)(nil)
+
+type _QueryAllAllowedBidderResponse_1_list struct {
+	list *[]*AllowedBidder
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowedBidder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowedBidder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(AllowedBidder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) NewElement() protoreflect.Value {
+	v := new(AllowedBidder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllAllowedBidderResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllAllowedBidderResponse                protoreflect.MessageDescriptor
+	fd_QueryAllAllowedBidderResponse_allowed_bidder protoreflect.FieldDescriptor
+	fd_QueryAllAllowedBidderResponse_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllAllowedBidderResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllAllowedBidderResponse")
+	fd_QueryAllAllowedBidderResponse_allowed_bidder = md_QueryAllAllowedBidderResponse.Fields().ByName("allowed_bidder")
+	fd_QueryAllAllowedBidderResponse_pagination = md_QueryAllAllowedBidderResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllAllowedBidderResponse)(nil)
+
+type fastReflection_QueryAllAllowedBidderResponse QueryAllAllowedBidderResponse
+
+func (x *QueryAllAllowedBidderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllAllowedBidderResponse)(x)
+}
+
+func (x *QueryAllAllowedBidderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllAllowedBidderResponse_messageType fastReflection_QueryAllAllowedBidderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllAllowedBidderResponse_messageType{}
+
+type fastReflection_QueryAllAllowedBidderResponse_messageType struct{}
+
+func (x fastReflection_QueryAllAllowedBidderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllAllowedBidderResponse)(nil)
+}
+func (x fastReflection_QueryAllAllowedBidderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAllowedBidderResponse)
+}
+func (x fastReflection_QueryAllAllowedBidderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAllowedBidderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllAllowedBidderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllAllowedBidderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllAllowedBidderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllAllowedBidderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllAllowedBidderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AllowedBidder) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllAllowedBidderResponse_1_list{list: &x.AllowedBidder})
+		if !f(fd_QueryAllAllowedBidderResponse_allowed_bidder, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllAllowedBidderResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
+		return len(x.AllowedBidder) != 0
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
+		x.AllowedBidder = nil
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
+		if len(x.AllowedBidder) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllAllowedBidderResponse_1_list{})
+		}
+		listValue := &_QueryAllAllowedBidderResponse_1_list{list: &x.AllowedBidder}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
+		lv := value.List()
+		clv := lv.(*_QueryAllAllowedBidderResponse_1_list)
+		x.AllowedBidder = *clv.list
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
+		if x.AllowedBidder == nil {
+			x.AllowedBidder = []*AllowedBidder{}
+		}
+		value := &_QueryAllAllowedBidderResponse_1_list{list: &x.AllowedBidder}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllAllowedBidderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder":
+		list := []*AllowedBidder{}
+		return protoreflect.ValueOfList(&_QueryAllAllowedBidderResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllAllowedBidderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllAllowedBidderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllAllowedBidderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllAllowedBidderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllAllowedBidderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllAllowedBidderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllAllowedBidderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AllowedBidder) > 0 {
+			for _, e := range x.AllowedBidder {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllAllowedBidderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AllowedBidder) > 0 {
+			for iNdEx := len(x.AllowedBidder) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedBidder[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllAllowedBidderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedBidder = append(x.AllowedBidder, &AllowedBidder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedBidder[len(x.AllowedBidder)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetAllowedBidderRequest            protoreflect.MessageDescriptor
+	fd_QueryGetAllowedBidderRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryGetAllowedBidderRequest_bidder     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryGetAllowedBidderRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAllowedBidderRequest")
+	fd_QueryGetAllowedBidderRequest_auction_id = md_QueryGetAllowedBidderRequest.Fields().ByName("auction_id")
+	fd_QueryGetAllowedBidderRequest_bidder = md_QueryGetAllowedBidderRequest.Fields().ByName("bidder")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetAllowedBidderRequest)(nil)
+
+type fastReflection_QueryGetAllowedBidderRequest QueryGetAllowedBidderRequest
+
+func (x *QueryGetAllowedBidderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAllowedBidderRequest)(x)
+}
+
+func (x *QueryGetAllowedBidderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetAllowedBidderRequest_messageType fastReflection_QueryGetAllowedBidderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAllowedBidderRequest_messageType{}
+
+type fastReflection_QueryGetAllowedBidderRequest_messageType struct{}
+
+func (x fastReflection_QueryGetAllowedBidderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAllowedBidderRequest)(nil)
+}
+func (x fastReflection_QueryGetAllowedBidderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAllowedBidderRequest)
+}
+func (x fastReflection_QueryGetAllowedBidderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAllowedBidderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAllowedBidderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAllowedBidderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetAllowedBidderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAllowedBidderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAllowedBidderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QueryGetAllowedBidderRequest_auction_id, value) {
+			return
+		}
+	}
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_QueryGetAllowedBidderRequest_bidder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
+		return x.Bidder != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
+		x.Bidder = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
+		x.Bidder = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryGetAllowedBidderRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryGetAllowedBidderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetAllowedBidderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderRequest.bidder":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetAllowedBidderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAllowedBidderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetAllowedBidderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetAllowedBidderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetAllowedBidderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetAllowedBidderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAllowedBidderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAllowedBidderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetAllowedBidderResponse                protoreflect.MessageDescriptor
+	fd_QueryGetAllowedBidderResponse_allowed_bidder protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryGetAllowedBidderResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetAllowedBidderResponse")
+	fd_QueryGetAllowedBidderResponse_allowed_bidder = md_QueryGetAllowedBidderResponse.Fields().ByName("allowed_bidder")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetAllowedBidderResponse)(nil)
+
+type fastReflection_QueryGetAllowedBidderResponse QueryGetAllowedBidderResponse
+
+func (x *QueryGetAllowedBidderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetAllowedBidderResponse)(x)
+}
+
+func (x *QueryGetAllowedBidderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetAllowedBidderResponse_messageType fastReflection_QueryGetAllowedBidderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetAllowedBidderResponse_messageType{}
+
+type fastReflection_QueryGetAllowedBidderResponse_messageType struct{}
+
+func (x fastReflection_QueryGetAllowedBidderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetAllowedBidderResponse)(nil)
+}
+func (x fastReflection_QueryGetAllowedBidderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAllowedBidderResponse)
+}
+func (x fastReflection_QueryGetAllowedBidderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAllowedBidderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetAllowedBidderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetAllowedBidderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetAllowedBidderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetAllowedBidderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetAllowedBidderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AllowedBidder != nil {
+		value := protoreflect.ValueOfMessage(x.AllowedBidder.ProtoReflect())
+		if !f(fd_QueryGetAllowedBidderResponse_allowed_bidder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
+		return x.AllowedBidder != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
+		x.AllowedBidder = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
+		value := x.AllowedBidder
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
+		x.AllowedBidder = value.Message().Interface().(*AllowedBidder)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
+		if x.AllowedBidder == nil {
+			x.AllowedBidder = new(AllowedBidder)
+		}
+		return protoreflect.ValueOfMessage(x.AllowedBidder.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetAllowedBidderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder":
+		m := new(AllowedBidder)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetAllowedBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetAllowedBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetAllowedBidderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetAllowedBidderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetAllowedBidderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetAllowedBidderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetAllowedBidderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetAllowedBidderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetAllowedBidderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AllowedBidder != nil {
+			l = options.Size(x.AllowedBidder)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAllowedBidderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AllowedBidder != nil {
+			encoded, err := options.Marshal(x.AllowedBidder)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetAllowedBidderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AllowedBidder == nil {
+					x.AllowedBidder = &AllowedBidder{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedBidder); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetBidRequest            protoreflect.MessageDescriptor
+	fd_QueryGetBidRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryGetBidRequest_bid_id     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryGetBidRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetBidRequest")
+	fd_QueryGetBidRequest_auction_id = md_QueryGetBidRequest.Fields().ByName("auction_id")
+	fd_QueryGetBidRequest_bid_id = md_QueryGetBidRequest.Fields().ByName("bid_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetBidRequest)(nil)
+
+type fastReflection_QueryGetBidRequest QueryGetBidRequest
+
+func (x *QueryGetBidRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetBidRequest)(x)
+}
+
+func (x *QueryGetBidRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetBidRequest_messageType fastReflection_QueryGetBidRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetBidRequest_messageType{}
+
+type fastReflection_QueryGetBidRequest_messageType struct{}
+
+func (x fastReflection_QueryGetBidRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetBidRequest)(nil)
+}
+func (x fastReflection_QueryGetBidRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBidRequest)
+}
+func (x fastReflection_QueryGetBidRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBidRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetBidRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBidRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetBidRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetBidRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetBidRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBidRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetBidRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetBidRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetBidRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QueryGetBidRequest_auction_id, value) {
+			return
+		}
+	}
+	if x.BidId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BidId)
+		if !f(fd_QueryGetBidRequest_bid_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetBidRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+		return x.BidId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+		x.BidId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetBidRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+		value := x.BidId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+		x.BidId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryGetBidRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+		panic(fmt.Errorf("field bid_id of message fundraising.fundraising.v1.QueryGetBidRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetBidRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QueryGetBidRequest.bid_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetBidRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetBidRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetBidRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetBidRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetBidRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetBidRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		if x.BidId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BidId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBidRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BidId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BidId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBidRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+				}
+				x.BidId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BidId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetBidResponse     protoreflect.MessageDescriptor
+	fd_QueryGetBidResponse_bid protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryGetBidResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryGetBidResponse")
+	fd_QueryGetBidResponse_bid = md_QueryGetBidResponse.Fields().ByName("bid")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetBidResponse)(nil)
+
+type fastReflection_QueryGetBidResponse QueryGetBidResponse
+
+func (x *QueryGetBidResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetBidResponse)(x)
+}
+
+func (x *QueryGetBidResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetBidResponse_messageType fastReflection_QueryGetBidResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetBidResponse_messageType{}
+
+type fastReflection_QueryGetBidResponse_messageType struct{}
+
+func (x fastReflection_QueryGetBidResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetBidResponse)(nil)
+}
+func (x fastReflection_QueryGetBidResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBidResponse)
+}
+func (x fastReflection_QueryGetBidResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBidResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetBidResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBidResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetBidResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetBidResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetBidResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBidResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetBidResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetBidResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetBidResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bid != nil {
+		value := protoreflect.ValueOfMessage(x.Bid.ProtoReflect())
+		if !f(fd_QueryGetBidResponse_bid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetBidResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
+		return x.Bid != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
+		x.Bid = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetBidResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
+		value := x.Bid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
+		x.Bid = value.Message().Interface().(*Bid)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
+		if x.Bid == nil {
+			x.Bid = new(Bid)
+		}
+		return protoreflect.ValueOfMessage(x.Bid.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetBidResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryGetBidResponse.bid":
+		m := new(Bid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryGetBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryGetBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetBidResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryGetBidResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetBidResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBidResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetBidResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetBidResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetBidResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Bid != nil {
+			l = options.Size(x.Bid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBidResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Bid != nil {
+			encoded, err := options.Marshal(x.Bid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBidResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Bid == nil {
+					x.Bid = &Bid{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAllBidRequest            protoreflect.MessageDescriptor
+	fd_QueryAllBidRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryAllBidRequest_bidder     protoreflect.FieldDescriptor
+	fd_QueryAllBidRequest_is_matched protoreflect.FieldDescriptor
+	fd_QueryAllBidRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllBidRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllBidRequest")
+	fd_QueryAllBidRequest_auction_id = md_QueryAllBidRequest.Fields().ByName("auction_id")
+	fd_QueryAllBidRequest_bidder = md_QueryAllBidRequest.Fields().ByName("bidder")
+	fd_QueryAllBidRequest_is_matched = md_QueryAllBidRequest.Fields().ByName("is_matched")
+	fd_QueryAllBidRequest_pagination = md_QueryAllBidRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllBidRequest)(nil)
+
+type fastReflection_QueryAllBidRequest QueryAllBidRequest
+
+func (x *QueryAllBidRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllBidRequest)(x)
+}
+
+func (x *QueryAllBidRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllBidRequest_messageType fastReflection_QueryAllBidRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllBidRequest_messageType{}
+
+type fastReflection_QueryAllBidRequest_messageType struct{}
+
+func (x fastReflection_QueryAllBidRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllBidRequest)(nil)
+}
+func (x fastReflection_QueryAllBidRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllBidRequest)
+}
+func (x fastReflection_QueryAllBidRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllBidRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllBidRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllBidRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllBidRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllBidRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllBidRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllBidRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllBidRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllBidRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllBidRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QueryAllBidRequest_auction_id, value) {
+			return
+		}
+	}
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_QueryAllBidRequest_bidder, value) {
+			return
+		}
+	}
+	if x.IsMatched != "" {
+		value := protoreflect.ValueOfString(x.IsMatched)
+		if !f(fd_QueryAllBidRequest_is_matched, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllBidRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllBidRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+		return x.Bidder != ""
+	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+		return x.IsMatched != ""
+	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+		x.Bidder = ""
+	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+		x.IsMatched = ""
+	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllBidRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+		value := x.IsMatched
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+		x.Bidder = value.Interface().(string)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+		x.IsMatched = value.Interface().(string)
+	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAllBidRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryAllBidRequest is not mutable"))
+	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+		panic(fmt.Errorf("field is_matched of message fundraising.fundraising.v1.QueryAllBidRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllBidRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QueryAllBidRequest.bidder":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QueryAllBidRequest.is_matched":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QueryAllBidRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllBidRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllBidRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllBidRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllBidRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllBidRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllBidRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IsMatched)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllBidRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.IsMatched) > 0 {
+			i -= len(x.IsMatched)
+			copy(dAtA[i:], x.IsMatched)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IsMatched)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllBidRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IsMatched = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllBidResponse_1_list)(nil)
+
+type _QueryAllBidResponse_1_list struct {
+	list *[]*Bid
+}
+
+func (x *_QueryAllBidResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllBidResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllBidResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Bid)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllBidResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Bid)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllBidResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Bid)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllBidResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllBidResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Bid)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllBidResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllBidResponse            protoreflect.MessageDescriptor
+	fd_QueryAllBidResponse_bid        protoreflect.FieldDescriptor
+	fd_QueryAllBidResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllBidResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllBidResponse")
+	fd_QueryAllBidResponse_bid = md_QueryAllBidResponse.Fields().ByName("bid")
+	fd_QueryAllBidResponse_pagination = md_QueryAllBidResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllBidResponse)(nil)
+
+type fastReflection_QueryAllBidResponse QueryAllBidResponse
+
+func (x *QueryAllBidResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllBidResponse)(x)
+}
+
+func (x *QueryAllBidResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllBidResponse_messageType fastReflection_QueryAllBidResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllBidResponse_messageType{}
+
+type fastReflection_QueryAllBidResponse_messageType struct{}
+
+func (x fastReflection_QueryAllBidResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllBidResponse)(nil)
+}
+func (x fastReflection_QueryAllBidResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllBidResponse)
+}
+func (x fastReflection_QueryAllBidResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllBidResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllBidResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllBidResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllBidResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllBidResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllBidResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllBidResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllBidResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllBidResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllBidResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Bid) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllBidResponse_1_list{list: &x.Bid})
+		if !f(fd_QueryAllBidResponse_bid, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllBidResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllBidResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
+		return len(x.Bid) != 0
+	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
+		x.Bid = nil
+	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllBidResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
+		if len(x.Bid) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllBidResponse_1_list{})
+		}
+		listValue := &_QueryAllBidResponse_1_list{list: &x.Bid}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
+		lv := value.List()
+		clv := lv.(*_QueryAllBidResponse_1_list)
+		x.Bid = *clv.list
+	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
+		if x.Bid == nil {
+			x.Bid = []*Bid{}
+		}
+		value := &_QueryAllBidResponse_1_list{list: &x.Bid}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllBidResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllBidResponse.bid":
+		list := []*Bid{}
+		return protoreflect.ValueOfList(&_QueryAllBidResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryAllBidResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllBidResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllBidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllBidResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllBidResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllBidResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllBidResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllBidResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllBidResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllBidResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Bid) > 0 {
+			for _, e := range x.Bid {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllBidResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Bid) > 0 {
+			for iNdEx := len(x.Bid) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Bid[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllBidResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bid = append(x.Bid, &Bid{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bid[len(x.Bid)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBidsByBidderRequest            protoreflect.MessageDescriptor
+	fd_QueryBidsByBidderRequest_bidder     protoreflect.FieldDescriptor
+	fd_QueryBidsByBidderRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryBidsByBidderRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBidsByBidderRequest")
+	fd_QueryBidsByBidderRequest_bidder = md_QueryBidsByBidderRequest.Fields().ByName("bidder")
+	fd_QueryBidsByBidderRequest_pagination = md_QueryBidsByBidderRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBidsByBidderRequest)(nil)
+
+type fastReflection_QueryBidsByBidderRequest QueryBidsByBidderRequest
+
+func (x *QueryBidsByBidderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBidsByBidderRequest)(x)
+}
+
+func (x *QueryBidsByBidderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBidsByBidderRequest_messageType fastReflection_QueryBidsByBidderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBidsByBidderRequest_messageType{}
+
+type fastReflection_QueryBidsByBidderRequest_messageType struct{}
+
+func (x fastReflection_QueryBidsByBidderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBidsByBidderRequest)(nil)
+}
+func (x fastReflection_QueryBidsByBidderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBidsByBidderRequest)
+}
+func (x fastReflection_QueryBidsByBidderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidsByBidderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBidsByBidderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidsByBidderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBidsByBidderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBidsByBidderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBidsByBidderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBidsByBidderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBidsByBidderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBidsByBidderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBidsByBidderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_QueryBidsByBidderRequest_bidder, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryBidsByBidderRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBidsByBidderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.bidder":
+		return x.Bidder != ""
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.bidder":
+		x.Bidder = ""
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBidsByBidderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.bidder":
+		x.Bidder = value.Interface().(string)
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.bidder":
+		panic(fmt.Errorf("field bidder of message fundraising.fundraising.v1.QueryBidsByBidderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBidsByBidderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.bidder":
+		return protoreflect.ValueOfString("")
+	case "fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBidsByBidderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBidsByBidderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBidsByBidderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBidsByBidderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBidsByBidderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBidsByBidderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidsByBidderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidsByBidderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidsByBidderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidsByBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryBidsByBidderResponse_1_list)(nil)
+
+type _QueryBidsByBidderResponse_1_list struct {
+	list *[]*Bid
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Bid)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Bid)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Bid)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Bid)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBidsByBidderResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryBidsByBidderResponse            protoreflect.MessageDescriptor
+	fd_QueryBidsByBidderResponse_bid        protoreflect.FieldDescriptor
+	fd_QueryBidsByBidderResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryBidsByBidderResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryBidsByBidderResponse")
+	fd_QueryBidsByBidderResponse_bid = md_QueryBidsByBidderResponse.Fields().ByName("bid")
+	fd_QueryBidsByBidderResponse_pagination = md_QueryBidsByBidderResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBidsByBidderResponse)(nil)
+
+type fastReflection_QueryBidsByBidderResponse QueryBidsByBidderResponse
+
+func (x *QueryBidsByBidderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBidsByBidderResponse)(x)
+}
+
+func (x *QueryBidsByBidderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBidsByBidderResponse_messageType fastReflection_QueryBidsByBidderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBidsByBidderResponse_messageType{}
+
+type fastReflection_QueryBidsByBidderResponse_messageType struct{}
+
+func (x fastReflection_QueryBidsByBidderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBidsByBidderResponse)(nil)
+}
+func (x fastReflection_QueryBidsByBidderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBidsByBidderResponse)
+}
+func (x fastReflection_QueryBidsByBidderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidsByBidderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBidsByBidderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBidsByBidderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBidsByBidderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBidsByBidderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBidsByBidderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBidsByBidderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBidsByBidderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBidsByBidderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBidsByBidderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Bid) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBidsByBidderResponse_1_list{list: &x.Bid})
+		if !f(fd_QueryBidsByBidderResponse_bid, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryBidsByBidderResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBidsByBidderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.bid":
+		return len(x.Bid) != 0
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.bid":
+		x.Bid = nil
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBidsByBidderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.bid":
+		if len(x.Bid) == 0 {
+			return protoreflect.ValueOfList(&_QueryBidsByBidderResponse_1_list{})
+		}
+		listValue := &_QueryBidsByBidderResponse_1_list{list: &x.Bid}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.bid":
+		lv := value.List()
+		clv := lv.(*_QueryBidsByBidderResponse_1_list)
+		x.Bid = *clv.list
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.bid":
+		if x.Bid == nil {
+			x.Bid = []*Bid{}
+		}
+		value := &_QueryBidsByBidderResponse_1_list{list: &x.Bid}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBidsByBidderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.bid":
+		list := []*Bid{}
+		return protoreflect.ValueOfList(&_QueryBidsByBidderResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryBidsByBidderResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryBidsByBidderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBidsByBidderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryBidsByBidderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBidsByBidderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBidsByBidderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBidsByBidderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBidsByBidderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBidsByBidderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Bid) > 0 {
+			for _, e := range x.Bid {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidsByBidderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Bid) > 0 {
+			for iNdEx := len(x.Bid) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Bid[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBidsByBidderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidsByBidderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBidsByBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bid = append(x.Bid, &Bid{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bid[len(x.Bid)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAllFailedTransitionRequest            protoreflect.MessageDescriptor
+	fd_QueryAllFailedTransitionRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllFailedTransitionRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllFailedTransitionRequest")
+	fd_QueryAllFailedTransitionRequest_pagination = md_QueryAllFailedTransitionRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllFailedTransitionRequest)(nil)
+
+type fastReflection_QueryAllFailedTransitionRequest QueryAllFailedTransitionRequest
+
+func (x *QueryAllFailedTransitionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllFailedTransitionRequest)(x)
+}
+
+func (x *QueryAllFailedTransitionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllFailedTransitionRequest_messageType fastReflection_QueryAllFailedTransitionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllFailedTransitionRequest_messageType{}
+
+type fastReflection_QueryAllFailedTransitionRequest_messageType struct{}
+
+func (x fastReflection_QueryAllFailedTransitionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllFailedTransitionRequest)(nil)
+}
+func (x fastReflection_QueryAllFailedTransitionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllFailedTransitionRequest)
+}
+func (x fastReflection_QueryAllFailedTransitionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllFailedTransitionRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllFailedTransitionRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllFailedTransitionRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllFailedTransitionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllFailedTransitionRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllFailedTransitionRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllFailedTransitionRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllFailedTransitionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllFailedTransitionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllFailedTransitionRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllFailedTransitionRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllFailedTransitionRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllFailedTransitionRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllFailedTransitionRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllFailedTransitionRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllFailedTransitionRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllFailedTransitionRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllFailedTransitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllFailedTransitionResponse_1_list)(nil)
+
+type _QueryAllFailedTransitionResponse_1_list struct {
+	list *[]*FailedTransition
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedTransition)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedTransition)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(FailedTransition)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) NewElement() protoreflect.Value {
+	v := new(FailedTransition)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllFailedTransitionResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllFailedTransitionResponse                   protoreflect.MessageDescriptor
+	fd_QueryAllFailedTransitionResponse_failed_transition protoreflect.FieldDescriptor
+	fd_QueryAllFailedTransitionResponse_pagination        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllFailedTransitionResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllFailedTransitionResponse")
+	fd_QueryAllFailedTransitionResponse_failed_transition = md_QueryAllFailedTransitionResponse.Fields().ByName("failed_transition")
+	fd_QueryAllFailedTransitionResponse_pagination = md_QueryAllFailedTransitionResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllFailedTransitionResponse)(nil)
+
+type fastReflection_QueryAllFailedTransitionResponse QueryAllFailedTransitionResponse
+
+func (x *QueryAllFailedTransitionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllFailedTransitionResponse)(x)
+}
+
+func (x *QueryAllFailedTransitionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllFailedTransitionResponse_messageType fastReflection_QueryAllFailedTransitionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllFailedTransitionResponse_messageType{}
+
+type fastReflection_QueryAllFailedTransitionResponse_messageType struct{}
+
+func (x fastReflection_QueryAllFailedTransitionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllFailedTransitionResponse)(nil)
+}
+func (x fastReflection_QueryAllFailedTransitionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllFailedTransitionResponse)
+}
+func (x fastReflection_QueryAllFailedTransitionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllFailedTransitionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllFailedTransitionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllFailedTransitionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllFailedTransitionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllFailedTransitionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllFailedTransitionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.FailedTransition) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllFailedTransitionResponse_1_list{list: &x.FailedTransition})
+		if !f(fd_QueryAllFailedTransitionResponse_failed_transition, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllFailedTransitionResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition":
+		return len(x.FailedTransition) != 0
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition":
+		x.FailedTransition = nil
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition":
+		if len(x.FailedTransition) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllFailedTransitionResponse_1_list{})
+		}
+		listValue := &_QueryAllFailedTransitionResponse_1_list{list: &x.FailedTransition}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition":
+		lv := value.List()
+		clv := lv.(*_QueryAllFailedTransitionResponse_1_list)
+		x.FailedTransition = *clv.list
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition":
+		if x.FailedTransition == nil {
+			x.FailedTransition = []*FailedTransition{}
+		}
+		value := &_QueryAllFailedTransitionResponse_1_list{list: &x.FailedTransition}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllFailedTransitionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition":
+		list := []*FailedTransition{}
+		return protoreflect.ValueOfList(&_QueryAllFailedTransitionResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllFailedTransitionResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllFailedTransitionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllFailedTransitionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllFailedTransitionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllFailedTransitionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllFailedTransitionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllFailedTransitionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllFailedTransitionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllFailedTransitionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.FailedTransition) > 0 {
+			for _, e := range x.FailedTransition {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllFailedTransitionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FailedTransition) > 0 {
+			for iNdEx := len(x.FailedTransition) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FailedTransition[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllFailedTransitionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllFailedTransitionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllFailedTransitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedTransition", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedTransition = append(x.FailedTransition, &FailedTransition{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedTransition[len(x.FailedTransition)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAllVestingQueueRequest            protoreflect.MessageDescriptor
+	fd_QueryAllVestingQueueRequest_auction_id protoreflect.FieldDescriptor
+	fd_QueryAllVestingQueueRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllVestingQueueRequest = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllVestingQueueRequest")
+	fd_QueryAllVestingQueueRequest_auction_id = md_QueryAllVestingQueueRequest.Fields().ByName("auction_id")
+	fd_QueryAllVestingQueueRequest_pagination = md_QueryAllVestingQueueRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllVestingQueueRequest)(nil)
+
+type fastReflection_QueryAllVestingQueueRequest QueryAllVestingQueueRequest
+
+func (x *QueryAllVestingQueueRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllVestingQueueRequest)(x)
+}
+
+func (x *QueryAllVestingQueueRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllVestingQueueRequest_messageType fastReflection_QueryAllVestingQueueRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllVestingQueueRequest_messageType{}
+
+type fastReflection_QueryAllVestingQueueRequest_messageType struct{}
+
+func (x fastReflection_QueryAllVestingQueueRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllVestingQueueRequest)(nil)
+}
+func (x fastReflection_QueryAllVestingQueueRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllVestingQueueRequest)
+}
+func (x fastReflection_QueryAllVestingQueueRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllVestingQueueRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllVestingQueueRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllVestingQueueRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllVestingQueueRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllVestingQueueRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllVestingQueueRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllVestingQueueRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllVestingQueueRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllVestingQueueRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllVestingQueueRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_QueryAllVestingQueueRequest_auction_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllVestingQueueRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllVestingQueueRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+		return x.AuctionId != uint64(0)
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+		x.AuctionId = uint64(0)
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllVestingQueueRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+		x.AuctionId = value.Uint()
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.QueryAllVestingQueueRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllVestingQueueRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueRequest"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllVestingQueueRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllVestingQueueRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllVestingQueueRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllVestingQueueRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllVestingQueueRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllVestingQueueRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllVestingQueueRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllVestingQueueRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllVestingQueueResponse_1_list)(nil)
+
+type _QueryAllVestingQueueResponse_1_list struct {
+	list *[]*VestingQueue
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingQueue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingQueue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(VestingQueue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) NewElement() protoreflect.Value {
+	v := new(VestingQueue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllVestingQueueResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllVestingQueueResponse              protoreflect.MessageDescriptor
+	fd_QueryAllVestingQueueResponse_vestingQueue protoreflect.FieldDescriptor
+	fd_QueryAllVestingQueueResponse_pagination   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fundraising_fundraising_v1_query_proto_init()
+	md_QueryAllVestingQueueResponse = File_fundraising_fundraising_v1_query_proto.Messages().ByName("QueryAllVestingQueueResponse")
+	fd_QueryAllVestingQueueResponse_vestingQueue = md_QueryAllVestingQueueResponse.Fields().ByName("vestingQueue")
+	fd_QueryAllVestingQueueResponse_pagination = md_QueryAllVestingQueueResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllVestingQueueResponse)(nil)
+
+type fastReflection_QueryAllVestingQueueResponse QueryAllVestingQueueResponse
+
+func (x *QueryAllVestingQueueResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllVestingQueueResponse)(x)
+}
+
+func (x *QueryAllVestingQueueResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllVestingQueueResponse_messageType fastReflection_QueryAllVestingQueueResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllVestingQueueResponse_messageType{}
+
+type fastReflection_QueryAllVestingQueueResponse_messageType struct{}
+
+func (x fastReflection_QueryAllVestingQueueResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllVestingQueueResponse)(nil)
+}
+func (x fastReflection_QueryAllVestingQueueResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllVestingQueueResponse)
+}
+func (x fastReflection_QueryAllVestingQueueResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllVestingQueueResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllVestingQueueResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllVestingQueueResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllVestingQueueResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllVestingQueueResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllVestingQueueResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllVestingQueueResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllVestingQueueResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllVestingQueueResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllVestingQueueResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.VestingQueue) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllVestingQueueResponse_1_list{list: &x.VestingQueue})
+		if !f(fd_QueryAllVestingQueueResponse_vestingQueue, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllVestingQueueResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllVestingQueueResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
+		return len(x.VestingQueue) != 0
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
+		x.VestingQueue = nil
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllVestingQueueResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
+		if len(x.VestingQueue) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllVestingQueueResponse_1_list{})
+		}
+		listValue := &_QueryAllVestingQueueResponse_1_list{list: &x.VestingQueue}
+		return protoreflect.ValueOfList(listValue)
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
+		lv := value.List()
+		clv := lv.(*_QueryAllVestingQueueResponse_1_list)
+		x.VestingQueue = *clv.list
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
+		if x.VestingQueue == nil {
+			x.VestingQueue = []*VestingQueue{}
+		}
+		value := &_QueryAllVestingQueueResponse_1_list{list: &x.VestingQueue}
+		return protoreflect.ValueOfList(value)
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllVestingQueueResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue":
+		list := []*VestingQueue{}
+		return protoreflect.ValueOfList(&_QueryAllVestingQueueResponse_1_list{list: &list})
+	case "fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.QueryAllVestingQueueResponse"))
+		}
+		panic(fmt.Errorf("message fundraising.fundraising.v1.QueryAllVestingQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllVestingQueueResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fundraising.fundraising.v1.QueryAllVestingQueueResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllVestingQueueResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllVestingQueueResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllVestingQueueResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllVestingQueueResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllVestingQueueResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.VestingQueue) > 0 {
+			for _, e := range x.VestingQueue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllVestingQueueResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VestingQueue) > 0 {
+			for iNdEx := len(x.VestingQueue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingQueue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllVestingQueueResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllVestingQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingQueue = append(x.VestingQueue, &VestingQueue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingQueue[len(x.VestingQueue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fundraising/fundraising/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryAllowedDenomsRequest is request type for the Query/AllowedDenoms RPC
+// method.
+type QueryAllowedDenomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryAllowedDenomsRequest) Reset() {
+	*x = QueryAllowedDenomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowedDenomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowedDenomsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowedDenomsRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllowedDenomsRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+// QueryAllowedDenomsResponse is response type for the Query/AllowedDenoms RPC
+// method.
+type QueryAllowedDenomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowed_paying_denoms specifies the denoms that an auction can be paid
+	// with; any denom is allowed when it is empty
+	AllowedPayingDenoms []string `protobuf:"bytes,1,rep,name=allowed_paying_denoms,json=allowedPayingDenoms,proto3" json:"allowed_paying_denoms,omitempty"`
+	// blocked_selling_denoms specifies the denoms that can't be sold in an
+	// auction
+	BlockedSellingDenoms []string `protobuf:"bytes,2,rep,name=blocked_selling_denoms,json=blockedSellingDenoms,proto3" json:"blocked_selling_denoms,omitempty"`
+}
+
+func (x *QueryAllowedDenomsResponse) Reset() {
+	*x = QueryAllowedDenomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllowedDenomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllowedDenomsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllowedDenomsResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryAllowedDenomsResponse) GetAllowedPayingDenoms() []string {
+	if x != nil {
+		return x.AllowedPayingDenoms
+	}
+	return nil
+}
+
+func (x *QueryAllowedDenomsResponse) GetBlockedSellingDenoms() []string {
+	if x != nil {
+		return x.BlockedSellingDenoms
+	}
+	return nil
+}
+
+// QueryAllAuctionRequest is request type for the Query/Auctions RPC method.
+type QueryAllAuctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Type_      string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllAuctionRequest) Reset() {
+	*x = QueryAllAuctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllAuctionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllAuctionRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryAllAuctionRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryAllAuctionRequest) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *QueryAllAuctionRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllAuctionResponse is response type for the Query/Auctions RPC method.
+type QueryAllAuctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// auctions specifies the existing auctions
+	Auction []*anypb.Any `protobuf:"bytes,1,rep,name=auction,proto3" json:"auction,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllAuctionResponse) Reset() {
+	*x = QueryAllAuctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllAuctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllAuctionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllAuctionResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllAuctionResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryAllAuctionResponse) GetAuction() []*anypb.Any {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+func (x *QueryAllAuctionResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGetAuctionRequest is the request type for the Query/Auction RPC method.
+type QueryGetAuctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (x *QueryGetAuctionRequest) Reset() {
+	*x = QueryGetAuctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetAuctionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetAuctionRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryGetAuctionRequest) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+// QueryGetAuctionResponse is the response type for the Query/Auction RPC method.
+type QueryGetAuctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auction *anypb.Any `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+}
+
+func (x *QueryGetAuctionResponse) Reset() {
+	*x = QueryGetAuctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetAuctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetAuctionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetAuctionResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetAuctionResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryGetAuctionResponse) GetAuction() *anypb.Any {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+// QueryCollectedFeesRequest is the request type for the Query/CollectedFees RPC method.
+type QueryCollectedFeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (x *QueryCollectedFeesRequest) Reset() {
+	*x = QueryCollectedFeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCollectedFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCollectedFeesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCollectedFeesRequest.ProtoReflect.Descriptor instead.
+func (*QueryCollectedFeesRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryCollectedFeesRequest) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+// QueryCollectedFeesResponse is the response type for the Query/CollectedFees RPC method.
+type QueryCollectedFeesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectedFees *CollectedFees `protobuf:"bytes,1,opt,name=collected_fees,json=collectedFees,proto3" json:"collected_fees,omitempty"`
+}
+
+func (x *QueryCollectedFeesResponse) Reset() {
+	*x = QueryCollectedFeesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCollectedFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCollectedFeesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCollectedFeesResponse.ProtoReflect.Descriptor instead.
+func (*QueryCollectedFeesResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryCollectedFeesResponse) GetCollectedFees() *CollectedFees {
+	if x != nil {
+		return x.CollectedFees
+	}
+	return nil
+}
+
+// QueryAuctionResultRequest is the request type for the Query/AuctionResult RPC method.
+type QueryAuctionResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (x *QueryAuctionResultRequest) Reset() {
+	*x = QueryAuctionResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuctionResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuctionResultRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAuctionResultRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuctionResultRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryAuctionResultRequest) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+// QueryAuctionResultResponse is the response type for the Query/AuctionResult RPC method.
+type QueryAuctionResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionResult *AuctionResult `protobuf:"bytes,1,opt,name=auction_result,json=auctionResult,proto3" json:"auction_result,omitempty"`
+}
+
+func (x *QueryAuctionResultResponse) Reset() {
+	*x = QueryAuctionResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuctionResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuctionResultResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAuctionResultResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuctionResultResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryAuctionResultResponse) GetAuctionResult() *AuctionResult {
+	if x != nil {
+		return x.AuctionResult
+	}
 	return nil
 }
 
-// QueryAllAuctionResponse is response type for the Query/Auctions RPC method.
-type QueryAllAuctionResponse struct {
+// QueryBidderAllocationRequest is the request type for the Query/BidderAllocation RPC method.
+type QueryBidderAllocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// auctions specifies the existing auctions
-	Auction []*anypb.Any `protobuf:"bytes,1,rep,name=auction,proto3" json:"auction,omitempty"`
-	// pagination defines the pagination in the response
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (x *QueryAllAuctionResponse) Reset() {
-	*x = QueryAllAuctionResponse{}
+func (x *QueryBidderAllocationRequest) Reset() {
+	*x = QueryBidderAllocationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBidderAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBidderAllocationRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBidderAllocationRequest.ProtoReflect.Descriptor instead.
+func (*QueryBidderAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryBidderAllocationRequest) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *QueryBidderAllocationRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+// QueryBidderAllocationResponse is the response type for the Query/BidderAllocation RPC method.
+type QueryBidderAllocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BidderAllocation *BidderAllocation `protobuf:"bytes,1,opt,name=bidder_allocation,json=bidderAllocation,proto3" json:"bidder_allocation,omitempty"`
+}
+
+func (x *QueryBidderAllocationResponse) Reset() {
+	*x = QueryBidderAllocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBidderAllocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBidderAllocationResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBidderAllocationResponse.ProtoReflect.Descriptor instead.
+func (*QueryBidderAllocationResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryBidderAllocationResponse) GetBidderAllocation() *BidderAllocation {
+	if x != nil {
+		return x.BidderAllocation
+	}
+	return nil
+}
+
+// QueryBuyerVestingRequest is the request type for the Query/BuyerVesting RPC method.
+type QueryBuyerVestingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (x *QueryBuyerVestingRequest) Reset() {
+	*x = QueryBuyerVestingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBuyerVestingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBuyerVestingRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBuyerVestingRequest.ProtoReflect.Descriptor instead.
+func (*QueryBuyerVestingRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryBuyerVestingRequest) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *QueryBuyerVestingRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+// QueryBuyerVestingResponse is the response type for the Query/BuyerVesting RPC method.
+type QueryBuyerVestingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuyerVesting *BuyerVesting `protobuf:"bytes,1,opt,name=buyer_vesting,json=buyerVesting,proto3" json:"buyer_vesting,omitempty"`
+	// locked_coin specifies the selling coin that is not unlocked yet
+	LockedCoin *v1beta11.Coin `protobuf:"bytes,2,opt,name=locked_coin,json=lockedCoin,proto3" json:"locked_coin,omitempty"`
+	// unlocked_coin specifies the selling coin unlocked so far, including the
+	// claimed one
+	UnlockedCoin *v1beta11.Coin `protobuf:"bytes,3,opt,name=unlocked_coin,json=unlockedCoin,proto3" json:"unlocked_coin,omitempty"`
+	// claimable_coin specifies the unlocked selling coin that is not claimed yet
+	ClaimableCoin *v1beta11.Coin `protobuf:"bytes,4,opt,name=claimable_coin,json=claimableCoin,proto3" json:"claimable_coin,omitempty"`
+}
+
+func (x *QueryBuyerVestingResponse) Reset() {
+	*x = QueryBuyerVestingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBuyerVestingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBuyerVestingResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBuyerVestingResponse.ProtoReflect.Descriptor instead.
+func (*QueryBuyerVestingResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryBuyerVestingResponse) GetBuyerVesting() *BuyerVesting {
+	if x != nil {
+		return x.BuyerVesting
+	}
+	return nil
+}
+
+func (x *QueryBuyerVestingResponse) GetLockedCoin() *v1beta11.Coin {
+	if x != nil {
+		return x.LockedCoin
+	}
+	return nil
+}
+
+func (x *QueryBuyerVestingResponse) GetUnlockedCoin() *v1beta11.Coin {
+	if x != nil {
+		return x.UnlockedCoin
+	}
+	return nil
+}
+
+func (x *QueryBuyerVestingResponse) GetClaimableCoin() *v1beta11.Coin {
+	if x != nil {
+		return x.ClaimableCoin
+	}
+	return nil
+}
+
+// QuerySimulateMatchingRequest is the request type for the Query/SimulateMatching RPC method.
+type QuerySimulateMatchingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is an optional bech32-encoded address of the bidder to simulate the allocation for
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (x *QuerySimulateMatchingRequest) Reset() {
+	*x = QuerySimulateMatchingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySimulateMatchingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateMatchingRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateMatchingRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateMatchingRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuerySimulateMatchingRequest) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *QuerySimulateMatchingRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+// QuerySimulateMatchingResponse is the response type for the Query/SimulateMatching RPC method.
+type QuerySimulateMatchingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// matched_price specifies the hypothetical clearing price of the auction
+	MatchedPrice string `protobuf:"bytes,1,opt,name=matched_price,json=matchedPrice,proto3" json:"matched_price,omitempty"`
+	// matched_amount specifies the hypothetical amount of the selling coin to be sold
+	MatchedAmount string `protobuf:"bytes,2,opt,name=matched_amount,json=matchedAmount,proto3" json:"matched_amount,omitempty"`
+	// matched_len specifies the hypothetical number of the matched bids
+	MatchedLen int64 `protobuf:"varint,3,opt,name=matched_len,json=matchedLen,proto3" json:"matched_len,omitempty"`
+	// bidder_allocation specifies what the bidder would receive if the bidder is given
+	BidderAllocation *BidderAllocation `protobuf:"bytes,4,opt,name=bidder_allocation,json=bidderAllocation,proto3" json:"bidder_allocation,omitempty"`
+	// pricing_mode specifies the price that the matched bids pay
+	PricingMode PricingMode `protobuf:"varint,5,opt,name=pricing_mode,json=pricingMode,proto3,enum=fundraising.fundraising.v1.PricingMode" json:"pricing_mode,omitempty"`
+}
+
+func (x *QuerySimulateMatchingResponse) Reset() {
+	*x = QuerySimulateMatchingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySimulateMatchingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateMatchingResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateMatchingResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateMatchingResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QuerySimulateMatchingResponse) GetMatchedPrice() string {
+	if x != nil {
+		return x.MatchedPrice
+	}
+	return ""
+}
+
+func (x *QuerySimulateMatchingResponse) GetMatchedAmount() string {
+	if x != nil {
+		return x.MatchedAmount
+	}
+	return ""
+}
+
+func (x *QuerySimulateMatchingResponse) GetMatchedLen() int64 {
+	if x != nil {
+		return x.MatchedLen
+	}
+	return 0
+}
+
+func (x *QuerySimulateMatchingResponse) GetBidderAllocation() *BidderAllocation {
+	if x != nil {
+		return x.BidderAllocation
+	}
+	return nil
+}
+
+func (x *QuerySimulateMatchingResponse) GetPricingMode() PricingMode {
+	if x != nil {
+		return x.PricingMode
+	}
+	return PricingMode_PRICING_MODE_UNSPECIFIED
+}
+
+// QueryBidBookRequest is the request type for the Query/BidBook RPC method.
+type QueryBidBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (x *QueryBidBookRequest) Reset() {
+	*x = QueryBidBookRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[3]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllAuctionResponse) String() string {
+func (x *QueryBidBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllAuctionResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryAllAuctionResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllAuctionResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{3}
-}
+func (*QueryBidBookRequest) ProtoMessage() {}
 
-func (x *QueryAllAuctionResponse) GetAuction() []*anypb.Any {
-	if x != nil {
-		return x.Auction
-	}
-	return nil
+// Deprecated: Use QueryBidBookRequest.ProtoReflect.Descriptor instead.
+func (*QueryBidBookRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryAllAuctionResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryBidBookRequest) GetAuctionId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.AuctionId
 	}
-	return nil
+	return 0
 }
 
-// QueryGetAuctionRequest is the request type for the Query/Auction RPC method.
-type QueryGetAuctionRequest struct {
+// QueryBidBookResponse is the response type for the Query/BidBook RPC method.
+type QueryBidBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// selling_coin specifies the selling coin of the auction
+	SellingCoin *v1beta11.Coin `protobuf:"bytes,1,opt,name=selling_coin,json=sellingCoin,proto3" json:"selling_coin,omitempty"`
+	// levels specifies the price levels of the bids in descending order of the price
+	Levels []*BidBookLevel `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
 }
 
-func (x *QueryGetAuctionRequest) Reset() {
-	*x = QueryGetAuctionRequest{}
+func (x *QueryBidBookResponse) Reset() {
+	*x = QueryBidBookResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[4]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetAuctionRequest) String() string {
+func (x *QueryBidBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetAuctionRequest) ProtoMessage() {}
+func (*QueryBidBookResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetAuctionRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetAuctionRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryBidBookResponse.ProtoReflect.Descriptor instead.
+func (*QueryBidBookResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryGetAuctionRequest) GetAuctionId() uint64 {
+func (x *QueryBidBookResponse) GetSellingCoin() *v1beta11.Coin {
 	if x != nil {
-		return x.AuctionId
+		return x.SellingCoin
 	}
-	return 0
+	return nil
 }
 
-// QueryGetAuctionResponse is the response type for the Query/Auction RPC method.
-type QueryGetAuctionResponse struct {
+func (x *QueryBidBookResponse) GetLevels() []*BidBookLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+// BidBookLevel defines the demand of the selling coin at a price level of the bids.
+type BidBookLevel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auction *anypb.Any `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+	// price specifies the bid price of the level
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// cumulative_amount specifies the amount of the selling coin demanded by the bids
+	// at or above the price, capped by the maximum bid amount of each bidder
+	CumulativeAmount string `protobuf:"bytes,2,opt,name=cumulative_amount,json=cumulativeAmount,proto3" json:"cumulative_amount,omitempty"`
 }
 
-func (x *QueryGetAuctionResponse) Reset() {
-	*x = QueryGetAuctionResponse{}
+func (x *BidBookLevel) Reset() {
+	*x = BidBookLevel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[5]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetAuctionResponse) String() string {
+func (x *BidBookLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetAuctionResponse) ProtoMessage() {}
+func (*BidBookLevel) ProtoMessage() {}
 
-// Deprecated: Use QueryGetAuctionResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetAuctionResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BidBookLevel.ProtoReflect.Descriptor instead.
+func (*BidBookLevel) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryGetAuctionResponse) GetAuction() *anypb.Any {
+func (x *BidBookLevel) GetPrice() string {
 	if x != nil {
-		return x.Auction
+		return x.Price
 	}
-	return nil
+	return ""
+}
+
+func (x *BidBookLevel) GetCumulativeAmount() string {
+	if x != nil {
+		return x.CumulativeAmount
+	}
+	return ""
 }
 
 // QueryAllowedBidderRequest is the request type for the Query/AllowedBidder RPC method.
@@ -8130,7 +18136,7 @@ type QueryAllAllowedBidderRequest struct {
 func (x *QueryAllAllowedBidderRequest) Reset() {
 	*x = QueryAllAllowedBidderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[6]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8144,7 +18150,7 @@ func (*QueryAllAllowedBidderRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAllAllowedBidderRequest.ProtoReflect.Descriptor instead.
 func (*QueryAllAllowedBidderRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryAllAllowedBidderRequest) GetAuctionId() uint64 {
@@ -8175,7 +18181,7 @@ type QueryAllAllowedBidderResponse struct {
 func (x *QueryAllAllowedBidderResponse) Reset() {
 	*x = QueryAllAllowedBidderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[7]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8189,7 +18195,7 @@ func (*QueryAllAllowedBidderResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAllAllowedBidderResponse.ProtoReflect.Descriptor instead.
 func (*QueryAllAllowedBidderResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryAllAllowedBidderResponse) GetAllowedBidder() []*AllowedBidder {
@@ -8220,7 +18226,7 @@ type QueryGetAllowedBidderRequest struct {
 func (x *QueryGetAllowedBidderRequest) Reset() {
 	*x = QueryGetAllowedBidderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[8]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8234,7 +18240,7 @@ func (*QueryGetAllowedBidderRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetAllowedBidderRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetAllowedBidderRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryGetAllowedBidderRequest) GetAuctionId() uint64 {
@@ -8264,7 +18270,7 @@ type QueryGetAllowedBidderResponse struct {
 func (x *QueryGetAllowedBidderResponse) Reset() {
 	*x = QueryGetAllowedBidderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[9]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8278,7 +18284,7 @@ func (*QueryGetAllowedBidderResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetAllowedBidderResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAllowedBidderResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryGetAllowedBidderResponse) GetAllowedBidder() *AllowedBidder {
@@ -8301,7 +18307,7 @@ type QueryGetBidRequest struct {
 func (x *QueryGetBidRequest) Reset() {
 	*x = QueryGetBidRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[10]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8315,7 +18321,7 @@ func (*QueryGetBidRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetBidRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetBidRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryGetBidRequest) GetAuctionId() uint64 {
@@ -8345,7 +18351,7 @@ type QueryGetBidResponse struct {
 func (x *QueryGetBidResponse) Reset() {
 	*x = QueryGetBidResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[11]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8359,7 +18365,7 @@ func (*QueryGetBidResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetBidResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetBidResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryGetBidResponse) GetBid() *Bid {
@@ -8384,7 +18390,7 @@ type QueryAllBidRequest struct {
 func (x *QueryAllBidRequest) Reset() {
 	*x = QueryAllBidRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[12]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8398,7 +18404,7 @@ func (*QueryAllBidRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAllBidRequest.ProtoReflect.Descriptor instead.
 func (*QueryAllBidRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryAllBidRequest) GetAuctionId() uint64 {
@@ -8444,7 +18450,7 @@ type QueryAllBidResponse struct {
 func (x *QueryAllBidResponse) Reset() {
 	*x = QueryAllBidResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[13]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8458,7 +18464,7 @@ func (*QueryAllBidResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAllBidResponse.ProtoReflect.Descriptor instead.
 func (*QueryAllBidResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryAllBidResponse) GetBid() []*Bid {
@@ -8475,6 +18481,178 @@ func (x *QueryAllBidResponse) GetPagination() *v1beta1.PageResponse {
 	return nil
 }
 
+// QueryBidsByBidderRequest is request type for the Query/ListBidsByBidder RPC method.
+type QueryBidsByBidderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bidder     string               `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryBidsByBidderRequest) Reset() {
+	*x = QueryBidsByBidderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBidsByBidderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBidsByBidderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBidsByBidderRequest.ProtoReflect.Descriptor instead.
+func (*QueryBidsByBidderRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryBidsByBidderRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *QueryBidsByBidderRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryBidsByBidderResponse is response type for the Query/ListBidsByBidder RPC method.
+type QueryBidsByBidderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bid specifies the bids placed by the bidder
+	Bid []*Bid `protobuf:"bytes,1,rep,name=bid,proto3" json:"bid,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryBidsByBidderResponse) Reset() {
+	*x = QueryBidsByBidderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBidsByBidderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBidsByBidderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBidsByBidderResponse.ProtoReflect.Descriptor instead.
+func (*QueryBidsByBidderResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryBidsByBidderResponse) GetBid() []*Bid {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *QueryBidsByBidderResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllFailedTransitionRequest is request type for the Query/ListFailedTransition RPC method.
+type QueryAllFailedTransitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllFailedTransitionRequest) Reset() {
+	*x = QueryAllFailedTransitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllFailedTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllFailedTransitionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllFailedTransitionRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllFailedTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryAllFailedTransitionRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllFailedTransitionResponse is response type for the Query/ListFailedTransition RPC method.
+type QueryAllFailedTransitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// failed_transition specifies the auction state transitions that failed and wait for a retry
+	FailedTransition []*FailedTransition `protobuf:"bytes,1,rep,name=failed_transition,json=failedTransition,proto3" json:"failed_transition,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllFailedTransitionResponse) Reset() {
+	*x = QueryAllFailedTransitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllFailedTransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllFailedTransitionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllFailedTransitionResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllFailedTransitionResponse) Descriptor() ([]byte, []int) {
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryAllFailedTransitionResponse) GetFailedTransition() []*FailedTransition {
+	if x != nil {
+		return x.FailedTransition
+	}
+	return nil
+}
+
+func (x *QueryAllFailedTransitionResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryAllVestingQueueRequest is request type for the Query/Vestings RPC method.
 type QueryAllVestingQueueRequest struct {
 	state         protoimpl.MessageState
@@ -8488,7 +18666,7 @@ type QueryAllVestingQueueRequest struct {
 func (x *QueryAllVestingQueueRequest) Reset() {
 	*x = QueryAllVestingQueueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[14]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8502,7 +18680,7 @@ func (*QueryAllVestingQueueRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAllVestingQueueRequest.ProtoReflect.Descriptor instead.
 func (*QueryAllVestingQueueRequest) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryAllVestingQueueRequest) GetAuctionId() uint64 {
@@ -8533,7 +18711,7 @@ type QueryAllVestingQueueResponse struct {
 func (x *QueryAllVestingQueueResponse) Reset() {
 	*x = QueryAllVestingQueueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[15]
+		mi := &file_fundraising_fundraising_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8547,7 +18725,7 @@ func (*QueryAllVestingQueueResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAllVestingQueueResponse.ProtoReflect.Descriptor instead.
 func (*QueryAllVestingQueueResponse) Descriptor() ([]byte, []int) {
-	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_fundraising_fundraising_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryAllVestingQueueResponse) GetVestingQueue() []*VestingQueue {
@@ -8575,6 +18753,8 @@ var file_fundraising_fundraising_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f,
 	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64,
@@ -8582,255 +18762,556 @@ var file_fundraising_fundraising_v1_query_proto_rawDesc = []byte{
 	0x65, 0x64, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x28, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e,
 	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72,
 	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x27, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e,
-	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a,
-	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x79,
+	0x65, 0x72, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x32, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a,
+	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x75, 0x6e,
+	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86,
+	0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x6c, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0c, 0xca, 0xb4, 0x2d, 0x08, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x16, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x57, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0c, 0xca, 0xb4, 0x2d, 0x08, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x22, 0x3a, 0x0a,
+	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x1a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x6f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72,
+	0x22, 0x80, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x11, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x10, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75, 0x79, 0x65,
+	0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72,
+	0x22, 0xcf, 0x02, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x56,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x0d, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x65, 0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x62, 0x75, 0x79, 0x65, 0x72, 0x56, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x4b, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f,
+	0x69, 0x6e, 0x22, 0x6f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x72, 0x22, 0x93, 0x03, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a,
+	0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c,
+	0x65, 0x6e, 0x12, 0x59, 0x0a, 0x11, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a,
+	0x0c, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x70, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0xac, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x65, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x69,
+	0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0xb1,
+	0x01, 0x0a, 0x0c, 0x42, 0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x47, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x10, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0c, 0xca,
-	0xb4, 0x2d, 0x08, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x52, 0x07, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a,
-	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0c, 0xca, 0xb4, 0x2d, 0x08, 0x41, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x85, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x22, 0x77, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x22, 0x4a, 0x0a,
+	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x62, 0x69, 0x64, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x73, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97,
+	0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12,
+	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65,
-	0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x1c, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65,
-	0x72, 0x22, 0x77, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x69,
-	0x64, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x75, 0x6e,
-	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
-	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42,
-	0x69, 0x64, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x12, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x15, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x62, 0x69, 0x64, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x65, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x75, 0x6e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x69, 0x64, 0x73, 0x42, 0x79, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x9d, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x73, 0x42, 0x79, 0x42,
+	0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x75, 0x6e,
 	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
 	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69,
-	0x64, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x13,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
-	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
-	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x6c, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a,
-	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
-	0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x93, 0x0c, 0x0a, 0x05, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0xa0, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x2e, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x69, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x01, 0x0a, 0x20, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x75, 0x6e,
+	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xbb, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
 	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66, 0x75,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xd0,
+	0x1a, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xa0, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x2e, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xbd, 0x01, 0x0a, 0x0d,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x35, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x66, 0x75,
 	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
 	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c,
-	0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xb7, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xb7, 0x01, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
 	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
 	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0xcf, 0x01,
+	0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x35, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x12, 0x47, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x12,
+	0xd4, 0x01, 0x0a, 0x0c, 0x42, 0x75, 0x79, 0x65, 0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x34, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x51, 0x12, 0x4f, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69,
 	0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66,
 	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0xd9, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42,
-	0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x38, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x72, 0x7d, 0x12, 0xd9, 0x01, 0x0a, 0x10, 0x42, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
 	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x39, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x49, 0x12, 0x47, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64,
-	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0xe1, 0x01, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x7d, 0x12, 0xdb, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x39, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x12, 0xb7, 0x01, 0x0a, 0x07, 0x42, 0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x2f, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
+	0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x42, 0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x62, 0x69, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0xd9, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72,
 	0x12, 0x38, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
 	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64,
+	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64,
 	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x66, 0x75, 0x6e,
 	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
-	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
 	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x12, 0x47, 0x2f,
 	0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72,
 	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
 	0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x7d, 0x12,
-	0xb0, 0x01, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x2e, 0x2e, 0x66, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c,
-	0x6c, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x75,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0xe1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x38, 0x2e, 0x66, 0x75,
 	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c,
-	0x6c, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62,
-	0x69, 0x64, 0x12, 0xb8, 0x01, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x12, 0x2e, 0x2e,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
-	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x2f, 0x7b, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x7d, 0x12, 0xb0, 0x01, 0x0a, 0x07, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x2e, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12,
+	0x3c, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e,
+	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x69, 0x64, 0x12, 0xb8, 0x01,
+	0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x12, 0x2e, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x47, 0x12, 0x45, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x69, 0x64, 0x2f,
+	0x7b, 0x62, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x69, 0x64, 0x73, 0x42, 0x79, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x34, 0x2e,
 	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64,
 	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x12, 0x45, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f,
-	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x62, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xd0, 0x01,
-	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x12, 0x37, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
-	0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x66, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c,
-	0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x42, 0x86, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
-	0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
-	0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69,
-	0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
-	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c,
-	0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75, 0x6e,
-	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x42, 0x69, 0x64, 0x73, 0x42, 0x79, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x73, 0x42, 0x79, 0x42, 0x69, 0x64, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x39, 0x12, 0x37, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x2f, 0x7b,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x62, 0x69, 0x64, 0x12, 0xd0, 0x01, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3c, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e,
+	0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xd0,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x37, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41,
+	0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x42, 0x86, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
+	0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -8845,70 +19326,131 @@ func file_fundraising_fundraising_v1_query_proto_rawDescGZIP() []byte {
 	return file_fundraising_fundraising_v1_query_proto_rawDescData
 }
 
-var file_fundraising_fundraising_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_fundraising_fundraising_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_fundraising_fundraising_v1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),            // 0: fundraising.fundraising.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),           // 1: fundraising.fundraising.v1.QueryParamsResponse
-	(*QueryAllAuctionRequest)(nil),        // 2: fundraising.fundraising.v1.QueryAllAuctionRequest
-	(*QueryAllAuctionResponse)(nil),       // 3: fundraising.fundraising.v1.QueryAllAuctionResponse
-	(*QueryGetAuctionRequest)(nil),        // 4: fundraising.fundraising.v1.QueryGetAuctionRequest
-	(*QueryGetAuctionResponse)(nil),       // 5: fundraising.fundraising.v1.QueryGetAuctionResponse
-	(*QueryAllAllowedBidderRequest)(nil),  // 6: fundraising.fundraising.v1.QueryAllAllowedBidderRequest
-	(*QueryAllAllowedBidderResponse)(nil), // 7: fundraising.fundraising.v1.QueryAllAllowedBidderResponse
-	(*QueryGetAllowedBidderRequest)(nil),  // 8: fundraising.fundraising.v1.QueryGetAllowedBidderRequest
-	(*QueryGetAllowedBidderResponse)(nil), // 9: fundraising.fundraising.v1.QueryGetAllowedBidderResponse
-	(*QueryGetBidRequest)(nil),            // 10: fundraising.fundraising.v1.QueryGetBidRequest
-	(*QueryGetBidResponse)(nil),           // 11: fundraising.fundraising.v1.QueryGetBidResponse
-	(*QueryAllBidRequest)(nil),            // 12: fundraising.fundraising.v1.QueryAllBidRequest
-	(*QueryAllBidResponse)(nil),           // 13: fundraising.fundraising.v1.QueryAllBidResponse
-	(*QueryAllVestingQueueRequest)(nil),   // 14: fundraising.fundraising.v1.QueryAllVestingQueueRequest
-	(*QueryAllVestingQueueResponse)(nil),  // 15: fundraising.fundraising.v1.QueryAllVestingQueueResponse
-	(*Params)(nil),                        // 16: fundraising.fundraising.v1.Params
-	(*v1beta1.PageRequest)(nil),           // 17: cosmos.base.query.v1beta1.PageRequest
-	(*anypb.Any)(nil),                     // 18: google.protobuf.Any
-	(*v1beta1.PageResponse)(nil),          // 19: cosmos.base.query.v1beta1.PageResponse
-	(*AllowedBidder)(nil),                 // 20: fundraising.fundraising.v1.AllowedBidder
-	(*Bid)(nil),                           // 21: fundraising.fundraising.v1.Bid
-	(*VestingQueue)(nil),                  // 22: fundraising.fundraising.v1.VestingQueue
+	(*QueryParamsRequest)(nil),               // 0: fundraising.fundraising.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),              // 1: fundraising.fundraising.v1.QueryParamsResponse
+	(*QueryAllowedDenomsRequest)(nil),        // 2: fundraising.fundraising.v1.QueryAllowedDenomsRequest
+	(*QueryAllowedDenomsResponse)(nil),       // 3: fundraising.fundraising.v1.QueryAllowedDenomsResponse
+	(*QueryAllAuctionRequest)(nil),           // 4: fundraising.fundraising.v1.QueryAllAuctionRequest
+	(*QueryAllAuctionResponse)(nil),          // 5: fundraising.fundraising.v1.QueryAllAuctionResponse
+	(*QueryGetAuctionRequest)(nil),           // 6: fundraising.fundraising.v1.QueryGetAuctionRequest
+	(*QueryGetAuctionResponse)(nil),          // 7: fundraising.fundraising.v1.QueryGetAuctionResponse
+	(*QueryCollectedFeesRequest)(nil),        // 8: fundraising.fundraising.v1.QueryCollectedFeesRequest
+	(*QueryCollectedFeesResponse)(nil),       // 9: fundraising.fundraising.v1.QueryCollectedFeesResponse
+	(*QueryAuctionResultRequest)(nil),        // 10: fundraising.fundraising.v1.QueryAuctionResultRequest
+	(*QueryAuctionResultResponse)(nil),       // 11: fundraising.fundraising.v1.QueryAuctionResultResponse
+	(*QueryBidderAllocationRequest)(nil),     // 12: fundraising.fundraising.v1.QueryBidderAllocationRequest
+	(*QueryBidderAllocationResponse)(nil),    // 13: fundraising.fundraising.v1.QueryBidderAllocationResponse
+	(*QueryBuyerVestingRequest)(nil),         // 14: fundraising.fundraising.v1.QueryBuyerVestingRequest
+	(*QueryBuyerVestingResponse)(nil),        // 15: fundraising.fundraising.v1.QueryBuyerVestingResponse
+	(*QuerySimulateMatchingRequest)(nil),     // 16: fundraising.fundraising.v1.QuerySimulateMatchingRequest
+	(*QuerySimulateMatchingResponse)(nil),    // 17: fundraising.fundraising.v1.QuerySimulateMatchingResponse
+	(*QueryBidBookRequest)(nil),              // 18: fundraising.fundraising.v1.QueryBidBookRequest
+	(*QueryBidBookResponse)(nil),             // 19: fundraising.fundraising.v1.QueryBidBookResponse
+	(*BidBookLevel)(nil),                     // 20: fundraising.fundraising.v1.BidBookLevel
+	(*QueryAllAllowedBidderRequest)(nil),     // 21: fundraising.fundraising.v1.QueryAllAllowedBidderRequest
+	(*QueryAllAllowedBidderResponse)(nil),    // 22: fundraising.fundraising.v1.QueryAllAllowedBidderResponse
+	(*QueryGetAllowedBidderRequest)(nil),     // 23: fundraising.fundraising.v1.QueryGetAllowedBidderRequest
+	(*QueryGetAllowedBidderResponse)(nil),    // 24: fundraising.fundraising.v1.QueryGetAllowedBidderResponse
+	(*QueryGetBidRequest)(nil),               // 25: fundraising.fundraising.v1.QueryGetBidRequest
+	(*QueryGetBidResponse)(nil),              // 26: fundraising.fundraising.v1.QueryGetBidResponse
+	(*QueryAllBidRequest)(nil),               // 27: fundraising.fundraising.v1.QueryAllBidRequest
+	(*QueryAllBidResponse)(nil),              // 28: fundraising.fundraising.v1.QueryAllBidResponse
+	(*QueryBidsByBidderRequest)(nil),         // 29: fundraising.fundraising.v1.QueryBidsByBidderRequest
+	(*QueryBidsByBidderResponse)(nil),        // 30: fundraising.fundraising.v1.QueryBidsByBidderResponse
+	(*QueryAllFailedTransitionRequest)(nil),  // 31: fundraising.fundraising.v1.QueryAllFailedTransitionRequest
+	(*QueryAllFailedTransitionResponse)(nil), // 32: fundraising.fundraising.v1.QueryAllFailedTransitionResponse
+	(*QueryAllVestingQueueRequest)(nil),      // 33: fundraising.fundraising.v1.QueryAllVestingQueueRequest
+	(*QueryAllVestingQueueResponse)(nil),     // 34: fundraising.fundraising.v1.QueryAllVestingQueueResponse
+	(*Params)(nil),                           // 35: fundraising.fundraising.v1.Params
+	(*v1beta1.PageRequest)(nil),              // 36: cosmos.base.query.v1beta1.PageRequest
+	(*anypb.Any)(nil),                        // 37: google.protobuf.Any
+	(*v1beta1.PageResponse)(nil),             // 38: cosmos.base.query.v1beta1.PageResponse
+	(*CollectedFees)(nil),                    // 39: fundraising.fundraising.v1.CollectedFees
+	(*AuctionResult)(nil),                    // 40: fundraising.fundraising.v1.AuctionResult
+	(*BidderAllocation)(nil),                 // 41: fundraising.fundraising.v1.BidderAllocation
+	(*BuyerVesting)(nil),                     // 42: fundraising.fundraising.v1.BuyerVesting
+	(*v1beta11.Coin)(nil),                    // 43: cosmos.base.v1beta1.Coin
+	(PricingMode)(0),                         // 44: fundraising.fundraising.v1.PricingMode
+	(*AllowedBidder)(nil),                    // 45: fundraising.fundraising.v1.AllowedBidder
+	(*Bid)(nil),                              // 46: fundraising.fundraising.v1.Bid
+	(*FailedTransition)(nil),                 // 47: fundraising.fundraising.v1.FailedTransition
+	(*VestingQueue)(nil),                     // 48: fundraising.fundraising.v1.VestingQueue
 }
 var file_fundraising_fundraising_v1_query_proto_depIdxs = []int32{
-	16, // 0: fundraising.fundraising.v1.QueryParamsResponse.params:type_name -> fundraising.fundraising.v1.Params
-	17, // 1: fundraising.fundraising.v1.QueryAllAuctionRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 2: fundraising.fundraising.v1.QueryAllAuctionResponse.auction:type_name -> google.protobuf.Any
-	19, // 3: fundraising.fundraising.v1.QueryAllAuctionResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	18, // 4: fundraising.fundraising.v1.QueryGetAuctionResponse.auction:type_name -> google.protobuf.Any
-	17, // 5: fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	20, // 6: fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder:type_name -> fundraising.fundraising.v1.AllowedBidder
-	19, // 7: fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	20, // 8: fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder:type_name -> fundraising.fundraising.v1.AllowedBidder
-	21, // 9: fundraising.fundraising.v1.QueryGetBidResponse.bid:type_name -> fundraising.fundraising.v1.Bid
-	17, // 10: fundraising.fundraising.v1.QueryAllBidRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	21, // 11: fundraising.fundraising.v1.QueryAllBidResponse.bid:type_name -> fundraising.fundraising.v1.Bid
-	19, // 12: fundraising.fundraising.v1.QueryAllBidResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	17, // 13: fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	22, // 14: fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue:type_name -> fundraising.fundraising.v1.VestingQueue
-	19, // 15: fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 16: fundraising.fundraising.v1.Query.Params:input_type -> fundraising.fundraising.v1.QueryParamsRequest
-	2,  // 17: fundraising.fundraising.v1.Query.ListAuction:input_type -> fundraising.fundraising.v1.QueryAllAuctionRequest
-	4,  // 18: fundraising.fundraising.v1.Query.GetAuction:input_type -> fundraising.fundraising.v1.QueryGetAuctionRequest
-	6,  // 19: fundraising.fundraising.v1.Query.ListAllowedBidder:input_type -> fundraising.fundraising.v1.QueryAllAllowedBidderRequest
-	8,  // 20: fundraising.fundraising.v1.Query.GetAllowedBidder:input_type -> fundraising.fundraising.v1.QueryGetAllowedBidderRequest
-	12, // 21: fundraising.fundraising.v1.Query.ListBid:input_type -> fundraising.fundraising.v1.QueryAllBidRequest
-	10, // 22: fundraising.fundraising.v1.Query.GetBid:input_type -> fundraising.fundraising.v1.QueryGetBidRequest
-	14, // 23: fundraising.fundraising.v1.Query.ListVestingQueue:input_type -> fundraising.fundraising.v1.QueryAllVestingQueueRequest
-	1,  // 24: fundraising.fundraising.v1.Query.Params:output_type -> fundraising.fundraising.v1.QueryParamsResponse
-	3,  // 25: fundraising.fundraising.v1.Query.ListAuction:output_type -> fundraising.fundraising.v1.QueryAllAuctionResponse
-	5,  // 26: fundraising.fundraising.v1.Query.GetAuction:output_type -> fundraising.fundraising.v1.QueryGetAuctionResponse
-	7,  // 27: fundraising.fundraising.v1.Query.ListAllowedBidder:output_type -> fundraising.fundraising.v1.QueryAllAllowedBidderResponse
-	9,  // 28: fundraising.fundraising.v1.Query.GetAllowedBidder:output_type -> fundraising.fundraising.v1.QueryGetAllowedBidderResponse
-	13, // 29: fundraising.fundraising.v1.Query.ListBid:output_type -> fundraising.fundraising.v1.QueryAllBidResponse
-	11, // 30: fundraising.fundraising.v1.Query.GetBid:output_type -> fundraising.fundraising.v1.QueryGetBidResponse
-	15, // 31: fundraising.fundraising.v1.Query.ListVestingQueue:output_type -> fundraising.fundraising.v1.QueryAllVestingQueueResponse
-	24, // [24:32] is the sub-list for method output_type
-	16, // [16:24] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	35, // 0: fundraising.fundraising.v1.QueryParamsResponse.params:type_name -> fundraising.fundraising.v1.Params
+	36, // 1: fundraising.fundraising.v1.QueryAllAuctionRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 2: fundraising.fundraising.v1.QueryAllAuctionResponse.auction:type_name -> google.protobuf.Any
+	38, // 3: fundraising.fundraising.v1.QueryAllAuctionResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	37, // 4: fundraising.fundraising.v1.QueryGetAuctionResponse.auction:type_name -> google.protobuf.Any
+	39, // 5: fundraising.fundraising.v1.QueryCollectedFeesResponse.collected_fees:type_name -> fundraising.fundraising.v1.CollectedFees
+	40, // 6: fundraising.fundraising.v1.QueryAuctionResultResponse.auction_result:type_name -> fundraising.fundraising.v1.AuctionResult
+	41, // 7: fundraising.fundraising.v1.QueryBidderAllocationResponse.bidder_allocation:type_name -> fundraising.fundraising.v1.BidderAllocation
+	42, // 8: fundraising.fundraising.v1.QueryBuyerVestingResponse.buyer_vesting:type_name -> fundraising.fundraising.v1.BuyerVesting
+	43, // 9: fundraising.fundraising.v1.QueryBuyerVestingResponse.locked_coin:type_name -> cosmos.base.v1beta1.Coin
+	43, // 10: fundraising.fundraising.v1.QueryBuyerVestingResponse.unlocked_coin:type_name -> cosmos.base.v1beta1.Coin
+	43, // 11: fundraising.fundraising.v1.QueryBuyerVestingResponse.claimable_coin:type_name -> cosmos.base.v1beta1.Coin
+	41, // 12: fundraising.fundraising.v1.QuerySimulateMatchingResponse.bidder_allocation:type_name -> fundraising.fundraising.v1.BidderAllocation
+	44, // 13: fundraising.fundraising.v1.QuerySimulateMatchingResponse.pricing_mode:type_name -> fundraising.fundraising.v1.PricingMode
+	43, // 14: fundraising.fundraising.v1.QueryBidBookResponse.selling_coin:type_name -> cosmos.base.v1beta1.Coin
+	20, // 15: fundraising.fundraising.v1.QueryBidBookResponse.levels:type_name -> fundraising.fundraising.v1.BidBookLevel
+	36, // 16: fundraising.fundraising.v1.QueryAllAllowedBidderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	45, // 17: fundraising.fundraising.v1.QueryAllAllowedBidderResponse.allowed_bidder:type_name -> fundraising.fundraising.v1.AllowedBidder
+	38, // 18: fundraising.fundraising.v1.QueryAllAllowedBidderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	45, // 19: fundraising.fundraising.v1.QueryGetAllowedBidderResponse.allowed_bidder:type_name -> fundraising.fundraising.v1.AllowedBidder
+	46, // 20: fundraising.fundraising.v1.QueryGetBidResponse.bid:type_name -> fundraising.fundraising.v1.Bid
+	36, // 21: fundraising.fundraising.v1.QueryAllBidRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	46, // 22: fundraising.fundraising.v1.QueryAllBidResponse.bid:type_name -> fundraising.fundraising.v1.Bid
+	38, // 23: fundraising.fundraising.v1.QueryAllBidResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	36, // 24: fundraising.fundraising.v1.QueryBidsByBidderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	46, // 25: fundraising.fundraising.v1.QueryBidsByBidderResponse.bid:type_name -> fundraising.fundraising.v1.Bid
+	38, // 26: fundraising.fundraising.v1.QueryBidsByBidderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	36, // 27: fundraising.fundraising.v1.QueryAllFailedTransitionRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	47, // 28: fundraising.fundraising.v1.QueryAllFailedTransitionResponse.failed_transition:type_name -> fundraising.fundraising.v1.FailedTransition
+	38, // 29: fundraising.fundraising.v1.QueryAllFailedTransitionResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	36, // 30: fundraising.fundraising.v1.QueryAllVestingQueueRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	48, // 31: fundraising.fundraising.v1.QueryAllVestingQueueResponse.vestingQueue:type_name -> fundraising.fundraising.v1.VestingQueue
+	38, // 32: fundraising.fundraising.v1.QueryAllVestingQueueResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 33: fundraising.fundraising.v1.Query.Params:input_type -> fundraising.fundraising.v1.QueryParamsRequest
+	2,  // 34: fundraising.fundraising.v1.Query.AllowedDenoms:input_type -> fundraising.fundraising.v1.QueryAllowedDenomsRequest
+	4,  // 35: fundraising.fundraising.v1.Query.ListAuction:input_type -> fundraising.fundraising.v1.QueryAllAuctionRequest
+	6,  // 36: fundraising.fundraising.v1.Query.GetAuction:input_type -> fundraising.fundraising.v1.QueryGetAuctionRequest
+	10, // 37: fundraising.fundraising.v1.Query.AuctionResult:input_type -> fundraising.fundraising.v1.QueryAuctionResultRequest
+	8,  // 38: fundraising.fundraising.v1.Query.CollectedFees:input_type -> fundraising.fundraising.v1.QueryCollectedFeesRequest
+	14, // 39: fundraising.fundraising.v1.Query.BuyerVesting:input_type -> fundraising.fundraising.v1.QueryBuyerVestingRequest
+	12, // 40: fundraising.fundraising.v1.Query.BidderAllocation:input_type -> fundraising.fundraising.v1.QueryBidderAllocationRequest
+	16, // 41: fundraising.fundraising.v1.Query.SimulateMatching:input_type -> fundraising.fundraising.v1.QuerySimulateMatchingRequest
+	18, // 42: fundraising.fundraising.v1.Query.BidBook:input_type -> fundraising.fundraising.v1.QueryBidBookRequest
+	21, // 43: fundraising.fundraising.v1.Query.ListAllowedBidder:input_type -> fundraising.fundraising.v1.QueryAllAllowedBidderRequest
+	23, // 44: fundraising.fundraising.v1.Query.GetAllowedBidder:input_type -> fundraising.fundraising.v1.QueryGetAllowedBidderRequest
+	27, // 45: fundraising.fundraising.v1.Query.ListBid:input_type -> fundraising.fundraising.v1.QueryAllBidRequest
+	25, // 46: fundraising.fundraising.v1.Query.GetBid:input_type -> fundraising.fundraising.v1.QueryGetBidRequest
+	29, // 47: fundraising.fundraising.v1.Query.ListBidsByBidder:input_type -> fundraising.fundraising.v1.QueryBidsByBidderRequest
+	31, // 48: fundraising.fundraising.v1.Query.ListFailedTransition:input_type -> fundraising.fundraising.v1.QueryAllFailedTransitionRequest
+	33, // 49: fundraising.fundraising.v1.Query.ListVestingQueue:input_type -> fundraising.fundraising.v1.QueryAllVestingQueueRequest
+	1,  // 50: fundraising.fundraising.v1.Query.Params:output_type -> fundraising.fundraising.v1.QueryParamsResponse
+	3,  // 51: fundraising.fundraising.v1.Query.AllowedDenoms:output_type -> fundraising.fundraising.v1.QueryAllowedDenomsResponse
+	5,  // 52: fundraising.fundraising.v1.Query.ListAuction:output_type -> fundraising.fundraising.v1.QueryAllAuctionResponse
+	7,  // 53: fundraising.fundraising.v1.Query.GetAuction:output_type -> fundraising.fundraising.v1.QueryGetAuctionResponse
+	11, // 54: fundraising.fundraising.v1.Query.AuctionResult:output_type -> fundraising.fundraising.v1.QueryAuctionResultResponse
+	9,  // 55: fundraising.fundraising.v1.Query.CollectedFees:output_type -> fundraising.fundraising.v1.QueryCollectedFeesResponse
+	15, // 56: fundraising.fundraising.v1.Query.BuyerVesting:output_type -> fundraising.fundraising.v1.QueryBuyerVestingResponse
+	13, // 57: fundraising.fundraising.v1.Query.BidderAllocation:output_type -> fundraising.fundraising.v1.QueryBidderAllocationResponse
+	17, // 58: fundraising.fundraising.v1.Query.SimulateMatching:output_type -> fundraising.fundraising.v1.QuerySimulateMatchingResponse
+	19, // 59: fundraising.fundraising.v1.Query.BidBook:output_type -> fundraising.fundraising.v1.QueryBidBookResponse
+	22, // 60: fundraising.fundraising.v1.Query.ListAllowedBidder:output_type -> fundraising.fundraising.v1.QueryAllAllowedBidderResponse
+	24, // 61: fundraising.fundraising.v1.Query.GetAllowedBidder:output_type -> fundraising.fundraising.v1.QueryGetAllowedBidderResponse
+	28, // 62: fundraising.fundraising.v1.Query.ListBid:output_type -> fundraising.fundraising.v1.QueryAllBidResponse
+	26, // 63: fundraising.fundraising.v1.Query.GetBid:output_type -> fundraising.fundraising.v1.QueryGetBidResponse
+	30, // 64: fundraising.fundraising.v1.Query.ListBidsByBidder:output_type -> fundraising.fundraising.v1.QueryBidsByBidderResponse
+	32, // 65: fundraising.fundraising.v1.Query.ListFailedTransition:output_type -> fundraising.fundraising.v1.QueryAllFailedTransitionResponse
+	34, // 66: fundraising.fundraising.v1.Query.ListVestingQueue:output_type -> fundraising.fundraising.v1.QueryAllVestingQueueResponse
+	50, // [50:67] is the sub-list for method output_type
+	33, // [33:50] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_fundraising_fundraising_v1_query_proto_init() }
@@ -8918,7 +19460,11 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 	}
 	file_fundraising_fundraising_v1_allowed_bidder_proto_init()
 	file_fundraising_fundraising_v1_auction_proto_init()
+	file_fundraising_fundraising_v1_auction_result_proto_init()
 	file_fundraising_fundraising_v1_bid_proto_init()
+	file_fundraising_fundraising_v1_buyer_vesting_proto_init()
+	file_fundraising_fundraising_v1_collected_fees_proto_init()
+	file_fundraising_fundraising_v1_failed_transition_proto_init()
 	file_fundraising_fundraising_v1_params_proto_init()
 	file_fundraising_fundraising_v1_vesting_queue_proto_init()
 	if !protoimpl.UnsafeEnabled {
@@ -8947,7 +19493,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllAuctionRequest); i {
+			switch v := v.(*QueryAllowedDenomsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8959,7 +19505,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllAuctionResponse); i {
+			switch v := v.(*QueryAllowedDenomsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8971,7 +19517,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAuctionRequest); i {
+			switch v := v.(*QueryAllAuctionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8983,7 +19529,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAuctionResponse); i {
+			switch v := v.(*QueryAllAuctionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8995,7 +19541,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllAllowedBidderRequest); i {
+			switch v := v.(*QueryGetAuctionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9007,7 +19553,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllAllowedBidderResponse); i {
+			switch v := v.(*QueryGetAuctionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9019,7 +19565,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAllowedBidderRequest); i {
+			switch v := v.(*QueryCollectedFeesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9031,7 +19577,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAllowedBidderResponse); i {
+			switch v := v.(*QueryCollectedFeesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9043,7 +19589,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetBidRequest); i {
+			switch v := v.(*QueryAuctionResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9055,7 +19601,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetBidResponse); i {
+			switch v := v.(*QueryAuctionResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9067,7 +19613,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllBidRequest); i {
+			switch v := v.(*QueryBidderAllocationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9079,7 +19625,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllBidResponse); i {
+			switch v := v.(*QueryBidderAllocationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9091,7 +19637,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllVestingQueueRequest); i {
+			switch v := v.(*QueryBuyerVestingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9103,6 +19649,234 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			}
 		}
 		file_fundraising_fundraising_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBuyerVestingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateMatchingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateMatchingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBidBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBidBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidBookLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllAllowedBidderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllAllowedBidderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAllowedBidderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAllowedBidderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetBidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetBidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllBidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllBidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBidsByBidderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBidsByBidderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllFailedTransitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllFailedTransitionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllVestingQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fundraising_fundraising_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryAllVestingQueueResponse); i {
 			case 0:
 				return &v.state
@@ -9121,7 +19895,7 @@ func file_fundraising_fundraising_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fundraising_fundraising_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName            = "/fundraising.fundraising.v1.Query/Params"
-	Query_ListAuction_FullMethodName       = "/fundraising.fundraising.v1.Query/ListAuction"
-	Query_GetAuction_FullMethodName        = "/fundraising.fundraising.v1.Query/GetAuction"
-	Query_ListAllowedBidder_FullMethodName = "/fundraising.fundraising.v1.Query/ListAllowedBidder"
-	Query_GetAllowedBidder_FullMethodName  = "/fundraising.fundraising.v1.Query/GetAllowedBidder"
-	Query_ListBid_FullMethodName           = "/fundraising.fundraising.v1.Query/ListBid"
-	Query_GetBid_FullMethodName            = "/fundraising.fundraising.v1.Query/GetBid"
-	Query_ListVestingQueue_FullMethodName  = "/fundraising.fundraising.v1.Query/ListVestingQueue"
+	Query_Params_FullMethodName               = "/fundraising.fundraising.v1.Query/Params"
+	Query_AllowedDenoms_FullMethodName        = "/fundraising.fundraising.v1.Query/AllowedDenoms"
+	Query_ListAuction_FullMethodName          = "/fundraising.fundraising.v1.Query/ListAuction"
+	Query_GetAuction_FullMethodName           = "/fundraising.fundraising.v1.Query/GetAuction"
+	Query_AuctionResult_FullMethodName        = "/fundraising.fundraising.v1.Query/AuctionResult"
+	Query_CollectedFees_FullMethodName        = "/fundraising.fundraising.v1.Query/CollectedFees"
+	Query_BuyerVesting_FullMethodName         = "/fundraising.fundraising.v1.Query/BuyerVesting"
+	Query_BidderAllocation_FullMethodName     = "/fundraising.fundraising.v1.Query/BidderAllocation"
+	Query_SimulateMatching_FullMethodName     = "/fundraising.fundraising.v1.Query/SimulateMatching"
+	Query_BidBook_FullMethodName              = "/fundraising.fundraising.v1.Query/BidBook"
+	Query_ListAllowedBidder_FullMethodName    = "/fundraising.fundraising.v1.Query/ListAllowedBidder"
+	Query_GetAllowedBidder_FullMethodName     = "/fundraising.fundraising.v1.Query/GetAllowedBidder"
+	Query_ListBid_FullMethodName              = "/fundraising.fundraising.v1.Query/ListBid"
+	Query_GetBid_FullMethodName               = "/fundraising.fundraising.v1.Query/GetBid"
+	Query_ListBidsByBidder_FullMethodName     = "/fundraising.fundraising.v1.Query/ListBidsByBidder"
+	Query_ListFailedTransition_FullMethodName = "/fundraising.fundraising.v1.Query/ListFailedTransition"
+	Query_ListVestingQueue_FullMethodName     = "/fundraising.fundraising.v1.Query/ListVestingQueue"
 )
 
 // QueryClient is the client API for Query service.
@@ -35,15 +44,35 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllowedDenoms queries the paying denoms allowed and the selling denoms
+	// blocked by the parameters of the module.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
 	// Queries a list of Auction items.
 	ListAuction(ctx context.Context, in *QueryAllAuctionRequest, opts ...grpc.CallOption) (*QueryAllAuctionResponse, error)
 	GetAuction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error)
+	// Queries the final matching result of a closed auction.
+	AuctionResult(ctx context.Context, in *QueryAuctionResultRequest, opts ...grpc.CallOption) (*QueryAuctionResultResponse, error)
+	// Queries the fees collected for an auction.
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
+	// Queries the locked and unlocked selling coin of a bidder by the buyer
+	// vesting schedules of an auction.
+	BuyerVesting(ctx context.Context, in *QueryBuyerVestingRequest, opts ...grpc.CallOption) (*QueryBuyerVestingResponse, error)
+	// Queries the settlement of a bidder in a closed auction.
+	BidderAllocation(ctx context.Context, in *QueryBidderAllocationRequest, opts ...grpc.CallOption) (*QueryBidderAllocationResponse, error)
+	// Simulates the matching of a running batch auction with the bids placed so far.
+	SimulateMatching(ctx context.Context, in *QuerySimulateMatchingRequest, opts ...grpc.CallOption) (*QuerySimulateMatchingResponse, error)
+	// Queries the cumulative demand curve of a batch auction.
+	BidBook(ctx context.Context, in *QueryBidBookRequest, opts ...grpc.CallOption) (*QueryBidBookResponse, error)
 	// Queries a list of AllowedBidder items.
 	ListAllowedBidder(ctx context.Context, in *QueryAllAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllAllowedBidderResponse, error)
 	GetAllowedBidder(ctx context.Context, in *QueryGetAllowedBidderRequest, opts ...grpc.CallOption) (*QueryGetAllowedBidderResponse, error)
 	// Queries a list of Bid items.
 	ListBid(ctx context.Context, in *QueryAllBidRequest, opts ...grpc.CallOption) (*QueryAllBidResponse, error)
 	GetBid(ctx context.Context, in *QueryGetBidRequest, opts ...grpc.CallOption) (*QueryGetBidResponse, error)
+	// Queries a list of Bid items placed by the bidder.
+	ListBidsByBidder(ctx context.Context, in *QueryBidsByBidderRequest, opts ...grpc.CallOption) (*QueryBidsByBidderResponse, error)
+	// Queries a list of FailedTransition items.
+	ListFailedTransition(ctx context.Context, in *QueryAllFailedTransitionRequest, opts ...grpc.CallOption) (*QueryAllFailedTransitionResponse, error)
 	// Queries a list of VestingQueue items.
 	ListVestingQueue(ctx context.Context, in *QueryAllVestingQueueRequest, opts ...grpc.CallOption) (*QueryAllVestingQueueResponse, error)
 }
@@ -65,6 +94,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListAuction(ctx context.Context, in *QueryAllAuctionRequest, opts ...grpc.CallOption) (*QueryAllAuctionResponse, error) {
 	out := new(QueryAllAuctionResponse)
 	err := c.cc.Invoke(ctx, Query_ListAuction_FullMethodName, in, out, opts...)
@@ -83,6 +121,60 @@ func (c *queryClient) GetAuction(ctx context.Context, in *QueryGetAuctionRequest
 	return out, nil
 }
 
+func (c *queryClient) AuctionResult(ctx context.Context, in *QueryAuctionResultRequest, opts ...grpc.CallOption) (*QueryAuctionResultResponse, error) {
+	out := new(QueryAuctionResultResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_CollectedFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyerVesting(ctx context.Context, in *QueryBuyerVestingRequest, opts ...grpc.CallOption) (*QueryBuyerVestingResponse, error) {
+	out := new(QueryBuyerVestingResponse)
+	err := c.cc.Invoke(ctx, Query_BuyerVesting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderAllocation(ctx context.Context, in *QueryBidderAllocationRequest, opts ...grpc.CallOption) (*QueryBidderAllocationResponse, error) {
+	out := new(QueryBidderAllocationResponse)
+	err := c.cc.Invoke(ctx, Query_BidderAllocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateMatching(ctx context.Context, in *QuerySimulateMatchingRequest, opts ...grpc.CallOption) (*QuerySimulateMatchingResponse, error) {
+	out := new(QuerySimulateMatchingResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateMatching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidBook(ctx context.Context, in *QueryBidBookRequest, opts ...grpc.CallOption) (*QueryBidBookResponse, error) {
+	out := new(QueryBidBookResponse)
+	err := c.cc.Invoke(ctx, Query_BidBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListAllowedBidder(ctx context.Context, in *QueryAllAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllAllowedBidderResponse, error) {
 	out := new(QueryAllAllowedBidderResponse)
 	err := c.cc.Invoke(ctx, Query_ListAllowedBidder_FullMethodName, in, out, opts...)
@@ -119,6 +211,24 @@ func (c *queryClient) GetBid(ctx context.Context, in *QueryGetBidRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ListBidsByBidder(ctx context.Context, in *QueryBidsByBidderRequest, opts ...grpc.CallOption) (*QueryBidsByBidderResponse, error) {
+	out := new(QueryBidsByBidderResponse)
+	err := c.cc.Invoke(ctx, Query_ListBidsByBidder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListFailedTransition(ctx context.Context, in *QueryAllFailedTransitionRequest, opts ...grpc.CallOption) (*QueryAllFailedTransitionResponse, error) {
+	out := new(QueryAllFailedTransitionResponse)
+	err := c.cc.Invoke(ctx, Query_ListFailedTransition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListVestingQueue(ctx context.Context, in *QueryAllVestingQueueRequest, opts ...grpc.CallOption) (*QueryAllVestingQueueResponse, error) {
 	out := new(QueryAllVestingQueueResponse)
 	err := c.cc.Invoke(ctx, Query_ListVestingQueue_FullMethodName, in, out, opts...)
@@ -134,15 +244,35 @@ func (c *queryClient) ListVestingQueue(ctx context.Context, in *QueryAllVestingQ
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllowedDenoms queries the paying denoms allowed and the selling denoms
+	// blocked by the parameters of the module.
+	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
 	// Queries a list of Auction items.
 	ListAuction(context.Context, *QueryAllAuctionRequest) (*QueryAllAuctionResponse, error)
 	GetAuction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error)
+	// Queries the final matching result of a closed auction.
+	AuctionResult(context.Context, *QueryAuctionResultRequest) (*QueryAuctionResultResponse, error)
+	// Queries the fees collected for an auction.
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
+	// Queries the locked and unlocked selling coin of a bidder by the buyer
+	// vesting schedules of an auction.
+	BuyerVesting(context.Context, *QueryBuyerVestingRequest) (*QueryBuyerVestingResponse, error)
+	// Queries the settlement of a bidder in a closed auction.
+	BidderAllocation(context.Context, *QueryBidderAllocationRequest) (*QueryBidderAllocationResponse, error)
+	// Simulates the matching of a running batch auction with the bids placed so far.
+	SimulateMatching(context.Context, *QuerySimulateMatchingRequest) (*QuerySimulateMatchingResponse, error)
+	// Queries the cumulative demand curve of a batch auction.
+	BidBook(context.Context, *QueryBidBookRequest) (*QueryBidBookResponse, error)
 	// Queries a list of AllowedBidder items.
 	ListAllowedBidder(context.Context, *QueryAllAllowedBidderRequest) (*QueryAllAllowedBidderResponse, error)
 	GetAllowedBidder(context.Context, *QueryGetAllowedBidderRequest) (*QueryGetAllowedBidderResponse, error)
 	// Queries a list of Bid items.
 	ListBid(context.Context, *QueryAllBidRequest) (*QueryAllBidResponse, error)
 	GetBid(context.Context, *QueryGetBidRequest) (*QueryGetBidResponse, error)
+	// Queries a list of Bid items placed by the bidder.
+	ListBidsByBidder(context.Context, *QueryBidsByBidderRequest) (*QueryBidsByBidderResponse, error)
+	// Queries a list of FailedTransition items.
+	ListFailedTransition(context.Context, *QueryAllFailedTransitionRequest) (*QueryAllFailedTransitionResponse, error)
 	// Queries a list of VestingQueue items.
 	ListVestingQueue(context.Context, *QueryAllVestingQueueRequest) (*QueryAllVestingQueueResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -155,12 +285,33 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
 func (UnimplementedQueryServer) ListAuction(context.Context, *QueryAllAuctionRequest) (*QueryAllAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAuction not implemented")
 }
 func (UnimplementedQueryServer) GetAuction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuction not implemented")
 }
+func (UnimplementedQueryServer) AuctionResult(context.Context, *QueryAuctionResultRequest) (*QueryAuctionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionResult not implemented")
+}
+func (UnimplementedQueryServer) CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
+func (UnimplementedQueryServer) BuyerVesting(context.Context, *QueryBuyerVestingRequest) (*QueryBuyerVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyerVesting not implemented")
+}
+func (UnimplementedQueryServer) BidderAllocation(context.Context, *QueryBidderAllocationRequest) (*QueryBidderAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAllocation not implemented")
+}
+func (UnimplementedQueryServer) SimulateMatching(context.Context, *QuerySimulateMatchingRequest) (*QuerySimulateMatchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMatching not implemented")
+}
+func (UnimplementedQueryServer) BidBook(context.Context, *QueryBidBookRequest) (*QueryBidBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidBook not implemented")
+}
 func (UnimplementedQueryServer) ListAllowedBidder(context.Context, *QueryAllAllowedBidderRequest) (*QueryAllAllowedBidderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedBidder not implemented")
 }
@@ -173,6 +324,12 @@ func (UnimplementedQueryServer) ListBid(context.Context, *QueryAllBidRequest) (*
 func (UnimplementedQueryServer) GetBid(context.Context, *QueryGetBidRequest) (*QueryGetBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBid not implemented")
 }
+func (UnimplementedQueryServer) ListBidsByBidder(context.Context, *QueryBidsByBidderRequest) (*QueryBidsByBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidsByBidder not implemented")
+}
+func (UnimplementedQueryServer) ListFailedTransition(context.Context, *QueryAllFailedTransitionRequest) (*QueryAllFailedTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedTransition not implemented")
+}
 func (UnimplementedQueryServer) ListVestingQueue(context.Context, *QueryAllVestingQueueRequest) (*QueryAllVestingQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVestingQueue not implemented")
 }
@@ -207,6 +364,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllAuctionRequest)
 	if err := dec(in); err != nil {
@@ -243,6 +418,114 @@ func _Query_GetAuction_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionResult(ctx, req.(*QueryAuctionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CollectedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyerVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyerVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyerVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BuyerVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyerVesting(ctx, req.(*QueryBuyerVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BidderAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAllocation(ctx, req.(*QueryBidderAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateMatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateMatchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMatching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateMatching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMatching(ctx, req.(*QuerySimulateMatchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BidBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidBook(ctx, req.(*QueryBidBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllAllowedBidderRequest)
 	if err := dec(in); err != nil {
@@ -315,6 +598,42 @@ func _Query_GetBid_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListBidsByBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidsByBidderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBidsByBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListBidsByBidder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBidsByBidder(ctx, req.(*QueryBidsByBidderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFailedTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFailedTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFailedTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListFailedTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFailedTransition(ctx, req.(*QueryAllFailedTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListVestingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllVestingQueueRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +663,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
 		{
 			MethodName: "ListAuction",
 			Handler:    _Query_ListAuction_Handler,
@@ -352,6 +675,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuction",
 			Handler:    _Query_GetAuction_Handler,
 		},
+		{
+			MethodName: "AuctionResult",
+			Handler:    _Query_AuctionResult_Handler,
+		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
+		{
+			MethodName: "BuyerVesting",
+			Handler:    _Query_BuyerVesting_Handler,
+		},
+		{
+			MethodName: "BidderAllocation",
+			Handler:    _Query_BidderAllocation_Handler,
+		},
+		{
+			MethodName: "SimulateMatching",
+			Handler:    _Query_SimulateMatching_Handler,
+		},
+		{
+			MethodName: "BidBook",
+			Handler:    _Query_BidBook_Handler,
+		},
 		{
 			MethodName: "ListAllowedBidder",
 			Handler:    _Query_ListAllowedBidder_Handler,
@@ -368,6 +715,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBid",
 			Handler:    _Query_GetBid_Handler,
 		},
+		{
+			MethodName: "ListBidsByBidder",
+			Handler:    _Query_ListBidsByBidder_Handler,
+		},
+		{
+			MethodName: "ListFailedTransition",
+			Handler:    _Query_ListFailedTransition_Handler,
+		},
 		{
 			MethodName: "ListVestingQueue",
 			Handler:    _Query_ListVestingQueue_Handler,
@@ -19,13 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName            = "/fundraising.fundraising.v1.Msg/UpdateParams"
-	Msg_CreateFixedPriceAuction_FullMethodName = "/fundraising.fundraising.v1.Msg/CreateFixedPriceAuction"
-	Msg_CreateBatchAuction_FullMethodName      = "/fundraising.fundraising.v1.Msg/CreateBatchAuction"
-	Msg_CancelAuction_FullMethodName           = "/fundraising.fundraising.v1.Msg/CancelAuction"
-	Msg_PlaceBid_FullMethodName                = "/fundraising.fundraising.v1.Msg/PlaceBid"
-	Msg_ModifyBid_FullMethodName               = "/fundraising.fundraising.v1.Msg/ModifyBid"
-	Msg_AddAllowedBidder_FullMethodName        = "/fundraising.fundraising.v1.Msg/AddAllowedBidder"
+	Msg_UpdateParams_FullMethodName             = "/fundraising.fundraising.v1.Msg/UpdateParams"
+	Msg_CreateFixedPriceAuction_FullMethodName  = "/fundraising.fundraising.v1.Msg/CreateFixedPriceAuction"
+	Msg_CreateBatchAuction_FullMethodName       = "/fundraising.fundraising.v1.Msg/CreateBatchAuction"
+	Msg_CreateDutchAuction_FullMethodName       = "/fundraising.fundraising.v1.Msg/CreateDutchAuction"
+	Msg_CancelAuction_FullMethodName            = "/fundraising.fundraising.v1.Msg/CancelAuction"
+	Msg_PauseAuction_FullMethodName             = "/fundraising.fundraising.v1.Msg/PauseAuction"
+	Msg_ResumeAuction_FullMethodName            = "/fundraising.fundraising.v1.Msg/ResumeAuction"
+	Msg_CloseAuctionEarly_FullMethodName        = "/fundraising.fundraising.v1.Msg/CloseAuctionEarly"
+	Msg_ForceCancelAuction_FullMethodName       = "/fundraising.fundraising.v1.Msg/ForceCancelAuction"
+	Msg_PlaceBid_FullMethodName                 = "/fundraising.fundraising.v1.Msg/PlaceBid"
+	Msg_ModifyBid_FullMethodName                = "/fundraising.fundraising.v1.Msg/ModifyBid"
+	Msg_CancelBid_FullMethodName                = "/fundraising.fundraising.v1.Msg/CancelBid"
+	Msg_ClaimBuyerVesting_FullMethodName        = "/fundraising.fundraising.v1.Msg/ClaimBuyerVesting"
+	Msg_ClaimVestedProceeds_FullMethodName      = "/fundraising.fundraising.v1.Msg/ClaimVestedProceeds"
+	Msg_TransferAuctionOwnership_FullMethodName = "/fundraising.fundraising.v1.Msg/TransferAuctionOwnership"
+	Msg_AcceptAuctionOwnership_FullMethodName   = "/fundraising.fundraising.v1.Msg/AcceptAuctionOwnership"
+	Msg_CommitBid_FullMethodName                = "/fundraising.fundraising.v1.Msg/CommitBid"
+	Msg_RevealBid_FullMethodName                = "/fundraising.fundraising.v1.Msg/RevealBid"
+	Msg_AddAllowedBidder_FullMethodName         = "/fundraising.fundraising.v1.Msg/AddAllowedBidder"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,12 +51,43 @@ type MsgClient interface {
 	CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error)
 	// CreateBatchAuction submits a create batch auction message.
 	CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error)
+	// CreateDutchAuction submits a create dutch auction message.
+	CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error)
 	// CancelAuction defines a method to cancel the auction message.
 	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a method to pause the started auction.
+	PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a method to resume the paused auction.
+	ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error)
+	// CloseAuctionEarly defines a method to close the auction before its end time.
+	CloseAuctionEarly(ctx context.Context, in *MsgCloseAuctionEarly, opts ...grpc.CallOption) (*MsgCloseAuctionEarlyResponse, error)
+	// ForceCancelAuction defines a governance operation to cancel the auction
+	// that is not finished yet and refund the reserved coins.
+	ForceCancelAuction(ctx context.Context, in *MsgForceCancelAuction, opts ...grpc.CallOption) (*MsgForceCancelAuctionResponse, error)
 	// PlaceBid defines a method to place a bid message.
 	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
 	// ModifyBid defines a method to modify the bid message.
 	ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error)
+	// CancelBid defines a method to cancel the bid message.
+	CancelBid(ctx context.Context, in *MsgCancelBid, opts ...grpc.CallOption) (*MsgCancelBidResponse, error)
+	// ClaimBuyerVesting defines a method to claim the unlocked selling coin
+	// allocated to a bidder.
+	ClaimBuyerVesting(ctx context.Context, in *MsgClaimBuyerVesting, opts ...grpc.CallOption) (*MsgClaimBuyerVestingResponse, error)
+	// ClaimVestedProceeds defines a method to claim the paying coin of the
+	// matured vesting queues of an auction.
+	ClaimVestedProceeds(ctx context.Context, in *MsgClaimVestedProceeds, opts ...grpc.CallOption) (*MsgClaimVestedProceedsResponse, error)
+	// TransferAuctionOwnership defines a method to propose a new owner and
+	// beneficiary of an auction.
+	TransferAuctionOwnership(ctx context.Context, in *MsgTransferAuctionOwnership, opts ...grpc.CallOption) (*MsgTransferAuctionOwnershipResponse, error)
+	// AcceptAuctionOwnership defines a method to accept the proposed ownership
+	// of an auction.
+	AcceptAuctionOwnership(ctx context.Context, in *MsgAcceptAuctionOwnership, opts ...grpc.CallOption) (*MsgAcceptAuctionOwnershipResponse, error)
+	// CommitBid defines a method to commit a sealed bid for a sealed-bid batch
+	// auction.
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method to reveal a committed bid during the reveal
+	// phase of a sealed-bid batch auction.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
 	// AddAllowedBidder defines a method sto add a single allowed bidder message.
 	// This is for the testing purpose and it must not be used in mainnet.
 	AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error)
@@ -85,6 +128,15 @@ func (c *msgClient) CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAu
 	return out, nil
 }
 
+func (c *msgClient) CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error) {
+	out := new(MsgCreateDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDutchAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
 	out := new(MsgCancelAuctionResponse)
 	err := c.cc.Invoke(ctx, Msg_CancelAuction_FullMethodName, in, out, opts...)
@@ -94,6 +146,42 @@ func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opt
 	return out, nil
 }
 
+func (c *msgClient) PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error) {
+	out := new(MsgPauseAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error) {
+	out := new(MsgResumeAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseAuctionEarly(ctx context.Context, in *MsgCloseAuctionEarly, opts ...grpc.CallOption) (*MsgCloseAuctionEarlyResponse, error) {
+	out := new(MsgCloseAuctionEarlyResponse)
+	err := c.cc.Invoke(ctx, Msg_CloseAuctionEarly_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceCancelAuction(ctx context.Context, in *MsgForceCancelAuction, opts ...grpc.CallOption) (*MsgForceCancelAuctionResponse, error) {
+	out := new(MsgForceCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceCancelAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
 	out := new(MsgPlaceBidResponse)
 	err := c.cc.Invoke(ctx, Msg_PlaceBid_FullMethodName, in, out, opts...)
@@ -112,6 +200,69 @@ func (c *msgClient) ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) CancelBid(ctx context.Context, in *MsgCancelBid, opts ...grpc.CallOption) (*MsgCancelBidResponse, error) {
+	out := new(MsgCancelBidResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimBuyerVesting(ctx context.Context, in *MsgClaimBuyerVesting, opts ...grpc.CallOption) (*MsgClaimBuyerVestingResponse, error) {
+	out := new(MsgClaimBuyerVestingResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimBuyerVesting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVestedProceeds(ctx context.Context, in *MsgClaimVestedProceeds, opts ...grpc.CallOption) (*MsgClaimVestedProceedsResponse, error) {
+	out := new(MsgClaimVestedProceedsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimVestedProceeds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferAuctionOwnership(ctx context.Context, in *MsgTransferAuctionOwnership, opts ...grpc.CallOption) (*MsgTransferAuctionOwnershipResponse, error) {
+	out := new(MsgTransferAuctionOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferAuctionOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAuctionOwnership(ctx context.Context, in *MsgAcceptAuctionOwnership, opts ...grpc.CallOption) (*MsgAcceptAuctionOwnershipResponse, error) {
+	out := new(MsgAcceptAuctionOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAuctionOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error) {
 	out := new(MsgAddAllowedBidderResponse)
 	err := c.cc.Invoke(ctx, Msg_AddAllowedBidder_FullMethodName, in, out, opts...)
@@ -132,12 +283,43 @@ type MsgServer interface {
 	CreateFixedPriceAuction(context.Context, *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error)
 	// CreateBatchAuction submits a create batch auction message.
 	CreateBatchAuction(context.Context, *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error)
+	// CreateDutchAuction submits a create dutch auction message.
+	CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error)
 	// CancelAuction defines a method to cancel the auction message.
 	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a method to pause the started auction.
+	PauseAuction(context.Context, *MsgPauseAuction) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a method to resume the paused auction.
+	ResumeAuction(context.Context, *MsgResumeAuction) (*MsgResumeAuctionResponse, error)
+	// CloseAuctionEarly defines a method to close the auction before its end time.
+	CloseAuctionEarly(context.Context, *MsgCloseAuctionEarly) (*MsgCloseAuctionEarlyResponse, error)
+	// ForceCancelAuction defines a governance operation to cancel the auction
+	// that is not finished yet and refund the reserved coins.
+	ForceCancelAuction(context.Context, *MsgForceCancelAuction) (*MsgForceCancelAuctionResponse, error)
 	// PlaceBid defines a method to place a bid message.
 	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
 	// ModifyBid defines a method to modify the bid message.
 	ModifyBid(context.Context, *MsgModifyBid) (*MsgModifyBidResponse, error)
+	// CancelBid defines a method to cancel the bid message.
+	CancelBid(context.Context, *MsgCancelBid) (*MsgCancelBidResponse, error)
+	// ClaimBuyerVesting defines a method to claim the unlocked selling coin
+	// allocated to a bidder.
+	ClaimBuyerVesting(context.Context, *MsgClaimBuyerVesting) (*MsgClaimBuyerVestingResponse, error)
+	// ClaimVestedProceeds defines a method to claim the paying coin of the
+	// matured vesting queues of an auction.
+	ClaimVestedProceeds(context.Context, *MsgClaimVestedProceeds) (*MsgClaimVestedProceedsResponse, error)
+	// TransferAuctionOwnership defines a method to propose a new owner and
+	// beneficiary of an auction.
+	TransferAuctionOwnership(context.Context, *MsgTransferAuctionOwnership) (*MsgTransferAuctionOwnershipResponse, error)
+	// AcceptAuctionOwnership defines a method to accept the proposed ownership
+	// of an auction.
+	AcceptAuctionOwnership(context.Context, *MsgAcceptAuctionOwnership) (*MsgAcceptAuctionOwnershipResponse, error)
+	// CommitBid defines a method to commit a sealed bid for a sealed-bid batch
+	// auction.
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method to reveal a committed bid during the reveal
+	// phase of a sealed-bid batch auction.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
 	// AddAllowedBidder defines a method sto add a single allowed bidder message.
 	// This is for the testing purpose and it must not be used in mainnet.
 	AddAllowedBidder(context.Context, *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error)
@@ -157,15 +339,51 @@ func (UnimplementedMsgServer) CreateFixedPriceAuction(context.Context, *MsgCreat
 func (UnimplementedMsgServer) CreateBatchAuction(context.Context, *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBatchAuction not implemented")
 }
+func (UnimplementedMsgServer) CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDutchAuction not implemented")
+}
 func (UnimplementedMsgServer) CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
 }
+func (UnimplementedMsgServer) PauseAuction(context.Context, *MsgPauseAuction) (*MsgPauseAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAuction not implemented")
+}
+func (UnimplementedMsgServer) ResumeAuction(context.Context, *MsgResumeAuction) (*MsgResumeAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAuction not implemented")
+}
+func (UnimplementedMsgServer) CloseAuctionEarly(context.Context, *MsgCloseAuctionEarly) (*MsgCloseAuctionEarlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAuctionEarly not implemented")
+}
+func (UnimplementedMsgServer) ForceCancelAuction(context.Context, *MsgForceCancelAuction) (*MsgForceCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCancelAuction not implemented")
+}
 func (UnimplementedMsgServer) PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
 }
 func (UnimplementedMsgServer) ModifyBid(context.Context, *MsgModifyBid) (*MsgModifyBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyBid not implemented")
 }
+func (UnimplementedMsgServer) CancelBid(context.Context, *MsgCancelBid) (*MsgCancelBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBid not implemented")
+}
+func (UnimplementedMsgServer) ClaimBuyerVesting(context.Context, *MsgClaimBuyerVesting) (*MsgClaimBuyerVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBuyerVesting not implemented")
+}
+func (UnimplementedMsgServer) ClaimVestedProceeds(context.Context, *MsgClaimVestedProceeds) (*MsgClaimVestedProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVestedProceeds not implemented")
+}
+func (UnimplementedMsgServer) TransferAuctionOwnership(context.Context, *MsgTransferAuctionOwnership) (*MsgTransferAuctionOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAuctionOwnership not implemented")
+}
+func (UnimplementedMsgServer) AcceptAuctionOwnership(context.Context, *MsgAcceptAuctionOwnership) (*MsgAcceptAuctionOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAuctionOwnership not implemented")
+}
+func (UnimplementedMsgServer) CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (UnimplementedMsgServer) RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
 func (UnimplementedMsgServer) AddAllowedBidder(context.Context, *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBidder not implemented")
 }
@@ -236,6 +454,24 @@ func _Msg_CreateBatchAuction_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDutchAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDutchAuction(ctx, req.(*MsgCreateDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCancelAuction)
 	if err := dec(in); err != nil {
@@ -254,6 +490,78 @@ func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseAuction(ctx, req.(*MsgPauseAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeAuction(ctx, req.(*MsgResumeAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseAuctionEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseAuctionEarly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseAuctionEarly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CloseAuctionEarly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseAuctionEarly(ctx, req.(*MsgCloseAuctionEarly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceCancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceCancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceCancelAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceCancelAuction(ctx, req.(*MsgForceCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgPlaceBid)
 	if err := dec(in); err != nil {
@@ -290,6 +598,132 @@ func _Msg_ModifyBid_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBid(ctx, req.(*MsgCancelBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimBuyerVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimBuyerVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimBuyerVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimBuyerVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimBuyerVesting(ctx, req.(*MsgClaimBuyerVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVestedProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVestedProceeds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVestedProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimVestedProceeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVestedProceeds(ctx, req.(*MsgClaimVestedProceeds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAuctionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAuctionOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAuctionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferAuctionOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAuctionOwnership(ctx, req.(*MsgTransferAuctionOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAuctionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAuctionOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAuctionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAuctionOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAuctionOwnership(ctx, req.(*MsgAcceptAuctionOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddAllowedBidder)
 	if err := dec(in); err != nil {
@@ -327,10 +761,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBatchAuction",
 			Handler:    _Msg_CreateBatchAuction_Handler,
 		},
+		{
+			MethodName: "CreateDutchAuction",
+			Handler:    _Msg_CreateDutchAuction_Handler,
+		},
 		{
 			MethodName: "CancelAuction",
 			Handler:    _Msg_CancelAuction_Handler,
 		},
+		{
+			MethodName: "PauseAuction",
+			Handler:    _Msg_PauseAuction_Handler,
+		},
+		{
+			MethodName: "ResumeAuction",
+			Handler:    _Msg_ResumeAuction_Handler,
+		},
+		{
+			MethodName: "CloseAuctionEarly",
+			Handler:    _Msg_CloseAuctionEarly_Handler,
+		},
+		{
+			MethodName: "ForceCancelAuction",
+			Handler:    _Msg_ForceCancelAuction_Handler,
+		},
 		{
 			MethodName: "PlaceBid",
 			Handler:    _Msg_PlaceBid_Handler,
@@ -339,6 +793,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyBid",
 			Handler:    _Msg_ModifyBid_Handler,
 		},
+		{
+			MethodName: "CancelBid",
+			Handler:    _Msg_CancelBid_Handler,
+		},
+		{
+			MethodName: "ClaimBuyerVesting",
+			Handler:    _Msg_ClaimBuyerVesting_Handler,
+		},
+		{
+			MethodName: "ClaimVestedProceeds",
+			Handler:    _Msg_ClaimVestedProceeds_Handler,
+		},
+		{
+			MethodName: "TransferAuctionOwnership",
+			Handler:    _Msg_TransferAuctionOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptAuctionOwnership",
+			Handler:    _Msg_AcceptAuctionOwnership_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
 		{
 			MethodName: "AddAllowedBidder",
 			Handler:    _Msg_AddAllowedBidder_Handler,
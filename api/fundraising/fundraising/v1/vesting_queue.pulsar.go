@@ -18,12 +18,14 @@ import (
 )
 
 var (
-	md_VestingQueue              protoreflect.MessageDescriptor
-	fd_VestingQueue_auction_id   protoreflect.FieldDescriptor
-	fd_VestingQueue_auctioneer   protoreflect.FieldDescriptor
-	fd_VestingQueue_paying_coin  protoreflect.FieldDescriptor
-	fd_VestingQueue_release_time protoreflect.FieldDescriptor
-	fd_VestingQueue_released     protoreflect.FieldDescriptor
+	md_VestingQueue               protoreflect.MessageDescriptor
+	fd_VestingQueue_auction_id    protoreflect.FieldDescriptor
+	fd_VestingQueue_auctioneer    protoreflect.FieldDescriptor
+	fd_VestingQueue_paying_coin   protoreflect.FieldDescriptor
+	fd_VestingQueue_release_time  protoreflect.FieldDescriptor
+	fd_VestingQueue_released      protoreflect.FieldDescriptor
+	fd_VestingQueue_released_coin protoreflect.FieldDescriptor
+	fd_VestingQueue_sequence      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +36,8 @@ func init() {
 	fd_VestingQueue_paying_coin = md_VestingQueue.Fields().ByName("paying_coin")
 	fd_VestingQueue_release_time = md_VestingQueue.Fields().ByName("release_time")
 	fd_VestingQueue_released = md_VestingQueue.Fields().ByName("released")
+	fd_VestingQueue_released_coin = md_VestingQueue.Fields().ByName("released_coin")
+	fd_VestingQueue_sequence = md_VestingQueue.Fields().ByName("sequence")
 }
 
 var _ protoreflect.Message = (*fastReflection_VestingQueue)(nil)
@@ -131,6 +135,18 @@ func (x *fastReflection_VestingQueue) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.ReleasedCoin != nil {
+		value := protoreflect.ValueOfMessage(x.ReleasedCoin.ProtoReflect())
+		if !f(fd_VestingQueue_released_coin, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_VestingQueue_sequence, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -156,6 +172,10 @@ func (x *fastReflection_VestingQueue) Has(fd protoreflect.FieldDescriptor) bool
 		return x.ReleaseTime != nil
 	case "fundraising.fundraising.v1.VestingQueue.released":
 		return x.Released != false
+	case "fundraising.fundraising.v1.VestingQueue.released_coin":
+		return x.ReleasedCoin != nil
+	case "fundraising.fundraising.v1.VestingQueue.sequence":
+		return x.Sequence != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingQueue"))
@@ -182,6 +202,10 @@ func (x *fastReflection_VestingQueue) Clear(fd protoreflect.FieldDescriptor) {
 		x.ReleaseTime = nil
 	case "fundraising.fundraising.v1.VestingQueue.released":
 		x.Released = false
+	case "fundraising.fundraising.v1.VestingQueue.released_coin":
+		x.ReleasedCoin = nil
+	case "fundraising.fundraising.v1.VestingQueue.sequence":
+		x.Sequence = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingQueue"))
@@ -213,6 +237,12 @@ func (x *fastReflection_VestingQueue) Get(descriptor protoreflect.FieldDescripto
 	case "fundraising.fundraising.v1.VestingQueue.released":
 		value := x.Released
 		return protoreflect.ValueOfBool(value)
+	case "fundraising.fundraising.v1.VestingQueue.released_coin":
+		value := x.ReleasedCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fundraising.fundraising.v1.VestingQueue.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingQueue"))
@@ -243,6 +273,10 @@ func (x *fastReflection_VestingQueue) Set(fd protoreflect.FieldDescriptor, value
 		x.ReleaseTime = value.Message().Interface().(*timestamppb.Timestamp)
 	case "fundraising.fundraising.v1.VestingQueue.released":
 		x.Released = value.Bool()
+	case "fundraising.fundraising.v1.VestingQueue.released_coin":
+		x.ReleasedCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "fundraising.fundraising.v1.VestingQueue.sequence":
+		x.Sequence = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingQueue"))
@@ -273,12 +307,19 @@ func (x *fastReflection_VestingQueue) Mutable(fd protoreflect.FieldDescriptor) p
 			x.ReleaseTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.ReleaseTime.ProtoReflect())
+	case "fundraising.fundraising.v1.VestingQueue.released_coin":
+		if x.ReleasedCoin == nil {
+			x.ReleasedCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ReleasedCoin.ProtoReflect())
 	case "fundraising.fundraising.v1.VestingQueue.auction_id":
 		panic(fmt.Errorf("field auction_id of message fundraising.fundraising.v1.VestingQueue is not mutable"))
 	case "fundraising.fundraising.v1.VestingQueue.auctioneer":
 		panic(fmt.Errorf("field auctioneer of message fundraising.fundraising.v1.VestingQueue is not mutable"))
 	case "fundraising.fundraising.v1.VestingQueue.released":
 		panic(fmt.Errorf("field released of message fundraising.fundraising.v1.VestingQueue is not mutable"))
+	case "fundraising.fundraising.v1.VestingQueue.sequence":
+		panic(fmt.Errorf("field sequence of message fundraising.fundraising.v1.VestingQueue is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingQueue"))
@@ -304,6 +345,11 @@ func (x *fastReflection_VestingQueue) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "fundraising.fundraising.v1.VestingQueue.released":
 		return protoreflect.ValueOfBool(false)
+	case "fundraising.fundraising.v1.VestingQueue.released_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fundraising.fundraising.v1.VestingQueue.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fundraising.fundraising.v1.VestingQueue"))
@@ -391,6 +437,13 @@ func (x *fastReflection_VestingQueue) ProtoMethods() *protoiface.Methods {
 		if x.Released {
 			n += 2
 		}
+		if x.ReleasedCoin != nil {
+			l = options.Size(x.ReleasedCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -420,6 +473,25 @@ func (x *fastReflection_VestingQueue) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ReleasedCoin != nil {
+			encoded, err := options.Marshal(x.ReleasedCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.Released {
 			i--
 			if x.Released {
@@ -662,6 +734,61 @@ func (x *fastReflection_VestingQueue) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Released = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReleasedCoin == nil {
+					x.ReleasedCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleasedCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -726,6 +853,12 @@ type VestingQueue struct {
 	ReleaseTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
 	// released specifies the status of distribution
 	Released bool `protobuf:"varint,5,opt,name=released,proto3" json:"released,omitempty"`
+	// released_coin specifies the paying coin released so far by the linear
+	// vesting of the auction; it is nil for the vesting schedules
+	ReleasedCoin *v1beta1.Coin `protobuf:"bytes,6,opt,name=released_coin,json=releasedCoin,proto3" json:"released_coin,omitempty"`
+	// sequence specifies the index of the vesting queue in the auction, in the
+	// order of the release time
+	Sequence uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (x *VestingQueue) Reset() {
@@ -783,6 +916,20 @@ func (x *VestingQueue) GetReleased() bool {
 	return false
 }
 
+func (x *VestingQueue) GetReleasedCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.ReleasedCoin
+	}
+	return nil
+}
+
+func (x *VestingQueue) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 var File_fundraising_fundraising_v1_vesting_queue_proto protoreflect.FileDescriptor
 
 var file_fundraising_fundraising_v1_vesting_queue_proto_rawDesc = []byte{
@@ -799,7 +946,7 @@ var file_fundraising_fundraising_v1_vesting_queue_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x0c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
+	0x6f, 0x22, 0x92, 0x03, 0x0a, 0x0c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
 	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
 	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x18,
@@ -818,25 +965,30 @@ var file_fundraising_fundraising_v1_vesting_queue_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
 	0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a,
 	0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x8d, 0x02, 0x0a, 0x1e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66,
-	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x56,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
-	0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
-	0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73,
-	0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x26, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67,
-	0x5c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75,
-	0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72,
-	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x8d, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x66,
+	0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x75, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x3b, 0x66, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x1a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c,
+	0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x26, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x46, 0x75, 0x6e,
+	0x64, 0x72, 0x61, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61,
+	0x69, 0x73, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x46, 0x75, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -860,11 +1012,12 @@ var file_fundraising_fundraising_v1_vesting_queue_proto_goTypes = []interface{}{
 var file_fundraising_fundraising_v1_vesting_queue_proto_depIdxs = []int32{
 	1, // 0: fundraising.fundraising.v1.VestingQueue.paying_coin:type_name -> cosmos.base.v1beta1.Coin
 	2, // 1: fundraising.fundraising.v1.VestingQueue.release_time:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1, // 2: fundraising.fundraising.v1.VestingQueue.released_coin:type_name -> cosmos.base.v1beta1.Coin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_fundraising_fundraising_v1_vesting_queue_proto_init() }
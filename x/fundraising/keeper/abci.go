@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
@@ -13,22 +17,102 @@ import (
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	// Get all auctions from the store and execute operations depending on auction status.
-	auctions, err := k.Auctions(ctx)
+	// Only the auctions whose state transition is due are taken from the auction queue,
+	// so the cost doesn't grow with the number of auctions that are already closed.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	auctionIds, err := k.DequeueDueAuctions(ctx, sdkCtx.BlockTime())
 	if err != nil {
 		return err
 	}
-	for _, auction := range auctions {
-		switch auction.GetStatus() {
-		case types.AuctionStatusStandBy:
-			err = k.ExecuteStandByStatus(ctx, auction)
-		case types.AuctionStatusStarted:
-			err = k.ExecuteStartedStatus(ctx, auction)
-		case types.AuctionStatusVesting:
-			err = k.ExecuteVestingStatus(ctx, auction)
-		default:
-			err = fmt.Errorf("invalid auction status %s", auction.GetStatus())
+	for _, auctionId := range auctionIds {
+		// Each transition runs in its own cached context, so that a failing auction
+		// neither leaves a partial state behind nor prevents the others from proceeding.
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.ExecuteAuctionTransition(cacheCtx, auctionId); err != nil {
+			if err := k.RecordFailedTransition(ctx, auctionId, err); err != nil {
+				return err
+			}
+			continue
 		}
+		write()
+
+		if err := k.FailedTransition.Remove(ctx, auctionId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecuteAuctionTransition executes the state transition of the auction depending on
+// its status and queues the auction again for its next state transition.
+func (k Keeper) ExecuteAuctionTransition(ctx context.Context, auctionId uint64) error {
+	auction, err := k.Auction.Get(ctx, auctionId)
+	if err != nil {
+		return err
+	}
+
+	switch auction.GetStatus() {
+	case types.AuctionStatusStandBy:
+		err = k.ExecuteStandByStatus(ctx, auction)
+	case types.AuctionStatusStarted:
+		err = k.ExecuteStartedStatus(ctx, auction)
+	case types.AuctionStatusRevealing:
+		err = k.ExecuteRevealingStatus(ctx, auction)
+	case types.AuctionStatusVesting:
+		err = k.ExecuteVestingStatus(ctx, auction)
+	case types.AuctionStatusFinished, types.AuctionStatusCancelled, types.AuctionStatusFailed, types.AuctionStatusPaused:
+		// A stale queue entry of the auction that has no state transition left is dropped
+		return nil
+	default:
+		err = fmt.Errorf("invalid auction status %s", auction.GetStatus())
+	}
+	if err != nil {
+		return err
+	}
+
+	auction, err = k.Auction.Get(ctx, auctionId)
+	if err != nil {
+		return err
 	}
-	return err
+	return k.ScheduleAuction(ctx, auction)
+}
+
+// RecordFailedTransition stores the failure of the auction state transition and
+// queues the auction to retry the transition after a delay that grows with the failed attempts.
+func (k Keeper) RecordFailedTransition(ctx context.Context, auctionId uint64, transitionErr error) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	failed, err := k.FailedTransition.Get(ctx, auctionId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	failed.AuctionId = auctionId
+	failed.Error = transitionErr.Error()
+	failed.Height = sdkCtx.BlockHeight()
+	failed.Time = sdkCtx.BlockTime()
+	failed.Attempts++
+
+	if auction, err := k.Auction.Get(ctx, auctionId); err == nil {
+		failed.AuctionStatus = auction.GetStatus()
+	}
+
+	if err := k.FailedTransition.Set(ctx, auctionId, failed); err != nil {
+		return err
+	}
+	if err := k.AuctionQueue.Set(ctx, collections.Join(sdkCtx.BlockTime().Add(failed.RetryDelay()), auctionId)); err != nil {
+		return err
+	}
+
+	k.Logger().Error("failed to execute auction state transition", "auction_id", auctionId, "error", transitionErr)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransitionFailed,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, transitionErr.Error()),
+			sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(failed.Attempts, 10)),
+		),
+	})
+
+	return nil
 }
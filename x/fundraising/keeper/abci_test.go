@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (s *KeeperTestSuite) TestBeginBlocker_FailedTransition() {
+	endTime := time.Now().AddDate(0, 1, 0)
+	failing := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		endTime,
+		true,
+	)
+	healthy := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(failing.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+	s.placeBidFixedPrice(healthy.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom4"), true)
+
+	// Drain the selling reserve so that the allocation of the selling coin fails
+	drained := sdk.NewCoins(parseCoin("1_000_000_000denom1"))
+	err := s.app.BankKeeper.SendCoins(s.ctx, failing.GetSellingReserveAddress(), s.addr(9), drained)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(endTime).WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The healthy auction is closed regardless of the failing one
+	auction, err := s.keeper.Auction.Get(s.ctx, healthy.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+	s.Require().Equal(parseCoin("1_000_000denom3"), s.getBalance(s.addr(1), "denom3"))
+
+	// No partial state is left behind for the failing auction
+	auction, err = s.keeper.Auction.Get(s.ctx, failing.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	s.Require().Equal(parseCoin("1_000_000denom2"), s.getBalance(failing.GetPayingReserveAddress(), "denom2"))
+
+	failed, err := s.keeper.FailedTransition.Get(s.ctx, failing.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStarted, failed.AuctionStatus)
+	s.Require().Equal(int64(10), failed.Height)
+	s.Require().Equal(uint64(1), failed.Attempts)
+	s.Require().NotEmpty(failed.Error)
+
+	found := false
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeTransitionFailed {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	// The transition is retried after the base delay
+	has, err := s.keeper.AuctionQueue.Has(s.ctx, collections.Join(endTime.UTC().Add(types.TransitionRetryBaseDelay), failing.Id))
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(5 * time.Second)).WithBlockHeight(11)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	failed, err = s.keeper.FailedTransition.Get(s.ctx, failing.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), failed.Attempts)
+
+	// The delay doubles on the next failure
+	has, err = s.keeper.AuctionQueue.Has(s.ctx, collections.Join(endTime.UTC().Add(15*time.Second), failing.Id))
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	// Put the selling coin back and the retry succeeds
+	err = s.app.BankKeeper.SendCoins(s.ctx, s.addr(9), failing.GetSellingReserveAddress(), drained)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(10 * time.Second)).WithBlockHeight(12)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	auction, err = s.keeper.Auction.Get(s.ctx, failing.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(15 * time.Second)).WithBlockHeight(13)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	auction, err = s.keeper.Auction.Get(s.ctx, failing.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+	s.Require().Equal(parseCoin("1_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+
+	_, err = s.keeper.FailedTransition.Get(s.ctx, failing.Id)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestBeginBlocker_StaleQueueEntry() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 1, 0),
+		time.Now().AddDate(0, 2, 0),
+		true,
+	)
+	err := s.keeper.CancelAuction(s.ctx, &types.MsgCancelAuction{
+		Auctioneer: auction.Auctioneer,
+		AuctionId:  auction.Id,
+	})
+	s.Require().NoError(err)
+
+	// A stale queue entry of the cancelled auction is dropped without being retried
+	blockTime := s.ctx.BlockTime()
+	err = s.keeper.AuctionQueue.Set(s.ctx, collections.Join(blockTime, auction.Id))
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Empty(s.queuedAuctions())
+
+	_, err = s.keeper.FailedTransition.Get(s.ctx, auction.Id)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+	for _, ev := range s.ctx.EventManager().Events() {
+		s.Require().NotEqual(types.EventTypeTransitionFailed, ev.Type)
+	}
+}
@@ -42,6 +42,23 @@ func (k Keeper) IterateAuctions(ctx context.Context, cb func(uint64, types.Aucti
 	return nil
 }
 
+// UpdateAuctionStatus updates the status of the auction in the store and emits the status change event.
+func (k Keeper) UpdateAuctionStatus(ctx context.Context, auction types.AuctionI, status types.AuctionStatus) error {
+	prevStatus := auction.GetStatus()
+	if err := auction.SetStatus(status); err != nil {
+		return err
+	}
+	if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAuctionStatusChanged{
+		AuctionId:      auction.GetId(),
+		PreviousStatus: prevStatus,
+		Status:         status,
+	})
+}
+
 // AddAllowedBidders is a function that is implemented for an external module.
 // An external module uses this function to add allowed bidders in the auction's allowed bidders list.
 // It doesn't look up the bidder's previous maximum bid amount. Instead, it overlaps.
@@ -129,6 +146,11 @@ func (k Keeper) AllocateSellingCoin(ctx context.Context, auction types.AuctionI,
 	sellingReserveAddr := auction.GetSellingReserveAddress()
 	sellingCoinDenom := auction.GetSellingCoin().Denom
 
+	// The allocated selling coin is locked in the buyer vesting reserve account
+	// when the auction has buyer vesting schedules
+	buyerVesting := len(auction.GetBuyerVestingSchedules()) > 0
+	buyerVestingReserveAddr := types.BuyerVestingReserveAddress(auction.GetId())
+
 	ioCoins := make(map[string]inOutCoins)
 
 	// Sort bidders to reserve determinism
@@ -150,10 +172,23 @@ func (k Keeper) AllocateSellingCoin(ctx context.Context, auction types.AuctionI,
 			return err
 		}
 
+		recipientAddr := bidderAddr
+		if buyerVesting {
+			recipientAddr = buyerVestingReserveAddr
+			if err := k.BuyerVesting.Set(ctx, collections.Join(auction.GetId(), bidderAddr), types.BuyerVesting{
+				AuctionId:     auction.GetId(),
+				Bidder:        bidder,
+				AllocatedCoin: allocateCoins[0],
+				ClaimedCoin:   sdk.NewCoin(sellingCoinDenom, math.ZeroInt()),
+			}); err != nil {
+				return err
+			}
+		}
+
 		if _, ok := ioCoins[bidderAddr.String()]; !ok {
 			ioCoins[bidder] = inOutCoins{
 				bidder:  bidder,
-				outputs: []banktypes.Output{banktypes.NewOutput(bidderAddr, allocateCoins)},
+				outputs: []banktypes.Output{banktypes.NewOutput(recipientAddr, allocateCoins)},
 				input:   banktypes.NewInput(sellingReserveAddr, allocateCoins),
 			}
 			continue
@@ -161,7 +196,7 @@ func (k Keeper) AllocateSellingCoin(ctx context.Context, auction types.AuctionI,
 
 		inout := ioCoins[bidder]
 		inout.input.Coins = inout.input.Coins.Add(allocateCoins...)
-		inout.outputs = append(inout.outputs, banktypes.NewOutput(bidderAddr, allocateCoins))
+		inout.outputs = append(inout.outputs, banktypes.NewOutput(recipientAddr, allocateCoins))
 		ioCoins[bidder] = inout
 	}
 
@@ -172,81 +207,168 @@ func (k Keeper) AllocateSellingCoin(ctx context.Context, auction types.AuctionI,
 		}
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, bidder := range bidders {
+		if mInfo.AllocationMap[bidder].IsZero() {
+			continue
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventSellingCoinAllocated{
+			AuctionId: auction.GetId(),
+			Bidder:    bidder,
+			Coin:      sdk.NewCoin(sellingCoinDenom, mInfo.AllocationMap[bidder]),
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ReleaseVestingPayingCoin releases the vested selling coin to the auctioneer from the vesting reserve account.
-func (k Keeper) ReleaseVestingPayingCoin(ctx context.Context, auction types.AuctionI) error {
+// ReleaseVestingPayingCoin releases the paying coin of all the matured vesting queues of the auction
+// to the recipient from the vesting reserve account and returns the released paying coins.
+func (k Keeper) ReleaseVestingPayingCoin(ctx context.Context, auction types.AuctionI, recipient sdk.AccAddress) (sdk.Coins, error) {
+	if linearVesting := auction.GetLinearVesting(); linearVesting != nil {
+		return k.releaseLinearVestingPayingCoin(ctx, auction, *linearVesting, recipient)
+	}
+
 	vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	vestingQueuesLen := len(vestingQueues)
 
+	var released sdk.Coins
 	for i, vestingQueue := range vestingQueues {
 		blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
 		if vestingQueue.ShouldRelease(blockTime) {
 			vestingReserveAddr := auction.GetVestingReserveAddress()
-			auctioneerAddr := auction.GetAuctioneer()
 			payingCoins := sdk.NewCoins(vestingQueue.PayingCoin)
 
-			if err := k.bankKeeper.SendCoins(ctx, vestingReserveAddr, auctioneerAddr, payingCoins); err != nil {
-				return sdkerrors.Wrap(err, "failed to release paying coin to the auctioneer")
+			if err := k.bankKeeper.SendCoins(ctx, vestingReserveAddr, recipient, payingCoins); err != nil {
+				return nil, sdkerrors.Wrap(err, "failed to release paying coin to the auctioneer")
+			}
+
+			if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPayingCoinReleased{
+				AuctionId:   auction.GetId(),
+				Auctioneer:  auction.GetAuctioneer().String(),
+				PayingCoin:  vestingQueue.PayingCoin,
+				ReleaseTime: vestingQueue.ReleaseTime,
+				Recipient:   recipient.String(),
+			}); err != nil {
+				return nil, err
 			}
+			released = released.Add(vestingQueue.PayingCoin)
 
 			vestingQueue.SetReleased(true)
 			if err := k.VestingQueue.Set(ctx, collections.Join(
 				vestingQueue.AuctionId,
-				vestingQueue.ReleaseTime,
+				vestingQueue.Sequence,
 			), vestingQueue); err != nil {
-				return err
+				return nil, err
 			}
+			vestingQueues[i] = vestingQueue
+		}
+	}
 
-			// Update status when all the amounts are released
-			if i == vestingQueuesLen-1 {
-				if err := auction.SetStatus(types.AuctionStatusFinished); err != nil {
-					return err
-				}
-				if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
-					return err
-				}
-			}
+	if err := k.finishVestingIfReleased(ctx, auction, vestingQueues); err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
+// finishVestingIfReleased finishes the auction once every vesting queue of the auction is released.
+func (k Keeper) finishVestingIfReleased(ctx context.Context, auction types.AuctionI, vestingQueues []types.VestingQueue) error {
+	if len(vestingQueues) == 0 {
+		return nil
+	}
+	for _, vestingQueue := range vestingQueues {
+		if !vestingQueue.Released {
+			return nil
 		}
 	}
+	return k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusFinished)
+}
 
-	return nil
+// ClaimVestedProceeds releases the paying coin of all the matured vesting queues of the auction
+// to the beneficiary of the claim, or to the beneficiary of the auction if none is given.
+func (k Keeper) ClaimVestedProceeds(ctx context.Context, msg *types.MsgClaimVestedProceeds) (sdk.Coins, error) {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(errors.ErrNotFound, "auction %d is not found", msg.AuctionId)
+	}
+
+	if auction.GetAuctioneer().String() != msg.Auctioneer {
+		return nil, sdkerrors.Wrap(errors.ErrUnauthorized, "only the auctioneer can claim the vested proceeds")
+	}
+
+	if auction.GetStatus() != types.AuctionStatusVesting {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuctionStatus, "unable to claim the vested proceeds of the auction in %s", auction.GetStatus())
+	}
+
+	// The auctioneer can redirect the proceeds only when they are not configured to go to another beneficiary
+	recipient := auction.GetBeneficiary()
+	if msg.Beneficiary != "" {
+		override, err := sdk.AccAddressFromBech32(msg.Beneficiary)
+		if err != nil {
+			return nil, err
+		}
+		if !recipient.Equals(auction.GetAuctioneer()) && !override.Equals(recipient) {
+			return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "the vested proceeds can only be claimed to the beneficiary %s", recipient)
+		}
+		recipient = override
+	}
+
+	released, err := k.ReleaseVestingPayingCoin(ctx, auction, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if released.IsZero() {
+		return nil, types.ErrNothingToClaim
+	}
+
+	return released, nil
 }
 
-// RefundRemainingSellingCoin refunds the remaining selling coin to the auctioneer.
+// RefundRemainingSellingCoin refunds the remaining selling coin to the beneficiary of the auction.
 func (k Keeper) RefundRemainingSellingCoin(ctx context.Context, auction types.AuctionI) error {
 	sellingReserveAddr := auction.GetSellingReserveAddress()
 	sellingCoinDenom := auction.GetSellingCoin().Denom
 	spendableCoins := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
 	releaseCoins := sdk.NewCoins(sdk.NewCoin(sellingCoinDenom, spendableCoins.AmountOf(sellingCoinDenom)))
 
-	if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetAuctioneer(), releaseCoins); err != nil {
+	if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetBeneficiary(), releaseCoins); err != nil {
 		return err
 	}
-	return nil
+
+	if releaseCoins.IsZero() {
+		return nil
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRemainingSellingCoinRefunded{
+		AuctionId:  auction.GetId(),
+		Auctioneer: auction.GetAuctioneer().String(),
+		Coin:       sdk.NewCoin(sellingCoinDenom, releaseCoins.AmountOf(sellingCoinDenom)),
+	})
 }
 
-// RefundPayingCoin refunds paying coin to the corresponding bidders.
+// RefundPayingCoin refunds paying coin to the corresponding bidders in the paying denoms they reserved.
+// The refund map already accounts for the pricing mode of a batch auction, since the paid amount
+// of each matched bid is calculated at either the matched price or its own bid price.
 func (k Keeper) RefundPayingCoin(ctx context.Context, auction types.AuctionI, mInfo MatchingInfo) error {
 	payingReserveAddr := auction.GetPayingReserveAddress()
-	payingCoinDenom := auction.GetPayingCoinDenom()
 
 	ioCoins := make(map[string]inOutCoins)
 
 	// Sort bidders to reserve determinism
 	var bidders []string
-	for bidder := range mInfo.RefundMap {
+	for bidder := range mInfo.RefundCoinsMap {
 		bidders = append(bidders, bidder)
 	}
 	sort.Strings(bidders)
 
 	// Refund the unmatched bid amount back to the bidder
 	for _, bidder := range bidders {
-		if mInfo.RefundMap[bidder].IsZero() {
+		refundCoins := mInfo.RefundCoinsMap[bidder]
+		if refundCoins.IsZero() {
 			continue
 		}
 
@@ -254,7 +376,6 @@ func (k Keeper) RefundPayingCoin(ctx context.Context, auction types.AuctionI, mI
 		if err != nil {
 			return err
 		}
-		refundCoins := sdk.NewCoins(sdk.NewCoin(payingCoinDenom, mInfo.RefundMap[bidder]))
 
 		if _, ok := ioCoins[bidderAddr.String()]; !ok {
 			ioCoins[bidder] = inOutCoins{
@@ -278,6 +399,19 @@ func (k Keeper) RefundPayingCoin(ctx context.Context, auction types.AuctionI, mI
 		}
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, bidder := range bidders {
+		for _, refundCoin := range mInfo.RefundCoinsMap[bidder] {
+			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPayingCoinRefunded{
+				AuctionId: auction.GetId(),
+				Bidder:    bidder,
+				Coin:      refundCoin,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -293,16 +427,132 @@ func (k Keeper) ExtendRound(ctx context.Context, ba *types.BatchAuction) error {
 
 	_ = ba.SetEndTimes(endTimes)
 
-	return k.Auction.Set(ctx, ba.GetId(), ba)
+	if err := k.Auction.Set(ctx, ba.GetId(), ba); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRoundExtended{
+		AuctionId:     ba.GetId(),
+		ExtendedRound: uint32(len(endTimes) - 1),
+		EndTime:       nextEndTime,
+	})
+}
+
+// RecordAuctionResult stores the final matching result of the auction being closed, writes the matched price
+// back to the batch auction and emits the auction closed event.
+func (k Keeper) RecordAuctionResult(ctx context.Context, auction types.AuctionI, mInfo MatchingInfo) error {
+	sellingCoinDenom := auction.GetSellingCoin().Denom
+	payingCoinDenom := auction.GetPayingCoinDenom()
+
+	matchedPrice := mInfo.MatchedPrice
+	if matchedPrice.IsNil() {
+		matchedPrice = math.LegacyZeroDec()
+	}
+
+	result := types.AuctionResult{
+		AuctionId:         auction.GetId(),
+		MatchedPrice:      matchedPrice,
+		MatchedLen:        mInfo.MatchedLen,
+		SoldCoin:          sdk.NewCoin(sellingCoinDenom, mInfo.TotalMatchedAmount),
+		PaidCoin:          sdk.NewCoin(payingCoinDenom, math.ZeroInt()),
+		RefundedCoin:      sdk.NewCoin(payingCoinDenom, math.ZeroInt()),
+		BidderAllocations: []types.BidderAllocation{},
+		ClosedTime:        sdk.UnwrapSDKContext(ctx).BlockTime(),
+		VestedCoin:        sdk.NewCoin(payingCoinDenom, math.ZeroInt()),
+	}
+
+	// Sort bidders to reserve determinism
+	bidderSet := make(map[string]struct{})
+	for _, m := range []map[string]math.Int{mInfo.AllocationMap, mInfo.ReservedMatchedMap, mInfo.RefundMap} {
+		for bidder := range m {
+			bidderSet[bidder] = struct{}{}
+		}
+	}
+	bidders := make([]string, 0, len(bidderSet))
+	for bidder := range bidderSet {
+		bidders = append(bidders, bidder)
+	}
+	sort.Strings(bidders)
+
+	for _, bidder := range bidders {
+		allocation := types.BidderAllocation{
+			Bidder:        bidder,
+			AllocatedCoin: sdk.NewCoin(sellingCoinDenom, amountOf(mInfo.AllocationMap, bidder)),
+			PaidCoin:      sdk.NewCoin(payingCoinDenom, amountOf(mInfo.ReservedMatchedMap, bidder)),
+			RefundedCoin:  sdk.NewCoin(payingCoinDenom, amountOf(mInfo.RefundMap, bidder)),
+			PaidCoins:     mInfo.PaidCoinsMap[bidder],
+			RefundedCoins: mInfo.RefundCoinsMap[bidder],
+		}
+		result.PaidCoin = result.PaidCoin.Add(allocation.PaidCoin)
+		result.RefundedCoin = result.RefundedCoin.Add(allocation.RefundedCoin)
+		result.PaidCoins = result.PaidCoins.Add(allocation.PaidCoins...)
+		result.RefundedCoins = result.RefundedCoins.Add(allocation.RefundedCoins...)
+		result.BidderAllocations = append(result.BidderAllocations, allocation)
+	}
+
+	if ba, ok := auction.(*types.BatchAuction); ok {
+		ba.MatchedPrice = matchedPrice
+		if err := k.Auction.Set(ctx, ba.GetId(), ba); err != nil {
+			return err
+		}
+	}
+
+	if err := k.AuctionResult.Set(ctx, auction.GetId(), result); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAuctionClosed{
+		AuctionId:          auction.GetId(),
+		MatchedPrice:       matchedPrice,
+		MatchedLen:         mInfo.MatchedLen,
+		TotalMatchedAmount: mInfo.TotalMatchedAmount,
+	})
+}
+
+// amountOf returns the amount of the key in the map or zero if it doesn't exist.
+func amountOf(m map[string]math.Int, key string) math.Int {
+	if amt, ok := m[key]; ok {
+		return amt
+	}
+	return math.ZeroInt()
 }
 
 // CloseFixedPriceAuction closes a fixed price auction.
 func (k Keeper) CloseFixedPriceAuction(ctx context.Context, auction types.AuctionI) error {
-	mInfo, err := k.CalculateFixedPriceAllocation(ctx, auction)
+	fa, ok := auction.(*types.FixedPriceAuction)
+	if !ok {
+		return fmt.Errorf("unable to close auction that is not a fixed price auction: %T", auction)
+	}
+
+	var (
+		mInfo MatchingInfo
+		err   error
+	)
+	if fa.IsOverflow() {
+		mInfo, err = k.CalculateOverflowAllocation(ctx, auction)
+	} else {
+		mInfo, err = k.CalculateFixedPriceAllocation(ctx, auction)
+	}
 	if err != nil {
 		return err
 	}
 
+	if raisedAmt := mInfo.TotalPayingAmount(); raisedAmt.LT(auction.GetSoftCap()) {
+		return k.FailAuction(ctx, auction, raisedAmt)
+	}
+
+	// The selling coin of an overflow sale is allocated only now
+	if fa.IsOverflow() {
+		fa.RemainingSellingCoin = fa.SellingCoin.SubAmount(mInfo.TotalMatchedAmount)
+		if err := k.Auction.Set(ctx, fa.GetId(), fa); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RecordAuctionResult(ctx, auction, mInfo); err != nil {
+		return err
+	}
+
 	if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
 		return err
 	}
@@ -311,6 +561,10 @@ func (k Keeper) CloseFixedPriceAuction(ctx context.Context, auction types.Auctio
 		return err
 	}
 
+	if err := k.RefundPayingCoin(ctx, auction, mInfo); err != nil {
+		return err
+	}
+
 	if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
 		return err
 	}
@@ -325,6 +579,11 @@ func (k Keeper) CloseBatchAuction(ctx context.Context, auction types.AuctionI) e
 		return fmt.Errorf("unable to close auction that is not a batch auction: %T", auction)
 	}
 
+	// The sealed bids are matched only after they are revealed
+	if ba.IsSealedBid() {
+		return k.UpdateAuctionStatus(ctx, ba, types.AuctionStatusRevealing)
+	}
+
 	// Extend round since there is no last matched length to compare with
 	lastMatchedLen, err := k.GetLastMatchedBidsLen(ctx, ba.GetId())
 	if err != nil {
@@ -338,23 +597,7 @@ func (k Keeper) CloseBatchAuction(ctx context.Context, auction types.AuctionI) e
 	// Close the auction when maximum extended round + 1 is the same as the length of end times
 	// If the value of MaxExtendedRound is 0, it means that an auctioneer does not want have an extended round
 	if ba.MaxExtendedRound+1 == uint32(len(auction.GetEndTimes())) {
-		if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
-			return err
-		}
-
-		if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
-			return err
-		}
-
-		if err := k.RefundPayingCoin(ctx, auction, mInfo); err != nil {
-			return err
-		}
-
-		if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
-			return err
-		}
-
-		return nil
+		return k.SettleBatchAuction(ctx, auction, mInfo)
 	}
 
 	if lastMatchedLen == 0 {
@@ -372,6 +615,58 @@ func (k Keeper) CloseBatchAuction(ctx context.Context, auction types.AuctionI) e
 		return k.ExtendRound(ctx, ba)
 	}
 
+	return k.SettleBatchAuction(ctx, auction, mInfo)
+}
+
+// SettleBatchAuction allocates the selling coin to the matched bidders and refunds the rest of the coins
+// according to the matching information of the batch auction, and applies the vesting schedules.
+func (k Keeper) SettleBatchAuction(ctx context.Context, auction types.AuctionI, mInfo MatchingInfo) error {
+	if raisedAmt := mInfo.TotalPayingAmount(); raisedAmt.LT(auction.GetSoftCap()) {
+		return k.FailAuction(ctx, auction, raisedAmt)
+	}
+
+	if err := k.RecordAuctionResult(ctx, auction, mInfo); err != nil {
+		return err
+	}
+
+	if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
+		return err
+	}
+
+	if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
+		return err
+	}
+
+	if err := k.RefundPayingCoin(ctx, auction, mInfo); err != nil {
+		return err
+	}
+
+	if err := k.SettleEscrowedBidFees(ctx, auction); err != nil {
+		return err
+	}
+
+	if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CloseDutchAuction closes a dutch auction.
+func (k Keeper) CloseDutchAuction(ctx context.Context, auction types.AuctionI) error {
+	mInfo, err := k.CalculateDutchAllocation(ctx, auction)
+	if err != nil {
+		return err
+	}
+
+	if raisedAmt := mInfo.TotalPayingAmount(); raisedAmt.LT(auction.GetSoftCap()) {
+		return k.FailAuction(ctx, auction, raisedAmt)
+	}
+
+	if err := k.RecordAuctionResult(ctx, auction, mInfo); err != nil {
+		return err
+	}
+
 	if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
 		return err
 	}
@@ -404,6 +699,19 @@ func (k Keeper) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreat
 		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum number of vesting schedules")
 	}
 
+	if len(msg.BuyerVestingSchedules) > types.MaxNumVestingSchedules {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum number of buyer vesting schedules")
+	}
+
+	if err := k.ValidateAuctionDenoms(ctx, msg.SellingCoin.Denom, msg.PayingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	payingDenoms, err := k.ResolvePayingDenoms(ctx, msg.PayingCoinDenom, msg.AdditionalPayingDenoms)
+	if err != nil {
+		return nil, err
+	}
+
 	nextId, err := k.AuctionSeq.Next(ctx)
 	if err != nil {
 		return nil, err
@@ -414,7 +722,7 @@ func (k Keeper) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreat
 		return nil, err
 	}
 
-	if err := k.PayCreationFee(ctx, auctioneer); err != nil {
+	if err := k.PayCreationFee(ctx, nextId, auctioneer); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to pay auction creation fee")
 	}
 
@@ -436,6 +744,13 @@ func (k Keeper) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreat
 		msg.StartTime,
 		[]time.Time{msg.EndTime}, // it is an array data type to handle BatchAuction
 		types.AuctionStatusStandBy,
+		msg.BidFee,
+		msg.SoftCap,
+		msg.BuyerVestingSchedules,
+		msg.LinearVesting,
+		msg.AutoRelease,
+		msg.Beneficiary,
+		payingDenoms,
 	)
 
 	// Update status if the start time is already passed over the current time
@@ -443,7 +758,7 @@ func (k Keeper) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreat
 		_ = ba.SetStatus(types.AuctionStatusStarted)
 	}
 
-	auction := types.NewFixedPriceAuction(ba, msg.SellingCoin)
+	auction := types.NewFixedPriceAuction(ba, msg.SellingCoin, msg.SaleMode)
 
 	// Call hook before storing an auction
 	if err := k.BeforeFixedPriceAuctionCreated(
@@ -463,6 +778,10 @@ func (k Keeper) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreat
 		return nil, err
 	}
 
+	if err := k.ScheduleAuction(ctx, auction); err != nil {
+		return nil, err
+	}
+
 	// Call hook after storing an auction
 	if err := k.AfterFixedPriceAuctionCreated(
 		ctx,
@@ -493,6 +812,7 @@ func (k Keeper) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreat
 			sdk.NewAttribute(types.AttributeKeyStartTime, auction.GetStartTime().String()),
 			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
 			sdk.NewAttribute(types.AttributeKeyAuctionStatus, auction.GetStatus().String()),
+			sdk.NewAttribute(types.AttributeKeySaleMode, auction.SaleMode.String()),
 		),
 	})
 
@@ -512,6 +832,19 @@ func (k Keeper) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateBatc
 		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum number of vesting schedules")
 	}
 
+	if len(msg.BuyerVestingSchedules) > types.MaxNumVestingSchedules {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum number of buyer vesting schedules")
+	}
+
+	if err := k.ValidateAuctionDenoms(ctx, msg.SellingCoin.Denom, msg.PayingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	payingDenoms, err := k.ResolvePayingDenoms(ctx, msg.PayingCoinDenom, msg.AdditionalPayingDenoms)
+	if err != nil {
+		return nil, err
+	}
+
 	if msg.MaxExtendedRound > types.MaxExtendedRound {
 		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum extended round")
 	}
@@ -526,7 +859,7 @@ func (k Keeper) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateBatc
 		return nil, err
 	}
 
-	if err := k.PayCreationFee(ctx, auctioneer); err != nil {
+	if err := k.PayCreationFee(ctx, nextId, auctioneer); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to pay auction creation fee")
 	}
 
@@ -550,6 +883,13 @@ func (k Keeper) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateBatc
 		msg.StartTime,
 		endTimes,
 		types.AuctionStatusStandBy,
+		msg.BidFee,
+		msg.SoftCap,
+		msg.BuyerVestingSchedules,
+		msg.LinearVesting,
+		msg.AutoRelease,
+		msg.Beneficiary,
+		payingDenoms,
 	)
 
 	// Update status if the start time is already passed the current time
@@ -563,6 +903,10 @@ func (k Keeper) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateBatc
 		math.LegacyZeroDec(),
 		msg.MaxExtendedRound,
 		msg.ExtendedRoundRate,
+		msg.BidCancelCutoff,
+		msg.MarginalFillMode,
+		msg.PricingMode,
+		msg.SealedBidConfig,
 	)
 
 	// Call hook before storing an auction
@@ -586,6 +930,10 @@ func (k Keeper) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateBatc
 		return nil, err
 	}
 
+	if err := k.ScheduleAuction(ctx, auction); err != nil {
+		return nil, err
+	}
+
 	// Call hook after storing an auction
 	if err := k.AfterBatchAuctionCreated(
 		ctx,
@@ -621,61 +969,779 @@ func (k Keeper) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateBatc
 			sdk.NewAttribute(types.AttributeKeyMinBidPrice, auction.MinBidPrice.String()),
 			sdk.NewAttribute(types.AttributeKeyMaxExtendedRound, fmt.Sprint(auction.MaxExtendedRound)),
 			sdk.NewAttribute(types.AttributeKeyExtendedRoundRate, auction.ExtendedRoundRate.String()),
+			sdk.NewAttribute(types.AttributeKeyBidCancelCutoff, auction.BidCancelCutoff.String()),
+			sdk.NewAttribute(types.AttributeKeyMarginalFillMode, auction.MarginalFillMode.String()),
+			sdk.NewAttribute(types.AttributeKeyPricingMode, auction.PricingMode.String()),
+			sdk.NewAttribute(types.AttributeKeySealedBid, strconv.FormatBool(auction.IsSealedBid())),
 		),
 	})
 
 	return auction, nil
 }
 
-// CancelAuction handles types.MsgCancelAuction and cancels the auction.
-// An auction can only be canceled when it is not started yet.
-func (k Keeper) CancelAuction(ctx context.Context, msg *types.MsgCancelAuction) error {
-	auction, err := k.Auction.Get(ctx, msg.AuctionId)
-	if err != nil {
-		return err
+// CreateDutchAuction handles types.MsgCreateDutchAuction and create a dutch auction.
+// Note that the module is designed to delegate authorization to an external module to add allowed bidders for the auction.
+func (k Keeper) CreateDutchAuction(ctx context.Context, msg *types.MsgCreateDutchAuction) (types.AuctionI, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	if blockTime.After(msg.EndTime) { // EndTime < CurrentTime
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "end time must be set after the current time")
 	}
 
-	if auction.GetAuctioneer().String() != msg.Auctioneer {
-		return sdkerrors.Wrap(errors.ErrUnauthorized, "only the auctioneer can cancel the auction")
+	if len(msg.VestingSchedules) > types.MaxNumVestingSchedules {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum number of vesting schedules")
 	}
 
-	if auction.GetStatus() != types.AuctionStatusStandBy {
-		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the stand by auction can be cancelled")
+	if len(msg.BuyerVestingSchedules) > types.MaxNumVestingSchedules {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, "exceed maximum number of buyer vesting schedules")
 	}
 
-	sellingReserveAddr := auction.GetSellingReserveAddress()
-	sellingCoinDenom := auction.GetSellingCoin().Denom
-	spendableCoins := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
-	releaseCoin := sdk.NewCoin(sellingCoinDenom, spendableCoins.AmountOf(sellingCoinDenom))
+	if err := k.ValidateAuctionDenoms(ctx, msg.SellingCoin.Denom, msg.PayingCoinDenom); err != nil {
+		return nil, err
+	}
 
-	// Release the selling coin back to the auctioneer
-	if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetAuctioneer(), sdk.NewCoins(releaseCoin)); err != nil {
-		return sdkerrors.Wrap(err, "failed to release the selling coin")
+	payingDenoms, err := k.ResolvePayingDenoms(ctx, msg.PayingCoinDenom, msg.AdditionalPayingDenoms)
+	if err != nil {
+		return nil, err
 	}
 
-	// Call hook before cancelling the auction
-	if err := k.BeforeAuctionCanceled(ctx, msg.AuctionId, msg.Auctioneer); err != nil {
-		return err
+	nextId, err := k.AuctionSeq.Next(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if auction.GetType() == types.AuctionTypeFixedPrice {
-		fa := auction.(*types.FixedPriceAuction)
-		fa.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, math.ZeroInt())
-		auction = fa
+	auctioneer, err := sdk.AccAddressFromBech32(msg.GetAuctioneer())
+	if err != nil {
+		return nil, err
 	}
 
-	_ = auction.SetStatus(types.AuctionStatusCancelled)
-	if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
-		return err
+	if err := k.PayCreationFee(ctx, nextId, auctioneer); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to pay auction creation fee")
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelAuction,
-			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
-		),
-	})
+	if err := k.ReserveSellingCoin(ctx, nextId, auctioneer, msg.SellingCoin); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to reserve selling coin")
+	}
 
-	return nil
+	ba := types.NewBaseAuction(
+		nextId,
+		types.AuctionTypeDutch,
+		msg.Auctioneer,
+		types.SellingReserveAddress(nextId).String(),
+		types.PayingReserveAddress(nextId).String(),
+		msg.StartPrice,
+		msg.SellingCoin,
+		msg.PayingCoinDenom,
+		types.VestingReserveAddress(nextId).String(),
+		msg.VestingSchedules,
+		msg.StartTime,
+		[]time.Time{msg.EndTime},
+		types.AuctionStatusStandBy,
+		msg.BidFee,
+		msg.SoftCap,
+		msg.BuyerVestingSchedules,
+		msg.LinearVesting,
+		msg.AutoRelease,
+		msg.Beneficiary,
+		payingDenoms,
+	)
+
+	// Update status if the start time is already passed over the current time
+	if ba.ShouldAuctionStarted(blockTime) {
+		_ = ba.SetStatus(types.AuctionStatusStarted)
+	}
+
+	auction := types.NewDutchAuction(ba, msg.FloorPrice, msg.DecayInterval, msg.SellingCoin)
+
+	// Call hook before storing an auction
+	if err := k.BeforeDutchAuctionCreated(
+		ctx,
+		auction.Auctioneer,
+		auction.StartPrice,
+		auction.FloorPrice,
+		auction.SellingCoin,
+		auction.PayingCoinDenom,
+		auction.VestingSchedules,
+		auction.DecayInterval,
+		auction.StartTime,
+		auction.EndTimes[0],
+	); err != nil {
+		return nil, err
+	}
+
+	if err := k.Auction.Set(ctx, ba.GetId(), auction); err != nil {
+		return nil, err
+	}
+
+	if err := k.ScheduleAuction(ctx, auction); err != nil {
+		return nil, err
+	}
+
+	// Call hook after storing an auction
+	if err := k.AfterDutchAuctionCreated(
+		ctx,
+		auction.Id,
+		auction.Auctioneer,
+		auction.StartPrice,
+		auction.FloorPrice,
+		auction.SellingCoin,
+		auction.PayingCoinDenom,
+		auction.VestingSchedules,
+		auction.DecayInterval,
+		auction.StartTime,
+		auction.EndTimes[0],
+	); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDutchAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(nextId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctioneerAddress, auction.GetAuctioneer().String()),
+			sdk.NewAttribute(types.AttributeKeySellingReserveAddress, auction.GetSellingReserveAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyPayingReserveAddress, auction.GetPayingReserveAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyStartPrice, auction.GetStartPrice().String()),
+			sdk.NewAttribute(types.AttributeKeyFloorPrice, auction.FloorPrice.String()),
+			sdk.NewAttribute(types.AttributeKeySellingCoin, auction.GetSellingCoin().String()),
+			sdk.NewAttribute(types.AttributeKeyPayingCoinDenom, auction.GetPayingCoinDenom()),
+			sdk.NewAttribute(types.AttributeKeyVestingReserveAddress, auction.GetVestingReserveAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingSellingCoin, auction.RemainingSellingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayInterval, auction.DecayInterval.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, auction.GetStartTime().String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyAuctionStatus, auction.GetStatus().String()),
+		),
+	})
+
+	return auction, nil
+}
+
+// CancelAuction handles types.MsgCancelAuction and cancels the auction.
+// An auction can only be canceled when it is not started yet.
+func (k Keeper) CancelAuction(ctx context.Context, msg *types.MsgCancelAuction) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	if auction.GetAuctioneer().String() != msg.Auctioneer {
+		return sdkerrors.Wrap(errors.ErrUnauthorized, "only the auctioneer can cancel the auction")
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStandBy {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the stand by auction can be cancelled")
+	}
+
+	sellingReserveAddr := auction.GetSellingReserveAddress()
+	sellingCoinDenom := auction.GetSellingCoin().Denom
+	spendableCoins := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
+	releaseCoin := sdk.NewCoin(sellingCoinDenom, spendableCoins.AmountOf(sellingCoinDenom))
+
+	// Release the selling coin back to the beneficiary
+	if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetBeneficiary(), sdk.NewCoins(releaseCoin)); err != nil {
+		return sdkerrors.Wrap(err, "failed to release the selling coin")
+	}
+
+	// Call hook before cancelling the auction
+	if err := k.BeforeAuctionCanceled(ctx, msg.AuctionId, msg.Auctioneer); err != nil {
+		return err
+	}
+
+	switch a := auction.(type) {
+	case *types.FixedPriceAuction:
+		a.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, math.ZeroInt())
+	case *types.DutchAuction:
+		a.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, math.ZeroInt())
+	}
+
+	if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusCancelled); err != nil {
+		return err
+	}
+
+	// The stand by auction is queued to get started at its start time
+	if err := k.UnscheduleAuction(ctx, auction.GetStartTime(), auction.GetId()); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+		),
+	})
+
+	return nil
+}
+
+// authorizeAuctionOperator returns an error if the sender is neither the auctioneer of the auction
+// nor the module authority.
+func (k Keeper) authorizeAuctionOperator(auction types.AuctionI, sender, action string) error {
+	if auction.GetAuctioneer().String() != sender && k.authority != sender {
+		return sdkerrors.Wrapf(errors.ErrUnauthorized, "only the auctioneer or the authority can %s the auction", action)
+	}
+	return nil
+}
+
+// PauseAuction handles types.MsgPauseAuction and pauses the started auction.
+// No bid can be placed, modified or cancelled while the auction is paused.
+func (k Keeper) PauseAuction(ctx context.Context, msg *types.MsgPauseAuction) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.authorizeAuctionOperator(auction, msg.Sender, "pause"); err != nil {
+		return err
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the started auction can be paused")
+	}
+
+	// The started auction is queued to get closed at its last end time, and to retry
+	// its state transition if it has failed
+	if err := k.UnscheduleAuctionAll(ctx, auction.GetId()); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	if err := auction.SetPausedTime(&blockTime); err != nil {
+		return err
+	}
+
+	if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusPaused); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePauseAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return nil
+}
+
+// ResumeAuction handles types.MsgResumeAuction and resumes the paused auction.
+// The end time of the auction is postponed by the duration of the pause, and so is
+// the start time of a dutch auction so that its price doesn't decay during the pause.
+func (k Keeper) ResumeAuction(ctx context.Context, msg *types.MsgResumeAuction) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.authorizeAuctionOperator(auction, msg.Sender, "resume"); err != nil {
+		return err
+	}
+
+	if auction.GetStatus() != types.AuctionStatusPaused {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the paused auction can be resumed")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pausedDuration := time.Duration(0)
+	if pausedTime := auction.GetPausedTime(); pausedTime != nil {
+		pausedDuration = sdkCtx.BlockTime().Sub(*pausedTime)
+	}
+
+	endTimes := auction.GetEndTimes()
+	endTimes[len(endTimes)-1] = endTimes[len(endTimes)-1].Add(pausedDuration)
+	if err := auction.SetEndTimes(endTimes); err != nil {
+		return err
+	}
+
+	// The price of a dutch auction doesn't decay while it is paused
+	if _, ok := auction.(*types.DutchAuction); ok {
+		if err := auction.SetStartTime(auction.GetStartTime().Add(pausedDuration)); err != nil {
+			return err
+		}
+	}
+
+	// Vesting schedules are postponed as well so that they still come after the end time
+	schedules := auction.GetVestingSchedules()
+	for i := range schedules {
+		schedules[i].ReleaseTime = schedules[i].ReleaseTime.Add(pausedDuration)
+	}
+	if err := auction.SetVestingSchedules(schedules); err != nil {
+		return err
+	}
+
+	buyerSchedules := auction.GetBuyerVestingSchedules()
+	for i := range buyerSchedules {
+		buyerSchedules[i].ReleaseTime = buyerSchedules[i].ReleaseTime.Add(pausedDuration)
+	}
+	if err := auction.SetBuyerVestingSchedules(buyerSchedules); err != nil {
+		return err
+	}
+
+	if linearVesting := auction.GetLinearVesting(); linearVesting != nil {
+		linearVesting.StartTime = linearVesting.StartTime.Add(pausedDuration)
+		linearVesting.EndTime = linearVesting.EndTime.Add(pausedDuration)
+		if linearVesting.CliffTime != nil {
+			cliffTime := linearVesting.CliffTime.Add(pausedDuration)
+			linearVesting.CliffTime = &cliffTime
+		}
+	}
+
+	if err := auction.SetPausedTime(nil); err != nil {
+		return err
+	}
+
+	if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusStarted); err != nil {
+		return err
+	}
+
+	if err := k.ScheduleAuction(ctx, auction); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResumeAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return nil
+}
+
+// CloseAuctionEarly handles types.MsgCloseAuctionEarly and closes the started or paused auction
+// at the current block. The auction is settled the same way as it is at its end time, except that
+// a batch auction doesn't get any extended round.
+func (k Keeper) CloseAuctionEarly(ctx context.Context, msg *types.MsgCloseAuctionEarly) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.authorizeAuctionOperator(auction, msg.Sender, "close"); err != nil {
+		return err
+	}
+
+	endTimes := auction.GetEndTimes()
+	switch auction.GetStatus() {
+	case types.AuctionStatusStarted:
+		if err := k.UnscheduleAuction(ctx, endTimes[len(endTimes)-1], auction.GetId()); err != nil {
+			return err
+		}
+	case types.AuctionStatusPaused:
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the started or paused auction can be closed early")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endTimes[len(endTimes)-1] = sdkCtx.BlockTime()
+	if err := auction.SetEndTimes(endTimes); err != nil {
+		return err
+	}
+
+	if err := auction.SetPausedTime(nil); err != nil {
+		return err
+	}
+
+	if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+		return err
+	}
+
+	switch auction.GetType() {
+	case types.AuctionTypeFixedPrice:
+		err = k.CloseFixedPriceAuction(ctx, auction)
+	case types.AuctionTypeBatch:
+		if ba, ok := auction.(*types.BatchAuction); ok && ba.IsSealedBid() {
+			err = k.UpdateAuctionStatus(ctx, ba, types.AuctionStatusRevealing)
+			break
+		}
+		var mInfo MatchingInfo
+		mInfo, err = k.CalculateBatchAllocation(ctx, auction)
+		if err == nil {
+			err = k.SettleBatchAuction(ctx, auction, mInfo)
+		}
+	case types.AuctionTypeDutch:
+		err = k.CloseDutchAuction(ctx, auction)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The auction is queued again if it has a reveal phase or vesting schedules ahead
+	auction, err = k.Auction.Get(ctx, auction.GetId())
+	if err != nil {
+		return err
+	}
+	if err := k.ScheduleAuction(ctx, auction); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCloseAuctionEarly,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return nil
+}
+
+// ForceCancelAuction handles types.MsgForceCancelAuction and cancels the auction that is not finished yet.
+// The reserved paying coin is refunded to the bidders, the unsold selling coin is returned to the auctioneer and,
+// if the auction is vesting, the unreleased paying coin is returned to the matched bidders pro rata to their payments.
+func (k Keeper) ForceCancelAuction(ctx context.Context, msg *types.MsgForceCancelAuction) error {
+	if k.authority != msg.Authority {
+		return sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	previousStatus := auction.GetStatus()
+	switch previousStatus {
+	case types.AuctionStatusStandBy, types.AuctionStatusStarted, types.AuctionStatusPaused, types.AuctionStatusRevealing, types.AuctionStatusVesting:
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the auction that is not finished or cancelled can be force cancelled")
+	}
+
+	// The auction may be queued to retry its failed state transition as well as for the next one
+	if err := k.UnscheduleAuctionAll(ctx, auction.GetId()); err != nil {
+		return err
+	}
+	if err := k.FailedTransition.Remove(ctx, auction.GetId()); err != nil {
+		return err
+	}
+
+	var refunds []types.ForceCancelRefund
+
+	bidRefunds, err := k.refundBids(ctx, auction)
+	if err != nil {
+		return err
+	}
+	refunds = append(refunds, bidRefunds...)
+
+	commitmentRefunds, err := k.settleBidCommitments(ctx, auction, false)
+	if err != nil {
+		return err
+	}
+	refunds = append(refunds, commitmentRefunds...)
+
+	if previousStatus == types.AuctionStatusVesting {
+		vestingRefunds, err := k.refundUnreleasedVesting(ctx, auction)
+		if err != nil {
+			return err
+		}
+		refunds = append(refunds, vestingRefunds...)
+
+		buyerVestingRefunds, err := k.refundBuyerVesting(ctx, auction)
+		if err != nil {
+			return err
+		}
+		refunds = append(refunds, buyerVestingRefunds...)
+	}
+
+	releaseCoin, err := k.releaseSellingReserve(ctx, auction)
+	if err != nil {
+		return err
+	}
+	if releaseCoin.IsPositive() {
+		refunds = append(refunds, types.ForceCancelRefund{
+			Recipient: auction.GetBeneficiary().String(),
+			Source:    types.RefundSourceSellingReserve,
+			Coin:      releaseCoin,
+		})
+	}
+
+	// Call hook before cancelling the auction
+	if err := k.BeforeAuctionCanceled(ctx, auction.GetId(), auction.GetAuctioneer().String()); err != nil {
+		return err
+	}
+
+	if err := auction.SetPausedTime(nil); err != nil {
+		return err
+	}
+
+	if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusCancelled); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionForceCancelled{
+		AuctionId:      auction.GetId(),
+		PreviousStatus: previousStatus,
+		Refunds:        refunds,
+	}); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForceCancelAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+		),
+	})
+
+	return nil
+}
+
+// FailAuction fails the auction whose raised paying amount doesn't reach its soft cap.
+// All the bids are deleted and refunded to the bidders, and the selling coin is returned to the auctioneer.
+func (k Keeper) FailAuction(ctx context.Context, auction types.AuctionI, raisedAmt math.Int) error {
+	refunds, err := k.refundBids(ctx, auction)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, refund := range refunds {
+		if refund.Source != types.RefundSourcePayingReserve {
+			continue
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPayingCoinRefunded{
+			AuctionId: auction.GetId(),
+			Bidder:    refund.Recipient,
+			Coin:      refund.Coin,
+		}); err != nil {
+			return err
+		}
+	}
+
+	releaseCoin, err := k.releaseSellingReserve(ctx, auction)
+	if err != nil {
+		return err
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRemainingSellingCoinRefunded{
+		AuctionId:  auction.GetId(),
+		Auctioneer: auction.GetAuctioneer().String(),
+		Coin:       releaseCoin,
+	}); err != nil {
+		return err
+	}
+
+	if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusFailed); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionFailed{
+		AuctionId:    auction.GetId(),
+		SoftCap:      auction.GetSoftCap(),
+		RaisedAmount: raisedAmt,
+	})
+}
+
+// releaseSellingReserve sends all the selling coin left in the selling reserve account to the beneficiary
+// and sets the remaining selling coin of the auction to zero.
+func (k Keeper) releaseSellingReserve(ctx context.Context, auction types.AuctionI) (sdk.Coin, error) {
+	sellingReserveAddr := auction.GetSellingReserveAddress()
+	sellingCoinDenom := auction.GetSellingCoin().Denom
+	spendableCoins := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
+	releaseCoin := sdk.NewCoin(sellingCoinDenom, spendableCoins.AmountOf(sellingCoinDenom))
+	if releaseCoin.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetBeneficiary(), sdk.NewCoins(releaseCoin)); err != nil {
+			return sdk.Coin{}, sdkerrors.Wrap(err, "failed to release the selling coin")
+		}
+	}
+
+	switch a := auction.(type) {
+	case *types.FixedPriceAuction:
+		a.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, math.ZeroInt())
+	case *types.DutchAuction:
+		a.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, math.ZeroInt())
+	}
+	return releaseCoin, nil
+}
+
+// refundBids deletes all the bids of the auction and, unless the bids are already settled,
+// refunds the paying coin reserved for them to the bidders. The escrowed place bid fees are
+// refunded as well.
+func (k Keeper) refundBids(ctx context.Context, auction types.AuctionI) ([]types.ForceCancelRefund, error) {
+	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	settled := auction.GetStatus() == types.AuctionStatusVesting
+	reservedCoinsByBidder := make(map[string]sdk.Coins)
+	escrowedFeeByBidder := make(map[string]sdk.Coins)
+	for _, bid := range bids {
+		if !settled {
+			reservedCoinsByBidder[bid.Bidder] = reservedCoinsByBidder[bid.Bidder].Add(bid.ReservedPayingCoin(auction))
+		}
+		if !bid.EscrowedFee.IsZero() {
+			escrowedFeeByBidder[bid.Bidder] = escrowedFeeByBidder[bid.Bidder].Add(bid.EscrowedFee...)
+		}
+		if err := k.Bid.Remove(ctx, collections.Join(bid.AuctionId, bid.Id)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sort bidders to reserve determinism
+	bidders := make([]string, 0, len(reservedCoinsByBidder))
+	for bidder := range reservedCoinsByBidder {
+		bidders = append(bidders, bidder)
+	}
+	sort.Strings(bidders)
+
+	var refunds []types.ForceCancelRefund
+	for _, bidder := range bidders {
+		bidderAddr, err := sdk.AccAddressFromBech32(bidder)
+		if err != nil {
+			return nil, err
+		}
+		refundCoins := reservedCoinsByBidder[bidder]
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), bidderAddr, refundCoins); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to refund paying coin")
+		}
+		for _, refundCoin := range refundCoins {
+			refunds = append(refunds, types.ForceCancelRefund{
+				Recipient: bidder,
+				Source:    types.RefundSourcePayingReserve,
+				Coin:      refundCoin,
+			})
+		}
+	}
+
+	feeBidders := make([]string, 0, len(escrowedFeeByBidder))
+	for bidder := range escrowedFeeByBidder {
+		feeBidders = append(feeBidders, bidder)
+	}
+	sort.Strings(feeBidders)
+
+	for _, bidder := range feeBidders {
+		bidderAddr, err := sdk.AccAddressFromBech32(bidder)
+		if err != nil {
+			return nil, err
+		}
+		fee := escrowedFeeByBidder[bidder]
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddr, fee); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to refund place bid fee")
+		}
+		for _, coin := range fee {
+			refunds = append(refunds, types.ForceCancelRefund{
+				Recipient: bidder,
+				Source:    types.RefundSourceBidFeeEscrow,
+				Coin:      coin,
+			})
+		}
+	}
+	return refunds, nil
+}
+
+// refundBuyerVesting deletes the buyer vestings of the auction and returns the selling coin
+// that is not claimed by the bidders yet from the buyer vesting reserve account to the beneficiary.
+func (k Keeper) refundBuyerVesting(ctx context.Context, auction types.AuctionI) ([]types.ForceCancelRefund, error) {
+	buyerVestings, err := k.GetBuyerVestingsByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	unclaimedCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, math.ZeroInt())
+	for _, buyerVesting := range buyerVestings {
+		unclaimedCoin = unclaimedCoin.Add(buyerVesting.AllocatedCoin.Sub(buyerVesting.ClaimedCoin))
+
+		bidderAddr, err := sdk.AccAddressFromBech32(buyerVesting.Bidder)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.BuyerVesting.Remove(ctx, collections.Join(auction.GetId(), bidderAddr)); err != nil {
+			return nil, err
+		}
+	}
+	if !unclaimedCoin.IsPositive() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.BuyerVestingReserveAddress(auction.GetId()), auction.GetBeneficiary(), sdk.NewCoins(unclaimedCoin)); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to refund buyer vesting selling coin")
+	}
+	return []types.ForceCancelRefund{{
+		Recipient: auction.GetBeneficiary().String(),
+		Source:    types.RefundSourceBuyerVestingReserve,
+		Coin:      unclaimedCoin,
+	}}, nil
+}
+
+// refundUnreleasedVesting deletes the unreleased vesting queues of the auction and returns the paying coin
+// they hold to the matched bidders pro rata to the paying coin paid by each of them.
+// The remainder of the truncated shares goes to the last bidder in the order of the addresses.
+func (k Keeper) refundUnreleasedVesting(ctx context.Context, auction types.AuctionI) ([]types.ForceCancelRefund, error) {
+	result, err := k.AuctionResult.Get(ctx, auction.GetId())
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "auction result of auction %d", auction.GetId())
+	}
+
+	vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var unreleasedCoins sdk.Coins
+	for _, vestingQueue := range vestingQueues {
+		if vestingQueue.Released {
+			continue
+		}
+		unreleasedCoins = unreleasedCoins.Add(vestingQueue.RemainingPayingCoin())
+		if err := k.VestingQueue.Remove(ctx, collections.Join(vestingQueue.AuctionId, vestingQueue.Sequence)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Each paying denom is refunded pro rata to the amount of the denom paid by the bidders
+	var refunds []types.ForceCancelRefund
+	for _, unreleasedCoin := range unreleasedCoins {
+		denom := unreleasedCoin.Denom
+
+		totalPaidAmt := math.ZeroInt()
+		var (
+			allocations []types.BidderAllocation
+			paidAmts    []math.Int
+		)
+		for _, allocation := range result.BidderAllocations {
+			paidAmt := allocation.PaidCoins.AmountOf(denom)
+			// The results recorded before the paid coins were tracked only hold the paid coin
+			if allocation.PaidCoins.Empty() && allocation.PaidCoin.Denom == denom {
+				paidAmt = allocation.PaidCoin.Amount
+			}
+			if paidAmt.IsPositive() {
+				totalPaidAmt = totalPaidAmt.Add(paidAmt)
+				allocations = append(allocations, allocation)
+				paidAmts = append(paidAmts, paidAmt)
+			}
+		}
+		if totalPaidAmt.IsZero() {
+			continue
+		}
+
+		remainingAmt := unreleasedCoin.Amount
+		for i, allocation := range allocations {
+			refundAmt := unreleasedCoin.Amount.Mul(paidAmts[i]).Quo(totalPaidAmt)
+			if i == len(allocations)-1 {
+				refundAmt = remainingAmt
+			}
+			remainingAmt = remainingAmt.Sub(refundAmt)
+			if refundAmt.IsZero() {
+				continue
+			}
+
+			bidderAddr, err := sdk.AccAddressFromBech32(allocation.Bidder)
+			if err != nil {
+				return nil, err
+			}
+			refundCoin := sdk.NewCoin(denom, refundAmt)
+			if err := k.bankKeeper.SendCoins(ctx, auction.GetVestingReserveAddress(), bidderAddr, sdk.NewCoins(refundCoin)); err != nil {
+				return nil, sdkerrors.Wrap(err, "failed to refund vesting paying coin")
+			}
+			refunds = append(refunds, types.ForceCancelRefund{
+				Recipient: allocation.Bidder,
+				Source:    types.RefundSourceVestingReserve,
+				Coin:      refundCoin,
+			})
+		}
+	}
+	return refunds, nil
 }
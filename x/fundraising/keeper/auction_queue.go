@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// NextTransitionTime returns the time at which the next state transition of the auction is due.
+// It returns false if the auction doesn't have any state transition left.
+func (k Keeper) NextTransitionTime(ctx context.Context, auction types.AuctionI) (time.Time, bool, error) {
+	switch auction.GetStatus() {
+	case types.AuctionStatusStandBy:
+		return auction.GetStartTime(), true, nil
+
+	case types.AuctionStatusStarted:
+		endTimes := auction.GetEndTimes()
+		if len(endTimes) == 0 {
+			return time.Time{}, false, nil
+		}
+		return endTimes[len(endTimes)-1], true, nil
+
+	case types.AuctionStatusRevealing:
+		ba, ok := auction.(*types.BatchAuction)
+		if !ok || !ba.IsSealedBid() {
+			return time.Time{}, false, nil
+		}
+		return ba.RevealEndTime(), true, nil
+
+	case types.AuctionStatusVesting:
+		// The vested paying coin is claimed by the auctioneer unless it is released automatically
+		if !auction.GetAutoRelease() {
+			return time.Time{}, false, nil
+		}
+		vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		for _, vestingQueue := range vestingQueues {
+			if vestingQueue.Released {
+				continue
+			}
+			// The linear vesting is released at every release interval once the first release time has passed
+			if linearVesting := auction.GetLinearVesting(); linearVesting != nil {
+				return linearVesting.NextReleaseTime(sdk.UnwrapSDKContext(ctx).BlockTime()), true, nil
+			}
+			return vestingQueue.ReleaseTime, true, nil
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// ScheduleAuction stores the auction id in the auction queue at the time of its next state transition.
+func (k Keeper) ScheduleAuction(ctx context.Context, auction types.AuctionI) error {
+	t, ok, err := k.NextTransitionTime(ctx, auction)
+	if err != nil || !ok {
+		return err
+	}
+	return k.AuctionQueue.Set(ctx, collections.Join(t, auction.GetId()))
+}
+
+// UnscheduleAuction removes the auction id that is queued at the given time.
+func (k Keeper) UnscheduleAuction(ctx context.Context, t time.Time, auctionId uint64) error {
+	return k.AuctionQueue.Remove(ctx, collections.Join(t, auctionId))
+}
+
+// UnscheduleAuctionAll removes all the auction queue entries of the auction, including the one
+// queued to retry its failed state transition.
+func (k Keeper) UnscheduleAuctionAll(ctx context.Context, auctionId uint64) error {
+	var keys []collections.Pair[time.Time, uint64]
+	if err := k.AuctionQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K2() == auctionId {
+			keys = append(keys, key)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.AuctionQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DequeueDueAuctions removes all the auction queue entries that are due at the given time t
+// and returns the ids of the corresponding auctions, ordered by the scheduled time.
+func (k Keeper) DequeueDueAuctions(ctx context.Context, t time.Time) ([]uint64, error) {
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(t, uint64(math.MaxUint64)))
+
+	keys := make([]collections.Pair[time.Time, uint64], 0)
+	if err := k.AuctionQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool)
+	auctionIds := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		if err := k.AuctionQueue.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		if !seen[key.K2()] {
+			seen[key.K2()] = true
+			auctionIds = append(auctionIds, key.K2())
+		}
+	}
+	return auctionIds, nil
+}
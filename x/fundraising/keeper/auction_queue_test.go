@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	_ "github.com/stretchr/testify/suite"
+
+	testkeeper "github.com/tendermint/fundraising/testutil/keeper"
+	"github.com/tendermint/fundraising/testutil/sample"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (s *KeeperTestSuite) queuedAuctions() []collections.Pair[time.Time, uint64] {
+	iter, err := s.keeper.AuctionQueue.Iterate(s.ctx, nil)
+	s.Require().NoError(err)
+	keys, err := iter.Keys()
+	s.Require().NoError(err)
+	return keys
+}
+
+func (s *KeeperTestSuite) TestAuctionQueue() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime1 := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	releaseTime2 := types.MustParseRFC3339("2023-04-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime.AddDate(0, 0, -1))
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: releaseTime1, Weight: parseDec("0.5")},
+			{ReleaseTime: releaseTime2, Weight: parseDec("0.5")},
+		},
+		startTime,
+		endTime,
+		true,
+	)
+	s.enableAutoRelease(auction.Id)
+	s.Require().Equal(types.AuctionStatusStandBy, auction.GetStatus())
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{collections.Join(startTime, auction.Id)}, s.queuedAuctions())
+
+	// Nothing is due before the start time
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{collections.Join(startTime, auction.Id)}, s.queuedAuctions())
+
+	s.ctx = s.ctx.WithBlockTime(startTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{collections.Join(endTime, auction.Id)}, s.queuedAuctions())
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{collections.Join(releaseTime1, auction.Id)}, s.queuedAuctions())
+
+	s.ctx = s.ctx.WithBlockTime(releaseTime1)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{collections.Join(releaseTime2, auction.Id)}, s.queuedAuctions())
+
+	s.ctx = s.ctx.WithBlockTime(releaseTime2)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Empty(s.queuedAuctions())
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+}
+
+func (s *KeeperTestSuite) TestAuctionQueue_CancelAuction() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 1, 0),
+		time.Now().AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Len(s.queuedAuctions(), 1)
+
+	err := s.keeper.CancelAuction(s.ctx, &types.MsgCancelAuction{
+		Auctioneer: auction.Auctioneer,
+		AuctionId:  auction.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+}
+
+func BenchmarkBeginBlocker(b *testing.B) {
+	for _, numFinished := range []int{100, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("finished_auctions=%d", numFinished), func(b *testing.B) {
+			k, goCtx, _ := testkeeper.FundraisingKeeper(b)
+			now := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+			ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(now)
+
+			// Historical auctions that don't have any state transition left
+			for i := 0; i < numFinished; i++ {
+				auction := types.NewFixedPriceAuction(
+					&types.BaseAuction{
+						Id:         uint64(i + 1),
+						Type:       types.AuctionTypeFixedPrice,
+						Auctioneer: sample.Address(),
+						StartTime:  now.AddDate(0, -2, 0),
+						EndTimes:   []time.Time{now.AddDate(0, -1, 0)},
+						Status:     types.AuctionStatusFinished,
+					},
+					sdk.NewInt64Coin("denom1", 0),
+					types.SaleModeFirstCome,
+				)
+				require.NoError(b, k.Auction.Set(ctx, auction.Id, auction))
+				require.NoError(b, k.ScheduleAuction(ctx, auction))
+			}
+
+			// An auction that is yet to start
+			standByAuction := types.NewFixedPriceAuction(
+				&types.BaseAuction{
+					Id:         uint64(numFinished + 1),
+					Type:       types.AuctionTypeFixedPrice,
+					Auctioneer: sample.Address(),
+					StartTime:  now.AddDate(0, 1, 0),
+					EndTimes:   []time.Time{now.AddDate(0, 2, 0)},
+					Status:     types.AuctionStatusStandBy,
+				},
+				sdk.NewInt64Coin("denom1", 0),
+				types.SaleModeFirstCome,
+			)
+			require.NoError(b, k.Auction.Set(ctx, standByAuction.Id, standByAuction))
+			require.NoError(b, k.ScheduleAuction(ctx, standByAuction))
+
+			// Persist the state so that the iteration doesn't go through the uncommitted writes
+			ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := k.BeginBlocker(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -10,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	_ "github.com/stretchr/testify/suite"
 
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
 
@@ -243,7 +246,7 @@ func (s *KeeperTestSuite) TestFixedPriceAuction_ReleaseVestingPayingCoin() {
 	s.Require().NoError(err)
 
 	// Distribute paying coin
-	err = s.keeper.ReleaseVestingPayingCoin(s.ctx, auction)
+	_, err = s.keeper.ReleaseVestingPayingCoin(s.ctx, auction, auction.GetAuctioneer())
 	s.Require().NoError(err)
 
 	// First two vesting queues must be released
@@ -262,7 +265,8 @@ func (s *KeeperTestSuite) TestFixedPriceAuction_ReleaseVestingPayingCoin() {
 	s.ctx = s.ctx.WithBlockTime(vqs[3].GetReleaseTime().AddDate(0, 0, 1))
 	err = s.keeper.BeginBlocker(s.ctx)
 	s.Require().NoError(err)
-	s.Require().NoError(s.keeper.ReleaseVestingPayingCoin(s.ctx, auction))
+	_, err = s.keeper.ReleaseVestingPayingCoin(s.ctx, auction, auction.GetAuctioneer())
+	s.Require().NoError(err)
 
 	// All of the vesting queues must be released
 	vqByAuction, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.GetId())
@@ -392,6 +396,17 @@ func (s *KeeperTestSuite) TestBatchAuction_MaxNumVestingSchedules() {
 		math.LegacyMustNewDecFromStr("0.2"),
 		time.Now().AddDate(0, 6, 0),
 		time.Now().AddDate(0, 6, 0).AddDate(0, 1, 0),
+		0,
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		types.MarginalFillModeNone,
+		types.PricingModeUniform,
+		nil,
+		nil,
 	)
 
 	params, err := s.keeper.Params.Get(s.ctx)
@@ -438,6 +453,14 @@ func (s *KeeperTestSuite) TestFixedPriceAuction_MaxNumVestingSchedules() {
 		[]types.VestingSchedule{},
 		time.Now().AddDate(0, 0, -1),
 		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		nil,
+		types.SaleModeFirstCome,
 	)
 
 	params, err := s.keeper.Params.Get(s.ctx)
@@ -478,6 +501,14 @@ func (s *KeeperTestSuite) TestInvalidEndTime() {
 		[]types.VestingSchedule{},
 		types.MustParseRFC3339("2022-03-01T00:00:00Z"),
 		types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		nil,
+		types.SaleModeFirstCome,
 	)
 	s.fundAddr(s.addr(0), params.AuctionCreationFee.Add(fixedPriceAuction.SellingCoin))
 
@@ -495,6 +526,17 @@ func (s *KeeperTestSuite) TestInvalidEndTime() {
 		math.LegacyMustNewDecFromStr("0.2"),
 		types.MustParseRFC3339("2022-03-01T00:00:00Z"),
 		types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		0,
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		types.MarginalFillModeNone,
+		types.PricingModeUniform,
+		nil,
+		nil,
 	)
 	s.fundAddr(s.addr(1), params.AuctionCreationFee.Add(batchAuction.SellingCoin))
 
@@ -502,6 +544,71 @@ func (s *KeeperTestSuite) TestInvalidEndTime() {
 	s.Require().EqualError(err, sdkerrors.Wrap(errors.ErrInvalidRequest, "end time must be set after the current time").Error())
 }
 
+func (s *KeeperTestSuite) TestAuctionDenoms() {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.AllowedPayingDenoms = []string{"denom2"}
+	params.BlockedSellingDenoms = []string{"stake"}
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	startTime := s.ctx.BlockTime().AddDate(0, 0, 1)
+	endTime := startTime.AddDate(0, 1, 0)
+
+	fixedPriceAuction := types.NewMsgCreateFixedPriceAuction(
+		s.addr(0).String(),
+		parseDec("0.5"),
+		parseCoin("500_000_000stake"),
+		"denom2",
+		[]types.VestingSchedule{},
+		startTime,
+		endTime,
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		nil,
+		types.SaleModeFirstCome,
+	)
+	s.fundAddr(s.addr(0), params.AuctionCreationFee.Add(fixedPriceAuction.SellingCoin))
+
+	_, err = s.keeper.CreateFixedPriceAuction(s.ctx, fixedPriceAuction)
+	s.Require().ErrorIs(err, types.ErrDenomNotAllowed)
+
+	batchAuction := types.NewMsgCreateBatchAuction(
+		s.addr(1).String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("500_000_000denom1"),
+		"denom3",
+		[]types.VestingSchedule{},
+		1,
+		math.LegacyMustNewDecFromStr("0.2"),
+		startTime,
+		endTime,
+		0,
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		types.MarginalFillModeNone,
+		types.PricingModeUniform,
+		nil,
+		nil,
+	)
+	s.fundAddr(s.addr(1), params.AuctionCreationFee.Add(batchAuction.SellingCoin))
+
+	_, err = s.keeper.CreateBatchAuction(s.ctx, batchAuction)
+	s.Require().ErrorIs(err, types.ErrDenomNotAllowed)
+
+	batchAuction.PayingCoinDenom = "denom2"
+	_, err = s.keeper.CreateBatchAuction(s.ctx, batchAuction)
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestAddAllowedBidders() {
 	startedAuction := s.createFixedPriceAuction(
 		s.addr(0),
@@ -788,6 +895,19 @@ func (s *KeeperTestSuite) TestCloseFixedPriceAuction() {
 	vestingQueues, err := s.keeper.VestingQueues(s.ctx)
 	s.Require().NoError(err)
 	s.Require().Len(vestingQueues, len(a.GetVestingSchedules()))
+
+	result, err := s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("1"), result.MatchedPrice)
+	s.Require().Equal(int64(4), result.MatchedLen)
+	s.Require().Equal(parseCoin("1_000_000_000denom1"), result.SoldCoin)
+	s.Require().Equal(parseCoin("1_000_000_000denom2"), result.PaidCoin)
+	s.Require().Equal(parseCoin("0denom2"), result.RefundedCoin)
+	s.Require().Len(result.BidderAllocations, 4)
+	for _, allocation := range result.BidderAllocations {
+		s.Require().Equal(parseCoin("250_000_000denom1"), allocation.AllocatedCoin)
+		s.Require().Equal(parseCoin("250_000_000denom2"), allocation.PaidCoin)
+	}
 }
 
 func (s *KeeperTestSuite) TestCloseBatchAuction() {
@@ -828,6 +948,52 @@ func (s *KeeperTestSuite) TestCloseBatchAuction() {
 	vestingQueues, err := s.keeper.VestingQueues(s.ctx)
 	s.Require().NoError(err)
 	s.Require().Len(vestingQueues, len(a.GetVestingSchedules()))
+
+	// The matched price is written back to the auction
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("0.7"), a.(*types.BatchAuction).MatchedPrice)
+
+	result, err := s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("0.7"), result.MatchedPrice)
+	s.Require().Equal(int64(3), result.MatchedLen)
+	s.Require().Equal(parseCoin("500_000_000denom1"), result.SoldCoin)
+	s.Require().Equal(parseCoin("350_000_000denom2"), result.PaidCoin)
+	s.Require().Equal(parseCoin("60_000_000denom2"), result.RefundedCoin)
+	s.Require().Equal(parseCoins("350_000_000denom2"), result.PaidCoins)
+	s.Require().Equal(parseCoins("60_000_000denom2"), result.RefundedCoins)
+	s.Require().Equal(s.ctx.BlockTime().UTC(), result.ClosedTime.UTC())
+
+	expected := map[string]types.BidderAllocation{
+		s.addr(1).String(): {
+			Bidder:        s.addr(1).String(),
+			AllocatedCoin: parseCoin("200_000_000denom1"),
+			PaidCoin:      parseCoin("140_000_000denom2"),
+			RefundedCoin:  parseCoin("40_000_000denom2"),
+			PaidCoins:     parseCoins("140_000_000denom2"),
+			RefundedCoins: parseCoins("40_000_000denom2"),
+		},
+		s.addr(2).String(): {
+			Bidder:        s.addr(2).String(),
+			AllocatedCoin: parseCoin("200_000_000denom1"),
+			PaidCoin:      parseCoin("140_000_000denom2"),
+			RefundedCoin:  parseCoin("20_000_000denom2"),
+			PaidCoins:     parseCoins("140_000_000denom2"),
+			RefundedCoins: parseCoins("20_000_000denom2"),
+		},
+		s.addr(3).String(): {
+			Bidder:        s.addr(3).String(),
+			AllocatedCoin: parseCoin("100_000_000denom1"),
+			PaidCoin:      parseCoin("70_000_000denom2"),
+			RefundedCoin:  parseCoin("0denom2"),
+			PaidCoins:     parseCoins("70_000_000denom2"),
+		},
+	}
+	s.Require().Len(result.BidderAllocations, len(expected))
+	for _, allocation := range result.BidderAllocations {
+		s.Require().Equal(expected[allocation.Bidder], allocation)
+	}
 }
 
 func (s *KeeperTestSuite) TestCloseBatchAuction_ExtendRound() {
@@ -921,3 +1087,1385 @@ func (s *KeeperTestSuite) TestCloseBatchAuction_Valid() {
 	s.Require().NoError(err)
 	s.Require().Len(a.GetEndTimes(), 2)
 }
+
+func (s *KeeperTestSuite) TestCloseDutchAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-01-11T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(types.MustParseRFC3339("2023-01-06T00:00:00Z"))
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		startTime,
+		endTime,
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	// The bid is filled at the current price, not the price that the bidder bids with
+	bid := s.placeBidDutch(auction.Id, s.addr(1), parseDec("2.0"), parseCoin("150_000_000denom2"), true)
+	s.Require().True(bid.IsMatched)
+	s.Require().Equal(parseDec("1.5"), bid.Price)
+
+	s.ctx = s.ctx.WithBlockTime(types.MustParseRFC3339("2023-01-08T00:00:00Z"))
+	bid = s.placeBidDutch(auction.Id, s.addr(2), parseDec("1.3"), parseCoin("100_000_000denom1"), true)
+	s.Require().Equal(parseDec("1.3"), bid.Price)
+	s.Require().Equal(parseCoin("0denom2"), s.getBalance(s.addr(2), "denom2"))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("800_000_000denom1"), a.(*types.DutchAuction).RemainingSellingCoin)
+
+	mInfo, err := s.keeper.CalculateDutchAllocation(s.ctx, a)
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("1.3"), mInfo.MatchedPrice)
+	s.Require().Equal(parseInt("200_000_000"), mInfo.TotalMatchedAmount)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.ExecuteStartedStatus(s.ctx, a))
+
+	s.Require().Equal(parseCoin("100_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("100_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("800_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().Equal(parseCoin("280_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+}
+
+func (s *KeeperTestSuite) TestCloseDutchAuction_RefundExcessPayingCoin() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-01-11T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(types.MustParseRFC3339("2023-01-06T00:00:00Z"))
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		startTime,
+		endTime,
+		true,
+	)
+
+	// 100_000_000denom2 buys 66_666_666denom1 at the price of 1.5, which costs 99_999_999denom2
+	bid := s.placeBidDutch(auction.Id, s.addr(1), parseDec("2.0"), parseCoin("100_000_000denom2"), true)
+	s.Require().Equal(parseDec("1.5"), bid.Price)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.ExecuteStartedStatus(s.ctx, a))
+
+	s.Require().Equal(parseCoin("66_666_666denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("1denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("99_999_999denom2"), s.getBalance(s.addr(0), "denom2"))
+}
+
+func (s *KeeperTestSuite) TestPauseAndResumeAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: releaseTime, Weight: math.LegacyOneDec()},
+		},
+		startTime,
+		endTime,
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	// Unauthorized
+	err := s.keeper.PauseAuction(s.ctx, types.NewMsgPauseAuction(s.addr(1).String(), auction.Id))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	// Only the paused auction can be resumed
+	err = s.keeper.ResumeAuction(s.ctx, types.NewMsgResumeAuction(auction.Auctioneer, auction.Id))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+
+	pausedTime := startTime.AddDate(0, 0, 10)
+	s.ctx = s.ctx.WithBlockTime(pausedTime)
+	err = s.keeper.PauseAuction(s.ctx, types.NewMsgPauseAuction(auction.Auctioneer, auction.Id))
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusPaused, a.GetStatus())
+	s.Require().Equal(pausedTime, a.GetPausedTime().UTC())
+
+	// No bid is accepted while the auction is paused
+	s.fundAddr(s.addr(1), parseCoins("1_000_000denom2"))
+	_, err = s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(
+		auction.Id, s.addr(1).String(), types.BidTypeFixedPrice, parseDec("1"), parseCoin("1_000_000denom2"),
+		"",
+	))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+
+	// The auction isn't closed at its end time while it is paused
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The authority can resume the auction as well
+	resumedTime := pausedTime.AddDate(0, 0, 5)
+	s.ctx = s.ctx.WithBlockTime(resumedTime)
+	err = s.keeper.ResumeAuction(s.ctx, types.NewMsgResumeAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStarted, a.GetStatus())
+	s.Require().Nil(a.GetPausedTime())
+
+	// The end time and the vesting schedules are postponed by the paused duration
+	newEndTime := endTime.AddDate(0, 0, 5)
+	s.Require().Equal(newEndTime, a.GetEndTimes()[0].UTC())
+	s.Require().Equal(releaseTime.AddDate(0, 0, 5), a.GetVestingSchedules()[0].ReleaseTime.UTC())
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{collections.Join(newEndTime, auction.Id)}, s.queuedAuctions())
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), false)
+}
+
+func (s *KeeperTestSuite) TestPauseAuction_RemovesRetry() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		startTime,
+		endTime,
+		true,
+	)
+
+	// The auction is queued to retry a failed state transition besides its end time
+	err := s.keeper.RecordFailedTransition(s.ctx, auction.Id, fmt.Errorf("failure"))
+	s.Require().NoError(err)
+	s.Require().Len(s.queuedAuctions(), 2)
+
+	err = s.keeper.PauseAuction(s.ctx, types.NewMsgPauseAuction(auction.Auctioneer, auction.Id))
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+}
+
+func (s *KeeperTestSuite) TestPauseAndResumeDutchAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-01-11T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		startTime,
+		endTime,
+		true,
+	)
+
+	pausedTime := startTime.AddDate(0, 0, 5)
+	s.ctx = s.ctx.WithBlockTime(pausedTime)
+	s.Require().Equal(parseDec("1.5"), auction.CurrentPrice(pausedTime))
+
+	err := s.keeper.PauseAuction(s.ctx, types.NewMsgPauseAuction(auction.Auctioneer, auction.Id))
+	s.Require().NoError(err)
+
+	resumedTime := pausedTime.AddDate(0, 0, 3)
+	s.ctx = s.ctx.WithBlockTime(resumedTime)
+	err = s.keeper.ResumeAuction(s.ctx, types.NewMsgResumeAuction(auction.Auctioneer, auction.Id))
+	s.Require().NoError(err)
+
+	// The price continues from where it was paused and keeps decaying at the same rate
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	da := a.(*types.DutchAuction)
+	s.Require().Equal(parseDec("1.5"), da.CurrentPrice(resumedTime))
+	s.Require().Equal(parseDec("1.3"), da.CurrentPrice(resumedTime.AddDate(0, 0, 2)))
+	s.Require().Equal(endTime.AddDate(0, 0, 3), da.GetEndTimes()[0].UTC())
+
+	bid := s.placeBidDutch(auction.Id, s.addr(1), parseDec("2.0"), parseCoin("150_000_000denom2"), true)
+	s.Require().Equal(parseDec("1.5"), bid.Price)
+}
+
+func (s *KeeperTestSuite) TestCloseAuctionEarly_FixedPriceAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("250_000_000denom1"), true)
+
+	// Unauthorized
+	err := s.keeper.CloseAuctionEarly(s.ctx, types.NewMsgCloseAuctionEarly(s.addr(1).String(), auction.Id))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	closedTime := startTime.AddDate(0, 0, 3)
+	s.ctx = s.ctx.WithBlockTime(closedTime)
+	err = s.keeper.CloseAuctionEarly(s.ctx, types.NewMsgCloseAuctionEarly(auction.Auctioneer, auction.Id))
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(closedTime, a.GetEndTimes()[0].UTC())
+
+	s.Require().Equal(parseCoin("250_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("750_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().Equal(parseCoin("250_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+
+	result, err := s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(closedTime, result.ClosedTime.UTC())
+
+	// The closed auction can't be closed again
+	err = s.keeper.CloseAuctionEarly(s.ctx, types.NewMsgCloseAuctionEarly(auction.Auctioneer, auction.Id))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
+
+func (s *KeeperTestSuite) TestCloseAuctionEarly_PausedBatchAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	// The batch auction is settled right away even though it has extended rounds left
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("10_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		uint32(5),
+		parseDec("0.2"),
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.9"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+
+	err := s.keeper.PauseAuction(s.ctx, types.NewMsgPauseAuction(auction.Auctioneer, auction.Id))
+	s.Require().NoError(err)
+
+	closedTime := startTime.AddDate(0, 0, 3)
+	s.ctx = s.ctx.WithBlockTime(closedTime)
+	err = s.keeper.CloseAuctionEarly(s.ctx, types.NewMsgCloseAuctionEarly(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Len(a.GetEndTimes(), 1)
+	s.Require().Nil(a.GetPausedTime())
+	s.Require().Equal(parseDec("0.8"), a.(*types.BatchAuction).MatchedPrice)
+
+	s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("320_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+}
+
+func (s *KeeperTestSuite) TestForceCancelAuction_StartedAuction() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("50_000_000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(2), parseDec("1"), parseCoin("200_000_000denom2"), true)
+
+	// Only the authority can force cancel the auction
+	err := s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(auction.Auctioneer, auction.Id))
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusCancelled, a.GetStatus())
+	s.Require().Empty(s.queuedAuctions())
+
+	bids, err := s.keeper.GetBidsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(bids)
+
+	s.Require().Equal(parseCoin("150_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("200_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().Equal(parseCoin("1_000_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().True(s.getBalance(a.GetPayingReserveAddress(), "denom2").IsZero())
+	s.Require().True(s.getBalance(a.GetSellingReserveAddress(), "denom1").IsZero())
+
+	events := s.typedEvents(&types.EventAuctionForceCancelled{})
+	s.Require().Len(events, 1)
+	event := events[0].(*types.EventAuctionForceCancelled)
+	s.Require().Equal(types.AuctionStatusStarted, event.PreviousStatus)
+	s.Require().ElementsMatch([]types.ForceCancelRefund{
+		{Recipient: s.addr(1).String(), Source: types.RefundSourcePayingReserve, Coin: parseCoin("150_000_000denom2")},
+		{Recipient: s.addr(2).String(), Source: types.RefundSourcePayingReserve, Coin: parseCoin("200_000_000denom2")},
+		{Recipient: s.addr(0).String(), Source: types.RefundSourceSellingReserve, Coin: parseCoin("1_000_000_000denom1")},
+	}, event.Refunds)
+
+	// The cancelled auction can't be cancelled again
+	err = s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
+
+func (s *KeeperTestSuite) TestForceCancelAuction_VestingAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime1 := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	releaseTime2 := types.MustParseRFC3339("2023-04-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: releaseTime1, Weight: parseDec("0.5")},
+			{ReleaseTime: releaseTime2, Weight: parseDec("0.5")},
+		},
+		0,
+		parseDec("0.2"),
+		startTime,
+		endTime,
+		true,
+	)
+	s.enableAutoRelease(auction.Id)
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.9"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("600_000_000denom1"), math.NewInt(1_000_000_000), true)
+
+	// The bids are matched at 0.8, and the first half of the paying coin is released to the auctioneer
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.ctx = s.ctx.WithBlockTime(releaseTime1)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal(parseCoin("320_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusVesting, a.GetStatus())
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+
+	// The unreleased paying coin is returned pro rata to the payments of 160_000_000 and 480_000_000,
+	// on top of the 20_000_000 already refunded to addr(1) at the end of the auction
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("240_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(a.GetVestingReserveAddress(), "denom2").IsZero())
+
+	vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Len(vestingQueues, 1)
+	s.Require().True(vestingQueues[0].Released)
+
+	events := s.typedEvents(&types.EventAuctionForceCancelled{})
+	s.Require().Len(events, 1)
+	s.Require().ElementsMatch([]types.ForceCancelRefund{
+		{Recipient: s.addr(1).String(), Source: types.RefundSourceVestingReserve, Coin: parseCoin("80_000_000denom2")},
+		{Recipient: s.addr(2).String(), Source: types.RefundSourceVestingReserve, Coin: parseCoin("240_000_000denom2")},
+	}, events[0].(*types.EventAuctionForceCancelled).Refunds)
+}
+
+func (s *KeeperTestSuite) TestForceCancelAuction_BuyerVesting() {
+	endTime := time.Now().AddDate(0, 1, 0)
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: endTime.AddDate(0, 3, 0), Weight: parseDec("1")},
+		},
+		time.Now().AddDate(0, 0, -1),
+		endTime,
+		true,
+	)
+	buyerSchedules := []types.VestingSchedule{
+		{ReleaseTime: endTime.AddDate(0, 1, 0), Weight: parseDec("0.25")},
+		{ReleaseTime: endTime.AddDate(0, 2, 0), Weight: parseDec("0.75")},
+	}
+	s.Require().NoError(auction.SetBuyerVestingSchedules(buyerSchedules))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("200_000_000denom1"), true)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusVesting, a.GetStatus())
+
+	// The bidder claims the first quarter before the auction is cancelled
+	s.ctx = s.ctx.WithBlockTime(buyerSchedules[0].ReleaseTime)
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, types.NewMsgClaimBuyerVesting(auction.Id, s.addr(1).String()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("50_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+
+	// The unclaimed selling coin goes back to the beneficiary along with the unsold one
+	s.Require().True(s.getBalance(types.BuyerVestingReserveAddress(auction.Id), "denom1").IsZero())
+	s.Require().Equal(parseCoin("950_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+
+	buyerVestings, err := s.keeper.GetBuyerVestingsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(buyerVestings)
+
+	events := s.typedEvents(&types.EventAuctionForceCancelled{})
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].(*types.EventAuctionForceCancelled).Refunds, types.ForceCancelRefund{
+		Recipient: s.addr(0).String(),
+		Source:    types.RefundSourceBuyerVestingReserve,
+		Coin:      parseCoin("150_000_000denom1"),
+	})
+
+	// The cancelled auction rejects the claims
+	s.ctx = s.ctx.WithBlockTime(buyerSchedules[1].ReleaseTime)
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, types.NewMsgClaimBuyerVesting(auction.Id, s.addr(1).String()))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+	s.Require().Equal(parseCoin("50_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+}
+
+func (s *KeeperTestSuite) TestForceCancelAuction_FailingAuction() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+
+	// Drain the selling reserve so that the closing of the auction keeps failing
+	drained := sdk.NewCoins(parseCoin("1_000_000_000denom1"))
+	err := s.app.BankKeeper.SendCoins(s.ctx, auction.GetSellingReserveAddress(), s.addr(9), drained)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.queuedAuctions(), 1)
+
+	err = s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+
+	// Neither the retry of the failed transition nor its record is left behind
+	s.Require().Empty(s.queuedAuctions())
+	_, err = s.keeper.FailedTransition.Get(s.ctx, auction.Id)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+	s.Require().Equal(parseCoin("1_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+}
+
+func (s *KeeperTestSuite) TestSettlementFee() {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.SettlementFeeRate = parseDec("0.1")
+	params.FeeTreasuryRate = parseDec("0.3")
+	params.FeeBurnRate = parseDec("0.2")
+	params.FeeTreasuryAddress = s.addr(9).String()
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000_000denom1"), true)
+
+	supplyBefore := s.app.BankKeeper.GetSupply(s.ctx, "denom2")
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
+
+	// 10% of the raised 1_000_000_000denom2 is taken as the settlement fee
+	s.Require().Equal(parseCoin("900_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+	s.Require().Equal(parseCoin("30_000_000denom2"), s.getBalance(s.addr(9), "denom2"))
+	s.Require().Equal(supplyBefore.SubAmount(math.NewInt(20_000_000)), s.app.BankKeeper.GetSupply(s.ctx, "denom2"))
+
+	events := s.typedEvents(&types.EventFeeCollected{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventFeeCollected{
+		AuctionId:     auction.Id,
+		Payer:         auction.GetPayingReserveAddress().String(),
+		FeeType:       types.FeeTypeSettlement,
+		CommunityPool: parseCoins("50_000_000denom2"),
+		Treasury:      parseCoins("30_000_000denom2"),
+		Burned:        parseCoins("20_000_000denom2"),
+	}, events[0])
+
+	released := s.typedEvents(&types.EventPayingCoinReleased{})
+	s.Require().Len(released, 1)
+	s.Require().Equal(parseCoin("900_000_000denom2"), released[0].(*types.EventPayingCoinReleased).PayingCoin)
+
+	fees, err := s.keeper.CollectedFees.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(params.AuctionCreationFee, fees.CreationFee)
+	s.Require().Equal(parseCoins("100_000_000denom2"), fees.SettlementFee)
+}
+
+func (s *KeeperTestSuite) TestAuctioneerBidFee() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	auction.SetBidFee(parseCoins("1_000denom3"))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	// The bidder can't place a bid without the bid fee
+	s.Require().NoError(s.addAllowedBidder(auction.Id, s.addr(1), math.NewInt(100_000_000)))
+	s.fundAddr(s.addr(1), parseCoins("100_000_000denom2"))
+	_, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeFixedPrice,
+		Price:     parseDec("1"),
+		Coin:      parseCoin("100_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, errors.ErrInsufficientFunds)
+
+	s.fundAddr(s.addr(1), parseCoins("1_000denom3"))
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom2"), false)
+	s.Require().Equal(parseCoin("1_000denom3"), s.getBalance(s.addr(0), "denom3"))
+	s.Require().True(s.getBalance(s.addr(1), "denom3").IsZero())
+
+	resp, err := keeper.NewQueryServerImpl(s.keeper).CollectedFees(s.ctx, &types.QueryCollectedFeesRequest{AuctionId: auction.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("1_000denom3"), resp.CollectedFees.AuctioneerBidFee)
+	s.Require().True(resp.CollectedFees.SettlementFee.IsZero())
+}
+
+func (s *KeeperTestSuite) TestRefundUnmatchedBidFee() {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.PlaceBidFee = parseCoins("1_000stake")
+	params.RefundUnmatchedBidFee = true
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("500_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		math.LegacyMustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+
+	for i := 1; i <= 4; i++ {
+		s.fundAddr(s.addr(i), parseCoins("1_000stake"))
+	}
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.9"), parseCoin("300_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+	bid := s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.5"), parseCoin("100_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.Require().Equal(parseCoins("1_000stake"), bid.EscrowedFee)
+
+	// The fee of a canceled bid is not refunded
+	bid = s.placeBidBatchMany(auction.Id, s.addr(4), parseDec("0.6"), parseCoin("100_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.Require().NoError(s.keeper.CancelBid(s.ctx, types.NewMsgCancelBid(auction.Id, s.addr(4).String(), bid.Id)))
+
+	moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(parseCoin("3_000stake"), s.getBalance(moduleAddr, "stake"))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, a))
+
+	s.Require().True(s.getBalance(moduleAddr, "stake").IsZero())
+	s.Require().True(s.getBalance(s.addr(1), "stake").IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "stake").IsZero())
+	s.Require().Equal(parseCoin("1_000stake"), s.getBalance(s.addr(3), "stake"))
+	s.Require().True(s.getBalance(s.addr(4), "stake").IsZero())
+
+	events := s.typedEvents(&types.EventBidFeeRefunded{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventBidFeeRefunded{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(3).String(),
+		Fee:       parseCoins("1_000stake"),
+	}, events[0])
+
+	fees, err := s.keeper.CollectedFees.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("3_000stake"), fees.PlaceBidFee)
+
+	bids, err := s.keeper.GetBidsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	for _, bid := range bids {
+		s.Require().True(bid.EscrowedFee.IsZero())
+	}
+}
+
+func (s *KeeperTestSuite) TestSoftCap_BatchAuctionFailed() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("500_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: time.Now().AddDate(1, 0, 0), Weight: math.LegacyOneDec()},
+		},
+		0,
+		math.LegacyMustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.Require().NoError(auction.SetSoftCap(math.NewInt(400_000_000)))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.6"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, a))
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFailed, a.GetStatus())
+
+	// All the coins are returned and nothing is vested
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().True(s.getBalance(s.addr(0), "denom2").IsZero())
+	s.Require().Equal(parseCoin("50_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("120_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	s.Require().True(s.getBalance(a.GetPayingReserveAddress(), "denom2").IsZero())
+	s.Require().True(s.getBalance(a.GetVestingReserveAddress(), "denom2").IsZero())
+
+	vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(vestingQueues)
+	bids, err := s.keeper.GetBidsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(bids)
+	_, err = s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+	_, ok, err := s.keeper.NextTransitionTime(s.ctx, a)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	events := s.typedEvents(&types.EventAuctionFailed{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventAuctionFailed{
+		AuctionId:    auction.Id,
+		SoftCap:      math.NewInt(400_000_000),
+		RaisedAmount: math.NewInt(150_000_000),
+	}, events[0])
+	s.Require().Len(s.typedEvents(&types.EventPayingCoinRefunded{}), 2)
+}
+
+func (s *KeeperTestSuite) TestSoftCap_FixedPriceAuctionReached() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.Require().NoError(auction.SetSoftCap(math.NewInt(250_000_000)))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("250_000_000denom1"), true)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(parseCoin("250_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+	s.Require().Equal(parseCoin("250_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+}
+
+func (s *KeeperTestSuite) TestBuyerVesting() {
+	endTime := time.Now().AddDate(0, 1, 0)
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		endTime,
+		true,
+	)
+	buyerSchedules := []types.VestingSchedule{
+		{ReleaseTime: endTime.AddDate(0, 1, 0), Weight: parseDec("0.25")},
+		{ReleaseTime: endTime.AddDate(0, 2, 0), Weight: parseDec("0.75")},
+	}
+	s.Require().NoError(auction.SetBuyerVestingSchedules(buyerSchedules))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("200_000_000denom1"), true)
+
+	msg := types.NewMsgClaimBuyerVesting(auction.Id, s.addr(1).String())
+	_, err := s.msgServer.ClaimBuyerVesting(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
+
+	// The allocated selling coin is locked in the buyer vesting reserve account
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(types.BuyerVestingReserveAddress(auction.Id), "denom1"))
+
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// The first schedule unlocks a quarter of the allocated coin
+	s.ctx = s.ctx.WithBlockTime(buyerSchedules[0].ReleaseTime)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("50_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	events := s.typedEvents(&types.EventBuyerVestingClaimed{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventBuyerVestingClaimed{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		Coin:      parseCoin("50_000_000denom1"),
+	}, events[0])
+
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	resp, err := keeper.NewQueryServerImpl(s.keeper).BuyerVesting(s.ctx, &types.QueryBuyerVestingRequest{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("150_000_000denom1"), resp.LockedCoin)
+	s.Require().Equal(parseCoin("50_000_000denom1"), resp.UnlockedCoin)
+	s.Require().True(resp.ClaimableCoin.IsZero())
+
+	// The last schedule unlocks all the rest
+	s.ctx = s.ctx.WithBlockTime(buyerSchedules[1].ReleaseTime)
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().True(s.getBalance(types.BuyerVestingReserveAddress(auction.Id), "denom1").IsZero())
+
+	// Bidders without allocation have nothing to claim
+	_, err = s.msgServer.ClaimBuyerVesting(s.ctx, types.NewMsgClaimBuyerVesting(auction.Id, s.addr(2).String()))
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestLinearVesting() {
+	endTime := time.Now().AddDate(0, 1, 0)
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		endTime,
+		true,
+	)
+	cliffTime := endTime.AddDate(0, 0, 25)
+	linearVesting := &types.LinearVesting{
+		StartTime: endTime,
+		EndTime:   endTime.AddDate(0, 0, 100),
+		CliffTime: &cliffTime,
+	}
+	s.Require().NoError(auction.SetLinearVesting(linearVesting))
+	s.Require().NoError(auction.SetAutoRelease(true))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom1"), true)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
+
+	// A single vesting queue tracks the whole linear vesting
+	vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Len(vestingQueues, 1)
+	s.Require().Equal(parseCoin("100_000_000denom2"), vestingQueues[0].PayingCoin)
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusVesting, a.GetStatus())
+	next, ok, err := s.keeper.NextTransitionTime(s.ctx, a)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().True(next.Equal(cliffTime))
+
+	for _, tc := range []struct {
+		blockTime time.Time
+		released  sdk.Coin
+	}{
+		{endTime.AddDate(0, 0, 10), parseCoin("0denom2")},
+		{cliffTime, parseCoin("25_000_000denom2")},
+		{endTime.AddDate(0, 0, 50), parseCoin("50_000_000denom2")},
+		{endTime.AddDate(0, 0, 101), parseCoin("100_000_000denom2")},
+	} {
+		s.ctx = s.ctx.WithBlockTime(tc.blockTime)
+		a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+		s.Require().NoError(err)
+		_, err = s.keeper.ReleaseVestingPayingCoin(s.ctx, a, a.GetAuctioneer())
+		s.Require().NoError(err)
+		s.Require().Equal(tc.released, s.getBalance(s.addr(0), "denom2"))
+
+		_, broken := keeper.VestingPoolReserveAmountInvariant(s.keeper)(s.ctx)
+		s.Require().False(broken)
+
+		// The auction is queued by the next release interval rather than at every block
+		a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+		s.Require().NoError(err)
+		if a.GetStatus() == types.AuctionStatusVesting && !tc.blockTime.Before(cliffTime) {
+			next, ok, err := s.keeper.NextTransitionTime(s.ctx, a)
+			s.Require().NoError(err)
+			s.Require().True(ok)
+			s.Require().True(next.Equal(tc.blockTime.Add(types.LinearVestingReleaseInterval)))
+		}
+	}
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	_, ok, err = s.keeper.NextTransitionTime(s.ctx, a)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+}
+
+func (s *KeeperTestSuite) TestClaimVestedProceeds() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime1 := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	releaseTime2 := types.MustParseRFC3339("2023-04-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: releaseTime1, Weight: parseDec("0.5")},
+			{ReleaseTime: releaseTime2, Weight: parseDec("0.5")},
+		},
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The begin blocker doesn't release the vested paying coin by default
+	s.ctx = s.ctx.WithBlockTime(releaseTime1)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().True(s.getBalance(s.addr(0), "denom2").IsZero())
+
+	// Only the auctioneer can claim
+	_, err := s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(1).String(), auction.Id, ""))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	// The matured vesting queue is released to the beneficiary
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(0).String(), auction.Id, s.addr(2).String()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("50_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	events := s.typedEvents(&types.EventPayingCoinReleased{})
+	s.Require().Len(events, 1)
+	released := events[0].(*types.EventPayingCoinReleased)
+	s.Require().Equal(s.addr(0).String(), released.Auctioneer)
+	s.Require().Equal(s.addr(2).String(), released.Recipient)
+
+	_, err = s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(0).String(), auction.Id, ""))
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// The last claim finishes the auction
+	s.ctx = s.ctx.WithBlockTime(releaseTime2)
+	_, err = s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(0).String(), auction.Id, ""))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("50_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+
+	_, err = s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(0).String(), auction.Id, ""))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
+
+func (s *KeeperTestSuite) TestBeneficiary() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.Require().NoError(auction.SetBeneficiary(s.addr(2)))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("250_000_000denom1"), true)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
+
+	// The beneficiary receives the paying coin and the unsold selling coin
+	s.Require().Equal(parseCoin("250_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().Equal(parseCoin("750_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().True(s.getBalance(s.addr(0), "denom2").IsZero())
+	s.Require().True(s.getBalance(s.addr(0), "denom1").IsZero())
+}
+
+func (s *KeeperTestSuite) TestClaimVestedProceeds_Beneficiary() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{{ReleaseTime: releaseTime, Weight: parseDec("1")}},
+		startTime,
+		endTime,
+		true,
+	)
+	s.Require().NoError(auction.SetBeneficiary(s.addr(2)))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.ctx = s.ctx.WithBlockTime(releaseTime)
+
+	// The auctioneer can't redirect the proceeds away from the configured beneficiary
+	_, err := s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(0).String(), auction.Id, s.addr(0).String()))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	_, err = s.msgServer.ClaimVestedProceeds(s.ctx, types.NewMsgClaimVestedProceeds(s.addr(0).String(), auction.Id, s.addr(2).String()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(s.addr(0), "denom2").IsZero())
+}
+
+func (s *KeeperTestSuite) TestAuctionOwnershipTransfer() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 1, 0),
+		time.Now().AddDate(0, 2, 0),
+		true,
+	)
+
+	// Only the auctioneer can propose a new owner
+	_, err := s.msgServer.TransferAuctionOwnership(s.ctx, types.NewMsgTransferAuctionOwnership(s.addr(1).String(), auction.Id, s.addr(1).String(), ""))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.TransferAuctionOwnership(s.ctx, types.NewMsgTransferAuctionOwnership(s.addr(0).String(), auction.Id, s.addr(1).String(), s.addr(2).String()))
+	s.Require().NoError(err)
+	events := s.typedEvents(&types.EventAuctionOwnershipTransferProposed{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventAuctionOwnershipTransferProposed{
+		AuctionId:          auction.Id,
+		Auctioneer:         s.addr(0).String(),
+		PendingOwner:       s.addr(1).String(),
+		PendingBeneficiary: s.addr(2).String(),
+	}, events[0])
+
+	// The ownership doesn't change until the pending owner accepts it
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addr(0), a.GetAuctioneer())
+	s.Require().Equal(s.addr(0), a.GetBeneficiary())
+	s.Require().Equal(s.addr(1), a.GetPendingOwner())
+
+	_, err = s.msgServer.AcceptAuctionOwnership(s.ctx, types.NewMsgAcceptAuctionOwnership(s.addr(2).String(), auction.Id))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.AcceptAuctionOwnership(s.ctx, types.NewMsgAcceptAuctionOwnership(s.addr(1).String(), auction.Id))
+	s.Require().NoError(err)
+	events = s.typedEvents(&types.EventAuctionOwnershipTransferred{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventAuctionOwnershipTransferred{
+		AuctionId:     auction.Id,
+		PreviousOwner: s.addr(0).String(),
+		NewOwner:      s.addr(1).String(),
+		Beneficiary:   s.addr(2).String(),
+	}, events[0])
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addr(1), a.GetAuctioneer())
+	s.Require().Equal(s.addr(2), a.GetBeneficiary())
+	s.Require().Empty(a.GetPendingOwner())
+	s.Require().Empty(a.GetPendingBeneficiary())
+
+	// The pending transfer is consumed
+	_, err = s.msgServer.AcceptAuctionOwnership(s.ctx, types.NewMsgAcceptAuctionOwnership(s.addr(1).String(), auction.Id))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	// The previous owner can't manage the auction anymore
+	_, err = s.msgServer.CancelAuction(s.ctx, types.NewMsgCancelAuction(s.addr(0).String(), auction.Id))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	_, err = s.msgServer.CancelAuction(s.ctx, types.NewMsgCancelAuction(s.addr(1).String(), auction.Id))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("1_000_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+
+	_, err = s.msgServer.TransferAuctionOwnership(s.ctx, types.NewMsgTransferAuctionOwnership(s.addr(1).String(), auction.Id, s.addr(0).String(), ""))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
+
+// placeBidWithPayingDenom places a bid that reserves the given paying denom of the auction.
+func (s *KeeperTestSuite) placeBidWithPayingDenom(auctionId uint64, bidder sdk.AccAddress, bidType types.BidType, price math.LegacyDec, coin, fundCoin sdk.Coin, payingDenom string) types.Bid {
+	s.fundAddr(bidder, sdk.NewCoins(fundCoin))
+	s.Require().NoError(s.addAllowedBidder(auctionId, bidder, math.NewInt(10_000_000_000)))
+
+	b, err := s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(auctionId, bidder.String(), bidType, price, coin, payingDenom))
+	s.Require().NoError(err)
+	return b
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_AdditionalPayingDenoms() {
+	s.fundAddr(s.addr(0), sdk.NewCoins(parseCoin("1_000_000_000denom1"), parseCoin("100_000_000stake")))
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, &types.MsgCreateFixedPriceAuction{
+		Auctioneer:             s.addr(0).String(),
+		StartPrice:             parseDec("1"),
+		SellingCoin:            parseCoin("1_000_000_000denom1"),
+		PayingCoinDenom:        "denom2",
+		VestingSchedules:       []types.VestingSchedule{},
+		StartTime:              time.Now().AddDate(0, 0, -1),
+		EndTime:                time.Now().AddDate(0, 0, 1),
+		AdditionalPayingDenoms: []types.PayingDenom{types.NewPayingDenom("denom3", parseDec("2"))},
+	})
+	s.Require().NoError(err)
+	auction := a.(*types.FixedPriceAuction)
+
+	// The denoms the auction doesn't accept are rejected
+	s.fundAddr(s.addr(1), sdk.NewCoins(parseCoin("100_000_000denom4"), parseCoin("100_000_000denom2")))
+	s.Require().NoError(s.addAllowedBidder(auction.Id, s.addr(1), math.NewInt(10_000_000_000)))
+	_, err = s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(auction.Id, s.addr(1).String(), types.BidTypeFixedPrice, parseDec("1"), parseCoin("100_000_000denom4"), ""))
+	s.Require().ErrorIs(err, types.ErrIncorrectCoinDenom)
+	_, err = s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(auction.Id, s.addr(1).String(), types.BidTypeFixedPrice, parseDec("1"), parseCoin("100_000_000denom2"), "denom3"))
+	s.Require().ErrorIs(err, types.ErrIncorrectCoinDenom)
+
+	// A coin of denom3 is worth two coins of denom2
+	b1 := s.placeBidWithPayingDenom(auction.Id, s.addr(1), types.BidTypeFixedPrice, parseDec("1"), parseCoin("100_000_000denom2"), parseCoin("0denom2"), "")
+	b2 := s.placeBidWithPayingDenom(auction.Id, s.addr(2), types.BidTypeFixedPrice, parseDec("1"), parseCoin("150_000_000denom3"), parseCoin("150_000_000denom3"), "denom3")
+	s.Require().Equal("", b1.PayingDenom)
+	s.Require().Equal("denom3", b2.PayingDenom)
+	s.Require().Equal(parseCoin("150_000_000denom3"), s.getBalance(auction.GetPayingReserveAddress(), "denom3"))
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("600_000_000denom1"), a.(*types.FixedPriceAuction).RemainingSellingCoin)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	s.Require().Equal(parseCoin("100_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("300_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+	s.Require().Equal(parseCoin("150_000_000denom3"), s.getBalance(s.addr(0), "denom3"))
+
+	result, err := s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("100_000_000denom2,150_000_000denom3"), result.PaidCoins)
+}
+
+func (s *KeeperTestSuite) TestBatchAuction_AdditionalPayingDenoms() {
+	releaseTime1 := time.Now().AddDate(0, 1, 0)
+	releaseTime2 := time.Now().AddDate(0, 2, 0)
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: releaseTime1, Weight: parseDec("0.5")},
+			{ReleaseTime: releaseTime2, Weight: parseDec("0.5")},
+		},
+		0,
+		parseDec("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, 1),
+		true,
+	)
+	// A coin of denom3 is worth half a coin of denom2
+	s.Require().NoError(auction.SetAdditionalPayingDenoms([]types.PayingDenom{types.NewPayingDenom("denom3", parseDec("0.5"))}))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidWithPayingDenom(auction.Id, s.addr(1), types.BidTypeBatchWorth, parseDec("1"), parseCoin("200_000_000denom2"), parseCoin("200_000_000denom2"), "")
+	s.placeBidWithPayingDenom(auction.Id, s.addr(2), types.BidTypeBatchWorth, parseDec("1"), parseCoin("400_000_000denom3"), parseCoin("400_000_000denom3"), "denom3")
+	s.placeBidWithPayingDenom(auction.Id, s.addr(3), types.BidTypeBatchMany, parseDec("0.9"), parseCoin("300_000_000denom1"), parseCoin("540_000_000denom3"), "denom3")
+	s.placeBidWithPayingDenom(auction.Id, s.addr(4), types.BidTypeBatchMany, parseDec("0.5"), parseCoin("800_000_000denom1"), parseCoin("800_000_000denom3"), "denom3")
+	s.Require().Equal(parseCoin("1_740_000_000denom3"), s.getBalance(auction.GetPayingReserveAddress(), "denom3"))
+
+	_, broken := keeper.PayingPoolReserveAmountInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The bids of all the denoms are matched together at the same price
+	result, err := s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("0.9"), result.MatchedPrice)
+	s.Require().Equal(parseCoin("744_444_444denom1"), result.SoldCoin)
+	s.Require().Equal(parseCoins("200_000_000denom2,940_000_000denom3"), result.PaidCoins)
+	s.Require().Equal(parseCoins("800_000_000denom3"), result.RefundedCoins)
+
+	s.Require().Equal(parseCoin("222_222_222denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("300_000_000denom1"), s.getBalance(s.addr(3), "denom1"))
+	s.Require().Equal(parseCoin("800_000_000denom3"), s.getBalance(s.addr(4), "denom3"))
+
+	// Each paying denom is vested by its own vesting queues
+	vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Len(vestingQueues, 4)
+	s.Require().Equal(parseCoin("100_000_000denom2"), vestingQueues[0].PayingCoin)
+	s.Require().Equal(parseCoin("470_000_000denom3"), vestingQueues[1].PayingCoin)
+	s.Require().Equal(parseCoin("100_000_000denom2"), vestingQueues[2].PayingCoin)
+	s.Require().Equal(parseCoin("470_000_000denom3"), vestingQueues[3].PayingCoin)
+	s.Require().Equal(releaseTime1.UTC(), vestingQueues[1].ReleaseTime.UTC())
+	s.Require().Equal(releaseTime2.UTC(), vestingQueues[2].ReleaseTime.UTC())
+
+	result, err = s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("200_000_000denom2,940_000_000denom3"), result.VestedCoins)
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.PayingPoolReserveAmountInvariant(s.keeper),
+		keeper.VestingPoolReserveAmountInvariant(s.keeper),
+		keeper.VestingQueueAmountInvariant(s.keeper),
+	} {
+		_, broken := invariant(s.ctx)
+		s.Require().False(broken)
+	}
+
+	s.ctx = s.ctx.WithBlockTime(releaseTime1)
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	released, err := s.keeper.ReleaseVestingPayingCoin(s.ctx, a, s.addr(0))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("100_000_000denom2,470_000_000denom3"), released)
+}
+
+type fixedPriceSource struct {
+	ratios map[string]math.LegacyDec
+}
+
+func (ps fixedPriceSource) ConversionRatio(_ context.Context, denom, _ string) (math.LegacyDec, error) {
+	ratio, ok := ps.ratios[denom]
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("no price for %s", denom)
+	}
+	return ratio, nil
+}
+
+func (s *KeeperTestSuite) TestResolvePayingDenoms() {
+	payingDenoms := []types.PayingDenom{
+		types.NewPayingDenom("denom3", parseDec("2")),
+		types.NewPayingDenom("denom4", math.LegacyZeroDec()),
+	}
+
+	// The missing ratio can't be filled in without a price source
+	_, err := s.keeper.ResolvePayingDenoms(s.ctx, "denom2", payingDenoms)
+	s.Require().ErrorIs(err, types.ErrInvalidPayingDenoms)
+
+	s.keeper.SetPriceSource(fixedPriceSource{ratios: map[string]math.LegacyDec{"denom4": parseDec("0.25"), "denom5": math.LegacyZeroDec()}})
+	resolved, err := s.keeper.ResolvePayingDenoms(s.ctx, "denom2", payingDenoms)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PayingDenom{
+		types.NewPayingDenom("denom3", parseDec("2")),
+		types.NewPayingDenom("denom4", parseDec("0.25")),
+	}, resolved)
+
+	_, err = s.keeper.ResolvePayingDenoms(s.ctx, "denom2", []types.PayingDenom{types.NewPayingDenom("denom5", math.LegacyZeroDec())})
+	s.Require().ErrorIs(err, types.ErrInvalidPayingDenoms)
+	_, err = s.keeper.ResolvePayingDenoms(s.ctx, "denom2", []types.PayingDenom{types.NewPayingDenom("denom6", math.LegacyZeroDec())})
+	s.Require().Error(err)
+
+	// The additional paying denoms must be allowed by the params as well
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.AllowedPayingDenoms = []string{"denom2", "denom4"}
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+	_, err = s.keeper.ResolvePayingDenoms(s.ctx, "denom2", payingDenoms)
+	s.Require().ErrorIs(err, types.ErrDenomNotAllowed)
+}
+
+// createOverflowAuction creates a started fixed price auction that allocates the selling coin pro rata.
+func (s *KeeperTestSuite) createOverflowAuction(startPrice math.LegacyDec, sellingCoin sdk.Coin) *types.FixedPriceAuction {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		startPrice,
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, 1),
+		true,
+	)
+	auction.SaleMode = types.SaleModeOverflow
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+	return auction
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_Overflow() {
+	auction := s.createOverflowAuction(parseDec("0.5"), parseCoin("1_000_000_000denom1"))
+
+	// The bids beyond the selling coin are accepted without changing the remaining selling coin
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("1_000_000_000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("500_000_000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(3), parseDec("0.5"), parseCoin("500_000_000denom1"), true)
+	s.Require().Equal(parseCoin("1_750_000_000denom2"), s.getBalance(auction.GetPayingReserveAddress(), "denom2"))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("1_000_000_000denom1"), a.(*types.FixedPriceAuction).RemainingSellingCoin)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// Each bidder receives the selling coin pro rata to the 3_500_000_000denom1 demanded in total
+	// and the rest of the paying coin is refunded
+	s.Require().Equal(parseCoin("571_428_571denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("714_285_714denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("285_714_285denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("357_142_857denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().Equal(parseCoin("142_857_142denom1"), s.getBalance(s.addr(3), "denom1"))
+	s.Require().Equal(parseCoin("178_571_429denom2"), s.getBalance(s.addr(3), "denom2"))
+	s.Require().Equal(parseCoin("500_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+	s.Require().Equal(parseCoin("2denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().True(s.getBalance(auction.GetPayingReserveAddress(), "denom2").IsZero())
+	s.Require().Len(s.typedEvents(&types.EventPayingCoinRefunded{}), 3)
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(parseCoin("2denom1"), a.(*types.FixedPriceAuction).RemainingSellingCoin)
+
+	result, err := s.keeper.AuctionResult.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), result.MatchedLen)
+	s.Require().Equal(parseCoin("999_999_998denom1"), result.SoldCoin)
+	s.Require().Equal(parseCoin("500_000_000denom2"), result.PaidCoin)
+	s.Require().Equal(parseCoin("1_250_000_000denom2"), result.RefundedCoin)
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_OverflowUndersubscribed() {
+	auction := s.createOverflowAuction(parseDec("1"), parseCoin("1_000_000_000denom1"))
+
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("300_000_000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(2), parseDec("1"), parseCoin("200_000_000denom1"), true)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The bids are filled as they are when they don't exceed the selling coin
+	s.Require().Equal(parseCoin("300_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
+	s.Require().Equal(parseCoin("500_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().Empty(s.typedEvents(&types.EventPayingCoinRefunded{}))
+}
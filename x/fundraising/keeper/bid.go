@@ -39,16 +39,25 @@ func (k Keeper) GetBidsByAuctionId(ctx context.Context, auctionId uint64) ([]typ
 
 // GetBidsByBidder returns all bids associated with the bidder that are registered in the store.
 func (k Keeper) GetBidsByBidder(ctx context.Context, bidderAddr sdk.AccAddress) ([]types.Bid, error) {
+	iter, err := k.Bid.Indexes.Bidder.MatchExact(ctx, bidderAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
 	bids := make([]types.Bid, 0)
-	// TODO find a way to store by bidder id to avoid read all store
-	// rng := collections.NewPrefixedPairRange[uint64, uint64](bidderAddr)
-	err := k.Bid.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], bid types.Bid) (bool, error) {
-		if bid.Bidder == bidderAddr.String() {
-			bids = append(bids, bid)
+	for ; iter.Valid(); iter.Next() {
+		pk, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, err
 		}
-		return false, nil
-	})
-	return bids, err
+		bid, err := k.Bid.Get(ctx, pk)
+		if err != nil {
+			return nil, err
+		}
+		bids = append(bids, bid)
+	}
+	return bids, nil
 }
 
 // Bids returns all Bid.
@@ -82,6 +91,9 @@ func (k Keeper) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (types.Bid
 	}
 
 	if auction.GetType() == types.AuctionTypeBatch {
+		if auction.(*types.BatchAuction).IsSealedBid() {
+			return types.Bid{}, types.ErrSealedBidAuction
+		}
 		if msg.Price.LT(auction.(*types.BatchAuction).MinBidPrice) {
 			return types.Bid{}, types.ErrInsufficientMinBidPrice
 		}
@@ -97,22 +109,34 @@ func (k Keeper) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (types.Bid
 		return types.Bid{}, sdkerrors.Wrap(types.ErrNotAllowedBidder, err.Error())
 	}
 
-	if err := k.PayPlaceBidFee(ctx, bidder); err != nil {
+	payingDenom, err := bidPayingDenom(auction, msg.Coin.Denom, msg.PayingDenom)
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	escrowedFee, err := k.PayPlaceBidFee(ctx, auction, bidder)
+	if err != nil {
 		return types.Bid{}, sdkerrors.Wrap(err, "failed to pay place bid fee")
 	}
 
+	if err := k.PayAuctioneerBidFee(ctx, auction, bidder); err != nil {
+		return types.Bid{}, sdkerrors.Wrap(err, "failed to pay auctioneer bid fee")
+	}
+
 	bidID, err := k.GetNextBidIdWithUpdate(ctx, auction.GetId())
 	if err != nil {
 		return types.Bid{}, sdkerrors.Wrap(err, "failed to get next bid id")
 	}
 	bid := types.Bid{
-		AuctionId: msg.AuctionId,
-		Id:        bidID,
-		Bidder:    msg.Bidder,
-		Type:      msg.BidType,
-		Price:     msg.Price,
-		Coin:      msg.Coin,
-		IsMatched: false,
+		AuctionId:   msg.AuctionId,
+		Id:          bidID,
+		Bidder:      msg.Bidder,
+		Type:        msg.BidType,
+		Price:       msg.Price,
+		Coin:        msg.Coin,
+		IsMatched:   false,
+		EscrowedFee: escrowedFee,
+		PayingDenom: payingDenom,
 	}
 
 	payingCoinDenom := auction.GetPayingCoinDenom()
@@ -127,19 +151,20 @@ func (k Keeper) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (types.Bid
 		fa := auction.(*types.FixedPriceAuction)
 
 		// Reserve bid amount
-		bidPayingAmt := bid.ConvertToPayingAmount(payingCoinDenom)
-		bidPayingCoin := sdk.NewCoin(payingCoinDenom, bidPayingAmt)
-		if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, bidPayingCoin); err != nil {
+		if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, bid.ReservedPayingCoin(auction)); err != nil {
 			return types.Bid{}, sdkerrors.Wrap(err, "failed to reserve paying coin")
 		}
 
-		// Subtract bid amount from the remaining
-		bidSellingAmt := bid.ConvertToSellingAmount(payingCoinDenom)
-		bidSellingCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidSellingAmt)
-		fa.RemainingSellingCoin = fa.RemainingSellingCoin.Sub(bidSellingCoin)
+		// Subtract bid amount from the remaining; the selling coin of an overflow sale
+		// is allocated only when the auction ends
+		if !fa.IsOverflow() {
+			bidSellingAmt := bid.Normalize(auction).ConvertToSellingAmount(payingCoinDenom)
+			bidSellingCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidSellingAmt)
+			fa.RemainingSellingCoin = fa.RemainingSellingCoin.Sub(bidSellingCoin)
 
-		if err := k.Auction.Set(ctx, fa.GetId(), fa); err != nil {
-			return types.Bid{}, err
+			if err := k.Auction.Set(ctx, fa.GetId(), fa); err != nil {
+				return types.Bid{}, err
+			}
 		}
 
 		bid.SetMatched(true)
@@ -158,12 +183,35 @@ func (k Keeper) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (types.Bid
 			return types.Bid{}, err
 		}
 
-		reserveAmt := bid.ConvertToPayingAmount(payingCoinDenom)
-		reserveCoin := sdk.NewCoin(payingCoinDenom, reserveAmt)
+		if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, bid.ReservedPayingCoin(auction)); err != nil {
+			return types.Bid{}, sdkerrors.Wrap(err, "failed to reserve paying coin")
+		}
+
+	case types.BidTypeDutch:
+		if err := k.ValidateDutchBid(ctx, auction, bid); err != nil {
+			return types.Bid{}, err
+		}
+
+		da := auction.(*types.DutchAuction)
+
+		// The bid is filled at the current auction price, not the price that the bidder is willing to pay
+		bid.Price = da.CurrentPrice(sdk.UnwrapSDKContext(ctx).BlockTime())
 
-		if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, reserveCoin); err != nil {
+		// Reserve bid amount
+		if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, bid.ReservedPayingCoin(auction)); err != nil {
 			return types.Bid{}, sdkerrors.Wrap(err, "failed to reserve paying coin")
 		}
+
+		// Subtract bid amount from the remaining
+		bidSellingAmt := bid.Normalize(auction).ConvertToSellingAmount(payingCoinDenom)
+		bidSellingCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidSellingAmt)
+		da.RemainingSellingCoin = da.RemainingSellingCoin.Sub(bidSellingCoin)
+
+		if err := k.Auction.Set(ctx, da.GetId(), da); err != nil {
+			return types.Bid{}, err
+		}
+
+		bid.SetMatched(true)
 	}
 
 	// Call before bid placed hook
@@ -181,7 +229,7 @@ func (k Keeper) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (types.Bid
 			types.EventTypePlaceBid,
 			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
 			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.GetBidder()),
-			sdk.NewAttribute(types.AttributeKeyBidPrice, msg.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyBidPrice, bid.Price.String()),
 			sdk.NewAttribute(types.AttributeKeyBidCoin, msg.Coin.String()),
 		),
 	})
@@ -189,13 +237,32 @@ func (k Keeper) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (types.Bid
 	return bid, nil
 }
 
+// bidPayingDenom returns the additional paying denom that a bid with the coin denom reserves, or an empty
+// string when it reserves the paying coin denom of the auction. A bid in a paying denom reserves that denom,
+// and a bid in the selling coin reserves the requested paying denom.
+func bidPayingDenom(auction types.AuctionI, coinDenom, payingDenom string) (string, error) {
+	if coinDenom != auction.GetSellingCoin().Denom {
+		if payingDenom != "" && payingDenom != coinDenom {
+			return "", sdkerrors.Wrapf(types.ErrIncorrectCoinDenom, "paying denom %s must be the same as the bid coin denom", payingDenom)
+		}
+		payingDenom = coinDenom
+	}
+	if payingDenom == "" || payingDenom == auction.GetPayingCoinDenom() {
+		return "", nil
+	}
+	if _, ok := types.PayingDenomRatio(auction, payingDenom); !ok {
+		return "", sdkerrors.Wrapf(types.ErrIncorrectCoinDenom, "paying denom %s is not accepted by the auction", payingDenom)
+	}
+	return payingDenom, nil
+}
+
 // ValidateFixedPriceBid validates a fixed price bid type.
 func (k Keeper) ValidateFixedPriceBid(ctx context.Context, auction types.AuctionI, bid types.Bid) error {
 	if auction.GetType() != types.AuctionTypeFixedPrice {
 		return types.ErrIncorrectAuctionType
 	}
 
-	if bid.Coin.Denom != auction.GetPayingCoinDenom() &&
+	if bid.Coin.Denom != bid.GetPayingDenom(auction.GetPayingCoinDenom()) &&
 		bid.Coin.Denom != auction.GetSellingCoin().Denom {
 		return types.ErrIncorrectCoinDenom
 	}
@@ -205,12 +272,13 @@ func (k Keeper) ValidateFixedPriceBid(ctx context.Context, auction types.Auction
 	}
 
 	// For remaining coin validation, convert bid amount in selling coin denom
-	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	bidAmt := bid.Normalize(auction).ConvertToSellingAmount(auction.GetPayingCoinDenom())
 	bidCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidAmt)
-	remainingCoin := auction.(*types.FixedPriceAuction).RemainingSellingCoin
+	fa := auction.(*types.FixedPriceAuction)
 
-	if remainingCoin.IsLT(bidCoin) {
-		return sdkerrors.Wrapf(types.ErrInsufficientRemainingAmount, "remaining selling coin amount %s", remainingCoin)
+	// An overflow sale accepts bids beyond the selling coin since it is allocated pro rata at the end
+	if !fa.IsOverflow() && fa.RemainingSellingCoin.IsLT(bidCoin) {
+		return sdkerrors.Wrapf(types.ErrInsufficientRemainingAmount, "remaining selling coin amount %s", fa.RemainingSellingCoin)
 	}
 
 	// Get the total bid amount by the bidder
@@ -221,7 +289,7 @@ func (k Keeper) ValidateFixedPriceBid(ctx context.Context, auction types.Auction
 	totalBidAmt := math.ZeroInt()
 	for _, bid := range bids {
 		if bid.AuctionId == auction.GetId() {
-			bidSellingAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+			bidSellingAmt := bid.Normalize(auction).ConvertToSellingAmount(auction.GetPayingCoinDenom())
 			totalBidAmt = totalBidAmt.Add(bidSellingAmt)
 		}
 	}
@@ -247,7 +315,7 @@ func (k Keeper) ValidateBatchWorthBid(ctx context.Context, auction types.Auction
 		return types.ErrIncorrectAuctionType
 	}
 
-	if bid.Coin.Denom != auction.GetPayingCoinDenom() {
+	if bid.Coin.Denom != bid.GetPayingDenom(auction.GetPayingCoinDenom()) {
 		return types.ErrIncorrectCoinDenom
 	}
 
@@ -256,7 +324,7 @@ func (k Keeper) ValidateBatchWorthBid(ctx context.Context, auction types.Auction
 		return sdkerrors.Wrap(err, "bidder is not found in allowed bidder list")
 	}
 
-	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	bidAmt := bid.Normalize(auction).ConvertToSellingAmount(auction.GetPayingCoinDenom())
 
 	// The total bid amount can't be greater than the bidder's maximum bid amount
 	if bidAmt.GT(allowedBidder.MaxBidAmount) {
@@ -281,7 +349,7 @@ func (k Keeper) ValidateBatchManyBid(ctx context.Context, auction types.AuctionI
 		return sdkerrors.Wrap(err, "bidder is not found in allowed bidder list")
 	}
 
-	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	bidAmt := bid.Normalize(auction).ConvertToSellingAmount(auction.GetPayingCoinDenom())
 
 	// The total bid amount can't be greater than the bidder's maximum bid amount
 	if bidAmt.GT(allowedBidder.MaxBidAmount) {
@@ -291,6 +359,65 @@ func (k Keeper) ValidateBatchManyBid(ctx context.Context, auction types.AuctionI
 	return nil
 }
 
+// ValidateDutchBid validates a dutch bid type.
+// The bid price is the maximum price that the bidder is willing to pay and
+// it must be equal or greater than the current auction price.
+func (k Keeper) ValidateDutchBid(ctx context.Context, auction types.AuctionI, bid types.Bid) error {
+	if auction.GetType() != types.AuctionTypeDutch {
+		return types.ErrIncorrectAuctionType
+	}
+
+	if bid.Coin.Denom != bid.GetPayingDenom(auction.GetPayingCoinDenom()) &&
+		bid.Coin.Denom != auction.GetSellingCoin().Denom {
+		return types.ErrIncorrectCoinDenom
+	}
+
+	da := auction.(*types.DutchAuction)
+	currentPrice := da.CurrentPrice(sdk.UnwrapSDKContext(ctx).BlockTime())
+	if bid.Price.LT(currentPrice) {
+		return sdkerrors.Wrapf(types.ErrInsufficientMinBidPrice, "bid price must be equal or greater than the current price %s", currentPrice)
+	}
+
+	// For remaining coin validation, convert bid amount in selling coin denom at the current price
+	bid.Price = currentPrice
+	bidAmt := bid.Normalize(auction).ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	if !bidAmt.IsPositive() {
+		return sdkerrors.Wrap(errcode.ErrInvalidRequest, "bid amount is too small to purchase the selling coin")
+	}
+	bidCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidAmt)
+
+	if da.RemainingSellingCoin.IsLT(bidCoin) {
+		return sdkerrors.Wrapf(types.ErrInsufficientRemainingAmount, "remaining selling coin amount %s", da.RemainingSellingCoin)
+	}
+
+	// Get the total bid amount by the bidder
+	bids, err := k.GetBidsByBidder(ctx, bid.GetBidder())
+	if err != nil {
+		return err
+	}
+	totalBidAmt := math.ZeroInt()
+	for _, bid := range bids {
+		if bid.AuctionId == auction.GetId() {
+			bidSellingAmt := bid.Normalize(auction).ConvertToSellingAmount(auction.GetPayingCoinDenom())
+			totalBidAmt = totalBidAmt.Add(bidSellingAmt)
+		}
+	}
+
+	allowedBidder, err := k.AllowedBidder.Get(ctx, collections.Join(bid.AuctionId, bid.GetBidder()))
+	if err != nil {
+		return sdkerrors.Wrap(err, "bidder is not found in allowed bidder list")
+	}
+
+	totalBidAmt = totalBidAmt.Add(bidAmt)
+
+	// The total bid amount can't be greater than the bidder's maximum bid amount
+	if totalBidAmt.GT(allowedBidder.MaxBidAmount) {
+		return types.ErrOverMaxBidAmountLimit
+	}
+
+	return nil
+}
+
 // ModifyBid handles types.MsgModifyBid and stores the modified bid.
 // A bidder must provide either greater bid price or coin amount.
 // They are not permitted to modify with less bid price or coin amount.
@@ -338,30 +465,19 @@ func (k Keeper) ModifyBid(ctx context.Context, msg *types.MsgModifyBid) error {
 		return sdkerrors.Wrap(errcode.ErrInvalidRequest, "bid price and coin amount must be changed")
 	}
 
-	// Reserve bid amount difference
-	switch bid.Type {
-	case types.BidTypeBatchWorth:
-		diffReserveCoin := msg.Coin.Sub(bid.Coin)
-		if diffReserveCoin.IsPositive() {
-			if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, diffReserveCoin); err != nil {
-				return sdkerrors.Wrap(err, "failed to reserve paying coin")
-			}
-		}
-	case types.BidTypeBatchMany:
-		prevReserveAmt := math.LegacyNewDecFromInt(bid.Coin.Amount).Mul(bid.Price).Ceil()
-		currReserveAmt := math.LegacyNewDecFromInt(msg.Coin.Amount).Mul(msg.Price).Ceil()
-		diffReserveAmt := currReserveAmt.Sub(prevReserveAmt).TruncateInt()
-		diffReserveCoin := sdk.NewCoin(auction.GetPayingCoinDenom(), diffReserveAmt)
-		if diffReserveCoin.IsPositive() {
-			if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, diffReserveCoin); err != nil {
-				return sdkerrors.Wrap(err, "failed to reserve paying coin")
-			}
-		}
-	}
+	// Reserve bid amount difference in the paying denom of the bid
+	prevReserveCoin := bid.ReservedPayingCoin(auction)
 
 	bid.Price = msg.Price
 	bid.Coin = msg.Coin
 
+	diffReserveCoin := bid.ReservedPayingCoin(auction).Sub(prevReserveCoin)
+	if diffReserveCoin.IsPositive() {
+		if err := k.ReservePayingCoin(ctx, msg.AuctionId, bidder, diffReserveCoin); err != nil {
+			return sdkerrors.Wrap(err, "failed to reserve paying coin")
+		}
+	}
+
 	// Call the before mid modified hook
 	if err := k.BeforeBidModified(ctx, bid.AuctionId, bid.Id, bid.Bidder, bid.Type, bid.Price, bid.Coin); err != nil {
 		return err
@@ -370,5 +486,80 @@ func (k Keeper) ModifyBid(ctx context.Context, msg *types.MsgModifyBid) error {
 	if err := k.Bid.Set(ctx, collections.Join(bid.AuctionId, bid.Id), bid); err != nil {
 		return err
 	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBidModified{
+		AuctionId: bid.AuctionId,
+		BidId:     bid.Id,
+		Bidder:    bid.Bidder,
+		Price:     bid.Price,
+		Coin:      bid.Coin,
+	})
+}
+
+// CancelBid handles types.MsgCancelBid and cancels the bid for the batch auction.
+// The bid is removed from the store and the reserved paying coin is refunded to the bidder.
+// A bidder is not allowed to cancel the bid once the bid cancel cutoff of the auction is reached.
+func (k Keeper) CancelBid(ctx context.Context, msg *types.MsgCancelBid) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return types.ErrInvalidAuctionStatus
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch {
+		return types.ErrIncorrectAuctionType
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !auction.(*types.BatchAuction).ShouldBidCancelAllowed(sdkCtx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrBidCancelNotAllowed, "bid cancel cutoff is reached")
+	}
+
+	bid, err := k.Bid.Get(ctx, collections.Join(msg.AuctionId, msg.BidId))
+	if err != nil {
+		return err
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(msg.GetBidder())
+	if err != nil {
+		return err
+	}
+
+	if !bid.GetBidder().Equals(bidder) {
+		return sdkerrors.Wrap(errcode.ErrUnauthorized, "only the bid creator can cancel the bid")
+	}
+
+	// Call the before bid canceled hook
+	if err := k.BeforeBidCanceled(ctx, bid.AuctionId, bid.Id, bid.Bidder); err != nil {
+		return err
+	}
+
+	refundCoin := bid.ReservedPayingCoin(auction)
+	if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), bidder, sdk.NewCoins(refundCoin)); err != nil {
+		return sdkerrors.Wrap(err, "failed to refund paying coin")
+	}
+
+	// The escrowed place bid fee is only refunded for the bids left unmatched at the end of the auction
+	if err := k.CollectFee(ctx, auction.GetId(), k.accountKeeper.GetModuleAddress(types.ModuleName), types.FeeTypePlaceBid, bid.EscrowedFee); err != nil {
+		return sdkerrors.Wrap(err, "failed to collect escrowed place bid fee")
+	}
+
+	if err := k.Bid.Remove(ctx, collections.Join(bid.AuctionId, bid.Id)); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBidId, strconv.FormatUint(bid.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.GetBidder()),
+			sdk.NewAttribute(types.AttributeKeyRefundCoin, refundCoin.String()),
+		),
+	})
+
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	_ "github.com/stretchr/testify/suite"
 
@@ -430,6 +431,27 @@ func (s *KeeperTestSuite) TestModifyBid_BidTypeWorth() {
 		Coin:      parseCoin("100_000_000denom2"),
 	})
 	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Modify the bid with higher bid price
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+		Price:     parseDec("0.8"),
+		Coin:      parseCoin("100_000_000denom2"),
+	})
+	s.Require().NoError(err)
+
+	events := s.typedEvents(&types.EventBidModified{})
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventBidModified{
+		AuctionId: a.Id,
+		BidId:     b.Id,
+		Bidder:    s.addr(1).String(),
+		Price:     parseDec("0.8"),
+		Coin:      parseCoin("100_000_000denom2"),
+	}, events[0])
 }
 
 func (s *KeeperTestSuite) TestModifyBid_BidTypeMany() {
@@ -474,3 +496,205 @@ func (s *KeeperTestSuite) TestModifyBid_BidTypeMany() {
 	})
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestDutch_InsufficientBidPrice() {
+	s.ctx = s.ctx.WithBlockTime(types.MustParseRFC3339("2023-01-06T00:00:00Z"))
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		types.MustParseRFC3339("2023-01-01T00:00:00Z"),
+		types.MustParseRFC3339("2023-01-11T00:00:00Z"),
+		true,
+	)
+
+	s.fundAddr(s.addr(1), parseCoins("100_000_000denom2"))
+	err := s.addAllowedBidder(auction.Id, s.addr(1), parseInt("100_000_000"))
+	s.Require().NoError(err)
+
+	// The current price is 1.5
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("1.4"),
+		Coin:      parseCoin("100_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrInsufficientMinBidPrice)
+}
+
+func (s *KeeperTestSuite) TestDutch_InsufficientRemainingAmount() {
+	s.ctx = s.ctx.WithBlockTime(types.MustParseRFC3339("2023-01-06T00:00:00Z"))
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("100_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		24*time.Hour,
+		types.MustParseRFC3339("2023-01-01T00:00:00Z"),
+		types.MustParseRFC3339("2023-01-11T00:00:00Z"),
+		true,
+	)
+
+	s.placeBidDutch(auction.Id, s.addr(1), parseDec("2.0"), parseCoin("80_000_000denom1"), true)
+
+	s.fundAddr(s.addr(2), parseCoins("100_000_000denom2"))
+	err := s.addAllowedBidder(auction.Id, s.addr(2), parseInt("100_000_000"))
+	s.Require().NoError(err)
+
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(2).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("2.0"),
+		Coin:      parseCoin("30_000_000denom1"),
+	})
+	s.Require().ErrorIs(err, types.ErrInsufficientRemainingAmount)
+}
+
+func (s *KeeperTestSuite) TestDutch_IncorrectAuctionType() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	s.fundAddr(s.addr(1), parseCoins("100_000_000denom2"))
+	err := s.addAllowedBidder(auction.Id, s.addr(1), parseInt("100_000_000"))
+	s.Require().NoError(err)
+
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("1"),
+		Coin:      parseCoin("100_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrIncorrectAuctionType)
+}
+
+func (s *KeeperTestSuite) TestCancelBid() {
+	a := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		parseDec("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, a.GetStatus())
+
+	b := s.placeBidBatchWorth(a.Id, s.addr(1), parseDec("0.5"), parseCoin("1_000_000denom2"), parseInt("1_000_000_000"), true)
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
+
+	// Only the bid creator can cancel the bid
+	err := s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(2).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().NoError(err)
+
+	// The paying coin must be refunded and the bid must be removed
+	s.Require().Equal(parseCoin("1_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().True(s.getBalance(a.GetPayingReserveAddress(), "denom2").IsZero())
+
+	_, err = s.keeper.Bid.Get(s.ctx, collections.Join(a.Id, b.Id))
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestCancelBid_Cutoff() {
+	startTime := time.Now().AddDate(0, 0, -1)
+	endTime := startTime.AddDate(0, 0, 10)
+	a := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		parseDec("0.2"),
+		startTime,
+		endTime,
+		true,
+	)
+
+	a.BidCancelCutoff = 48 * time.Hour
+	err := s.keeper.Auction.Set(s.ctx, a.Id, a)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(endTime.AddDate(0, 0, -3))
+	b := s.placeBidBatchWorth(a.Id, s.addr(1), parseDec("0.5"), parseCoin("1_000_000denom2"), parseInt("1_000_000_000"), true)
+
+	// Bids placed within the cutoff window can't be cancelled
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(-time.Hour))
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrBidCancelNotAllowed)
+
+	s.ctx = s.ctx.WithBlockTime(endTime.AddDate(0, 0, -3))
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCancelBid_IncorrectAuctionType() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	b := s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+
+	err := s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrIncorrectAuctionType)
+}
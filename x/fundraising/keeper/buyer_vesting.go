@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// BuyerVestings returns all BuyerVesting.
+func (k Keeper) BuyerVestings(ctx context.Context) ([]types.BuyerVesting, error) {
+	buyerVestings := make([]types.BuyerVesting, 0)
+	err := k.BuyerVesting.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], buyerVesting types.BuyerVesting) (bool, error) {
+		buyerVestings = append(buyerVestings, buyerVesting)
+		return false, nil
+	})
+	return buyerVestings, err
+}
+
+// GetBuyerVestingsByAuctionId returns all BuyerVesting of the auction.
+func (k Keeper) GetBuyerVestingsByAuctionId(ctx context.Context, auctionId uint64) ([]types.BuyerVesting, error) {
+	buyerVestings := make([]types.BuyerVesting, 0)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](auctionId)
+	err := k.BuyerVesting.Walk(ctx, rng, func(_ collections.Pair[uint64, sdk.AccAddress], buyerVesting types.BuyerVesting) (bool, error) {
+		buyerVestings = append(buyerVestings, buyerVesting)
+		return false, nil
+	})
+	return buyerVestings, err
+}
+
+// ClaimBuyerVesting sends the selling coin unlocked by the buyer vesting schedules of the auction
+// and not claimed yet from the buyer vesting reserve account to the bidder.
+func (k Keeper) ClaimBuyerVesting(ctx context.Context, msg *types.MsgClaimBuyerVesting) (sdk.Coin, error) {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(errors.ErrNotFound, "auction %d is not found", msg.AuctionId)
+	}
+
+	// The selling coin is locked for the bidders only once the auction is settled
+	switch auction.GetStatus() {
+	case types.AuctionStatusVesting, types.AuctionStatusFinished:
+	default:
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the vesting or finished auction has buyer vesting to claim")
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	buyerVesting, err := k.BuyerVesting.Get(ctx, collections.Join(msg.AuctionId, bidderAddr))
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(errors.ErrNotFound, "buyer vesting of %s is not found in auction %d", msg.Bidder, msg.AuctionId)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	claimable := buyerVesting.UnlockedCoin(auction.GetBuyerVestingSchedules(), blockTime).Sub(buyerVesting.ClaimedCoin)
+	if !claimable.IsPositive() {
+		return sdk.Coin{}, types.ErrNothingToClaim
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.BuyerVestingReserveAddress(msg.AuctionId), bidderAddr, sdk.NewCoins(claimable)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	buyerVesting.ClaimedCoin = buyerVesting.ClaimedCoin.Add(claimable)
+	if err := k.BuyerVesting.Set(ctx, collections.Join(msg.AuctionId, bidderAddr), buyerVesting); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBuyerVestingClaimed{
+		AuctionId: msg.AuctionId,
+		Bidder:    msg.Bidder,
+		Coin:      claimable,
+	}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return claimable, nil
+}
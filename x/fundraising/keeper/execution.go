@@ -13,10 +13,7 @@ import (
 func (k Keeper) ExecuteStandByStatus(ctx context.Context, auction types.AuctionI) error {
 	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
 	if auction.ShouldAuctionStarted(blockTime) { // BlockTime >= StartTime
-		if err := auction.SetStatus(types.AuctionStatusStarted); err != nil {
-			return err
-		}
-		if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+		if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusStarted); err != nil {
 			return err
 		}
 	}
@@ -37,14 +34,32 @@ func (k Keeper) ExecuteStartedStatus(ctx context.Context, auction types.AuctionI
 			if err := k.CloseBatchAuction(ctx, auction); err != nil {
 				return err
 			}
+
+		case types.AuctionTypeDutch:
+			if err := k.CloseDutchAuction(ctx, auction); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// ExecuteRevealingStatus closes the sealed-bid auction once its reveal phase is over.
+func (k Keeper) ExecuteRevealingStatus(ctx context.Context, auction types.AuctionI) error {
+	ba, err := sealedBidAuction(auction)
+	if err != nil {
+		return err
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockTime().Before(ba.RevealEndTime()) {
+		return nil
+	}
+	return k.CloseRevealPhase(ctx, ba)
+}
+
 // ExecuteVestingStatus first gets all vesting queues in the store and
 // look up the release time of each vesting queue to see if the module needs to
 // distribute the paying coin to the auctioneer.
 func (k Keeper) ExecuteVestingStatus(ctx context.Context, auction types.AuctionI) error {
-	return k.ReleaseVestingPayingCoin(ctx, auction)
+	_, err := k.ReleaseVestingPayingCoin(ctx, auction, auction.GetBeneficiary())
+	return err
 }
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	_ "github.com/stretchr/testify/suite"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
@@ -99,6 +100,7 @@ func (s *KeeperTestSuite) TestEndBlockerVestingStatus() {
 		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
 		true,
 	)
+	s.enableAutoRelease(auction.Id)
 	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
 
 	bid1 := s.placeBidFixedPrice(auction.GetId(), s.addr(1), math.LegacyOneDec(), parseCoin("20000000denom2"), true)
@@ -158,3 +160,118 @@ func (s *KeeperTestSuite) TestExecuteStartedAuction_BatchAuction() {
 	err = s.keeper.ExecuteStartedStatus(s.ctx, auction)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestLifecycleEvents_BatchAuction() {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+
+	startTime := s.ctx.BlockTime().AddDate(0, 0, 1)
+	endTime := startTime.AddDate(0, 1, 0)
+	releaseTime := endTime.AddDate(0, 6, 0)
+	ba := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("100_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{
+				ReleaseTime: releaseTime,
+				Weight:      math.LegacyOneDec(),
+			},
+		},
+		1,
+		math.LegacyMustNewDecFromStr("0.2"),
+		startTime,
+		endTime,
+		true,
+	)
+	s.enableAutoRelease(ba.Id)
+	s.Require().Equal(types.AuctionStatusStandBy, ba.GetStatus())
+
+	// The auction gets started
+	s.ctx = s.ctx.WithBlockTime(startTime).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal([]proto.Message{
+		&types.EventAuctionStatusChanged{
+			AuctionId:      ba.Id,
+			PreviousStatus: types.AuctionStatusStandBy,
+			Status:         types.AuctionStatusStarted,
+		},
+	}, s.typedEvents(&types.EventAuctionStatusChanged{}))
+
+	s.placeBidBatchWorth(ba.Id, s.addr(1), parseDec("10"), parseCoin("500_000_000denom2"), parseInt("1_000_000_000"), true)
+	s.placeBidBatchMany(ba.Id, s.addr(2), parseDec("8"), parseCoin("80_000_000denom1"), parseInt("1_000_000_000"), true)
+
+	// The auction is extended since there is no matched length of the previous round
+	nextEndTime := endTime.AddDate(0, 0, int(params.ExtendedPeriod))
+	s.ctx = s.ctx.WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	events := s.typedEvents(&types.EventRoundExtended{})
+	s.Require().Len(events, 1)
+	extended := events[0].(*types.EventRoundExtended)
+	s.Require().Equal(ba.Id, extended.AuctionId)
+	s.Require().Equal(uint32(1), extended.ExtendedRound)
+	s.Require().Equal(nextEndTime.UTC(), extended.EndTime.UTC())
+
+	// The auction is closed at the end of the extended round
+	s.ctx = s.ctx.WithBlockTime(nextEndTime).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Equal([]proto.Message{
+		&types.EventAuctionClosed{
+			AuctionId:          ba.Id,
+			MatchedPrice:       parseDec("10"),
+			MatchedLen:         1,
+			TotalMatchedAmount: parseInt("50_000_000"),
+		},
+	}, s.typedEvents(&types.EventAuctionClosed{}))
+	s.Require().Equal([]proto.Message{
+		&types.EventSellingCoinAllocated{
+			AuctionId: ba.Id,
+			Bidder:    s.addr(1).String(),
+			Coin:      parseCoin("50_000_000denom1"),
+		},
+	}, s.typedEvents(&types.EventSellingCoinAllocated{}))
+	s.Require().Equal([]proto.Message{
+		&types.EventPayingCoinRefunded{
+			AuctionId: ba.Id,
+			Bidder:    s.addr(2).String(),
+			Coin:      parseCoin("640_000_000denom2"),
+		},
+	}, s.typedEvents(&types.EventPayingCoinRefunded{}))
+	s.Require().Equal([]proto.Message{
+		&types.EventRemainingSellingCoinRefunded{
+			AuctionId:  ba.Id,
+			Auctioneer: s.addr(0).String(),
+			Coin:       parseCoin("50_000_000denom1"),
+		},
+	}, s.typedEvents(&types.EventRemainingSellingCoinRefunded{}))
+	events = s.typedEvents(&types.EventVestingQueueCreated{})
+	s.Require().Len(events, 1)
+	queued := events[0].(*types.EventVestingQueueCreated)
+	s.Require().Equal(parseCoin("500_000_000denom2"), queued.PayingCoin)
+	s.Require().Equal(releaseTime.UTC(), queued.ReleaseTime.UTC())
+	s.Require().Equal([]proto.Message{
+		&types.EventAuctionStatusChanged{
+			AuctionId:      ba.Id,
+			PreviousStatus: types.AuctionStatusStarted,
+			Status:         types.AuctionStatusVesting,
+		},
+	}, s.typedEvents(&types.EventAuctionStatusChanged{}))
+
+	// The vested paying coin is released to the auctioneer
+	s.ctx = s.ctx.WithBlockTime(releaseTime).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	events = s.typedEvents(&types.EventPayingCoinReleased{})
+	s.Require().Len(events, 1)
+	released := events[0].(*types.EventPayingCoinReleased)
+	s.Require().Equal(s.addr(0).String(), released.Auctioneer)
+	s.Require().Equal(parseCoin("500_000_000denom2"), released.PayingCoin)
+	s.Require().Equal([]proto.Message{
+		&types.EventAuctionStatusChanged{
+			AuctionId:      ba.Id,
+			PreviousStatus: types.AuctionStatusVesting,
+			Status:         types.AuctionStatusFinished,
+		},
+	}, s.typedEvents(&types.EventAuctionStatusChanged{}))
+}
@@ -150,6 +150,72 @@ func (k Keeper) AfterBatchAuctionCreated(
 	return nil
 }
 
+// BeforeDutchAuctionCreated - call hook if registered and implemented
+func (k Keeper) BeforeDutchAuctionCreated(
+	ctx context.Context,
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	if dh, ok := k.hooks.(types.DutchAuctionHooks); ok {
+		if err := dh.BeforeDutchAuctionCreated(
+			ctx,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			decayInterval,
+			startTime,
+			endTime,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterDutchAuctionCreated - call hook if registered and implemented
+func (k Keeper) AfterDutchAuctionCreated(
+	ctx context.Context,
+	auctionId uint64,
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	if dh, ok := k.hooks.(types.DutchAuctionHooks); ok {
+		if err := dh.AfterDutchAuctionCreated(
+			ctx,
+			auctionId,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			decayInterval,
+			startTime,
+			endTime,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BeforeAuctionCanceled - call hook if registered
 func (k Keeper) BeforeAuctionCanceled(
 	ctx context.Context,
@@ -200,6 +266,21 @@ func (k Keeper) BeforeBidModified(
 	return nil
 }
 
+// BeforeBidCanceled - call hook if registered
+func (k Keeper) BeforeBidCanceled(
+	ctx context.Context,
+	auctionId uint64,
+	bidId uint64,
+	bidder string,
+) error {
+	if k.hooks != nil {
+		if err := k.hooks.BeforeBidCanceled(ctx, auctionId, bidId, bidder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BeforeAllowedBiddersAdded - call hook if registered
 func (k Keeper) BeforeAllowedBiddersAdded(
 	ctx context.Context,
@@ -11,7 +11,10 @@ import (
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
 
-var _ types.FundraisingHooks = &MockFundraisingHooksReceiver{}
+var (
+	_ types.FundraisingHooks  = &MockFundraisingHooksReceiver{}
+	_ types.DutchAuctionHooks = &MockFundraisingHooksReceiver{}
+)
 
 // MockFundraisingHooksReceiver event hooks for governance proposal object (noalias)
 type MockFundraisingHooksReceiver struct {
@@ -19,9 +22,12 @@ type MockFundraisingHooksReceiver struct {
 	AfterFixedPriceAuctionCreatedValid  bool
 	BeforeBatchAuctionCreatedValid      bool
 	AfterBatchAuctionCreatedValid       bool
+	BeforeDutchAuctionCreatedValid      bool
+	AfterDutchAuctionCreatedValid       bool
 	BeforeAuctionCanceledValid          bool
 	BeforeBidPlacedValid                bool
 	BeforeBidModifiedValid              bool
+	BeforeBidCanceledValid              bool
 	BeforeAllowedBiddersAddedValid      bool
 	BeforeAllowedBidderUpdatedValid     bool
 	BeforeSellingCoinsAllocatedValid    bool
@@ -91,6 +97,39 @@ func (h *MockFundraisingHooksReceiver) AfterBatchAuctionCreated(
 	return nil
 }
 
+func (h *MockFundraisingHooksReceiver) BeforeDutchAuctionCreated(
+	ctx context.Context,
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	h.BeforeDutchAuctionCreatedValid = true
+	return nil
+}
+
+func (h *MockFundraisingHooksReceiver) AfterDutchAuctionCreated(
+	ctx context.Context,
+	auctionId uint64,
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	h.AfterDutchAuctionCreatedValid = true
+	return nil
+}
+
 func (h *MockFundraisingHooksReceiver) BeforeAuctionCanceled(
 	ctx context.Context,
 	auctionId uint64,
@@ -126,6 +165,16 @@ func (h *MockFundraisingHooksReceiver) BeforeBidModified(
 	return nil
 }
 
+func (h *MockFundraisingHooksReceiver) BeforeBidCanceled(
+	ctx context.Context,
+	auctionId uint64,
+	bidId uint64,
+	bidder string,
+) error {
+	h.BeforeBidCanceledValid = true
+	return nil
+}
+
 func (h *MockFundraisingHooksReceiver) BeforeAllowedBiddersAdded(
 	ctx context.Context,
 	allowedBidders []types.AllowedBidder,
@@ -164,9 +213,12 @@ func (s *KeeperTestSuite) TestHooks() {
 	s.Require().False(fundraisingHooksReceiver.AfterFixedPriceAuctionCreatedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeBatchAuctionCreatedValid)
 	s.Require().False(fundraisingHooksReceiver.AfterBatchAuctionCreatedValid)
+	s.Require().False(fundraisingHooksReceiver.BeforeDutchAuctionCreatedValid)
+	s.Require().False(fundraisingHooksReceiver.AfterDutchAuctionCreatedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeAuctionCanceledValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeBidPlacedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeBidModifiedValid)
+	s.Require().False(fundraisingHooksReceiver.BeforeBidCanceledValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeAllowedBiddersAddedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeAllowedBidderUpdatedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeSellingCoinsAllocatedValid)
@@ -202,6 +254,22 @@ func (s *KeeperTestSuite) TestHooks() {
 	s.Require().True(fundraisingHooksReceiver.BeforeBatchAuctionCreatedValid)
 	s.Require().True(fundraisingHooksReceiver.AfterBatchAuctionCreatedValid)
 
+	// Create a dutch auction
+	s.createDutchAuction(
+		s.addr(1),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("1_000_000_000_000denom7"),
+		"denom8",
+		[]types.VestingSchedule{},
+		0,
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().True(fundraisingHooksReceiver.BeforeDutchAuctionCreatedValid)
+	s.Require().True(fundraisingHooksReceiver.AfterDutchAuctionCreatedValid)
+
 	// Create auction that is stand by status
 	standByAuction := s.createFixedPriceAuction(
 		s.addr(2),
@@ -252,6 +320,16 @@ func (s *KeeperTestSuite) TestHooks() {
 	s.Require().NoError(err)
 	s.Require().True(fundraisingHooksReceiver.BeforeBidModifiedValid)
 
+	// Place another bid and cancel it
+	bid2 := s.placeBidBatchWorth(auction.GetId(), s.addr(3), parseDec("0.6"), parseCoin("1_000_000denom4"), math.NewInt(10_000_000), true)
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: bid2.AuctionId,
+		Bidder:    bid2.Bidder,
+		BidId:     bid2.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().True(fundraisingHooksReceiver.BeforeBidCanceledValid)
+
 	// Calculate fixed price allocation
 	mInfo, err := s.keeper.CalculateFixedPriceAllocation(s.ctx, auction)
 	s.Require().NoError(err)
@@ -261,3 +339,25 @@ func (s *KeeperTestSuite) TestHooks() {
 	s.Require().NoError(err)
 	s.Require().True(fundraisingHooksReceiver.BeforeSellingCoinsAllocatedValid)
 }
+
+func (s *KeeperTestSuite) TestHooks_WithoutDutchAuctionHooks() {
+	fundraisingHooksReceiver := MockFundraisingHooksReceiver{}
+
+	// The receiver is wrapped to expose only the methods of FundraisingHooks
+	s.keeper.SetHooks(types.NewMultiFundraisingHooks(struct{ types.FundraisingHooks }{&fundraisingHooksReceiver}))
+
+	s.createDutchAuction(
+		s.addr(1),
+		parseDec("2.0"),
+		parseDec("1.0"),
+		parseCoin("1_000_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().False(fundraisingHooksReceiver.BeforeDutchAuctionCreatedValid)
+	s.Require().False(fundraisingHooksReceiver.AfterDutchAuctionCreatedValid)
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"fmt"
 
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
@@ -17,6 +16,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PayingPoolReserveAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "vesting-pool-reserve-amount",
 		VestingPoolReserveAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-queue-amount",
+		VestingQueueAmountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the fundraising module.
@@ -26,6 +27,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			SellingPoolReserveAmountInvariant,
 			PayingPoolReserveAmountInvariant,
 			VestingPoolReserveAmountInvariant,
+			VestingQueueAmountInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -48,7 +50,7 @@ func SellingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 			return "", false
 		}
 		for _, auction := range auctions {
-			if auction.GetStatus() == types.AuctionStatusStarted {
+			if auction.GetStatus() == types.AuctionStatusStarted || auction.GetStatus() == types.AuctionStatusPaused {
 				sellingReserveAddr := auction.GetSellingReserveAddress()
 				sellingCoinDenom := auction.GetSellingCoin().Denom
 				spendable := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
@@ -82,28 +84,35 @@ func PayingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 			return "", false
 		}
 		for _, auction := range auctions {
-			totalBidCoin := sdk.NewCoin(auction.GetPayingCoinDenom(), math.ZeroInt())
+			var totalBidCoins sdk.Coins
 
 			bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
 			if err != nil {
 				return "", false
 			}
-			if auction.GetStatus() == types.AuctionStatusStarted {
+			switch auction.GetStatus() {
+			case types.AuctionStatusStarted, types.AuctionStatusPaused, types.AuctionStatusRevealing:
 				for _, bid := range bids {
-					bidAmt := bid.ConvertToPayingAmount(auction.GetPayingCoinDenom())
-					totalBidCoin = totalBidCoin.Add(sdk.NewCoin(auction.GetPayingCoinDenom(), bidAmt))
+					totalBidCoins = totalBidCoins.Add(bid.ReservedPayingCoin(auction))
+				}
+
+				// The collateral of the sealed bids stays in the paying reserve until they are revealed
+				commitments, err := k.GetBidCommitmentsByAuctionId(ctx, auction.GetId())
+				if err != nil {
+					return "", false
+				}
+				for _, commitment := range commitments {
+					totalBidCoins = totalBidCoins.Add(commitment.Collateral)
 				}
 			}
 
 			payingReserveAddr := auction.GetPayingReserveAddress()
-			payingCoinDenom := auction.GetPayingCoinDenom()
-			spendable := k.bankKeeper.SpendableCoins(ctx, payingReserveAddr)
-			payingReserve := sdk.NewCoin(payingCoinDenom, spendable.AmountOf(payingCoinDenom))
-			if !payingReserve.IsGTE(totalBidCoin) {
+			payingReserve := payingReserveCoins(k.bankKeeper.SpendableCoins(ctx, payingReserveAddr), auction)
+			if !payingReserve.IsAllGTE(totalBidCoins) {
 				msg += fmt.Sprintf("\tpaying reserve balance %s\n"+
 					"\tpaying pool reserve: %v\n"+
 					"\ttotal bid coin: %v\n",
-					payingReserveAddr.String(), payingReserve, totalBidCoin)
+					payingReserveAddr.String(), payingReserve, totalBidCoins)
 				count++
 			}
 		}
@@ -125,7 +134,7 @@ func VestingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 			return "", false
 		}
 		for _, auction := range auctions {
-			totalPayingCoin := sdk.NewCoin(auction.GetPayingCoinDenom(), math.ZeroInt())
+			var totalPayingCoins sdk.Coins
 
 			vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
 			if err != nil {
@@ -133,21 +142,17 @@ func VestingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 			}
 			if auction.GetStatus() == types.AuctionStatusVesting {
 				for _, queue := range vestingQueues {
-					if !queue.Released {
-						totalPayingCoin = totalPayingCoin.Add(queue.PayingCoin)
-					}
+					totalPayingCoins = totalPayingCoins.Add(queue.RemainingPayingCoin())
 				}
 			}
 
 			vestingReserveAddr := auction.GetVestingReserveAddress()
-			payingCoinDenom := auction.GetPayingCoinDenom()
-			spendable := k.bankKeeper.SpendableCoins(ctx, vestingReserveAddr)
-			vestingReserve := sdk.NewCoin(payingCoinDenom, spendable.AmountOf(payingCoinDenom))
-			if !vestingReserve.IsGTE(totalPayingCoin) {
+			vestingReserve := payingReserveCoins(k.bankKeeper.SpendableCoins(ctx, vestingReserveAddr), auction)
+			if !vestingReserve.IsAllGTE(totalPayingCoins) {
 				msg += fmt.Sprintf("\tvesting reserve balance %s\n"+
 					"\tvesting pool reserve: %v\n"+
 					"\ttotal paying coin: %v\n",
-					vestingReserveAddr.String(), vestingReserve, totalPayingCoin)
+					vestingReserveAddr.String(), vestingReserve, totalPayingCoins)
 				count++
 			}
 		}
@@ -156,3 +161,62 @@ func VestingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "vesting pool reserve amount and total paying amount", msg), broken
 	}
 }
+
+// VestingQueueAmountInvariant checks an invariant that the total paying amount of the vesting queues
+// of an auction must equal the paying amount moved into the vesting reserve account.
+func VestingQueueAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return "", false
+		}
+		for _, auction := range auctions {
+			if auction.GetStatus() != types.AuctionStatusVesting && auction.GetStatus() != types.AuctionStatusFinished {
+				continue
+			}
+
+			// The auctions settled before the vested coin was recorded are not checked
+			result, err := k.AuctionResult.Get(ctx, auction.GetId())
+			if err != nil || result.VestedCoin.Amount.IsNil() {
+				continue
+			}
+
+			vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+			if err != nil {
+				return "", false
+			}
+			var totalPayingCoins sdk.Coins
+			for _, queue := range vestingQueues {
+				totalPayingCoins = totalPayingCoins.Add(queue.PayingCoin)
+			}
+
+			// The results recorded before the vested coins were tracked only hold the vested coin
+			vestedCoins := result.VestedCoins
+			if vestedCoins.Empty() {
+				vestedCoins = sdk.NewCoins(result.VestedCoin)
+			}
+			if !totalPayingCoins.Equal(vestedCoins) {
+				msg += fmt.Sprintf("\tauction %d\n"+
+					"\ttotal vesting queue paying coin: %v\n"+
+					"\tvested coin: %v\n",
+					auction.GetId(), totalPayingCoins, vestedCoins)
+				count++
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "vesting queue amount and vested coin amount", msg), broken
+	}
+}
+
+// payingReserveCoins returns the balances of the reserve account in the denoms that the auction accepts.
+func payingReserveCoins(balances sdk.Coins, auction types.AuctionI) sdk.Coins {
+	var coins sdk.Coins
+	for _, denom := range types.PayingDenoms(auction) {
+		coins = coins.Add(sdk.NewCoin(denom, balances.AmountOf(denom)))
+	}
+	return coins
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/stretchr/testify/suite"
@@ -154,3 +155,48 @@ func (s *KeeperTestSuite) TestVestingPoolReserveAmountInvariant() {
 	_, broken = keeper.VestingPoolReserveAmountInvariant(k)(ctx)
 	s.Require().False(broken)
 }
+
+func (s *KeeperTestSuite) TestVestingQueueAmountInvariant() {
+	k, ctx := s.keeper, s.ctx
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		math.LegacyOneDec(),
+		sdk.NewInt64Coin("denom3", 500_000_000_000),
+		"denom4",
+		[]types.VestingSchedule{
+			{
+				ReleaseTime: time.Now().AddDate(1, 0, 0),
+				Weight:      math.LegacyMustNewDecFromStr("0.5"),
+			},
+			{
+				ReleaseTime: time.Now().AddDate(1, 6, 0),
+				Weight:      math.LegacyMustNewDecFromStr("0.5"),
+			},
+		},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 3, 0),
+		true,
+	)
+	s.placeBidFixedPrice(auction.GetId(), s.addr(1), math.LegacyOneDec(), parseCoin("20000000denom4"), true)
+
+	// Make the auction ended
+	ctx = ctx.WithBlockTime(auction.GetEndTimes()[0].AddDate(0, 0, 1))
+	s.Require().NoError(k.BeginBlocker(ctx))
+
+	result, err := k.AuctionResult.Get(ctx, auction.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("20000000denom4"), result.VestedCoin)
+
+	_, broken := keeper.VestingQueueAmountInvariant(k)(ctx)
+	s.Require().False(broken)
+
+	vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+	s.Require().NoError(err)
+	vq := vestingQueues[0]
+	vq.PayingCoin = vq.PayingCoin.AddAmount(math.OneInt())
+	s.Require().NoError(k.VestingQueue.Set(ctx, collections.Join(vq.AuctionId, vq.Sequence), vq))
+
+	_, broken = keeper.VestingQueueAmountInvariant(k)(ctx)
+	s.Require().True(broken)
+}
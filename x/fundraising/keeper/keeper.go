@@ -2,13 +2,17 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +38,32 @@ func init() {
 	}
 }
 
+// BidIndexes defines the secondary indexes of the Bid store.
+type BidIndexes struct {
+	// Bidder indexes bids by the bidder address.
+	Bidder *indexes.Multi[sdk.AccAddress, collections.Pair[uint64, uint64], types.Bid]
+}
+
+func (i BidIndexes) IndexesList() []collections.Index[collections.Pair[uint64, uint64], types.Bid] {
+	return []collections.Index[collections.Pair[uint64, uint64], types.Bid]{i.Bidder}
+}
+
+// NewBidIndexes returns the secondary indexes of the Bid store.
+func NewBidIndexes(sb *collections.SchemaBuilder) BidIndexes {
+	return BidIndexes{
+		Bidder: indexes.NewMulti(
+			sb,
+			types.BidsByBidderKey,
+			"bids_by_bidder",
+			sdk.LengthPrefixedAddressKey(sdk.AccAddressKey),
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			func(_ collections.Pair[uint64, uint64], bid types.Bid) (sdk.AccAddress, error) {
+				return sdk.AccAddressFromBech32(bid.Bidder)
+			},
+		),
+	}
+}
+
 type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
@@ -45,22 +75,30 @@ type (
 		// Typically, this should be the x/gov module account.
 		authority string
 
-		Schema         collections.Schema
-		Params         collections.Item[types.Params]
-		MatchedBidsLen collections.Map[uint64, int64]
-		AllowedBidder  collections.Map[collections.Pair[uint64, sdk.AccAddress], types.AllowedBidder]
-		VestingQueue   collections.Map[collections.Pair[uint64, time.Time], types.VestingQueue]
-		BidSeq         collections.Map[uint64, uint64]
-		Bid            collections.Map[collections.Pair[uint64, uint64], types.Bid]
-		AuctionSeq     collections.Sequence
-		Auction        collections.Map[uint64, types.AuctionI]
+		Schema           collections.Schema
+		Params           collections.Item[types.Params]
+		MatchedBidsLen   collections.Map[uint64, int64]
+		AllowedBidder    collections.Map[collections.Pair[uint64, sdk.AccAddress], types.AllowedBidder]
+		VestingQueue     collections.Map[collections.Pair[uint64, uint64], types.VestingQueue]
+		BidSeq           collections.Map[uint64, uint64]
+		Bid              *collections.IndexedMap[collections.Pair[uint64, uint64], types.Bid, BidIndexes]
+		AuctionSeq       collections.Sequence
+		Auction          collections.Map[uint64, types.AuctionI]
+		AuctionQueue     collections.KeySet[collections.Pair[time.Time, uint64]]
+		FailedTransition collections.Map[uint64, types.FailedTransition]
+		AuctionResult    collections.Map[uint64, types.AuctionResult]
+		CollectedFees    collections.Map[uint64, types.CollectedFees]
+		BuyerVesting     collections.Map[collections.Pair[uint64, sdk.AccAddress], types.BuyerVesting]
+		BidCommitmentSeq collections.Map[uint64, uint64]
+		BidCommitment    collections.Map[collections.Pair[uint64, uint64], types.BidCommitment]
 		// this line is used by starport scaffolding # collection/type
 
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
 
-		hooks types.FundraisingHooks
+		hooks       types.FundraisingHooks
+		priceSource types.PriceSource
 	}
 )
 
@@ -81,22 +119,29 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	k := Keeper{
-		cdc:            cdc,
-		addressCodec:   addressCodec,
-		storeService:   storeService,
-		authority:      authority,
-		logger:         logger,
-		accountKeeper:  accountKeeper,
-		bankKeeper:     bankKeeper,
-		distrKeeper:    distrKeeper,
-		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		MatchedBidsLen: collections.NewMap(sb, types.MatchedBidsLenKey, "matchedBidsLen", collections.Uint64Key, collections.Int64Value),
-		AllowedBidder:  collections.NewMap(sb, types.AllowedBidderKey, "allowedBidder", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[types.AllowedBidder](cdc)),
-		VestingQueue:   collections.NewMap(sb, types.VestingQueueKey, "vestingQueue", collections.PairKeyCodec(collections.Uint64Key, sdk.TimeKey), codec.CollValue[types.VestingQueue](cdc)),
-		BidSeq:         collections.NewMap(sb, types.BidCountKey, "bid_seq", collections.Uint64Key, collections.Uint64Value),
-		Bid:            collections.NewMap(sb, types.BidKey, "bid", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Bid](cdc)),
-		AuctionSeq:     collections.NewSequence(sb, types.AuctionCountKey, "auction_seq"),
-		Auction:        collections.NewMap(sb, types.AuctionKey, "auction", collections.Uint64Key, codec.CollInterfaceValue[types.AuctionI](cdc)),
+		cdc:              cdc,
+		addressCodec:     addressCodec,
+		storeService:     storeService,
+		authority:        authority,
+		logger:           logger,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		distrKeeper:      distrKeeper,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		MatchedBidsLen:   collections.NewMap(sb, types.MatchedBidsLenKey, "matchedBidsLen", collections.Uint64Key, collections.Int64Value),
+		AllowedBidder:    collections.NewMap(sb, types.AllowedBidderKey, "allowedBidder", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[types.AllowedBidder](cdc)),
+		VestingQueue:     collections.NewMap(sb, types.VestingQueueKey, "vestingQueue", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.VestingQueue](cdc)),
+		BidSeq:           collections.NewMap(sb, types.BidCountKey, "bid_seq", collections.Uint64Key, collections.Uint64Value),
+		Bid:              collections.NewIndexedMap(sb, types.BidKey, "bid", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Bid](cdc), NewBidIndexes(sb)),
+		AuctionSeq:       collections.NewSequence(sb, types.AuctionCountKey, "auction_seq"),
+		Auction:          collections.NewMap(sb, types.AuctionKey, "auction", collections.Uint64Key, codec.CollInterfaceValue[types.AuctionI](cdc)),
+		AuctionQueue:     collections.NewKeySet(sb, types.AuctionQueueKey, "auctionQueue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		FailedTransition: collections.NewMap(sb, types.FailedTransitionKey, "failedTransition", collections.Uint64Key, codec.CollValue[types.FailedTransition](cdc)),
+		AuctionResult:    collections.NewMap(sb, types.AuctionResultKey, "auctionResult", collections.Uint64Key, codec.CollValue[types.AuctionResult](cdc)),
+		CollectedFees:    collections.NewMap(sb, types.CollectedFeesKey, "collectedFees", collections.Uint64Key, codec.CollValue[types.CollectedFees](cdc)),
+		BuyerVesting:     collections.NewMap(sb, types.BuyerVestingKey, "buyerVesting", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[types.BuyerVesting](cdc)),
+		BidCommitmentSeq: collections.NewMap(sb, types.BidCommitmentCountKey, "bidCommitmentSeq", collections.Uint64Key, collections.Uint64Value),
+		BidCommitment:    collections.NewMap(sb, types.BidCommitmentKey, "bidCommitment", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.BidCommitment](cdc)),
 		// this line is used by starport scaffolding # collection/instantiate
 	}
 
@@ -119,29 +164,239 @@ func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// PayCreationFee sends the auction creation fee to the fee collector account.
-func (k Keeper) PayCreationFee(ctx context.Context, auctioneerAddr sdk.AccAddress) error {
+// ValidateAuctionDenoms returns an error if the selling coin denom is blocked or
+// the paying coin denom is not allowed by the params.
+func (k Keeper) ValidateAuctionDenoms(ctx context.Context, sellingCoinDenom, payingCoinDenom string) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
-	if err := k.distrKeeper.FundCommunityPool(ctx, params.AuctionCreationFee, auctioneerAddr); err != nil {
-		return err
+	if params.IsSellingDenomBlocked(sellingCoinDenom) {
+		return sdkerrors.Wrapf(types.ErrDenomNotAllowed, "selling coin denom %s is blocked", sellingCoinDenom)
+	}
+	if !params.IsPayingDenomAllowed(payingCoinDenom) {
+		return sdkerrors.Wrapf(types.ErrDenomNotAllowed, "paying coin denom %s is not allowed", payingCoinDenom)
 	}
 	return nil
 }
 
-// PayPlaceBidFee sends the fee when placing a bid for an auction to the fee collector account.
-func (k Keeper) PayPlaceBidFee(ctx context.Context, bidderAddr sdk.AccAddress) error {
+// SetPriceSource sets the price source that provides the conversion ratios of the additional paying denoms.
+func (k *Keeper) SetPriceSource(ps types.PriceSource) *Keeper {
+	if k.priceSource != nil {
+		panic("cannot set fundraising price source twice")
+	}
+	k.priceSource = ps
+	return k
+}
+
+// ResolvePayingDenoms returns the additional paying denoms of an auction being created with the missing
+// conversion ratios filled in by the price source, so that the ratios stay fixed for the auction.
+// Each denom must be allowed as a paying denom by the params.
+func (k Keeper) ResolvePayingDenoms(ctx context.Context, payingCoinDenom string, payingDenoms []types.PayingDenom) ([]types.PayingDenom, error) {
+	if len(payingDenoms) == 0 {
+		return nil, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]types.PayingDenom, 0, len(payingDenoms))
+	for _, pd := range payingDenoms {
+		if !params.IsPayingDenomAllowed(pd.Denom) {
+			return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "paying denom %s is not allowed", pd.Denom)
+		}
+
+		if !pd.HasRatio() {
+			if k.priceSource == nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidPayingDenoms, "conversion ratio of %s is not set and there is no price source", pd.Denom)
+			}
+			ratio, err := k.priceSource.ConversionRatio(ctx, pd.Denom, payingCoinDenom)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to get conversion ratio of %s", pd.Denom)
+			}
+			if !ratio.IsPositive() {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidPayingDenoms, "conversion ratio of %s must be positive", pd.Denom)
+			}
+			pd.Ratio = ratio
+		}
+		resolved = append(resolved, pd)
+	}
+
+	return resolved, nil
+}
+
+// PayCreationFee collects the auction creation fee from the auctioneer.
+func (k Keeper) PayCreationFee(ctx context.Context, auctionId uint64, auctioneerAddr sdk.AccAddress) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
+	return k.CollectFee(ctx, auctionId, auctioneerAddr, types.FeeTypeCreation, params.AuctionCreationFee)
+}
+
+// PayPlaceBidFee collects the fee when placing a bid for an auction from the bidder.
+// When RefundUnmatchedBidFee is enabled, the fee for a batch auction is escrowed in the
+// module account instead and returned so that it can be stored in the bid.
+func (k Keeper) PayPlaceBidFee(ctx context.Context, auction types.AuctionI, bidderAddr sdk.AccAddress) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch || !params.RefundUnmatchedBidFee {
+		return nil, k.CollectFee(ctx, auction.GetId(), bidderAddr, types.FeeTypePlaceBid, params.PlaceBidFee)
+	}
 
-	if err := k.distrKeeper.FundCommunityPool(ctx, params.PlaceBidFee, bidderAddr); err != nil {
+	if params.PlaceBidFee.IsZero() {
+		return nil, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidderAddr, types.ModuleName, params.PlaceBidFee); err != nil {
+		return nil, err
+	}
+	return params.PlaceBidFee, nil
+}
+
+// SettleEscrowedBidFees refunds the escrowed place bid fees of the unmatched bids of the batch auction
+// to the bidders and collects the fees of the matched bids.
+func (k Keeper) SettleEscrowedBidFees(ctx context.Context, auction types.AuctionI) error {
+	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
+	if err != nil {
 		return err
 	}
-	return nil
+
+	matchedFee := sdk.NewCoins()
+	refundFeeByBidder := make(map[string]sdk.Coins)
+	for _, bid := range bids {
+		if bid.EscrowedFee.IsZero() {
+			continue
+		}
+
+		if bid.IsMatched {
+			matchedFee = matchedFee.Add(bid.EscrowedFee...)
+		} else {
+			refundFeeByBidder[bid.Bidder] = refundFeeByBidder[bid.Bidder].Add(bid.EscrowedFee...)
+		}
+
+		bid.EscrowedFee = nil
+		if err := k.Bid.Set(ctx, collections.Join(bid.AuctionId, bid.Id), bid); err != nil {
+			return err
+		}
+	}
+
+	// Sort bidders to reserve determinism
+	bidders := make([]string, 0, len(refundFeeByBidder))
+	for bidder := range refundFeeByBidder {
+		bidders = append(bidders, bidder)
+	}
+	sort.Strings(bidders)
+
+	for _, bidder := range bidders {
+		bidderAddr, err := sdk.AccAddressFromBech32(bidder)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddr, refundFeeByBidder[bidder]); err != nil {
+			return sdkerrors.Wrap(err, "failed to refund place bid fee")
+		}
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBidFeeRefunded{
+			AuctionId: auction.GetId(),
+			Bidder:    bidder,
+			Fee:       refundFeeByBidder[bidder],
+		}); err != nil {
+			return err
+		}
+	}
+
+	return k.CollectFee(ctx, auction.GetId(), k.accountKeeper.GetModuleAddress(types.ModuleName), types.FeeTypePlaceBid, matchedFee)
+}
+
+// CollectFee distributes the fee paid by the payer to the community pool, the fee treasury
+// and the burn according to the params, and records it as collected for the auction.
+func (k Keeper) CollectFee(ctx context.Context, auctionId uint64, payer sdk.AccAddress, feeType string, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	communityPool, treasury, burned := params.SplitFee(fee)
+	if !treasury.IsZero() {
+		treasuryAddr, err := k.addressCodec.StringToBytes(params.FeeTreasuryAddress)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid fee treasury address")
+		}
+		if err := k.bankKeeper.SendCoins(ctx, payer, treasuryAddr, treasury); err != nil {
+			return err
+		}
+	}
+	if !burned.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, burned); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return err
+		}
+	}
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, payer); err != nil {
+			return err
+		}
+	}
+
+	if err := k.addCollectedFee(ctx, auctionId, feeType, fee); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFeeCollected{
+		AuctionId:     auctionId,
+		Payer:         payer.String(),
+		FeeType:       feeType,
+		CommunityPool: communityPool,
+		Treasury:      treasury,
+		Burned:        burned,
+	})
+}
+
+// PayAuctioneerBidFee sends the bid fee set by the auctioneer from the bidder to the beneficiary of the auction.
+func (k Keeper) PayAuctioneerBidFee(ctx context.Context, auction types.AuctionI, bidderAddr sdk.AccAddress) error {
+	fee := auction.GetBidFee()
+	if fee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, bidderAddr, auction.GetBeneficiary(), fee); err != nil {
+		return err
+	}
+	return k.addCollectedFee(ctx, auction.GetId(), types.FeeTypeAuctioneerBid, fee)
+}
+
+// addCollectedFee adds the fee to the fees collected for the auction.
+func (k Keeper) addCollectedFee(ctx context.Context, auctionId uint64, feeType string, fee sdk.Coins) error {
+	collected, err := k.CollectedFees.Get(ctx, auctionId)
+	if errors.Is(err, collections.ErrNotFound) {
+		collected = types.CollectedFees{AuctionId: auctionId}
+	} else if err != nil {
+		return err
+	}
+
+	switch feeType {
+	case types.FeeTypeCreation:
+		collected.CreationFee = collected.CreationFee.Add(fee...)
+	case types.FeeTypePlaceBid:
+		collected.PlaceBidFee = collected.PlaceBidFee.Add(fee...)
+	case types.FeeTypeSettlement:
+		collected.SettlementFee = collected.SettlementFee.Add(fee...)
+	case types.FeeTypeAuctioneerBid:
+		collected.AuctioneerBidFee = collected.AuctioneerBidFee.Add(fee...)
+	default:
+		return fmt.Errorf("unknown fee type %s", feeType)
+	}
+
+	return k.CollectedFees.Set(ctx, auctionId, collected)
 }
 
 // ReserveSellingCoin reserves the selling coin to the selling reserve account.
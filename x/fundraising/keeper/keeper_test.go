@@ -9,8 +9,10 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrand "github.com/cometbft/cometbft/libs/rand"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/tendermint/fundraising/app"
@@ -125,6 +127,41 @@ func (s *KeeperTestSuite) createBatchAuction(
 	return auction.(*types.BatchAuction)
 }
 
+func (s *KeeperTestSuite) createDutchAuction(
+	auctioneer sdk.AccAddress,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+	fund bool,
+) *types.DutchAuction {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+
+	if fund {
+		s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+	}
+
+	auction, err := s.keeper.CreateDutchAuction(s.ctx, &types.MsgCreateDutchAuction{
+		Auctioneer:       auctioneer.String(),
+		StartPrice:       startPrice,
+		FloorPrice:       floorPrice,
+		SellingCoin:      sellingCoin,
+		PayingCoinDenom:  payingCoinDenom,
+		VestingSchedules: vestingSchedules,
+		DecayInterval:    decayInterval,
+		StartTime:        startTime,
+		EndTime:          endTime,
+	})
+	s.Require().NoError(err)
+
+	return auction.(*types.DutchAuction)
+}
+
 func (s *KeeperTestSuite) addAllowedBidder(auctionId uint64, bidder sdk.AccAddress, maxBidAmt math.Int) error {
 	allowedBidder, err := s.keeper.AllowedBidder.Get(s.ctx, collections.Join(auctionId, bidder))
 	if err == nil {
@@ -137,6 +174,14 @@ func (s *KeeperTestSuite) addAllowedBidder(auctionId uint64, bidder sdk.AccAddre
 	return s.keeper.AllowedBidder.Set(s.ctx, collections.Join(auctionId, bidder), types.NewAllowedBidder(auctionId, bidder, maxBidAmt))
 }
 
+// enableAutoRelease opts the auction in to the release of the vested paying coin by the begin blocker.
+func (s *KeeperTestSuite) enableAutoRelease(auctionId uint64) {
+	auction, err := s.keeper.Auction.Get(s.ctx, auctionId)
+	s.Require().NoError(err)
+	s.Require().NoError(auction.SetAutoRelease(true))
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auctionId, auction))
+}
+
 func (s *KeeperTestSuite) placeBidFixedPrice(
 	auctionId uint64,
 	bidder sdk.AccAddress,
@@ -240,6 +285,50 @@ func (s *KeeperTestSuite) placeBidBatchMany(
 }
 
 // Below are useful helpers to write test code easily.
+func (s *KeeperTestSuite) placeBidDutch(
+	auctionId uint64,
+	bidder sdk.AccAddress,
+	price math.LegacyDec,
+	coin sdk.Coin,
+	fund bool,
+) types.Bid {
+	auction, err := s.keeper.Auction.Get(s.ctx, auctionId)
+	s.Require().NoError(err)
+
+	// The bid is filled at the current price
+	currentPrice := auction.(*types.DutchAuction).CurrentPrice(s.ctx.BlockTime())
+
+	var fundCoin sdk.Coin
+	var maxBidAmt math.Int
+
+	if coin.Denom == auction.GetPayingCoinDenom() {
+		fundCoin = coin
+		maxBidAmt = math.LegacyNewDecFromInt(coin.Amount).QuoTruncate(currentPrice).TruncateInt()
+	} else {
+		fundAmt := math.LegacyNewDecFromInt(coin.Amount).Mul(currentPrice).Ceil().TruncateInt()
+		fundCoin = sdk.NewCoin(auction.GetPayingCoinDenom(), fundAmt)
+		maxBidAmt = coin.Amount
+	}
+
+	if fund {
+		s.fundAddr(bidder, sdk.NewCoins(fundCoin))
+	}
+
+	err = s.addAllowedBidder(auctionId, bidder, maxBidAmt)
+	s.Require().NoError(err)
+
+	b, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auctionId,
+		Bidder:    bidder.String(),
+		BidType:   types.BidTypeDutch,
+		Price:     price,
+		Coin:      coin,
+	})
+	s.Require().NoError(err)
+
+	return b
+}
+
 func (s *KeeperTestSuite) addr(index int) sdk.AccAddress {
 	if index >= maxAddress {
 		panic(fmt.Sprintf("invalid address index %d", index))
@@ -256,6 +345,20 @@ func (s *KeeperTestSuite) getBalance(addr sdk.AccAddress, denom string) sdk.Coin
 	return s.app.BankKeeper.GetBalance(s.ctx, addr, denom)
 }
 
+// typedEvents returns the typed events of the same type as msg emitted in the current context.
+func (s *KeeperTestSuite) typedEvents(msg proto.Message) []proto.Message {
+	var events []proto.Message
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type != proto.MessageName(msg) {
+			continue
+		}
+		event, err := sdk.ParseTypedEvent(abci.Event(ev))
+		s.Require().NoError(err)
+		events = append(events, event)
+	}
+	return events
+}
+
 func (s *KeeperTestSuite) sendCoins(fromAddr, toAddr sdk.AccAddress, coins sdk.Coins, fund bool) {
 	if fund {
 		s.fundAddr(fromAddr, coins)
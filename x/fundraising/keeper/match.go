@@ -7,18 +7,33 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
 
 // MatchingInfo holds information about an auction matching information.
+// The paying amounts are in the paying coin denom of the auction, where the coins of the additional
+// paying denoms are counted at their conversion ratios; the paying coins are held in the coins maps.
 type MatchingInfo struct {
-	MatchedLen         int64               // the length of matched bids
-	MatchedPrice       math.LegacyDec      // the final matched price
-	TotalMatchedAmount math.Int            // the total sold amount
-	AllocationMap      map[string]math.Int // the map that holds allocate amount information for each bidder
-	ReservedMatchedMap map[string]math.Int // the map that holds each bidder's matched amount out of their total reserved amount
-	RefundMap          map[string]math.Int // the map that holds refund amount information for each bidder
+	MatchedLen         int64                // the length of matched bids
+	MatchedPrice       math.LegacyDec       // the final matched price
+	TotalMatchedAmount math.Int             // the total sold amount
+	AllocationMap      map[string]math.Int  // the map that holds allocate amount information for each bidder
+	ReservedMatchedMap map[string]math.Int  // the map that holds each bidder's paid amount out of their total reserved amount
+	RefundMap          map[string]math.Int  // the map that holds refund amount information for each bidder
+	PaidCoinsMap       map[string]sdk.Coins // the map that holds each bidder's paid coins in the paying denoms they reserved
+	RefundCoinsMap     map[string]sdk.Coins // the map that holds each bidder's refund coins in the paying denoms they reserved
+	PartialFills       []types.PartialFill  // the bids at the matched price that are filled partially
+}
+
+// TotalPayingAmount returns the total paying amount of the matched bids.
+func (mInfo MatchingInfo) TotalPayingAmount() math.Int {
+	total := math.ZeroInt()
+	for _, amt := range mInfo.ReservedMatchedMap {
+		total = total.Add(amt)
+	}
+	return total
 }
 
 func (k Keeper) GetLastMatchedBidsLen(ctx context.Context, auctionId uint64) (int64, error) {
@@ -39,6 +54,8 @@ func (k Keeper) CalculateFixedPriceAllocation(ctx context.Context, auction types
 		MatchedPrice:       auction.GetStartPrice(),
 		TotalMatchedAmount: math.ZeroInt(),
 		AllocationMap:      map[string]math.Int{},
+		ReservedMatchedMap: map[string]math.Int{},
+		PaidCoinsMap:       map[string]sdk.Coins{},
 	}
 
 	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
@@ -50,13 +67,16 @@ func (k Keeper) CalculateFixedPriceAllocation(ctx context.Context, auction types
 	// Loop through all bids and calculate allocated amount
 	// Accumulate the allocated amount if a bidder placed multiple bids
 	for _, bid := range bids {
-		bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+		normBid := bid.Normalize(auction)
+		bidAmt := normBid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
 
 		allocatedAmt, ok := mInfo.AllocationMap[bid.Bidder]
 		if !ok {
 			allocatedAmt = math.ZeroInt()
 		}
 		mInfo.AllocationMap[bid.Bidder] = allocatedAmt.Add(bidAmt)
+		mInfo.ReservedMatchedMap[bid.Bidder] = amountOf(mInfo.ReservedMatchedMap, bid.Bidder).Add(normBid.ConvertToPayingAmount(auction.GetPayingCoinDenom()))
+		mInfo.PaidCoinsMap[bid.Bidder] = mInfo.PaidCoinsMap[bid.Bidder].Add(bid.ReservedPayingCoin(auction))
 		mInfo.TotalMatchedAmount = mInfo.TotalMatchedAmount.Add(bidAmt)
 		mInfo.MatchedLen++
 	}
@@ -64,25 +84,185 @@ func (k Keeper) CalculateFixedPriceAllocation(ctx context.Context, auction types
 	return mInfo, nil
 }
 
-func (k Keeper) CalculateBatchAllocation(ctx context.Context, auction types.AuctionI) (MatchingInfo, error) {
+// CalculateOverflowAllocation loops through all bids for the overflow sale of the fixed price auction and
+// calculates matching information. When the bids exceed the selling coin, each bid is allocated
+// sellingAmt * bidAmt / totalBidAmt of the selling coin at the fixed price and the rest of its paying coin is refunded.
+func (k Keeper) CalculateOverflowAllocation(ctx context.Context, auction types.AuctionI) (MatchingInfo, error) {
 	mInfo := MatchingInfo{
+		MatchedPrice:       auction.GetStartPrice(),
+		TotalMatchedAmount: math.ZeroInt(),
 		AllocationMap:      map[string]math.Int{},
 		ReservedMatchedMap: map[string]math.Int{},
 		RefundMap:          map[string]math.Int{},
+		PaidCoinsMap:       map[string]sdk.Coins{},
+		RefundCoinsMap:     map[string]sdk.Coins{},
 	}
 
 	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
 	if err != nil {
 		return mInfo, err
 	}
-	prices, bidsByPrice := types.BidsByPrice(bids)
+
+	payingCoinDenom := auction.GetPayingCoinDenom()
+	totalBidAmt := math.ZeroInt()
+	for _, bid := range bids {
+		totalBidAmt = totalBidAmt.Add(bid.Normalize(auction).ConvertToSellingAmount(payingCoinDenom))
+	}
 	sellingAmt := auction.GetSellingCoin().Amount
+	oversubscribed := totalBidAmt.GT(sellingAmt)
 
-	allowedBidders, err := k.GetAllowedBiddersByAuction(ctx, auction.GetId())
+	for _, bid := range bids {
+		normBid := bid.Normalize(auction)
+		bidAmt := normBid.ConvertToSellingAmount(payingCoinDenom)
+		reservedAmt := normBid.ConvertToPayingAmount(payingCoinDenom)
+		reservedCoin := bid.ReservedPayingCoin(auction)
+
+		// Every bid is filled as it is unless the sale is oversubscribed
+		allocatedAmt, payingAmt, paidCoin := bidAmt, reservedAmt, reservedCoin
+		if oversubscribed {
+			allocatedAmt = sellingAmt.Mul(bidAmt).Quo(totalBidAmt)
+			payingAmt = math.MinInt(math.LegacyNewDecFromInt(allocatedAmt).Mul(mInfo.MatchedPrice).Ceil().TruncateInt(), reservedAmt)
+
+			ratio, _ := types.PayingDenomRatio(auction, reservedCoin.Denom)
+			paidAmt := math.MinInt(types.DenormalizePayingAmount(payingAmt, ratio), reservedCoin.Amount)
+			paidCoin = sdk.NewCoin(reservedCoin.Denom, paidAmt)
+		}
+
+		mInfo.AllocationMap[bid.Bidder] = amountOf(mInfo.AllocationMap, bid.Bidder).Add(allocatedAmt)
+		mInfo.ReservedMatchedMap[bid.Bidder] = amountOf(mInfo.ReservedMatchedMap, bid.Bidder).Add(payingAmt)
+		mInfo.RefundMap[bid.Bidder] = amountOf(mInfo.RefundMap, bid.Bidder).Add(reservedAmt.Sub(payingAmt))
+		mInfo.PaidCoinsMap[bid.Bidder] = mInfo.PaidCoinsMap[bid.Bidder].Add(paidCoin)
+		mInfo.RefundCoinsMap[bid.Bidder] = mInfo.RefundCoinsMap[bid.Bidder].Add(reservedCoin.Sub(paidCoin))
+		mInfo.TotalMatchedAmount = mInfo.TotalMatchedAmount.Add(allocatedAmt)
+		if allocatedAmt.IsPositive() {
+			mInfo.MatchedLen++
+		}
+	}
+
+	return mInfo, nil
+}
+
+// CalculateDutchAllocation loops through all bids for the dutch auction and calculate matching information.
+// Every bid is filled at the auction price at the time it is placed, so the matched price is the lowest one.
+// A bid in the paying coin pays only for the selling coin it is allocated and the rest of its paying coin is refunded.
+func (k Keeper) CalculateDutchAllocation(ctx context.Context, auction types.AuctionI) (MatchingInfo, error) {
+	mInfo := MatchingInfo{
+		MatchedPrice:       auction.GetStartPrice(),
+		TotalMatchedAmount: math.ZeroInt(),
+		AllocationMap:      map[string]math.Int{},
+		ReservedMatchedMap: map[string]math.Int{},
+		RefundMap:          map[string]math.Int{},
+		PaidCoinsMap:       map[string]sdk.Coins{},
+		RefundCoinsMap:     map[string]sdk.Coins{},
+	}
+
+	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
 	if err != nil {
 		return mInfo, err
 	}
 
+	// All bids for the auction are already matched in message level
+	payingCoinDenom := auction.GetPayingCoinDenom()
+	for _, bid := range bids {
+		normBid := bid.Normalize(auction)
+		bidAmt := normBid.ConvertToSellingAmount(payingCoinDenom)
+		reservedAmt := normBid.ConvertToPayingAmount(payingCoinDenom)
+		reservedCoin := bid.ReservedPayingCoin(auction)
+
+		// The selling amount of the bid is truncated, so its paying amount is taken back from it
+		payingAmt := math.MinInt(math.LegacyNewDecFromInt(bidAmt).Mul(bid.Price).Ceil().TruncateInt(), reservedAmt)
+		ratio, _ := types.PayingDenomRatio(auction, reservedCoin.Denom)
+		paidAmt := math.MinInt(types.DenormalizePayingAmount(payingAmt, ratio), reservedCoin.Amount)
+		paidCoin := sdk.NewCoin(reservedCoin.Denom, paidAmt)
+
+		mInfo.AllocationMap[bid.Bidder] = amountOf(mInfo.AllocationMap, bid.Bidder).Add(bidAmt)
+		mInfo.ReservedMatchedMap[bid.Bidder] = amountOf(mInfo.ReservedMatchedMap, bid.Bidder).Add(payingAmt)
+		mInfo.RefundMap[bid.Bidder] = amountOf(mInfo.RefundMap, bid.Bidder).Add(reservedAmt.Sub(payingAmt))
+		mInfo.PaidCoinsMap[bid.Bidder] = mInfo.PaidCoinsMap[bid.Bidder].Add(paidCoin)
+		mInfo.RefundCoinsMap[bid.Bidder] = mInfo.RefundCoinsMap[bid.Bidder].Add(reservedCoin.Sub(paidCoin))
+		mInfo.TotalMatchedAmount = mInfo.TotalMatchedAmount.Add(bidAmt)
+		mInfo.MatchedLen++
+
+		if bid.Price.LT(mInfo.MatchedPrice) {
+			mInfo.MatchedPrice = bid.Price
+		}
+	}
+
+	return mInfo, nil
+}
+
+// CalculateBatchAllocation calculates matching information of the batch auction and
+// marks the matched bids and the length of them in the store.
+func (k Keeper) CalculateBatchAllocation(ctx context.Context, auction types.AuctionI) (MatchingInfo, error) {
+	mInfo, matchedBids, err := k.SimulateBatchAllocation(ctx, auction)
+	if err != nil {
+		return mInfo, err
+	}
+
+	matched := make(map[uint64]bool, len(matchedBids))
+	for _, bid := range matchedBids {
+		matched[bid.Id] = true
+	}
+
+	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return mInfo, err
+	}
+
+	// A bid matched at the previous end time may no longer be matched after an extended round
+	for _, bid := range bids {
+		if bid.IsMatched == matched[bid.Id] {
+			continue
+		}
+		bid.SetMatched(matched[bid.Id])
+		if err := k.Bid.Set(ctx, collections.Join(bid.AuctionId, bid.Id), bid); err != nil {
+			return mInfo, err
+		}
+	}
+
+	return mInfo, k.SetMatchedBidsLen(ctx, auction.GetId(), mInfo.MatchedLen)
+}
+
+// SimulateBatchAllocation calculates matching information of the batch auction with the bids placed so far
+// and returns it along with the matched bids. It doesn't write anything to the store.
+func (k Keeper) SimulateBatchAllocation(ctx context.Context, auction types.AuctionI) (MatchingInfo, []types.Bid, error) {
+	mInfo := MatchingInfo{
+		AllocationMap:      map[string]math.Int{},
+		ReservedMatchedMap: map[string]math.Int{},
+		RefundMap:          map[string]math.Int{},
+		PaidCoinsMap:       map[string]sdk.Coins{},
+		RefundCoinsMap:     map[string]sdk.Coins{},
+	}
+
+	bids, err := k.GetBidsByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return mInfo, nil, err
+	}
+
+	// The bids are matched in the paying coin denom regardless of the paying denoms they reserve
+	normBids := make([]types.Bid, len(bids))
+	bidById := make(map[uint64]types.Bid, len(bids))
+	for i, bid := range bids {
+		normBids[i] = bid.Normalize(auction)
+		bidById[bid.Id] = bid
+	}
+	prices, bidsByPrice := types.BidsByPrice(normBids)
+	sellingAmt := auction.GetSellingCoin().Amount
+
+	allowedBidders, err := k.GetAllowedBiddersByAuction(ctx, auction.GetId())
+	if err != nil {
+		return mInfo, nil, err
+	}
+
+	var (
+		fillMode    types.MarginalFillMode
+		pricingMode types.PricingMode
+	)
+	if batchAuction, ok := auction.(*types.BatchAuction); ok {
+		fillMode = batchAuction.MarginalFillMode
+		pricingMode = batchAuction.PricingMode
+	}
+
 	matchRes := &types.MatchResult{
 		MatchPrice:          math.LegacyDec{},
 		MatchedAmount:       math.ZeroInt(),
@@ -99,7 +279,7 @@ func (k Keeper) CalculateBatchAllocation(ctx context.Context, auction types.Auct
 		// Note that our goal is to find the first true(matched) condition, starting
 		// from the lowest price.
 		i = (len(prices) - 1) - i
-		res, matched := types.Match(prices[i], prices, bidsByPrice, sellingAmt, allowedBidders)
+		res, matched := types.Match(prices[i], prices, bidsByPrice, sellingAmt, allowedBidders, fillMode, pricingMode)
 		if matched { // If we found a valid matching price, store the result
 			matchRes = res
 		}
@@ -109,34 +289,53 @@ func (k Keeper) CalculateBatchAllocation(ctx context.Context, auction types.Auct
 	mInfo.MatchedLen = int64(len(matchRes.MatchedBids))
 	mInfo.MatchedPrice = matchRes.MatchPrice
 	mInfo.TotalMatchedAmount = matchRes.MatchedAmount
+	mInfo.PartialFills = matchRes.PartialFills
 
 	reservedAmtByBidder := map[string]math.Int{}
+	reservedCoinsByBidder := map[string]sdk.Coins{}
 	for _, bid := range bids {
 		bidderReservedAmt, ok := reservedAmtByBidder[bid.Bidder]
 		if !ok {
 			bidderReservedAmt = math.ZeroInt()
 		}
-		reservedAmtByBidder[bid.Bidder] = bidderReservedAmt.Add(bid.ConvertToPayingAmount(auction.GetPayingCoinDenom()))
+		reservedAmtByBidder[bid.Bidder] = bidderReservedAmt.Add(bid.Normalize(auction).ConvertToPayingAmount(auction.GetPayingCoinDenom()))
+		reservedCoinsByBidder[bid.Bidder] = reservedCoinsByBidder[bid.Bidder].Add(bid.ReservedPayingCoin(auction))
 	}
 
 	for bidder, reservedAmt := range reservedAmtByBidder {
 		mInfo.AllocationMap[bidder] = math.ZeroInt()
 		mInfo.ReservedMatchedMap[bidder] = math.ZeroInt()
 		mInfo.RefundMap[bidder] = reservedAmt
+		mInfo.RefundCoinsMap[bidder] = reservedCoinsByBidder[bidder]
 	}
 
 	for bidder, bidderRes := range matchRes.MatchResultByBidder {
 		mInfo.AllocationMap[bidder] = bidderRes.MatchedAmount
 		mInfo.ReservedMatchedMap[bidder] = bidderRes.PayingAmount
 		mInfo.RefundMap[bidder] = reservedAmtByBidder[bidder].Sub(bidderRes.PayingAmount)
+
+		// The paid amount in each paying denom is converted back by its ratio, which never takes
+		// more than the bidder reserved in the denom
+		reservedCoins := reservedCoinsByBidder[bidder]
+		var paidCoins sdk.Coins
+		for payingDenom, payingAmt := range bidderRes.PayingAmountByDenom {
+			denom := payingDenom
+			if denom == "" {
+				denom = auction.GetPayingCoinDenom()
+			}
+			ratio, _ := types.PayingDenomRatio(auction, denom)
+			paidAmt := math.MinInt(types.DenormalizePayingAmount(payingAmt, ratio), reservedCoins.AmountOf(denom))
+			paidCoins = paidCoins.Add(sdk.NewCoin(denom, paidAmt))
+		}
+		mInfo.PaidCoinsMap[bidder] = paidCoins
+		mInfo.RefundCoinsMap[bidder] = reservedCoins.Sub(paidCoins...)
 	}
 
+	// Return the matched bids as they are placed rather than normalized
+	var matchedBids []types.Bid
 	for _, bid := range matchRes.MatchedBids {
-		bid.SetMatched(true)
-		if err := k.Bid.Set(ctx, collections.Join(bid.AuctionId, bid.Id), bid); err != nil {
-			return mInfo, err
-		}
+		matchedBids = append(matchedBids, bidById[bid.Id])
 	}
 
-	return mInfo, k.SetMatchedBidsLen(ctx, auction.GetId(), mInfo.MatchedLen)
+	return mInfo, matchedBids, nil
 }
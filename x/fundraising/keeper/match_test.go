@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	_ "github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
 
@@ -191,6 +195,123 @@ func (s *KeeperTestSuite) TestBatchAuction_Worth() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestBatchAuction_MarginalFill() {
+	for _, tc := range []struct {
+		name         string
+		fillMode     types.MarginalFillMode
+		matchedPrice math.LegacyDec
+		allocations  []math.Int
+	}{
+		{"none", types.MarginalFillModeNone, parseDec("1"), []math.Int{math.NewInt(600_000_000), math.ZeroInt(), math.ZeroInt()}},
+		{"pro rata", types.MarginalFillModeProRata, parseDec("0.9"), []math.Int{math.NewInt(600_000_000), math.NewInt(300_000_000), math.NewInt(100_000_000)}},
+		{"bid id", types.MarginalFillModeBidId, parseDec("0.9"), []math.Int{math.NewInt(600_000_000), math.NewInt(400_000_000), math.ZeroInt()}},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			auction := s.createBatchAuction(
+				s.addr(0),
+				parseDec("1"),
+				parseDec("0.1"),
+				parseCoin("1_000_000_000denom1"),
+				"denom2",
+				[]types.VestingSchedule{},
+				1,
+				math.LegacyMustNewDecFromStr("0.2"),
+				time.Now().AddDate(0, 0, -1),
+				time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+				true,
+			)
+			auction.MarginalFillMode = tc.fillMode
+			s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+			s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1"), parseCoin("600_000_000denom1"), math.NewInt(1_000_000_000), true)
+			s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.9"), parseCoin("600_000_000denom1"), math.NewInt(1_000_000_000), true)
+			s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.9"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+
+			a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+			s.Require().NoError(err)
+
+			mInfo, err := s.keeper.CalculateBatchAllocation(s.ctx, a)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.matchedPrice, mInfo.MatchedPrice)
+			totalAmt := math.ZeroInt()
+			for i, allocation := range tc.allocations {
+				s.Require().Equal(allocation, mInfo.AllocationMap[s.addr(i+1).String()])
+				s.Require().Equal(tc.matchedPrice.MulInt(allocation).Ceil().TruncateInt(), mInfo.ReservedMatchedMap[s.addr(i+1).String()])
+				totalAmt = totalAmt.Add(allocation)
+			}
+			s.Require().Equal(totalAmt, mInfo.TotalMatchedAmount)
+
+			var partialFillAmts []math.Int
+			for _, fill := range mInfo.PartialFills {
+				partialFillAmts = append(partialFillAmts, fill.FilledAmount)
+			}
+			switch tc.fillMode {
+			case types.MarginalFillModeNone:
+				s.Require().Empty(partialFillAmts)
+			case types.MarginalFillModeProRata:
+				s.Require().Equal([]math.Int{math.NewInt(300_000_000), math.NewInt(100_000_000)}, partialFillAmts)
+				s.Require().Equal(parseDec("0.5"), mInfo.PartialFills[0].FillRatio)
+			case types.MarginalFillModeBidId:
+				s.Require().Equal([]math.Int{math.NewInt(400_000_000)}, partialFillAmts)
+			}
+
+			bids, err := s.keeper.GetBidsByAuctionId(s.ctx, auction.Id)
+			s.Require().NoError(err)
+			for i, bid := range bids {
+				s.Require().Equal(tc.allocations[i].IsPositive(), bid.IsMatched)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchAuction_DiscriminatoryPricing() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		math.LegacyMustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	auction.PricingMode = types.PricingModeDiscriminatory
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1"), parseCoin("500_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.9"), parseCoin("500_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.8"), parseCoin("500_000_000denom1"), math.NewInt(1_000_000_000), true)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+
+	mInfo, err := s.keeper.CalculateBatchAllocation(s.ctx, a)
+	s.Require().NoError(err)
+
+	// Each matched bid pays its own bid price while the matched price stays the clearing price
+	s.Require().Equal(parseDec("0.9"), mInfo.MatchedPrice)
+	s.Require().Equal(math.NewInt(1_000_000_000), mInfo.TotalMatchedAmount)
+	s.Require().Equal(math.NewInt(500_000_000), mInfo.ReservedMatchedMap[s.addr(1).String()])
+	s.Require().Equal(math.NewInt(450_000_000), mInfo.ReservedMatchedMap[s.addr(2).String()])
+	s.Require().Equal(math.ZeroInt(), mInfo.ReservedMatchedMap[s.addr(3).String()])
+	s.Require().Equal(math.NewInt(950_000_000), mInfo.TotalPayingAmount())
+	s.Require().True(mInfo.RefundMap[s.addr(1).String()].IsZero())
+	s.Require().True(mInfo.RefundMap[s.addr(2).String()].IsZero())
+	s.Require().Equal(math.NewInt(400_000_000), mInfo.RefundMap[s.addr(3).String()])
+
+	s.Require().NoError(s.keeper.RefundPayingCoin(s.ctx, a, mInfo))
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "denom2").IsZero())
+	s.Require().Equal(math.NewInt(400_000_000), s.getBalance(s.addr(3), "denom2").Amount)
+	s.Require().Equal(math.NewInt(950_000_000), s.getBalance(auction.GetPayingReserveAddress(), "denom2").Amount)
+}
+
 func (s *KeeperTestSuite) TestCalculateAllocation_Mixed() {
 	auction := s.createBatchAuction(
 		s.addr(0),
@@ -1284,3 +1405,118 @@ func (s *KeeperTestSuite) TestCalculateAllocation_Mixed3_LimitedDifferent() {
 	err = s.keeper.RefundPayingCoin(s.ctx, auction, mInfo)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestSimulateMatching() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("10_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		math.LegacyMustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	b1 := s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.9"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.7"), parseCoin("100_000_000denom1"), math.NewInt(1_000_000_000), true)
+
+	qs := keeper.NewQueryServerImpl(s.keeper)
+	res, err := qs.SimulateMatching(s.ctx, &types.QuerySimulateMatchingRequest{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("0.7"), res.MatchedPrice)
+	s.Require().Equal(parseInt("500_000_000"), res.MatchedAmount)
+	s.Require().Equal(int64(3), res.MatchedLen)
+	s.Require().Equal(&types.BidderAllocation{
+		Bidder:        s.addr(1).String(),
+		AllocatedCoin: parseCoin("200_000_000denom1"),
+		PaidCoin:      parseCoin("140_000_000denom2"),
+		RefundedCoin:  parseCoin("40_000_000denom2"),
+		PaidCoins:     parseCoins("140_000_000denom2"),
+		RefundedCoins: parseCoins("40_000_000denom2"),
+	}, res.BidderAllocation)
+
+	// The simulation must not write anything to the store
+	bid, err := s.keeper.Bid.Get(s.ctx, collections.Join(auction.Id, b1.Id))
+	s.Require().NoError(err)
+	s.Require().False(bid.IsMatched)
+	has, err := s.keeper.MatchedBidsLen.Has(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// The bidder allocation is omitted when the bidder is not given
+	res, err = qs.SimulateMatching(s.ctx, &types.QuerySimulateMatchingRequest{AuctionId: auction.Id})
+	s.Require().NoError(err)
+	s.Require().Nil(res.BidderAllocation)
+
+	// The simulation is the same as the actual matching
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	mInfo, err := s.keeper.CalculateBatchAllocation(s.ctx, a)
+	s.Require().NoError(err)
+	s.Require().Equal(res.MatchedPrice, mInfo.MatchedPrice)
+	s.Require().Equal(res.MatchedAmount, mInfo.TotalMatchedAmount)
+	s.Require().Equal(res.MatchedLen, mInfo.MatchedLen)
+	s.Require().Equal(types.PricingModeUniform, res.PricingMode)
+
+	_, err = qs.SimulateMatching(s.ctx, &types.QuerySimulateMatchingRequest{AuctionId: auction.Id, Bidder: "invalid"})
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid bidder"))
+
+	_, err = qs.SimulateMatching(s.ctx, &types.QuerySimulateMatchingRequest{AuctionId: 100})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "not found"))
+
+	fixedPriceAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	_, err = qs.SimulateMatching(s.ctx, &types.QuerySimulateMatchingRequest{AuctionId: fixedPriceAuction.Id})
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "auction is not a batch auction"))
+}
+
+func (s *KeeperTestSuite) TestBidBook() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("500_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		math.LegacyMustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.9"), parseCoin("200_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("160_000_000denom2"), math.NewInt(200_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.7"), parseCoin("100_000_000denom1"), math.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.7"), parseCoin("200_000_000denom1"), math.NewInt(100_000_000), true)
+
+	qs := keeper.NewQueryServerImpl(s.keeper)
+	res, err := qs.BidBook(s.ctx, &types.QueryBidBookRequest{AuctionId: auction.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("500_000_000denom1"), res.SellingCoin)
+	s.Require().Equal([]types.BidBookLevel{
+		{Price: parseDec("0.9"), CumulativeAmount: parseInt("200_000_000")},
+		{Price: parseDec("0.8"), CumulativeAmount: parseInt("400_000_000")},
+		// The demand of the second bidder is capped by the maximum bid amount
+		{Price: parseDec("0.7"), CumulativeAmount: parseInt("600_000_000")},
+	}, res.Levels)
+
+	_, err = qs.BidBook(s.ctx, &types.QueryBidBookRequest{AuctionId: 100})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "not found"))
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// It builds the bidder index for the bids that were stored before the index existed.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	bids, err := m.keeper.Bids(ctx)
+	if err != nil {
+		return err
+	}
+
+	notIndexed := func() (types.Bid, error) { return types.Bid{}, collections.ErrNotFound }
+	for _, bid := range bids {
+		pk := collections.Join(bid.AuctionId, bid.Id)
+		if err := m.keeper.Bid.Indexes.Bidder.Reference(ctx, pk, bid, notIndexed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+// It queues the auctions that still have a state transition left, so that the
+// begin blocker no longer needs to load all the auctions.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	auctions, err := m.keeper.Auctions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, auction := range auctions {
+		if err := m.keeper.ScheduleAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4.
+// It opts the existing auctions in to the release of the vested paying coin by
+// the begin blocker, which was the only way to release it before the version.
+// The vesting auctions are queued by Migrate4to5 once their vesting queues are re-keyed.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	auctions, err := m.keeper.Auctions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, auction := range auctions {
+		if err := auction.SetAutoRelease(true); err != nil {
+			return err
+		}
+		if err := m.keeper.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LegacyVestingQueue returns the vesting queues keyed by the auction id and the release time,
+// as they were stored before consensus version 5.
+func (k Keeper) LegacyVestingQueue() collections.Map[collections.Pair[uint64, time.Time], types.VestingQueue] {
+	sb := collections.NewSchemaBuilder(k.storeService)
+	return collections.NewMap(sb, types.LegacyVestingQueueKey, "legacyVestingQueue", collections.PairKeyCodec(collections.Uint64Key, sdk.TimeKey), codec.CollValue[types.VestingQueue](k.cdc))
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5.
+// It moves the vesting queues keyed by the release time to the keys of the sequence
+// in the order of the release time within each auction, and queues the vesting auctions
+// for the release of their vested paying coin.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	legacy := m.keeper.LegacyVestingQueue()
+
+	var keys []collections.Pair[uint64, time.Time]
+	var vestingQueues []types.VestingQueue
+	if err := legacy.Walk(ctx, nil, func(key collections.Pair[uint64, time.Time], vestingQueue types.VestingQueue) (bool, error) {
+		keys = append(keys, key)
+		vestingQueues = append(vestingQueues, vestingQueue)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// The legacy keys are sorted by the release time within each auction
+	sequences := make(map[uint64]uint64)
+	for i, key := range keys {
+		vestingQueue := vestingQueues[i]
+		vestingQueue.Sequence = sequences[key.K1()]
+		sequences[key.K1()]++
+
+		if err := m.keeper.VestingQueue.Set(ctx, collections.Join(key.K1(), vestingQueue.Sequence), vestingQueue); err != nil {
+			return err
+		}
+		if err := legacy.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	// The vesting auctions can only be queued for the release once their vesting queues are re-keyed
+	auctions, err := m.keeper.Auctions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, auction := range auctions {
+		if auction.GetStatus() != types.AuctionStatusVesting {
+			continue
+		}
+		if err := m.keeper.ScheduleAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		parseDec("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("10_000_000denom2"), parseInt("1_000_000_000"), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.6"), parseCoin("10_000_000denom2"), parseInt("1_000_000_000"), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(2), parseDec("0.6"), parseCoin("10_000_000denom2"), parseInt("1_000_000_000"), true)
+
+	// Drop the bidder index to mimic the store before the migration
+	bids, err := s.keeper.Bids(s.ctx)
+	s.Require().NoError(err)
+	for _, bid := range bids {
+		err := s.keeper.Bid.Indexes.Bidder.Unreference(s.ctx, collections.Join(bid.AuctionId, bid.Id), func() (types.Bid, error) {
+			return bid, nil
+		})
+		s.Require().NoError(err)
+	}
+
+	bidsByBidder, err := s.keeper.GetBidsByBidder(s.ctx, s.addr(1))
+	s.Require().NoError(err)
+	s.Require().Empty(bidsByBidder)
+
+	err = keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx)
+	s.Require().NoError(err)
+
+	bidsByBidder, err = s.keeper.GetBidsByBidder(s.ctx, s.addr(1))
+	s.Require().NoError(err)
+	s.Require().Len(bidsByBidder, 2)
+
+	bidsByBidder, err = s.keeper.GetBidsByBidder(s.ctx, s.addr(2))
+	s.Require().NoError(err)
+	s.Require().Len(bidsByBidder, 1)
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	standByAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 1, 0),
+		time.Now().AddDate(0, 2, 0),
+		true,
+	)
+	startedAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	cancelledAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom5"),
+		"denom6",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 1, 0),
+		time.Now().AddDate(0, 2, 0),
+		true,
+	)
+	err := s.keeper.CancelAuction(s.ctx, &types.MsgCancelAuction{
+		Auctioneer: cancelledAuction.Auctioneer,
+		AuctionId:  cancelledAuction.Id,
+	})
+	s.Require().NoError(err)
+
+	// Clear the auction queue to mimic the store before the migration
+	err = s.keeper.AuctionQueue.Clear(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(s.queuedAuctions())
+
+	err = keeper.NewMigrator(s.keeper).Migrate2to3(s.ctx)
+	s.Require().NoError(err)
+
+	s.Require().ElementsMatch([]collections.Pair[time.Time, uint64]{
+		collections.Join(standByAuction.StartTime.UTC(), standByAuction.Id),
+		collections.Join(startedAuction.EndTimes[0].UTC(), startedAuction.Id),
+	}, s.queuedAuctions())
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{{ReleaseTime: releaseTime, Weight: parseDec("1")}},
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+
+	// The vesting auction isn't queued since its paying coin is claimed by default
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Empty(s.queuedAuctions())
+
+	err := keeper.NewMigrator(s.keeper).Migrate3to4(s.ctx)
+	s.Require().NoError(err)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().True(a.GetAutoRelease())
+
+	// The vesting auction is queued by the next migration that re-keys the vesting queues
+	s.Require().Empty(s.queuedAuctions())
+}
+
+func (s *KeeperTestSuite) TestMigrate4to5() {
+	legacy := s.keeper.LegacyVestingQueue()
+	releaseTimes := []time.Time{
+		types.MustParseRFC3339("2023-03-01T00:00:00Z"),
+		types.MustParseRFC3339("2023-06-01T00:00:00Z"),
+	}
+	for _, auctionId := range []uint64{1, 2} {
+		for _, releaseTime := range releaseTimes {
+			err := legacy.Set(s.ctx, collections.Join(auctionId, releaseTime), types.VestingQueue{
+				AuctionId:   auctionId,
+				Auctioneer:  s.addr(0).String(),
+				PayingCoin:  parseCoin("500_000denom2"),
+				ReleaseTime: releaseTime,
+			})
+			s.Require().NoError(err)
+		}
+	}
+
+	err := keeper.NewMigrator(s.keeper).Migrate4to5(s.ctx)
+	s.Require().NoError(err)
+
+	for _, auctionId := range []uint64{1, 2} {
+		vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auctionId)
+		s.Require().NoError(err)
+		s.Require().Len(vestingQueues, 2)
+		for i, vestingQueue := range vestingQueues {
+			s.Require().Equal(uint64(i), vestingQueue.Sequence)
+			s.Require().Equal(releaseTimes[i], vestingQueue.ReleaseTime)
+		}
+	}
+
+	iter, err := legacy.Iterate(s.ctx, nil)
+	s.Require().NoError(err)
+	defer iter.Close()
+	s.Require().False(iter.Valid())
+}
+
+func (s *KeeperTestSuite) TestMigrate3to5() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{{ReleaseTime: releaseTime, Weight: parseDec("1")}},
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Empty(s.queuedAuctions())
+
+	// Move the vesting queues to the legacy keys to mimic the store before the migrations
+	legacy := s.keeper.LegacyVestingQueue()
+	vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Len(vestingQueues, 1)
+	for _, vestingQueue := range vestingQueues {
+		err := s.keeper.VestingQueue.Remove(s.ctx, collections.Join(vestingQueue.AuctionId, vestingQueue.Sequence))
+		s.Require().NoError(err)
+		err = legacy.Set(s.ctx, collections.Join(vestingQueue.AuctionId, vestingQueue.ReleaseTime), vestingQueue)
+		s.Require().NoError(err)
+	}
+
+	migrator := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(migrator.Migrate3to4(s.ctx))
+	s.Require().NoError(migrator.Migrate4to5(s.ctx))
+
+	s.Require().Equal([]collections.Pair[time.Time, uint64]{
+		collections.Join(releaseTime, auction.Id),
+	}, s.queuedAuctions())
+
+	// The vested paying coin is released automatically at the release time
+	s.ctx = s.ctx.WithBlockTime(releaseTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Empty(s.queuedAuctions())
+}
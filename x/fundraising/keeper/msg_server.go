@@ -61,6 +61,18 @@ func (k msgServer) CreateBatchAuction(ctx context.Context, msg *types.MsgCreateB
 	return &types.MsgCreateBatchAuctionResponse{}, nil
 }
 
+func (k msgServer) CreateDutchAuction(ctx context.Context, msg *types.MsgCreateDutchAuction) (*types.MsgCreateDutchAuctionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Auctioneer); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := k.Keeper.CreateDutchAuction(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDutchAuctionResponse{}, nil
+}
+
 func (k msgServer) CreateFixedPriceAuction(ctx context.Context, msg *types.MsgCreateFixedPriceAuction) (*types.MsgCreateFixedPriceAuctionResponse, error) {
 	if _, err := k.addressCodec.StringToBytes(msg.Auctioneer); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid authority address")
@@ -85,6 +97,18 @@ func (k msgServer) ModifyBid(ctx context.Context, msg *types.MsgModifyBid) (*typ
 	return &types.MsgModifyBidResponse{}, nil
 }
 
+func (k msgServer) CancelBid(ctx context.Context, msg *types.MsgCancelBid) (*types.MsgCancelBidResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Bidder); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := k.Keeper.CancelBid(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelBidResponse{}, nil
+}
+
 func (k msgServer) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
 	if _, err := k.addressCodec.StringToBytes(msg.Bidder); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid authority address")
@@ -96,3 +120,125 @@ func (k msgServer) PlaceBid(ctx context.Context, msg *types.MsgPlaceBid) (*types
 
 	return &types.MsgPlaceBidResponse{}, nil
 }
+
+func (k msgServer) ClaimBuyerVesting(ctx context.Context, msg *types.MsgClaimBuyerVesting) (*types.MsgClaimBuyerVestingResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Bidder); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid bidder address")
+	}
+
+	if _, err := k.Keeper.ClaimBuyerVesting(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBuyerVestingResponse{}, nil
+}
+
+func (k msgServer) ClaimVestedProceeds(ctx context.Context, msg *types.MsgClaimVestedProceeds) (*types.MsgClaimVestedProceedsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Auctioneer); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid auctioneer address")
+	}
+
+	if _, err := k.Keeper.ClaimVestedProceeds(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimVestedProceedsResponse{}, nil
+}
+
+func (k msgServer) TransferAuctionOwnership(ctx context.Context, msg *types.MsgTransferAuctionOwnership) (*types.MsgTransferAuctionOwnershipResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Auctioneer); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid auctioneer address")
+	}
+
+	if err := k.Keeper.TransferAuctionOwnership(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferAuctionOwnershipResponse{}, nil
+}
+
+func (k msgServer) AcceptAuctionOwnership(ctx context.Context, msg *types.MsgAcceptAuctionOwnership) (*types.MsgAcceptAuctionOwnershipResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid new owner address")
+	}
+
+	if err := k.Keeper.AcceptAuctionOwnership(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptAuctionOwnershipResponse{}, nil
+}
+
+func (k msgServer) CommitBid(ctx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Bidder); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid bidder address")
+	}
+
+	commitment, err := k.Keeper.CommitBid(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitBidResponse{CommitmentId: commitment.Id}, nil
+}
+
+func (k msgServer) RevealBid(ctx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Bidder); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid bidder address")
+	}
+
+	bid, err := k.Keeper.RevealBid(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealBidResponse{BidId: bid.Id}, nil
+}
+
+func (k msgServer) PauseAuction(ctx context.Context, msg *types.MsgPauseAuction) (*types.MsgPauseAuctionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if err := k.Keeper.PauseAuction(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseAuctionResponse{}, nil
+}
+
+func (k msgServer) ResumeAuction(ctx context.Context, msg *types.MsgResumeAuction) (*types.MsgResumeAuctionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if err := k.Keeper.ResumeAuction(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeAuctionResponse{}, nil
+}
+
+func (k msgServer) CloseAuctionEarly(ctx context.Context, msg *types.MsgCloseAuctionEarly) (*types.MsgCloseAuctionEarlyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if err := k.Keeper.CloseAuctionEarly(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseAuctionEarlyResponse{}, nil
+}
+
+func (k msgServer) ForceCancelAuction(ctx context.Context, msg *types.MsgForceCancelAuction) (*types.MsgForceCancelAuctionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Authority); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := k.Keeper.ForceCancelAuction(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceCancelAuctionResponse{}, nil
+}
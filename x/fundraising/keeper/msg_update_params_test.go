@@ -41,6 +41,26 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "invalid allowed paying denoms",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.Params{AllowedPayingDenoms: []string{"denom1", "denom1"}},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate denom denom1",
+		},
+		{
+			name: "allowed denoms",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.Params{
+					AllowedPayingDenoms:  []string{"denom1", "denom2"},
+					BlockedSellingDenoms: []string{"stake"},
+				},
+			},
+			expErr: false,
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
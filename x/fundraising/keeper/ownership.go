@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// isAuctionSettled returns true if the auction has nothing left to manage or pay out.
+func isAuctionSettled(status types.AuctionStatus) bool {
+	switch status {
+	case types.AuctionStatusFinished, types.AuctionStatusCancelled, types.AuctionStatusFailed:
+		return true
+	}
+	return false
+}
+
+// TransferAuctionOwnership handles types.MsgTransferAuctionOwnership and proposes the new owner
+// and beneficiary of the auction. The ownership doesn't change until the new owner accepts it,
+// and a later proposal replaces the pending one.
+func (k Keeper) TransferAuctionOwnership(ctx context.Context, msg *types.MsgTransferAuctionOwnership) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrNotFound, "auction %d is not found", msg.AuctionId)
+	}
+
+	if auction.GetAuctioneer().String() != msg.Auctioneer {
+		return sdkerrors.Wrap(errors.ErrUnauthorized, "only the auctioneer can transfer the ownership of the auction")
+	}
+
+	if isAuctionSettled(auction.GetStatus()) {
+		return sdkerrors.Wrapf(types.ErrInvalidAuctionStatus, "unable to transfer the ownership of the auction in %s", auction.GetStatus())
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return err
+	}
+
+	var newBeneficiary sdk.AccAddress
+	if msg.NewBeneficiary != "" {
+		newBeneficiary, err = sdk.AccAddressFromBech32(msg.NewBeneficiary)
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = auction.SetPendingOwner(newOwner)
+	_ = auction.SetPendingBeneficiary(newBeneficiary)
+
+	if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAuctionOwnershipTransferProposed{
+		AuctionId:          auction.GetId(),
+		Auctioneer:         msg.Auctioneer,
+		PendingOwner:       msg.NewOwner,
+		PendingBeneficiary: msg.NewBeneficiary,
+	})
+}
+
+// AcceptAuctionOwnership handles types.MsgAcceptAuctionOwnership and makes the pending owner
+// the auctioneer of the auction. The pending beneficiary becomes the beneficiary of the auction,
+// so the new owner receives the paying coin and the unsold selling coin when none was proposed.
+func (k Keeper) AcceptAuctionOwnership(ctx context.Context, msg *types.MsgAcceptAuctionOwnership) error {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrNotFound, "auction %d is not found", msg.AuctionId)
+	}
+
+	pendingOwner := auction.GetPendingOwner()
+	if pendingOwner.Empty() || pendingOwner.String() != msg.NewOwner {
+		return sdkerrors.Wrap(errors.ErrUnauthorized, "only the pending owner can accept the ownership of the auction")
+	}
+
+	if isAuctionSettled(auction.GetStatus()) {
+		return sdkerrors.Wrapf(types.ErrInvalidAuctionStatus, "unable to accept the ownership of the auction in %s", auction.GetStatus())
+	}
+
+	previousOwner := auction.GetAuctioneer()
+	_ = auction.SetAuctioneer(pendingOwner)
+	_ = auction.SetBeneficiary(auction.GetPendingBeneficiary())
+	_ = auction.SetPendingOwner(nil)
+	_ = auction.SetPendingBeneficiary(nil)
+
+	if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+		return err
+	}
+
+	// The vesting queues keep the auctioneer of the auction
+	vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return err
+	}
+	for _, vq := range vestingQueues {
+		vq.Auctioneer = pendingOwner.String()
+		if err := k.VestingQueue.Set(ctx, collections.Join(vq.AuctionId, vq.Sequence), vq); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAuctionOwnershipTransferred{
+		AuctionId:     auction.GetId(),
+		PreviousOwner: previousOwner.String(),
+		NewOwner:      pendingOwner.String(),
+		Beneficiary:   auction.GetBeneficiary().String(),
+	})
+}
@@ -19,13 +19,15 @@ func (q queryServer) ListAuction(ctx context.Context, req *types.QueryAllAuction
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	if req.Type != "" && !(req.Type == types.AuctionTypeFixedPrice.String() || req.Type == types.AuctionTypeBatch.String()) {
+	if req.Type != "" && !(req.Type == types.AuctionTypeFixedPrice.String() || req.Type == types.AuctionTypeBatch.String() ||
+		req.Type == types.AuctionTypeDutch.String()) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid auction type %s", req.Type)
 	}
 
 	if req.Status != "" && !(req.Status == types.AuctionStatusStandBy.String() || req.Status == types.AuctionStatusStarted.String() ||
 		req.Status == types.AuctionStatusVesting.String() || req.Status == types.AuctionStatusFinished.String() ||
-		req.Status == types.AuctionStatusCancelled.String()) {
+		req.Status == types.AuctionStatusCancelled.String() || req.Status == types.AuctionStatusPaused.String() ||
+		req.Status == types.AuctionStatusFailed.String() || req.Status == types.AuctionStatusRevealing.String()) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid auction status %s", req.Status)
 	}
 
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (q queryServer) AuctionResult(ctx context.Context, req *types.QueryAuctionResultRequest) (*types.QueryAuctionResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	result, err := q.k.AuctionResult.Get(ctx, req.AuctionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAuctionResultResponse{AuctionResult: result}, nil
+}
+
+func (q queryServer) BidderAllocation(ctx context.Context, req *types.QueryBidderAllocationRequest) (*types.QueryBidderAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Bidder); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid bidder")
+	}
+
+	result, err := q.k.AuctionResult.Get(ctx, req.AuctionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	for _, allocation := range result.BidderAllocations {
+		if allocation.Bidder == req.Bidder {
+			return &types.QueryBidderAllocationResponse{BidderAllocation: allocation}, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "not found")
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/tendermint/fundraising/testutil/keeper"
+	"github.com/tendermint/fundraising/testutil/nullify"
+	"github.com/tendermint/fundraising/testutil/sample"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func createNAuctionResult(keeper keeper.Keeper, ctx context.Context, n int) []types.AuctionResult {
+	items := make([]types.AuctionResult, n)
+	for i := range items {
+		items[i].AuctionId = uint64(i)
+		items[i].MatchedPrice = math.LegacyNewDec(int64(i + 1))
+		items[i].MatchedLen = 1
+		items[i].SoldCoin = sdk.NewInt64Coin("denom1", 100)
+		items[i].PaidCoin = sdk.NewInt64Coin("denom2", int64(100*(i+1)))
+		items[i].RefundedCoin = sdk.NewInt64Coin("denom2", 0)
+		items[i].BidderAllocations = []types.BidderAllocation{
+			{
+				Bidder:        sample.Address(),
+				AllocatedCoin: items[i].SoldCoin,
+				PaidCoin:      items[i].PaidCoin,
+				RefundedCoin:  items[i].RefundedCoin,
+			},
+		}
+		items[i].ClosedTime = time.Now().UTC()
+
+		_ = keeper.AuctionResult.Set(ctx, items[i].AuctionId, items[i])
+	}
+	return items
+}
+
+func TestAuctionResultQuerySingle(t *testing.T) {
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+	msgs := createNAuctionResult(k, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryAuctionResultRequest
+		response *types.QueryAuctionResultResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryAuctionResultRequest{AuctionId: msgs[0].AuctionId},
+			response: &types.QueryAuctionResultResponse{AuctionResult: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryAuctionResultRequest{AuctionId: msgs[1].AuctionId},
+			response: &types.QueryAuctionResultResponse{AuctionResult: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryAuctionResultRequest{AuctionId: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.AuctionResult(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestBidderAllocationQuerySingle(t *testing.T) {
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+	msgs := createNAuctionResult(k, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryBidderAllocationRequest
+		response *types.QueryBidderAllocationResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryBidderAllocationRequest{
+				AuctionId: msgs[0].AuctionId,
+				Bidder:    msgs[0].BidderAllocations[0].Bidder,
+			},
+			response: &types.QueryBidderAllocationResponse{BidderAllocation: msgs[0].BidderAllocations[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryBidderAllocationRequest{
+				AuctionId: msgs[1].AuctionId,
+				Bidder:    msgs[1].BidderAllocations[0].Bidder,
+			},
+			response: &types.QueryBidderAllocationResponse{BidderAllocation: msgs[1].BidderAllocations[0]},
+		},
+		{
+			desc: "BidderNotFound",
+			request: &types.QueryBidderAllocationRequest{
+				AuctionId: msgs[0].AuctionId,
+				Bidder:    msgs[1].BidderAllocations[0].Bidder,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryBidderAllocationRequest{
+				AuctionId: 100000,
+				Bidder:    msgs[0].BidderAllocations[0].Bidder,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidBidder",
+			request: &types.QueryBidderAllocationRequest{
+				AuctionId: msgs[0].AuctionId,
+				Bidder:    strconv.Itoa(100000),
+			},
+			err: status.Error(codes.InvalidArgument, "invalid bidder"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.BidderAllocation(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
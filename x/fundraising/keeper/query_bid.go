@@ -5,6 +5,10 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -49,3 +53,39 @@ func (q queryServer) GetBid(ctx context.Context, req *types.QueryGetBidRequest)
 
 	return &types.QueryGetBidResponse{Bid: bid}, nil
 }
+
+func (q queryServer) ListBidsByBidder(ctx context.Context, req *types.QueryBidsByBidderRequest) (*types.QueryBidsByBidderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid bidder")
+	}
+
+	// Paginate over the bidder index entries, which are keyed by the length prefixed
+	// bidder address followed by the bid primary key.
+	store := runtime.KVStoreAdapter(q.k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, append(types.BidsByBidderKey.Bytes(), address.MustLengthPrefix(bidder)...))
+	pkCodec := q.k.Bid.KeyCodec()
+
+	bids := make([]types.Bid, 0)
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		_, pk, err := pkCodec.Decode(key)
+		if err != nil {
+			return err
+		}
+		bid, err := q.k.Bid.Get(ctx, pk)
+		if err != nil {
+			return err
+		}
+		bids = append(bids, bid)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBidsByBidderResponse{Bid: bids, Pagination: pageRes}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (q queryServer) BidBook(ctx context.Context, req *types.QueryBidBookRequest) (*types.QueryBidBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	auction, err := q.k.Auction.Get(ctx, req.AuctionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch {
+		return nil, status.Error(codes.InvalidArgument, "auction is not a batch auction")
+	}
+
+	bids, err := q.k.GetBidsByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	allowedBidders, err := q.k.GetAllowedBiddersByAuction(ctx, auction.GetId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The bids in the additional paying denoms are counted in the paying coin denom
+	for i, bid := range bids {
+		bids[i] = bid.Normalize(auction)
+	}
+	prices, bidsByPrice := types.BidsByPrice(bids)
+
+	return &types.QueryBidBookResponse{
+		SellingCoin: auction.GetSellingCoin(),
+		Levels:      types.BidBook(prices, bidsByPrice, allowedBidders),
+	}, nil
+}
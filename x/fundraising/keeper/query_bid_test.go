@@ -137,3 +137,73 @@ func TestBidQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestBidsByBidderQueryPaginated(t *testing.T) {
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+	_, err := createNBid(k, ctx, 3)
+	require.NoError(t, err)
+
+	bidder := sample.Address()
+	msgs := make([]types.Bid, 5)
+	for i := range msgs {
+		msgs[i] = types.Bid{
+			AuctionId: uint64(i%2 + 1),
+			Id:        uint64(i),
+			Bidder:    bidder,
+			Coin:      sdk.NewCoin("coin", math.NewInt(int64(i))),
+			Price:     math.LegacyNewDec(int64(i)),
+			Type:      types.BidTypeBatchWorth,
+		}
+		require.NoError(t, k.Bid.Set(ctx, collections.Join(msgs[i].AuctionId, msgs[i].Id), msgs[i]))
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryBidsByBidderRequest {
+		return &types.QueryBidsByBidderRequest{
+			Bidder: bidder,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListBidsByBidder(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Bid), step)
+			require.Subset(t, msgs, resp.Bid)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var bids []types.Bid
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListBidsByBidder(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Bid), step)
+			require.Subset(t, msgs, resp.Bid)
+			bids = append(bids, resp.Bid...)
+			next = resp.Pagination.NextKey
+		}
+		require.ElementsMatch(t, msgs, bids)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.ListBidsByBidder(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.Bid)
+	})
+	t.Run("InvalidBidder", func(t *testing.T) {
+		_, err := qs.ListBidsByBidder(ctx, &types.QueryBidsByBidderRequest{Bidder: "invalid"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid bidder"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ListBidsByBidder(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (q queryServer) BuyerVesting(ctx context.Context, req *types.QueryBuyerVestingRequest) (*types.QueryBuyerVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid bidder")
+	}
+
+	auction, err := q.k.Auction.Get(ctx, req.AuctionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	buyerVesting, err := q.k.BuyerVesting.Get(ctx, collections.Join(req.AuctionId, bidder))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	unlocked := buyerVesting.UnlockedCoin(auction.GetBuyerVestingSchedules(), sdk.UnwrapSDKContext(ctx).BlockTime())
+
+	return &types.QueryBuyerVestingResponse{
+		BuyerVesting:  buyerVesting,
+		LockedCoin:    buyerVesting.AllocatedCoin.Sub(unlocked),
+		UnlockedCoin:  unlocked,
+		ClaimableCoin: unlocked.Sub(buyerVesting.ClaimedCoin),
+	}, nil
+}
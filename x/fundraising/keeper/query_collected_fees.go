@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (q queryServer) CollectedFees(ctx context.Context, req *types.QueryCollectedFeesRequest) (*types.QueryCollectedFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fees, err := q.k.CollectedFees.Get(ctx, req.AuctionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryCollectedFeesResponse{CollectedFees: fees}, nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/tendermint/fundraising/testutil/keeper"
+	"github.com/tendermint/fundraising/testutil/nullify"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func createNCollectedFees(keeper keeper.Keeper, ctx context.Context, n int) []types.CollectedFees {
+	items := make([]types.CollectedFees, n)
+	for i := range items {
+		items[i].AuctionId = uint64(i)
+		items[i].CreationFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+		items[i].SettlementFee = sdk.NewCoins(sdk.NewInt64Coin("denom2", int64(10*(i+1))))
+
+		_ = keeper.CollectedFees.Set(ctx, items[i].AuctionId, items[i])
+	}
+	return items
+}
+
+func TestCollectedFeesQuerySingle(t *testing.T) {
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+	msgs := createNCollectedFees(k, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryCollectedFeesRequest
+		response *types.QueryCollectedFeesResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryCollectedFeesRequest{AuctionId: msgs[0].AuctionId},
+			response: &types.QueryCollectedFeesResponse{CollectedFees: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryCollectedFeesRequest{AuctionId: msgs[1].AuctionId},
+			response: &types.QueryCollectedFeesResponse{CollectedFees: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryCollectedFeesRequest{AuctionId: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.CollectedFees(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (q queryServer) ListFailedTransition(ctx context.Context, req *types.QueryAllFailedTransitionRequest) (*types.QueryAllFailedTransitionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	failedTransitions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.FailedTransition,
+		req.Pagination,
+		func(_ uint64, value types.FailedTransition) (types.FailedTransition, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFailedTransitionResponse{FailedTransition: failedTransitions, Pagination: pageRes}, nil
+}
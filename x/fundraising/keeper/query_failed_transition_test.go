@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/tendermint/fundraising/testutil/keeper"
+	"github.com/tendermint/fundraising/testutil/nullify"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func createNFailedTransition(keeper keeper.Keeper, ctx context.Context, n int) []types.FailedTransition {
+	items := make([]types.FailedTransition, n)
+	for i := range items {
+		items[i].AuctionId = uint64(i)
+		items[i].AuctionStatus = types.AuctionStatusStarted
+		items[i].Error = "insufficient funds"
+		items[i].Height = int64(i)
+		items[i].Time = time.Now().UTC()
+		items[i].Attempts = 1
+
+		_ = keeper.FailedTransition.Set(ctx, items[i].AuctionId, items[i])
+	}
+	return items
+}
+
+func TestFailedTransitionQueryPaginated(t *testing.T) {
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+	msgs := createNFailedTransition(k, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllFailedTransitionRequest {
+		return &types.QueryAllFailedTransitionRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListFailedTransition(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FailedTransition), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FailedTransition),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListFailedTransition(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FailedTransition), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FailedTransition),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.ListFailedTransition(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.FailedTransition),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ListFailedTransition(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -31,3 +31,23 @@ func TestParamsQuery(t *testing.T) {
 
 	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
 }
+
+func TestAllowedDenomsQuery(t *testing.T) {
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+
+	qs := keeper.NewQueryServerImpl(k)
+	params := types.DefaultParams()
+	params.AllowedPayingDenoms = []string{"denom1", "denom2"}
+	params.BlockedSellingDenoms = []string{"stake"}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	response, err := qs.AllowedDenoms(ctx, &types.QueryAllowedDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAllowedDenomsResponse{
+		AllowedPayingDenoms:  []string{"denom1", "denom2"},
+		BlockedSellingDenoms: []string{"stake"},
+	}, response)
+
+	_, err = qs.AllowedDenoms(ctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func (q queryServer) SimulateMatching(ctx context.Context, req *types.QuerySimulateMatchingRequest) (*types.QuerySimulateMatchingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Bidder != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Bidder); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid bidder")
+		}
+	}
+
+	auction, err := q.k.Auction.Get(ctx, req.AuctionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch {
+		return nil, status.Error(codes.InvalidArgument, "auction is not a batch auction")
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return nil, status.Error(codes.FailedPrecondition, "auction is not started")
+	}
+
+	mInfo, _, err := q.k.SimulateBatchAllocation(ctx, auction)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	matchedPrice := mInfo.MatchedPrice
+	if matchedPrice.IsNil() {
+		matchedPrice = math.LegacyZeroDec()
+	}
+
+	res := &types.QuerySimulateMatchingResponse{
+		MatchedPrice:  matchedPrice,
+		MatchedAmount: mInfo.TotalMatchedAmount,
+		MatchedLen:    mInfo.MatchedLen,
+		PricingMode:   auction.(*types.BatchAuction).PricingMode,
+	}
+
+	if req.Bidder != "" {
+		res.BidderAllocation = &types.BidderAllocation{
+			Bidder:        req.Bidder,
+			AllocatedCoin: sdk.NewCoin(auction.GetSellingCoin().Denom, amountOf(mInfo.AllocationMap, req.Bidder)),
+			PaidCoin:      sdk.NewCoin(auction.GetPayingCoinDenom(), amountOf(mInfo.ReservedMatchedMap, req.Bidder)),
+			RefundedCoin:  sdk.NewCoin(auction.GetPayingCoinDenom(), amountOf(mInfo.RefundMap, req.Bidder)),
+			PaidCoins:     mInfo.PaidCoinsMap[req.Bidder],
+			RefundedCoins: mInfo.RefundCoinsMap[req.Bidder],
+		}
+	}
+
+	return res, nil
+}
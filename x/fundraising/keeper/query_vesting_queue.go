@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -21,7 +20,7 @@ func (q queryServer) ListVestingQueue(ctx context.Context, req *types.QueryAllVe
 		ctx,
 		q.k.VestingQueue,
 		req.Pagination,
-		func(_ collections.Pair[uint64, time.Time], value types.VestingQueue) (types.VestingQueue, error) {
+		func(_ collections.Pair[uint64, uint64], value types.VestingQueue) (types.VestingQueue, error) {
 			return value, nil
 		},
 	)
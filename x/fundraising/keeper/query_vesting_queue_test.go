@@ -32,7 +32,7 @@ func createNVestingQueue(keeper keeper.Keeper, ctx context.Context, n int) []typ
 		items[i].PayingCoin = sdk.NewCoin("coin", math.NewInt(int64(i)))
 		items[i].Auctioneer = sample.Address()
 
-		_ = keeper.VestingQueue.Set(ctx, collections.Join(items[i].AuctionId, items[i].ReleaseTime), items[i])
+		_ = keeper.VestingQueue.Set(ctx, collections.Join(items[i].AuctionId, items[i].Sequence), items[i])
 	}
 	return items
 }
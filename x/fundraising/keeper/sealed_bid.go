@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errcode "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// GetNextBidCommitmentIdWithUpdate increments the bid commitment id of the auction by one and set it.
+func (k Keeper) GetNextBidCommitmentIdWithUpdate(ctx context.Context, auctionId uint64) (uint64, error) {
+	seq, err := k.BidCommitmentSeq.Get(ctx, auctionId)
+	if errors.Is(err, collections.ErrNotFound) {
+		seq = 0
+	} else if err != nil {
+		return 0, err
+	}
+	seq++
+	return seq, k.BidCommitmentSeq.Set(ctx, auctionId, seq)
+}
+
+// GetBidCommitmentsByAuctionId returns all the bid commitments of the auction that are not revealed yet.
+func (k Keeper) GetBidCommitmentsByAuctionId(ctx context.Context, auctionId uint64) ([]types.BidCommitment, error) {
+	commitments := make([]types.BidCommitment, 0)
+	rng := collections.NewPrefixedPairRange[uint64, uint64](auctionId)
+	err := k.BidCommitment.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], commitment types.BidCommitment) (bool, error) {
+		commitments = append(commitments, commitment)
+		return false, nil
+	})
+	return commitments, err
+}
+
+// sealedBidAuction returns the auction as a batch auction if it accepts only sealed bids.
+func sealedBidAuction(auction types.AuctionI) (*types.BatchAuction, error) {
+	ba, ok := auction.(*types.BatchAuction)
+	if !ok || !ba.IsSealedBid() {
+		return nil, sdkerrors.Wrapf(types.ErrNotSealedBidAuction, "auction %d", auction.GetId())
+	}
+	return ba, nil
+}
+
+// CommitBid handles types.MsgCommitBid and stores the sealed bid of the bidder.
+// The collateral is reserved in the paying reserve account until the bid is revealed.
+func (k Keeper) CommitBid(ctx context.Context, msg *types.MsgCommitBid) (types.BidCommitment, error) {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	if _, err := sealedBidAuction(auction); err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return types.BidCommitment{}, types.ErrInvalidAuctionStatus
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	if _, err := k.AllowedBidder.Get(ctx, collections.Join(auction.GetId(), bidder)); err != nil {
+		return types.BidCommitment{}, sdkerrors.Wrap(types.ErrNotAllowedBidder, err.Error())
+	}
+
+	if _, ok := types.PayingDenomRatio(auction, msg.Collateral.Denom); !ok {
+		return types.BidCommitment{}, types.ErrIncorrectCoinDenom
+	}
+
+	if err := k.ReservePayingCoin(ctx, auction.GetId(), bidder, msg.Collateral); err != nil {
+		return types.BidCommitment{}, sdkerrors.Wrap(err, "failed to reserve collateral")
+	}
+
+	commitmentId, err := k.GetNextBidCommitmentIdWithUpdate(ctx, auction.GetId())
+	if err != nil {
+		return types.BidCommitment{}, sdkerrors.Wrap(err, "failed to get next bid commitment id")
+	}
+
+	commitment := types.BidCommitment{
+		AuctionId:  auction.GetId(),
+		Id:         commitmentId,
+		Bidder:     msg.Bidder,
+		Hash:       msg.Hash,
+		Collateral: msg.Collateral,
+	}
+	if err := k.BidCommitment.Set(ctx, collections.Join(commitment.AuctionId, commitment.Id), commitment); err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	return commitment, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBidCommitted{
+		AuctionId:    commitment.AuctionId,
+		CommitmentId: commitment.Id,
+		Bidder:       commitment.Bidder,
+		Collateral:   commitment.Collateral,
+	})
+}
+
+// RevealBid handles types.MsgRevealBid and places the committed bid once it matches the commitment.
+// The paying coin of the bid is reserved out of the collateral and the rest of the collateral
+// is refunded to the bidder.
+func (k Keeper) RevealBid(ctx context.Context, msg *types.MsgRevealBid) (types.Bid, error) {
+	auction, err := k.Auction.Get(ctx, msg.AuctionId)
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	ba, err := sealedBidAuction(auction)
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	if auction.GetStatus() != types.AuctionStatusRevealing {
+		return types.Bid{}, types.ErrInvalidAuctionStatus
+	}
+
+	// The auction stays revealing until the begin blocker ends the reveal phase,
+	// so the reveals in the same block as the reveal end time are rejected here
+	if !sdk.UnwrapSDKContext(ctx).BlockTime().Before(ba.RevealEndTime()) {
+		return types.Bid{}, sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "reveal phase has ended")
+	}
+
+	commitment, err := k.BidCommitment.Get(ctx, collections.Join(msg.AuctionId, msg.CommitmentId))
+	if err != nil {
+		return types.Bid{}, sdkerrors.Wrapf(errcode.ErrNotFound, "bid commitment %d is not found", msg.CommitmentId)
+	}
+
+	if commitment.Bidder != msg.Bidder {
+		return types.Bid{}, sdkerrors.Wrap(errcode.ErrUnauthorized, "only the bidder of the commitment can reveal it")
+	}
+
+	hash := types.BidCommitmentHash(msg.AuctionId, msg.Bidder, msg.BidType, msg.Price, msg.Coin, msg.Salt)
+	if !bytes.Equal(hash, commitment.Hash) {
+		return types.Bid{}, sdkerrors.Wrap(types.ErrInvalidBidReveal, "bid doesn't match the hash of the commitment")
+	}
+
+	if msg.Price.LT(ba.MinBidPrice) {
+		return types.Bid{}, types.ErrInsufficientMinBidPrice
+	}
+
+	bid := types.Bid{
+		AuctionId: msg.AuctionId,
+		Bidder:    msg.Bidder,
+		Type:      msg.BidType,
+		Price:     msg.Price,
+		Coin:      msg.Coin,
+	}
+
+	// The revealed bid reserves the denom of the collateral
+	bid.PayingDenom, err = bidPayingDenom(auction, msg.Coin.Denom, commitment.Collateral.Denom)
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	switch bid.Type {
+	case types.BidTypeBatchWorth:
+		err = k.ValidateBatchWorthBid(ctx, auction, bid)
+	case types.BidTypeBatchMany:
+		err = k.ValidateBatchManyBid(ctx, auction, bid)
+	default:
+		err = types.ErrIncorrectAuctionType
+	}
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	reserveCoin := bid.ReservedPayingCoin(auction)
+	if commitment.Collateral.IsLT(reserveCoin) {
+		return types.Bid{}, sdkerrors.Wrapf(types.ErrInvalidBidReveal, "collateral %s doesn't cover the bid that reserves %s", commitment.Collateral, reserveCoin)
+	}
+
+	bidder := commitment.GetBidder()
+
+	bid.EscrowedFee, err = k.PayPlaceBidFee(ctx, auction, bidder)
+	if err != nil {
+		return types.Bid{}, sdkerrors.Wrap(err, "failed to pay place bid fee")
+	}
+
+	if err := k.PayAuctioneerBidFee(ctx, auction, bidder); err != nil {
+		return types.Bid{}, sdkerrors.Wrap(err, "failed to pay auctioneer bid fee")
+	}
+
+	refundCoin := commitment.Collateral.Sub(reserveCoin)
+	if refundCoin.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), bidder, sdk.NewCoins(refundCoin)); err != nil {
+			return types.Bid{}, sdkerrors.Wrap(err, "failed to refund collateral")
+		}
+	}
+
+	bid.Id, err = k.GetNextBidIdWithUpdate(ctx, auction.GetId())
+	if err != nil {
+		return types.Bid{}, sdkerrors.Wrap(err, "failed to get next bid id")
+	}
+
+	if err := k.BeforeBidPlaced(ctx, bid.AuctionId, bid.Id, bid.Bidder, bid.Type, bid.Price, bid.Coin); err != nil {
+		return types.Bid{}, err
+	}
+
+	if err := k.Bid.Set(ctx, collections.Join(bid.AuctionId, bid.Id), bid); err != nil {
+		return types.Bid{}, err
+	}
+
+	if err := k.BidCommitment.Remove(ctx, collections.Join(commitment.AuctionId, commitment.Id)); err != nil {
+		return types.Bid{}, err
+	}
+
+	return bid, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBidRevealed{
+		AuctionId:          bid.AuctionId,
+		CommitmentId:       commitment.Id,
+		BidId:              bid.Id,
+		Bidder:             bid.Bidder,
+		RefundedCollateral: refundCoin,
+	})
+}
+
+// CloseRevealPhase closes the sealed-bid auction whose reveal phase is over.
+// The commitments left unrevealed are settled first, so only the revealed bids are matched.
+func (k Keeper) CloseRevealPhase(ctx context.Context, auction types.AuctionI) error {
+	ba, err := sealedBidAuction(auction)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.settleBidCommitments(ctx, ba, ba.SealedBidConfig.ForfeitUnrevealed); err != nil {
+		return err
+	}
+
+	mInfo, err := k.CalculateBatchAllocation(ctx, ba)
+	if err != nil {
+		return err
+	}
+	return k.SettleBatchAuction(ctx, ba, mInfo)
+}
+
+// settleBidCommitments deletes the unrevealed commitments of the auction and sends their collateral
+// to the beneficiary if forfeit is true, or back to the bidders otherwise.
+// It returns the refunds made to the bidders.
+func (k Keeper) settleBidCommitments(ctx context.Context, auction types.AuctionI, forfeit bool) ([]types.ForceCancelRefund, error) {
+	commitments, err := k.GetBidCommitmentsByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var refunds []types.ForceCancelRefund
+	for _, commitment := range commitments {
+		recipient := commitment.GetBidder()
+		if forfeit {
+			recipient = auction.GetBeneficiary()
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), recipient, sdk.NewCoins(commitment.Collateral)); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to settle collateral")
+		}
+		if !forfeit {
+			refunds = append(refunds, types.ForceCancelRefund{
+				Recipient: commitment.Bidder,
+				Source:    types.RefundSourcePayingReserve,
+				Coin:      commitment.Collateral,
+			})
+		}
+
+		if err := k.BidCommitment.Remove(ctx, collections.Join(commitment.AuctionId, commitment.Id)); err != nil {
+			return nil, err
+		}
+
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBidCommitmentSettled{
+			AuctionId:    commitment.AuctionId,
+			CommitmentId: commitment.Id,
+			Bidder:       commitment.Bidder,
+			Recipient:    recipient.String(),
+			Collateral:   commitment.Collateral,
+			Forfeited:    forfeit,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return refunds, nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// createSealedBidAuction creates a started batch auction that accepts only sealed bids.
+func (s *KeeperTestSuite) createSealedBidAuction(forfeitUnrevealed bool) *types.BatchAuction {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("2_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		math.LegacyMustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, 1),
+		true,
+	)
+	auction.SealedBidConfig = &types.SealedBidConfig{
+		RevealPeriod:      24 * time.Hour,
+		ForfeitUnrevealed: forfeitUnrevealed,
+	}
+	s.Require().NoError(s.keeper.Auction.Set(s.ctx, auction.Id, auction))
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+	return auction
+}
+
+func (s *KeeperTestSuite) commitBid(auctionId uint64, bidder sdk.AccAddress, bidType types.BidType, price math.LegacyDec, coin, collateral sdk.Coin, salt string) types.BidCommitment {
+	s.fundAddr(bidder, sdk.NewCoins(collateral))
+	s.Require().NoError(s.addAllowedBidder(auctionId, bidder, math.NewInt(10_000_000_000)))
+
+	hash := types.BidCommitmentHash(auctionId, bidder.String(), bidType, price, coin, salt)
+	commitment, err := s.keeper.CommitBid(s.ctx, types.NewMsgCommitBid(bidder.String(), auctionId, hash, collateral))
+	s.Require().NoError(err)
+	return commitment
+}
+
+func (s *KeeperTestSuite) TestSealedBidAuction() {
+	auction := s.createSealedBidAuction(true)
+
+	// The public bids are rejected in favor of the sealed bids
+	s.fundAddr(s.addr(1), sdk.NewCoins(parseCoin("500_000_000denom2")))
+	s.Require().NoError(s.addAllowedBidder(auction.Id, s.addr(1), math.NewInt(10_000_000_000)))
+	_, err := s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(auction.Id, s.addr(1).String(), types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), ""))
+	s.Require().ErrorIs(err, types.ErrSealedBidAuction)
+	s.sendCoins(s.addr(1), s.addr(9), sdk.NewCoins(parseCoin("500_000_000denom2")), false)
+
+	c1 := s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), parseCoin("600_000_000denom2"), "salt1")
+	c2 := s.commitBid(auction.Id, s.addr(2), types.BidTypeBatchMany, parseDec("0.9"), parseCoin("500_000_000denom1"), parseCoin("450_000_000denom2"), "salt2")
+	c3 := s.commitBid(auction.Id, s.addr(3), types.BidTypeBatchWorth, parseDec("1"), parseCoin("300_000_000denom2"), parseCoin("300_000_000denom2"), "salt3")
+	s.Require().Len(s.typedEvents(&types.EventBidCommitted{}), 3)
+	s.Require().Equal(parseCoin("1350_000_000denom2"), s.getBalance(auction.GetPayingReserveAddress(), "denom2"))
+
+	// The bids are revealed only during the reveal phase
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(1).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt1"))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0]).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusRevealing, a.GetStatus())
+	bids, err := s.keeper.GetBidsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(bids)
+
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(1).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt2"))
+	s.Require().ErrorIs(err, types.ErrInvalidBidReveal)
+
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(2).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt1"))
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	bid, err := s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(1).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt1"))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("500_000_000denom2"), bid.Coin)
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(2).String(), auction.Id, c2.Id, types.BidTypeBatchMany, parseDec("0.9"), parseCoin("500_000_000denom1"), "salt2"))
+	s.Require().NoError(err)
+	s.Require().True(s.getBalance(s.addr(2), "denom2").IsZero())
+	s.Require().Len(s.typedEvents(&types.EventBidRevealed{}), 2)
+
+	// The commitment is removed once it is revealed
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(1).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt1"))
+	s.Require().ErrorIs(err, errors.ErrNotFound)
+
+	// The unrevealed commitment is forfeited to the beneficiary when the reveal phase ends
+	beneficiaryBalance := s.getBalance(s.addr(0), "denom2")
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0].Add(24 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+
+	events := s.typedEvents(&types.EventBidCommitmentSettled{})
+	s.Require().Len(events, 1)
+	settled := events[0].(*types.EventBidCommitmentSettled)
+	s.Require().Equal(c3.Id, settled.CommitmentId)
+	s.Require().Equal(s.addr(0).String(), settled.Recipient)
+	s.Require().True(settled.Forfeited)
+
+	commitments, err := s.keeper.GetBidCommitmentsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(commitments)
+	s.Require().True(s.getBalance(s.addr(3), "denom2").IsZero())
+	s.Require().True(s.getBalance(s.addr(0), "denom2").Amount.GTE(beneficiaryBalance.Amount.Add(math.NewInt(300_000_000))))
+
+	// Only the revealed bids are matched
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsPositive())
+	s.Require().True(s.getBalance(s.addr(3), "denom1").IsZero())
+}
+
+func (s *KeeperTestSuite) TestSealedBidAuction_RefundUnrevealed() {
+	auction := s.createSealedBidAuction(false)
+
+	c1 := s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), parseCoin("400_000_000denom2"), "salt1")
+	s.commitBid(auction.Id, s.addr(2), types.BidTypeBatchWorth, parseDec("1"), parseCoin("300_000_000denom2"), parseCoin("300_000_000denom2"), "salt2")
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The collateral must cover the paying coin reserved for the bid
+	_, err := s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(1).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt1"))
+	s.Require().ErrorIs(err, types.ErrInvalidBidReveal)
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0].Add(24 * time.Hour))
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(parseCoin("400_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("300_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().Equal(parseCoin("2_000_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+}
+
+func (s *KeeperTestSuite) TestSealedBidAuction_ForceCancel() {
+	auction := s.createSealedBidAuction(true)
+
+	s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), parseCoin("500_000_000denom2"), "salt1")
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The commitments are refunded even though the auction forfeits the unrevealed ones
+	err := s.keeper.ForceCancelAuction(s.ctx, types.NewMsgForceCancelAuction(s.keeper.GetAuthority(), auction.Id))
+	s.Require().NoError(err)
+
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusCancelled, a.GetStatus())
+	s.Require().Equal(parseCoin("500_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+
+	commitments, err := s.keeper.GetBidCommitmentsByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(commitments)
+}
+
+func (s *KeeperTestSuite) TestRevealBid_AfterRevealEndTime() {
+	auction := s.createSealedBidAuction(true)
+
+	c1 := s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), parseCoin("500_000_000denom2"), "salt1")
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0])
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// The auction is still revealing until the begin blocker ends the reveal phase
+	s.ctx = s.ctx.WithBlockTime(auction.EndTimes[0].Add(24 * time.Hour))
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusRevealing, a.GetStatus())
+
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(s.addr(1).String(), auction.Id, c1.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000_000denom2"), "salt1"))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+
+	_, err = s.keeper.BidCommitment.Get(s.ctx, collections.Join(auction.Id, c1.Id))
+	s.Require().NoError(err)
+}
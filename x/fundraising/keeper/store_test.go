@@ -182,6 +182,7 @@ func (s *KeeperTestSuite) TestIterateBids() {
 func (s *KeeperTestSuite) TestVestingQueue() {
 	vestingQueue := types.NewVestingQueue(
 		1,
+		0,
 		s.addr(1),
 		parseCoin("100_000_000denom1"),
 		types.MustParseRFC3339("2023-01-01T00:00:00Z"),
@@ -191,13 +192,13 @@ func (s *KeeperTestSuite) TestVestingQueue() {
 		s.ctx,
 		collections.Join(
 			vestingQueue.AuctionId,
-			vestingQueue.ReleaseTime,
+			vestingQueue.Sequence,
 		),
 		vestingQueue,
 	)
 	s.Require().NoError(err)
 
-	vq, err := s.keeper.VestingQueue.Get(s.ctx, collections.Join(vestingQueue.AuctionId, vestingQueue.ReleaseTime))
+	vq, err := s.keeper.VestingQueue.Get(s.ctx, collections.Join(vestingQueue.AuctionId, vestingQueue.Sequence))
 	s.Require().NoError(err)
 	s.Require().EqualValues(vestingQueue, vq)
 }
@@ -208,7 +209,7 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 	reserveCoin := s.getBalance(payingReserveAddress, payingCoinDenom)
 
 	// Set vesting schedules with 2 vesting queues
-	for _, vs := range []types.VestingSchedule{
+	for i, vs := range []types.VestingSchedule{
 		{
 			ReleaseTime: types.MustParseRFC3339("2023-01-01T00:00:00Z"),
 			Weight:      math.LegacyMustNewDecFromStr("0.5"),
@@ -222,6 +223,7 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 
 		vestingQueue := types.VestingQueue{
 			AuctionId:   uint64(1),
+			Sequence:    uint64(i),
 			Auctioneer:  s.addr(1).String(),
 			PayingCoin:  sdk.NewCoin(payingCoinDenom, payingAmt),
 			ReleaseTime: vs.ReleaseTime,
@@ -231,7 +233,7 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 			s.ctx,
 			collections.Join(
 				vestingQueue.AuctionId,
-				vestingQueue.ReleaseTime,
+				vestingQueue.Sequence,
 			),
 			vestingQueue,
 		)
@@ -239,7 +241,7 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 	}
 
 	// Set vesting schedules with 4 vesting queues
-	for _, vs := range []types.VestingSchedule{
+	for i, vs := range []types.VestingSchedule{
 		{
 			ReleaseTime: types.MustParseRFC3339("2023-01-01T00:00:00Z"),
 			Weight:      math.LegacyMustNewDecFromStr("0.25"),
@@ -261,6 +263,7 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 
 		vestingQueue := types.VestingQueue{
 			AuctionId:   uint64(2),
+			Sequence:    uint64(i),
 			Auctioneer:  s.addr(2).String(),
 			PayingCoin:  sdk.NewCoin(payingCoinDenom, payingAmt),
 			ReleaseTime: vs.ReleaseTime,
@@ -270,7 +273,7 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 			s.ctx,
 			collections.Join(
 				vestingQueue.AuctionId,
-				vestingQueue.ReleaseTime,
+				vestingQueue.Sequence,
 			),
 			vestingQueue,
 		)
@@ -2,9 +2,10 @@ package keeper
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -14,7 +15,7 @@ import (
 // VestingQueues returns all VestingQueue.
 func (k Keeper) VestingQueues(ctx context.Context) ([]types.VestingQueue, error) {
 	vestingQueues := make([]types.VestingQueue, 0)
-	err := k.IterateVestingQueues(ctx, func(_ collections.Pair[uint64, time.Time], bid types.VestingQueue) (bool, error) {
+	err := k.IterateVestingQueues(ctx, func(_ collections.Pair[uint64, uint64], bid types.VestingQueue) (bool, error) {
 		vestingQueues = append(vestingQueues, bid)
 		return false, nil
 	})
@@ -22,7 +23,7 @@ func (k Keeper) VestingQueues(ctx context.Context) ([]types.VestingQueue, error)
 }
 
 // IterateVestingQueues iterates over all the VestingQueues and performs a callback function.
-func (k Keeper) IterateVestingQueues(ctx context.Context, cb func(collections.Pair[uint64, time.Time], types.VestingQueue) (bool, error)) error {
+func (k Keeper) IterateVestingQueues(ctx context.Context, cb func(collections.Pair[uint64, uint64], types.VestingQueue) (bool, error)) error {
 	err := k.VestingQueue.Walk(ctx, nil, cb)
 	if err != nil {
 		return err
@@ -33,8 +34,8 @@ func (k Keeper) IterateVestingQueues(ctx context.Context, cb func(collections.Pa
 // GetVestingQueuesByAuctionId returns all vesting queues associated with the auction id that are registered in the store.
 func (k Keeper) GetVestingQueuesByAuctionId(ctx context.Context, auctionId uint64) ([]types.VestingQueue, error) {
 	vestingQueues := make([]types.VestingQueue, 0)
-	rng := collections.NewPrefixedPairRange[uint64, time.Time](auctionId)
-	err := k.VestingQueue.Walk(ctx, rng, func(key collections.Pair[uint64, time.Time], vestingQueue types.VestingQueue) (bool, error) {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](auctionId)
+	err := k.VestingQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64], vestingQueue types.VestingQueue) (bool, error) {
 		vestingQueues = append(vestingQueues, vestingQueue)
 		return false, nil
 	})
@@ -42,69 +43,216 @@ func (k Keeper) GetVestingQueuesByAuctionId(ctx context.Context, auctionId uint6
 }
 
 // ApplyVestingSchedules stores vesting queues based on the vesting schedules of the auction and
-// sets status to vesting.
+// sets status to vesting. Each paying denom that the auction accepts is vested by its own vesting queues.
 func (k Keeper) ApplyVestingSchedules(ctx context.Context, auction types.AuctionI) error {
 	payingReserveAddr := auction.GetPayingReserveAddress()
 	vestingReserveAddr := auction.GetVestingReserveAddress()
-	payingCoinDenom := auction.GetPayingCoinDenom()
 	spendableCoins := k.bankKeeper.SpendableCoins(ctx, payingReserveAddr)
-	reserveCoin := sdk.NewCoin(payingCoinDenom, spendableCoins.AmountOf(payingCoinDenom))
 
-	vsLen := len(auction.GetVestingSchedules())
-	if vsLen == 0 {
-		// Send reserve coins to the auctioneer from the paying reserve account
-		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, auction.GetAuctioneer(), sdk.NewCoins(reserveCoin)); err != nil {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The paying coin denom is always vested, while the additional paying denoms only when raised
+	var (
+		reserveCoins   []sdk.Coin
+		settlementFees sdk.Coins
+	)
+	for _, denom := range types.PayingDenoms(auction) {
+		reserveCoin := sdk.NewCoin(denom, spendableCoins.AmountOf(denom))
+		if denom != auction.GetPayingCoinDenom() && !reserveCoin.IsPositive() {
+			continue
+		}
+
+		// The settlement fee is taken from the raised paying coin before it is paid to the auctioneer
+		settlementFee := params.SettlementFee(reserveCoin)
+		if settlementFee.IsPositive() {
+			settlementFees = settlementFees.Add(settlementFee)
+			reserveCoin = reserveCoin.Sub(settlementFee)
+		}
+		reserveCoins = append(reserveCoins, reserveCoin)
+	}
+	if !settlementFees.IsZero() {
+		if err := k.CollectFee(ctx, auction.GetId(), payingReserveAddr, types.FeeTypeSettlement, settlementFees); err != nil {
 			return err
 		}
+	}
 
-		if err := auction.SetStatus(types.AuctionStatusFinished); err != nil {
+	vsLen := len(auction.GetVestingSchedules())
+	linearVesting := auction.GetLinearVesting()
+	if vsLen == 0 && linearVesting == nil {
+		// Send reserve coins to the beneficiary from the paying reserve account
+		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, auction.GetBeneficiary(), sdk.NewCoins(reserveCoins...)); err != nil {
 			return err
 		}
-		if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+
+		for _, reserveCoin := range reserveCoins {
+			if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPayingCoinReleased{
+				AuctionId:   auction.GetId(),
+				Auctioneer:  auction.GetAuctioneer().String(),
+				PayingCoin:  reserveCoin,
+				ReleaseTime: sdk.UnwrapSDKContext(ctx).BlockTime(),
+				Recipient:   auction.GetBeneficiary().String(),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusFinished); err != nil {
 			return err
 		}
 	} else {
 		// Move reserve coins from the paying reserve to the vesting reserve account
-		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, vestingReserveAddr, sdk.NewCoins(reserveCoin)); err != nil {
+		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, vestingReserveAddr, sdk.NewCoins(reserveCoins...)); err != nil {
+			return err
+		}
+		if err := k.recordVestedCoin(ctx, auction.GetId(), reserveCoins); err != nil {
 			return err
 		}
 
-		remaining := reserveCoin
-		for i, schedule := range auction.GetVestingSchedules() {
-			payingAmt := math.LegacyNewDecFromInt(reserveCoin.Amount).MulTruncate(schedule.Weight).TruncateInt()
+		// The linear vesting is tracked by a single vesting queue for each paying denom that is
+		// released gradually until the end time of the vesting
+		if linearVesting != nil {
+			for d, reserveCoin := range reserveCoins {
+				if err := k.VestingQueue.Set(
+					ctx,
+					collections.Join(auction.GetId(), uint64(d)),
+					types.VestingQueue{
+						AuctionId:    auction.GetId(),
+						Sequence:     uint64(d),
+						Auctioneer:   auction.GetAuctioneer().String(),
+						PayingCoin:   reserveCoin,
+						ReleaseTime:  linearVesting.EndTime,
+						Released:     false,
+						ReleasedCoin: &sdk.Coin{Denom: reserveCoin.Denom, Amount: math.ZeroInt()},
+					},
+				); err != nil {
+					return err
+				}
 
-			// All the remaining paying coin goes to the last vesting queue
-			if i == vsLen-1 {
-				payingAmt = remaining.Amount
+				if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventVestingQueueCreated{
+					AuctionId:   auction.GetId(),
+					PayingCoin:  reserveCoin,
+					ReleaseTime: linearVesting.EndTime,
+				}); err != nil {
+					return err
+				}
 			}
 
-			if err := k.VestingQueue.Set(
-				ctx,
-				collections.Join(
-					auction.GetId(),
-					schedule.ReleaseTime,
-				),
-				types.VestingQueue{
+			return k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusVesting)
+		}
+
+		// The vesting queues are sequenced by the release time first and the paying denom next
+		for d, reserveCoin := range reserveCoins {
+			remaining := reserveCoin
+			for i, schedule := range auction.GetVestingSchedules() {
+				payingAmt := math.LegacyNewDecFromInt(reserveCoin.Amount).MulTruncate(schedule.Weight).TruncateInt()
+
+				// All the remaining paying coin goes to the last vesting queue
+				if i == vsLen-1 {
+					payingAmt = remaining.Amount
+				}
+
+				seq := uint64(i*len(reserveCoins) + d)
+				if err := k.VestingQueue.Set(
+					ctx,
+					collections.Join(auction.GetId(), seq),
+					types.VestingQueue{
+						AuctionId:   auction.GetId(),
+						Sequence:    seq,
+						Auctioneer:  auction.GetAuctioneer().String(),
+						PayingCoin:  sdk.NewCoin(reserveCoin.Denom, payingAmt),
+						ReleaseTime: schedule.ReleaseTime,
+						Released:    false,
+					},
+				); err != nil {
+					return err
+				}
+
+				if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventVestingQueueCreated{
 					AuctionId:   auction.GetId(),
-					Auctioneer:  auction.GetAuctioneer().String(),
-					PayingCoin:  sdk.NewCoin(payingCoinDenom, payingAmt),
+					PayingCoin:  sdk.NewCoin(reserveCoin.Denom, payingAmt),
 					ReleaseTime: schedule.ReleaseTime,
-					Released:    false,
-				},
-			); err != nil {
-				return err
-			}
+				}); err != nil {
+					return err
+				}
 
-			remaining = remaining.SubAmount(payingAmt)
+				remaining = remaining.SubAmount(payingAmt)
+			}
 		}
 
-		if err := auction.SetStatus(types.AuctionStatusVesting); err != nil {
-			return err
-		}
-		if err := k.Auction.Set(ctx, auction.GetId(), auction); err != nil {
+		if err := k.UpdateAuctionStatus(ctx, auction, types.AuctionStatusVesting); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// recordVestedCoin records the paying coins moved into the vesting reserve account on the result of the auction,
+// so that the vesting queues can be checked against them. The first coin is of the paying coin denom.
+func (k Keeper) recordVestedCoin(ctx context.Context, auctionId uint64, vestedCoins []sdk.Coin) error {
+	result, err := k.AuctionResult.Get(ctx, auctionId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	result.VestedCoin = vestedCoins[0]
+	result.VestedCoins = sdk.NewCoins(vestedCoins...)
+	return k.AuctionResult.Set(ctx, auctionId, result)
+}
+
+// releaseLinearVestingPayingCoin releases the paying coin vested so far by the linear vesting of the auction
+// and not released yet to the auctioneer from the vesting reserve account.
+func (k Keeper) releaseLinearVestingPayingCoin(ctx context.Context, auction types.AuctionI, linearVesting types.LinearVesting, recipient sdk.AccAddress) (sdk.Coins, error) {
+	vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var released sdk.Coins
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	for i, vestingQueue := range vestingQueues {
+		if vestingQueue.Released {
+			continue
+		}
+
+		vestedAmt := linearVesting.VestedAmount(vestingQueue.PayingCoin.Amount, blockTime)
+		releasedAmt := vestingQueue.PayingCoin.Amount.Sub(vestingQueue.RemainingPayingCoin().Amount)
+		releaseCoin := sdk.NewCoin(vestingQueue.PayingCoin.Denom, vestedAmt.Sub(releasedAmt))
+		if releaseCoin.IsPositive() {
+			if err := k.bankKeeper.SendCoins(ctx, auction.GetVestingReserveAddress(), recipient, sdk.NewCoins(releaseCoin)); err != nil {
+				return nil, sdkerrors.Wrap(err, "failed to release paying coin to the auctioneer")
+			}
+
+			if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPayingCoinReleased{
+				AuctionId:   auction.GetId(),
+				Auctioneer:  auction.GetAuctioneer().String(),
+				PayingCoin:  releaseCoin,
+				ReleaseTime: blockTime,
+				Recipient:   recipient.String(),
+			}); err != nil {
+				return nil, err
+			}
+			released = released.Add(releaseCoin)
+			releasedCoin := sdk.NewCoin(vestingQueue.PayingCoin.Denom, vestedAmt)
+			vestingQueue.ReleasedCoin = &releasedCoin
+		}
+
+		if !blockTime.Before(linearVesting.EndTime) {
+			vestingQueue.SetReleased(true)
+		}
+		if err := k.VestingQueue.Set(ctx, collections.Join(vestingQueue.AuctionId, vestingQueue.Sequence), vestingQueue); err != nil {
+			return nil, err
+		}
+		vestingQueues[i] = vestingQueue
+	}
+
+	if err := k.finishVestingIfReleased(ctx, auction, vestingQueues); err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
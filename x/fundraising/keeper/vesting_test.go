@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"cosmossdk.io/collections"
+
 	"github.com/tendermint/fundraising/x/fundraising/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -95,3 +97,58 @@ func (s *KeeperTestSuite) TestApplyVestingSchedules_RemainingCoin() {
 	}
 	s.Require().True(vestingReserveCoin.IsZero())
 }
+
+func (s *KeeperTestSuite) TestReleaseVestingPayingCoin_AllQueuesReleased() {
+	startTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	endTime := types.MustParseRFC3339("2023-02-01T00:00:00Z")
+	releaseTime1 := types.MustParseRFC3339("2023-03-01T00:00:00Z")
+	releaseTime2 := types.MustParseRFC3339("2023-04-01T00:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(startTime)
+
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: releaseTime1, Weight: parseDec("0.5")},
+			{ReleaseTime: releaseTime2, Weight: parseDec("0.5")},
+		},
+		startTime,
+		endTime,
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	// Swap the release times so that the last queue matures first
+	vestingQueues, err := s.keeper.GetVestingQueuesByAuctionId(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Len(vestingQueues, 2)
+	vestingQueues[0].ReleaseTime, vestingQueues[1].ReleaseTime = releaseTime2, releaseTime1
+	for _, vq := range vestingQueues {
+		s.Require().NoError(s.keeper.VestingQueue.Set(s.ctx, collections.Join(vq.AuctionId, vq.Sequence), vq))
+	}
+
+	s.ctx = s.ctx.WithBlockTime(releaseTime1)
+	a, err := s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	released, err := s.keeper.ReleaseVestingPayingCoin(s.ctx, a, s.addr(0))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("50_000_000denom2"), released)
+
+	// The auction keeps vesting until every queue is released
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusVesting, a.GetStatus())
+
+	s.ctx = s.ctx.WithBlockTime(releaseTime2)
+	_, err = s.keeper.ReleaseVestingPayingCoin(s.ctx, a, s.addr(0))
+	s.Require().NoError(err)
+
+	a, err = s.keeper.Auction.Get(s.ctx, auction.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+}
@@ -18,6 +18,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "AllowedDenoms",
+					Use:       "allowed-denoms",
+					Short:     "Shows the allowed paying denoms and the blocked selling denoms",
+				},
 				{
 					RpcMethod: "ListAllowedBidder",
 					Use:       "list-allowed-bidder",
@@ -30,6 +35,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-allowed-bidder"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
 				},
+				{
+					RpcMethod:      "AuctionResult",
+					Use:            "auction-result [auction-id]",
+					Short:          "Shows the final matching result of the auction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "CollectedFees",
+					Use:            "collected-fees [auction-id]",
+					Short:          "Shows the fees collected for the auction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "BuyerVesting",
+					Use:            "buyer-vesting [auction-id] [bidder]",
+					Short:          "Shows the locked, unlocked and claimable selling coin of the bidder in the auction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "bidder"}},
+				},
+				{
+					RpcMethod:      "BidderAllocation",
+					Use:            "bidder-allocation [auction-id] [bidder]",
+					Short:          "Shows the settlement of the bidder in the auction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "bidder"}},
+				},
+				{
+					RpcMethod:      "SimulateMatching",
+					Use:            "simulate-matching [auction-id]",
+					Short:          "Simulates the matching of a running batch auction with the bids placed so far",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "BidBook",
+					Use:            "bid-book [auction-id]",
+					Short:          "Shows the cumulative demand curve of the batch auction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod: "ListFailedTransition",
+					Use:       "list-failed-transition",
+					Short:     "List all FailedTransition",
+				},
 				{
 					RpcMethod: "ListVestingQueue",
 					Use:       "list-vesting-queue",
@@ -47,6 +93,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-bid"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "ListBidsByBidder",
+					Use:            "list-bids-by-bidder [bidder]",
+					Short:          "List all Bid placed by the bidder",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "bidder"}},
+				},
 				{
 					RpcMethod: "ListAuction",
 					Use:       "list-auction",
@@ -70,6 +122,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ForceCancelAuction",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreateFixedPriceAuction",
 					Use:            "create-fixed-price-auction [start-price] [selling-coin] [paying-coin-denom] [vesting-schedules] [start-time] [end-time]",
@@ -82,12 +138,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a CreateBatchAuction tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "startPrice"}, {ProtoField: "minBidPrice"}, {ProtoField: "sellingCoin"}, {ProtoField: "payingCoinDenom"}, {ProtoField: "vestingSchedules"}, {ProtoField: "maxExtendedRound"}, {ProtoField: "extendedRoundRate"}, {ProtoField: "startTime"}, {ProtoField: "endTime"}},
 				},
+				{
+					RpcMethod:      "CreateDutchAuction",
+					Use:            "create-dutch-auction [start-price] [floor-price] [selling-coin] [paying-coin-denom] [vesting-schedules] [decay-interval] [start-time] [end-time]",
+					Short:          "Send a CreateDutchAuction tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "startPrice"}, {ProtoField: "floorPrice"}, {ProtoField: "sellingCoin"}, {ProtoField: "payingCoinDenom"}, {ProtoField: "vestingSchedules"}, {ProtoField: "decayInterval"}, {ProtoField: "startTime"}, {ProtoField: "endTime"}},
+				},
 				{
 					RpcMethod:      "CancelAuction",
 					Use:            "cancel-auction [auction-id]",
 					Short:          "Send a CancelAuction tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
 				},
+				{
+					RpcMethod:      "PauseAuction",
+					Use:            "pause-auction [auction-id]",
+					Short:          "Send a PauseAuction tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "ResumeAuction",
+					Use:            "resume-auction [auction-id]",
+					Short:          "Send a ResumeAuction tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "CloseAuctionEarly",
+					Use:            "close-auction-early [auction-id]",
+					Short:          "Send a CloseAuctionEarly tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
 				{
 					RpcMethod:      "PlaceBid",
 					Use:            "place-bid [auction-id] [bid-type] [price] [coin]",
@@ -100,6 +180,48 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a ModifyBid tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "bidId"}, {ProtoField: "price"}, {ProtoField: "coin"}},
 				},
+				{
+					RpcMethod:      "CancelBid",
+					Use:            "cancel-bid [auction-id] [bid-id]",
+					Short:          "Send a CancelBid tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "bidId"}},
+				},
+				{
+					RpcMethod:      "ClaimBuyerVesting",
+					Use:            "claim-buyer-vesting [auction-id]",
+					Short:          "Send a ClaimBuyerVesting tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "ClaimVestedProceeds",
+					Use:            "claim-vested-proceeds [auction-id]",
+					Short:          "Send a ClaimVestedProceeds tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "TransferAuctionOwnership",
+					Use:            "transfer-auction-ownership [auction-id] [new-owner]",
+					Short:          "Send a TransferAuctionOwnership tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "newOwner"}},
+				},
+				{
+					RpcMethod:      "AcceptAuctionOwnership",
+					Use:            "accept-auction-ownership [auction-id]",
+					Short:          "Send a AcceptAuctionOwnership tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}},
+				},
+				{
+					RpcMethod:      "CommitBid",
+					Use:            "commit-bid [auction-id] [hash] [collateral]",
+					Short:          "Send a CommitBid tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "hash"}, {ProtoField: "collateral"}},
+				},
+				{
+					RpcMethod:      "RevealBid",
+					Use:            "reveal-bid [auction-id] [commitment-id] [bid-type] [price] [coin] [salt]",
+					Short:          "Send a RevealBid tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "auctionId"}, {ProtoField: "commitmentId"}, {ProtoField: "bidType"}, {ProtoField: "price"}, {ProtoField: "coin"}, {ProtoField: "salt"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
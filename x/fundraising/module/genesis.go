@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"cosmossdk.io/collections"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -54,18 +53,23 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 	}
 
 	// Set all the bid
+	// The ids are kept as they are, since the cancelled bids leave gaps in them
+	maxBidIds := make(map[uint64]uint64)
 	for _, elem := range genState.BidList {
 		_, err := k.Auction.Get(ctx, elem.AuctionId)
 		if errors.Is(err, collections.ErrNotFound) {
 			return fmt.Errorf("bid auction %d is not found", elem.AuctionId)
 		}
 
-		bidID, err := k.GetNextBidIdWithUpdate(ctx, elem.AuctionId)
-		if err != nil {
+		if err := k.Bid.Set(ctx, collections.Join(elem.AuctionId, elem.Id), elem); err != nil {
 			return err
 		}
-		elem.Id = bidID
-		if err := k.Bid.Set(ctx, collections.Join(elem.AuctionId, elem.Id), elem); err != nil {
+		if elem.Id > maxBidIds[elem.AuctionId] {
+			maxBidIds[elem.AuctionId] = elem.Id
+		}
+	}
+	for auctionId, maxId := range maxBidIds {
+		if err := k.BidSeq.Set(ctx, auctionId, maxId); err != nil {
 			return err
 		}
 	}
@@ -81,13 +85,111 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 			ctx,
 			collections.Join(
 				elem.AuctionId,
-				elem.ReleaseTime,
+				elem.Sequence,
 			),
 			elem); err != nil {
 			return err
 		}
 	}
 
+	// Set all the auctionResult
+	for _, elem := range genState.AuctionResultList {
+		_, err := k.Auction.Get(ctx, elem.AuctionId)
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("auction result auction %d is not found", elem.AuctionId)
+		}
+
+		if err := k.AuctionResult.Set(ctx, elem.AuctionId, elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the collectedFees
+	for _, elem := range genState.CollectedFeesList {
+		_, err := k.Auction.Get(ctx, elem.AuctionId)
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("collected fees auction %d is not found", elem.AuctionId)
+		}
+
+		if err := k.CollectedFees.Set(ctx, elem.AuctionId, elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the buyerVesting
+	for _, elem := range genState.BuyerVestingList {
+		_, err := k.Auction.Get(ctx, elem.AuctionId)
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("buyer vesting auction %d is not found", elem.AuctionId)
+		}
+
+		bidder, err := sdk.AccAddressFromBech32(elem.Bidder)
+		if err != nil {
+			return err
+		}
+		if err := k.BuyerVesting.Set(ctx, collections.Join(elem.AuctionId, bidder), elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the bidCommitment
+	// The ids are kept as they are, since the revealed commitments leave gaps in them
+	maxCommitmentIds := make(map[uint64]uint64)
+	for _, elem := range genState.BidCommitmentList {
+		_, err := k.Auction.Get(ctx, elem.AuctionId)
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("bid commitment auction %d is not found", elem.AuctionId)
+		}
+
+		if err := k.BidCommitment.Set(ctx, collections.Join(elem.AuctionId, elem.Id), elem); err != nil {
+			return err
+		}
+		if elem.Id > maxCommitmentIds[elem.AuctionId] {
+			maxCommitmentIds[elem.AuctionId] = elem.Id
+		}
+	}
+	for auctionId, maxId := range maxCommitmentIds {
+		if err := k.BidCommitmentSeq.Set(ctx, auctionId, maxId); err != nil {
+			return err
+		}
+	}
+
+	// Set all the failedTransition
+	for _, elem := range genState.FailedTransitionList {
+		_, err := k.Auction.Get(ctx, elem.AuctionId)
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("failed transition auction %d is not found", elem.AuctionId)
+		}
+
+		if err := k.FailedTransition.Set(ctx, elem.AuctionId, elem); err != nil {
+			return err
+		}
+	}
+
+	// Queue all the auctions for their next state transition
+	auctions, err := k.Auctions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, auction := range auctions {
+		// The auction whose transition failed keeps waiting for its retry, so that
+		// the backoff of the failed attempts isn't reset by the export
+		failed, err := k.FailedTransition.Get(ctx, auction.GetId())
+		switch {
+		case err == nil:
+			retryTime := failed.Time.Add(failed.RetryDelay())
+			if err := k.AuctionQueue.Set(ctx, collections.Join(retryTime, auction.GetId())); err != nil {
+				return err
+			}
+		case errors.Is(err, collections.ErrNotFound):
+			if err := k.ScheduleAuction(ctx, auction); err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 
 	return k.Params.Set(ctx, genState.Params)
@@ -116,13 +218,32 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, e
 	}); err != nil {
 		return nil, err
 	}
-	if err := k.VestingQueue.Walk(ctx, nil, func(key collections.Pair[uint64, time.Time], val types.VestingQueue) (bool, error) {
+	if err := k.VestingQueue.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], val types.VestingQueue) (bool, error) {
 		genesis.VestingQueueList = append(genesis.VestingQueueList, val)
 		return false, nil
 	}); err != nil {
 		return nil, err
 	}
 
+	if err := k.AuctionResult.Walk(ctx, nil, func(_ uint64, val types.AuctionResult) (bool, error) {
+		genesis.AuctionResultList = append(genesis.AuctionResultList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.CollectedFees.Walk(ctx, nil, func(_ uint64, val types.CollectedFees) (bool, error) {
+		genesis.CollectedFeesList = append(genesis.CollectedFeesList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.BuyerVestingList, err = k.BuyerVestings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.Bid.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], val types.Bid) (bool, error) {
 		genesis.BidList = append(genesis.BidList, val)
 		return false, nil
@@ -131,6 +252,21 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, e
 		return nil, err
 	}
 
+	err = k.BidCommitment.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], val types.BidCommitment) (bool, error) {
+		genesis.BidCommitmentList = append(genesis.BidCommitmentList, val)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.FailedTransition.Walk(ctx, nil, func(_ uint64, val types.FailedTransition) (bool, error) {
+		genesis.FailedTransitionList = append(genesis.FailedTransitionList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	genesis.AuctionList = make([]*codectypes.Any, 0)
 	err = k.Auction.Walk(ctx, nil, func(key uint64, elem types.AuctionI) (bool, error) {
 		auctionAny, err := types.PackAuction(elem)
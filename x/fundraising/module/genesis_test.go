@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"math/rand"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -24,10 +26,12 @@ func TestGenesis(t *testing.T) {
 	auctionAny1, _ := types.PackAuction(types.NewFixedPriceAuction(
 		&types.BaseAuction{Id: 1},
 		sdk.NewInt64Coin("denom1", 1_000),
+		types.SaleModeFirstCome,
 	))
 	auctionAny2, _ := types.PackAuction(types.NewFixedPriceAuction(
 		&types.BaseAuction{Id: 2},
 		sdk.NewInt64Coin("denom2", 2_000),
+		types.SaleModeFirstCome,
 	))
 
 	genesisState := types.GenesisState{
@@ -53,13 +57,36 @@ func TestGenesis(t *testing.T) {
 		},
 		BidList: []types.Bid{
 			{
-				Id: 0,
+				Id:     0,
+				Bidder: sample.Address(),
 			},
 			{
-				Id: 1,
+				Id:     1,
+				Bidder: sample.Address(),
 			},
 		},
 		AuctionList: []*codectypes.Any{auctionAny1, auctionAny2},
+		AuctionResultList: []types.AuctionResult{
+			{
+				AuctionId:    1,
+				MatchedPrice: math.LegacyOneDec(),
+				SoldCoin:     sdk.NewInt64Coin("denom1", 0),
+				PaidCoin:     sdk.NewInt64Coin("denom2", 0),
+				RefundedCoin: sdk.NewInt64Coin("denom2", 0),
+			},
+		},
+		BidCommitmentList: []types.BidCommitment{
+			{
+				AuctionId: 1,
+				Id:        1,
+				Bidder:    sample.Address(),
+			},
+			{
+				AuctionId: 1,
+				Id:        2,
+				Bidder:    sample.Address(),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -77,9 +104,144 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.VestingQueueList, got.VestingQueueList)
 	require.ElementsMatch(t, genesisState.BidList, got.BidList)
 	require.ElementsMatch(t, genesisState.AuctionList, got.AuctionList)
+	require.ElementsMatch(t, genesisState.AuctionResultList, got.AuctionResultList)
+	require.ElementsMatch(t, genesisState.BidCommitmentList, got.BidCommitmentList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
 
+func TestGenesis_BidCommitmentIdGap(t *testing.T) {
+	auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(
+		&types.BaseAuction{Id: 0},
+		sdk.NewInt64Coin("denom1", 1_000),
+		types.SaleModeFirstCome,
+	))
+
+	// The commitment 2 has been revealed, leaving a gap in the ids
+	genesisState := types.GenesisState{
+		Params:      types.DefaultParams(),
+		AuctionList: []*codectypes.Any{auctionAny},
+		BidCommitmentList: []types.BidCommitment{
+			{AuctionId: 0, Id: 1, Bidder: sample.Address()},
+			{AuctionId: 0, Id: 3, Bidder: sample.Address()},
+		},
+	}
+
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	require.NoError(t, fundraising.InitGenesis(ctx, k, genesisState))
+	exported, err := fundraising.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+
+	k, ctx, _ = keepertest.FundraisingKeeper(t)
+	require.NoError(t, fundraising.InitGenesis(ctx, k, *exported))
+
+	got, err := fundraising.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+	require.ElementsMatch(t, genesisState.BidCommitmentList, got.BidCommitmentList)
+
+	_, err = k.BidCommitment.Get(ctx, collections.Join(uint64(0), uint64(3)))
+	require.NoError(t, err)
+
+	// The next commitment doesn't collide with the imported ones
+	nextId, err := k.GetNextBidCommitmentIdWithUpdate(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), nextId)
+}
+
+func TestGenesis_BidIdGap(t *testing.T) {
+	auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(
+		&types.BaseAuction{Id: 0},
+		sdk.NewInt64Coin("denom1", 1_000),
+		types.SaleModeFirstCome,
+	))
+
+	// The bid 2 has been cancelled, leaving a gap in the ids
+	genesisState := types.GenesisState{
+		Params:      types.DefaultParams(),
+		AuctionList: []*codectypes.Any{auctionAny},
+		BidList: []types.Bid{
+			{AuctionId: 0, Id: 1, Bidder: sample.Address()},
+			{AuctionId: 0, Id: 3, Bidder: sample.Address()},
+		},
+	}
+
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	require.NoError(t, fundraising.InitGenesis(ctx, k, genesisState))
+	exported, err := fundraising.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+
+	k, ctx, _ = keepertest.FundraisingKeeper(t)
+	require.NoError(t, fundraising.InitGenesis(ctx, k, *exported))
+
+	got, err := fundraising.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+	require.Len(t, got.BidList, 2)
+	for i, bid := range got.BidList {
+		require.Equal(t, genesisState.BidList[i].Id, bid.Id)
+		require.Equal(t, genesisState.BidList[i].Bidder, bid.Bidder)
+	}
+
+	// The next bid doesn't collide with the imported ones
+	nextId, err := k.GetNextBidIdWithUpdate(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), nextId)
+}
+
+func TestGenesis_FailedTransition(t *testing.T) {
+	endTime := types.MustParseRFC3339("2023-01-01T00:00:00Z")
+	failedTime := types.MustParseRFC3339("2023-01-01T00:10:00Z")
+	auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(
+		&types.BaseAuction{
+			Id:        0,
+			StartTime: types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+			EndTimes:  []time.Time{endTime},
+			Status:    types.AuctionStatusStarted,
+		},
+		sdk.NewInt64Coin("denom1", 1_000),
+		types.SaleModeFirstCome,
+	))
+
+	genesisState := types.GenesisState{
+		Params:      types.DefaultParams(),
+		AuctionList: []*codectypes.Any{auctionAny},
+		FailedTransitionList: []types.FailedTransition{
+			{
+				AuctionId:     0,
+				AuctionStatus: types.AuctionStatusStarted,
+				Error:         "error",
+				Height:        10,
+				Time:          failedTime,
+				Attempts:      3,
+			},
+		},
+	}
+
+	k, ctx, _ := keepertest.FundraisingKeeper(t)
+	require.NoError(t, fundraising.InitGenesis(ctx, k, genesisState))
+	exported, err := fundraising.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+
+	k, ctx, _ = keepertest.FundraisingKeeper(t)
+	require.NoError(t, fundraising.InitGenesis(ctx, k, *exported))
+
+	got, err := fundraising.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+	require.Len(t, got.FailedTransitionList, 1)
+	require.Equal(t, uint64(3), got.FailedTransitionList[0].Attempts)
+	require.True(t, failedTime.Equal(got.FailedTransitionList[0].Time))
+
+	// The auction waits for the retry of the third attempt instead of its end time
+	var queued []collections.Pair[time.Time, uint64]
+	require.NoError(t, k.AuctionQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		queued = append(queued, key)
+		return false, nil
+	}))
+	require.Len(t, queued, 1)
+	require.True(t, failedTime.Add(20*time.Second).Equal(queued[0].K1()))
+	require.Equal(t, uint64(0), queued[0].K2())
+}
+
 // TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
 // Abnormal scenarios are not tested here.
 func TestRandomizedGenState(t *testing.T) {
@@ -24,6 +24,9 @@ const (
 	opWeightMsgCreateBatchAuction          = "op_weight_msg_create_batch_auction"
 	defaultWeightMsgCreateBatchAuction int = 20
 
+	opWeightMsgCreateDutchAuction          = "op_weight_msg_create_dutch_auction"
+	defaultWeightMsgCreateDutchAuction int = 20
+
 	opWeightMsgCancelAuction          = "op_weight_msg_cancel_auction"
 	defaultWeightMsgCancelAuction int = 15
 
@@ -33,6 +36,36 @@ const (
 	opWeightMsgModifyBid          = "op_weight_msg_modify_bid"
 	defaultWeightMsgModifyBid int = 15
 
+	opWeightMsgCancelBid          = "op_weight_msg_cancel_bid"
+	defaultWeightMsgCancelBid int = 10
+
+	opWeightMsgPauseAuction          = "op_weight_msg_pause_auction"
+	defaultWeightMsgPauseAuction int = 5
+
+	opWeightMsgResumeAuction          = "op_weight_msg_resume_auction"
+	defaultWeightMsgResumeAuction int = 10
+
+	opWeightMsgCloseAuctionEarly          = "op_weight_msg_close_auction_early"
+	defaultWeightMsgCloseAuctionEarly int = 3
+
+	opWeightMsgClaimBuyerVesting          = "op_weight_msg_claim_buyer_vesting"
+	defaultWeightMsgClaimBuyerVesting int = 10
+
+	opWeightMsgClaimVestedProceeds          = "op_weight_msg_claim_vested_proceeds"
+	defaultWeightMsgClaimVestedProceeds int = 10
+
+	opWeightMsgTransferAuctionOwnership          = "op_weight_msg_transfer_auction_ownership"
+	defaultWeightMsgTransferAuctionOwnership int = 10
+
+	opWeightMsgAcceptAuctionOwnership          = "op_weight_msg_accept_auction_ownership"
+	defaultWeightMsgAcceptAuctionOwnership int = 10
+
+	opWeightMsgCommitBid          = "op_weight_msg_commit_bid"
+	defaultWeightMsgCommitBid int = 10
+
+	opWeightMsgRevealBid          = "op_weight_msg_reveal_bid"
+	defaultWeightMsgRevealBid int = 10
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -111,6 +144,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		fundraisingsimulation.SimulateMsgCreateBatchAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
+	var weightMsgCreateDutchAuction int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateDutchAuction, &weightMsgCreateDutchAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDutchAuction = defaultWeightMsgCreateDutchAuction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateDutchAuction,
+		fundraisingsimulation.SimulateMsgCreateDutchAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
 	var weightMsgCancelAuction int
 	simState.AppParams.GetOrGenerate(opWeightMsgCancelAuction, &weightMsgCancelAuction, nil,
 		func(_ *rand.Rand) {
@@ -144,6 +188,116 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		fundraisingsimulation.SimulateMsgModifyBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
+	var weightMsgCancelBid int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelBid, &weightMsgCancelBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelBid = defaultWeightMsgCancelBid
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelBid,
+		fundraisingsimulation.SimulateMsgCancelBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgPauseAuction int
+	simState.AppParams.GetOrGenerate(opWeightMsgPauseAuction, &weightMsgPauseAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgPauseAuction = defaultWeightMsgPauseAuction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPauseAuction,
+		fundraisingsimulation.SimulateMsgPauseAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgResumeAuction int
+	simState.AppParams.GetOrGenerate(opWeightMsgResumeAuction, &weightMsgResumeAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgResumeAuction = defaultWeightMsgResumeAuction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResumeAuction,
+		fundraisingsimulation.SimulateMsgResumeAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCloseAuctionEarly int
+	simState.AppParams.GetOrGenerate(opWeightMsgCloseAuctionEarly, &weightMsgCloseAuctionEarly, nil,
+		func(_ *rand.Rand) {
+			weightMsgCloseAuctionEarly = defaultWeightMsgCloseAuctionEarly
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCloseAuctionEarly,
+		fundraisingsimulation.SimulateMsgCloseAuctionEarly(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgClaimBuyerVesting int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimBuyerVesting, &weightMsgClaimBuyerVesting, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimBuyerVesting = defaultWeightMsgClaimBuyerVesting
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimBuyerVesting,
+		fundraisingsimulation.SimulateMsgClaimBuyerVesting(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgClaimVestedProceeds int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimVestedProceeds, &weightMsgClaimVestedProceeds, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimVestedProceeds = defaultWeightMsgClaimVestedProceeds
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimVestedProceeds,
+		fundraisingsimulation.SimulateMsgClaimVestedProceeds(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgTransferAuctionOwnership int
+	simState.AppParams.GetOrGenerate(opWeightMsgTransferAuctionOwnership, &weightMsgTransferAuctionOwnership, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferAuctionOwnership = defaultWeightMsgTransferAuctionOwnership
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferAuctionOwnership,
+		fundraisingsimulation.SimulateMsgTransferAuctionOwnership(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAcceptAuctionOwnership int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptAuctionOwnership, &weightMsgAcceptAuctionOwnership, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptAuctionOwnership = defaultWeightMsgAcceptAuctionOwnership
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptAuctionOwnership,
+		fundraisingsimulation.SimulateMsgAcceptAuctionOwnership(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCommitBid int
+	simState.AppParams.GetOrGenerate(opWeightMsgCommitBid, &weightMsgCommitBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgCommitBid = defaultWeightMsgCommitBid
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCommitBid,
+		fundraisingsimulation.SimulateMsgCommitBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRevealBid int
+	simState.AppParams.GetOrGenerate(opWeightMsgRevealBid, &weightMsgRevealBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevealBid = defaultWeightMsgRevealBid
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRevealBid,
+		fundraisingsimulation.SimulateMsgRevealBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -168,6 +322,14 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCreateDutchAuction,
+			defaultWeightMsgCreateDutchAuction,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgCreateDutchAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
 		simulation.NewWeightedProposalMsg(
 			opWeightMsgCancelAuction,
 			defaultWeightMsgCancelAuction,
@@ -192,6 +354,86 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCancelBid,
+			defaultWeightMsgCancelBid,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgCancelBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgPauseAuction,
+			defaultWeightMsgPauseAuction,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgPauseAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgResumeAuction,
+			defaultWeightMsgResumeAuction,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgResumeAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCloseAuctionEarly,
+			defaultWeightMsgCloseAuctionEarly,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgCloseAuctionEarly(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgClaimBuyerVesting,
+			defaultWeightMsgClaimBuyerVesting,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgClaimBuyerVesting(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgClaimVestedProceeds,
+			defaultWeightMsgClaimVestedProceeds,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgClaimVestedProceeds(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgTransferAuctionOwnership,
+			defaultWeightMsgTransferAuctionOwnership,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgTransferAuctionOwnership(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgAcceptAuctionOwnership,
+			defaultWeightMsgAcceptAuctionOwnership,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgAcceptAuctionOwnership(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCommitBid,
+			defaultWeightMsgCommitBid,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgCommitBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgRevealBid,
+			defaultWeightMsgRevealBid,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				fundraisingsimulation.SimulateMsgRevealBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func SimulateMsgAcceptAuctionOwnership(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgAcceptAuctionOwnership{}
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auctions"), nil, nil
+		}
+
+		pending := make([]types.AuctionI, 0)
+		for _, auction := range auctions {
+			switch auction.GetStatus() {
+			case types.AuctionStatusFinished, types.AuctionStatusCancelled, types.AuctionStatusFailed:
+				continue
+			}
+			if !auction.GetPendingOwner().Empty() {
+				pending = append(pending, auction)
+			}
+		}
+		if len(pending) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no pending ownership transfer to accept"), nil, nil
+		}
+
+		// Select a random auction
+		auction := pending[r.Intn(len(pending))]
+
+		simAccount, found := FindAccount(accs, auction.GetPendingOwner().String())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "pending owner account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg = types.NewMsgAcceptAuctionOwnership(
+			account.GetAddress().String(),
+			auction.GetId(),
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,74 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func SimulateMsgClaimBuyerVesting(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgClaimBuyerVesting{}
+		buyerVestings, err := k.BuyerVestings(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get buyer vestings"), nil, nil
+		}
+		if len(buyerVestings) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no buyer vesting to claim"), nil, nil
+		}
+
+		// Select a random buyer vesting
+		buyerVesting := buyerVestings[r.Intn(len(buyerVestings))]
+		auction, err := k.Auction.Get(ctx, buyerVesting.AuctionId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auction"), nil, nil
+		}
+
+		unlocked := buyerVesting.UnlockedCoin(auction.GetBuyerVestingSchedules(), ctx.BlockTime())
+		if !unlocked.Sub(buyerVesting.ClaimedCoin).IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "nothing to claim"), nil, nil
+		}
+
+		simAccount, found := FindAccount(accs, buyerVesting.Bidder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "bidder account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg = types.NewMsgClaimBuyerVesting(
+			buyerVesting.AuctionId,
+			account.GetAddress().String(),
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,84 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func SimulateMsgClaimVestedProceeds(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgClaimVestedProceeds{}
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auctions"), nil, nil
+		}
+		if len(auctions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no auction to claim the vested proceeds"), nil, nil
+		}
+
+		// Select a random auction
+		auction := auctions[r.Intn(len(auctions))]
+		if auction.GetStatus() != types.AuctionStatusVesting || auction.GetAutoRelease() {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "auction is not vesting or is released automatically"), nil, nil
+		}
+
+		vestingQueues, err := k.GetVestingQueuesByAuctionId(ctx, auction.GetId())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get vesting queues"), nil, nil
+		}
+		matured := false
+		for _, vestingQueue := range vestingQueues {
+			if vestingQueue.ShouldRelease(ctx.BlockTime()) {
+				matured = true
+				break
+			}
+		}
+		if !matured && auction.GetLinearVesting() == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no matured vesting queue"), nil, nil
+		}
+
+		simAccount, found := FindAccount(accs, auction.GetAuctioneer().String())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "auctioneer account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg = types.NewMsgClaimVestedProceeds(
+			account.GetAddress().String(),
+			auction.GetId(),
+			"",
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// SimulateMsgCloseAuctionEarly generates a SimulateMsgCloseAuctionEarly with random values
+// nolint: interfacer
+func SimulateMsgCloseAuctionEarly(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCloseAuctionEarly{}
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auctions"), nil, nil
+		}
+
+		r.Shuffle(len(auctions), func(i, j int) {
+			auctions[i], auctions[j] = auctions[j], auctions[i]
+		})
+
+		var simAccount simtypes.Account
+		var auction types.AuctionI
+
+		// Find an auction that is started or paused
+		skip := true
+
+		for _, auction = range auctions {
+			if auction.GetStatus() == types.AuctionStatusStarted || auction.GetStatus() == types.AuctionStatusPaused {
+				skip = false
+				break
+			}
+		}
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no auction to close early"), nil, nil
+		}
+
+		accs = shuffleSimAccounts(r, accs)
+
+		// Only the auction's auctioneer can close the auction among the simulation accounts
+		found := false
+		for _, acc := range accs {
+			if acc.Address.Equals(auction.GetAuctioneer()) {
+				simAccount = acc
+				found = true
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "auctioneer is not a simulation account"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+		sender := account.GetAddress().String()
+
+		msg = types.NewMsgCloseAuctionEarly(sender, auction.GetId())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,106 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// simBidSalt is the salt of the sealed bids committed by the simulation, so that they can be revealed later.
+const simBidSalt = "simulation"
+
+// SimulateMsgCommitBid generates a MsgCommitBid with random values
+// nolint: interfacer
+func SimulateMsgCommitBid(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCommitBid{}
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auctions"), nil, nil
+		}
+
+		sealed := make([]*types.BatchAuction, 0)
+		for _, auction := range auctions {
+			if ba, ok := auction.(*types.BatchAuction); ok && ba.IsSealedBid() && ba.GetStatus() == types.AuctionStatusStarted {
+				sealed = append(sealed, ba)
+			}
+		}
+		if len(sealed) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no started sealed-bid auction to commit a bid"), nil, nil
+		}
+
+		// Select a random auction
+		auction := sealed[r.Intn(len(sealed))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		bidder := account.GetAddress()
+		payingCoinDenom := auction.GetPayingCoinDenom()
+
+		if _, err := fundBalances(ctx, r, bk, bidder, testCoinDenoms); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to fund bidder"), nil, err
+		}
+
+		// The committed bid is a worth bid at the start price whose coin is the whole collateral
+		bid := types.Bid{
+			Type:  types.BidTypeBatchWorth,
+			Price: auction.GetStartPrice(),
+			Coin:  sdk.NewInt64Coin(payingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000))),
+		}
+
+		if !bk.SpendableCoins(ctx, bidder).AmountOf(payingCoinDenom).GT(bid.Coin.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "insufficient balance to commit a bid"), nil, nil
+		}
+
+		maxBidAmt := bid.ConvertToSellingAmount(payingCoinDenom)
+		allowedBidder, err := k.AllowedBidder.Get(ctx, collections.Join(auction.GetId(), bidder))
+		if err == nil {
+			maxBidAmt = maxBidAmt.Add(allowedBidder.MaxBidAmount)
+		}
+
+		newAllowedBidder := types.NewAllowedBidder(auction.GetId(), bidder, maxBidAmt)
+		if err := k.AddAllowedBidders(ctx, auction.GetId(), []types.AllowedBidder{newAllowedBidder}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to add allowed bidders"), nil, err
+		}
+
+		msg = types.NewMsgCommitBid(
+			bidder.String(),
+			auction.GetId(),
+			types.BidCommitmentHash(auction.GetId(), bidder.String(), bid.Type, bid.Price, bid.Coin, simBidSalt),
+			bid.Coin,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
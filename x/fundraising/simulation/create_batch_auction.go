@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -50,6 +51,16 @@ func SimulateMsgCreateBatchAuction(
 		extendedRoundRate := math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 3)), 1) // 0.1 ~ 0.3
 		startTime := ctx.BlockTime().AddDate(0, 0, simtypes.RandIntBetween(r, 0, 2))
 		endTime := startTime.AddDate(0, simtypes.RandIntBetween(r, 1, 12), 0)
+		bidCancelCutoff := time.Duration(simtypes.RandIntBetween(r, 0, 24)) * time.Hour
+
+		var sealedBidConfig *types.SealedBidConfig
+		if r.Intn(4) == 0 {
+			maxExtendedRound = 0
+			sealedBidConfig = &types.SealedBidConfig{
+				RevealPeriod:      time.Duration(simtypes.RandIntBetween(r, 1, 48)) * time.Hour,
+				ForfeitUnrevealed: r.Intn(2) == 0,
+			}
+		}
 
 		if _, err := fundBalances(ctx, r, bk, auctioneer, testCoinDenoms); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to fund auctioneer"), nil, err
@@ -72,6 +83,17 @@ func SimulateMsgCreateBatchAuction(
 			extendedRoundRate,
 			startTime,
 			endTime,
+			bidCancelCutoff,
+			sdk.NewCoins(),
+			math.ZeroInt(),
+			[]types.VestingSchedule{},
+			nil,
+			r.Intn(2) == 0,
+			"",
+			types.MarginalFillMode(r.Intn(3)),
+			types.PricingMode(r.Intn(2)),
+			sealedBidConfig,
+			nil,
 		)
 
 		txCtx := simulation.OperationInput{
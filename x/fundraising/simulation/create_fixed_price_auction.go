@@ -66,6 +66,14 @@ func SimulateMsgCreateFixedPriceAuction(
 			vestingSchedules,
 			startTime,
 			endTime,
+			sdk.NewCoins(),
+			math.ZeroInt(),
+			[]types.VestingSchedule{},
+			nil,
+			r.Intn(2) == 0,
+			"",
+			nil,
+			types.SaleMode(r.Intn(2)),
 		)
 
 		txCtx := simulation.OperationInput{
@@ -41,6 +41,10 @@ func SimulateMsgPlaceBid(
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "auction must be started status"), nil, nil
 		}
 
+		if ba, ok := auction.(*types.BatchAuction); ok && ba.IsSealedBid() {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "sealed-bid auction only accepts committed bids"), nil, nil
+		}
+
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 
 		account := ak.GetAccount(ctx, simAccount.Address)
@@ -73,6 +77,15 @@ func SimulateMsgPlaceBid(
 				bid.Type = types.BidTypeBatchMany
 				bid.Coin = sdk.NewInt64Coin(sellingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000)))
 			}
+		case types.AuctionTypeDutch:
+			// The bid is filled at the current price, so use it to calculate the reserve and maximum bid amount
+			bid.Type = types.BidTypeDutch
+			bid.Price = auction.(*types.DutchAuction).CurrentPrice(ctx.BlockTime())
+			if r.Int()%2 == 0 {
+				bid.Coin = sdk.NewInt64Coin(payingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000)))
+			} else {
+				bid.Coin = sdk.NewInt64Coin(sellingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000)))
+			}
 		}
 
 		bidReserveAmt := bid.ConvertToPayingAmount(payingCoinDenom)
@@ -92,12 +105,18 @@ func SimulateMsgPlaceBid(
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to add allowed bidders"), nil, err
 		}
 
+		// Bid the start price for the dutch auction since it is the maximum price to pay
+		if bid.Type == types.BidTypeDutch {
+			bid.Price = auction.GetStartPrice()
+		}
+
 		msg = types.NewMsgPlaceBid(
 			auction.GetId(),
 			bidder.String(),
 			bid.Type,
 			bid.Price,
 			bid.Coin,
+			"",
 		)
 
 		txCtx := simulation.OperationInput{
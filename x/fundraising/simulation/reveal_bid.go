@@ -0,0 +1,97 @@
+package simulation
+
+import (
+	"bytes"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// SimulateMsgRevealBid generates a MsgRevealBid for a bid committed by the simulation
+// nolint: interfacer
+func SimulateMsgRevealBid(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRevealBid{}
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auctions"), nil, nil
+		}
+
+		commitments := make([]types.BidCommitment, 0)
+		for _, auction := range auctions {
+			if auction.GetStatus() != types.AuctionStatusRevealing {
+				continue
+			}
+			auctionCommitments, err := k.GetBidCommitmentsByAuctionId(ctx, auction.GetId())
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get bid commitments"), nil, nil
+			}
+			commitments = append(commitments, auctionCommitments...)
+		}
+		if len(commitments) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no bid commitment to reveal"), nil, nil
+		}
+
+		// Select a random commitment
+		commitment := commitments[r.Intn(len(commitments))]
+
+		auction, err := k.Auction.Get(ctx, commitment.AuctionId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auction"), nil, nil
+		}
+
+		simAccount, found := FindAccount(accs, commitment.Bidder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "bidder account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// Rebuild the bid committed by SimulateMsgCommitBid
+		bidType, price, coin := types.BidTypeBatchWorth, auction.GetStartPrice(), commitment.Collateral
+		hash := types.BidCommitmentHash(commitment.AuctionId, commitment.Bidder, bidType, price, coin, simBidSalt)
+		if !bytes.Equal(hash, commitment.Hash) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "bid commitment is not made by the simulation"), nil, nil
+		}
+
+		msg = types.NewMsgRevealBid(
+			commitment.Bidder,
+			commitment.AuctionId,
+			commitment.Id,
+			bidType,
+			price,
+			coin,
+			simBidSalt,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,78 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func SimulateMsgTransferAuctionOwnership(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgTransferAuctionOwnership{}
+		auctions, err := k.Auctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "failed to get auctions"), nil, nil
+		}
+		if len(auctions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no auction to transfer the ownership"), nil, nil
+		}
+
+		// Select a random auction
+		auction := auctions[r.Intn(len(auctions))]
+		switch auction.GetStatus() {
+		case types.AuctionStatusFinished, types.AuctionStatusCancelled, types.AuctionStatusFailed:
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "auction is already settled"), nil, nil
+		}
+
+		simAccount, found := FindAccount(accs, auction.GetAuctioneer().String())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "auctioneer account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		newOwner, _ := simtypes.RandomAcc(r, accs)
+		newBeneficiary := ""
+		if r.Intn(2) == 0 {
+			beneficiary, _ := simtypes.RandomAcc(r, accs)
+			newBeneficiary = beneficiary.Address.String()
+		}
+
+		msg = types.NewMsgTransferAuctionOwnership(
+			account.GetAddress().String(),
+			auction.GetId(),
+			newOwner.Address.String(),
+			newBeneficiary,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
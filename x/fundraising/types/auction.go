@@ -14,10 +14,11 @@ import (
 )
 
 const (
-	SellingReserveAddressPrefix string = "SellingReserveAddress"
-	PayingReserveAddressPrefix  string = "PayingReserveAddress"
-	VestingReserveAddressPrefix string = "VestingReserveAddress"
-	ModuleAddressNameSplitter   string = "|"
+	SellingReserveAddressPrefix      string = "SellingReserveAddress"
+	PayingReserveAddressPrefix       string = "PayingReserveAddress"
+	VestingReserveAddressPrefix      string = "VestingReserveAddress"
+	BuyerVestingReserveAddressPrefix string = "BuyerVestingReserveAddress"
+	ModuleAddressNameSplitter        string = "|"
 
 	// ReserveAddressType is an address type of reserve for selling, paying, and vesting.
 	// The module uses the address type of 32 bytes length, but it can be changed depending on Cosmos SDK's direction.
@@ -27,6 +28,7 @@ const (
 var (
 	_ AuctionI = (*FixedPriceAuction)(nil)
 	_ AuctionI = (*BatchAuction)(nil)
+	_ AuctionI = (*DutchAuction)(nil)
 )
 
 // NewBaseAuction creates a new BaseAuction object
@@ -38,21 +40,31 @@ func NewBaseAuction(
 	startPrice math.LegacyDec, sellingCoin sdk.Coin, payingCoinDenom string,
 	vestingPoolAddr string, vestingSchedules []VestingSchedule,
 	startTime time.Time, endTimes []time.Time, status AuctionStatus,
+	bidFee sdk.Coins, softCap math.Int, buyerVestingSchedules []VestingSchedule,
+	linearVesting *LinearVesting, autoRelease bool, beneficiaryAddr string,
+	additionalPayingDenoms []PayingDenom,
 ) *BaseAuction {
 	return &BaseAuction{
-		Id:                    id,
-		Type:                  typ,
-		Auctioneer:            auctioneerAddr,
-		SellingReserveAddress: sellingPoolAddr,
-		PayingReserveAddress:  payingPoolAddr,
-		StartPrice:            startPrice,
-		SellingCoin:           sellingCoin,
-		PayingCoinDenom:       payingCoinDenom,
-		VestingReserveAddress: vestingPoolAddr,
-		VestingSchedules:      vestingSchedules,
-		StartTime:             startTime,
-		EndTimes:              endTimes,
-		Status:                status,
+		Id:                     id,
+		Type:                   typ,
+		Auctioneer:             auctioneerAddr,
+		SellingReserveAddress:  sellingPoolAddr,
+		PayingReserveAddress:   payingPoolAddr,
+		StartPrice:             startPrice,
+		SellingCoin:            sellingCoin,
+		PayingCoinDenom:        payingCoinDenom,
+		VestingReserveAddress:  vestingPoolAddr,
+		VestingSchedules:       vestingSchedules,
+		StartTime:              startTime,
+		EndTimes:               endTimes,
+		Status:                 status,
+		BidFee:                 bidFee,
+		SoftCap:                softCap,
+		BuyerVestingSchedules:  buyerVestingSchedules,
+		LinearVesting:          linearVesting,
+		AutoRelease:            autoRelease,
+		Beneficiary:            beneficiaryAddr,
+		AdditionalPayingDenoms: additionalPayingDenoms,
 	}
 }
 
@@ -189,9 +201,129 @@ func (ba *BaseAuction) SetStatus(status AuctionStatus) error {
 	return nil
 }
 
+func (ba BaseAuction) GetPausedTime() *time.Time {
+	return ba.PausedTime
+}
+
+func (ba *BaseAuction) SetPausedTime(t *time.Time) error {
+	ba.PausedTime = t
+	return nil
+}
+
+func (ba BaseAuction) GetBidFee() sdk.Coins {
+	return ba.BidFee
+}
+
+func (ba *BaseAuction) SetBidFee(fee sdk.Coins) error {
+	ba.BidFee = fee
+	return nil
+}
+
+// GetSoftCap returns the soft cap of the auction; it is zero if not set.
+func (ba BaseAuction) GetSoftCap() math.Int {
+	if ba.SoftCap.IsNil() {
+		return math.ZeroInt()
+	}
+	return ba.SoftCap
+}
+
+func (ba *BaseAuction) SetSoftCap(softCap math.Int) error {
+	ba.SoftCap = softCap
+	return nil
+}
+
+func (ba BaseAuction) GetBuyerVestingSchedules() []VestingSchedule {
+	return ba.BuyerVestingSchedules
+}
+
+func (ba *BaseAuction) SetBuyerVestingSchedules(schedules []VestingSchedule) error {
+	ba.BuyerVestingSchedules = schedules
+	return nil
+}
+
+func (ba BaseAuction) GetLinearVesting() *LinearVesting {
+	return ba.LinearVesting
+}
+
+func (ba *BaseAuction) SetLinearVesting(linearVesting *LinearVesting) error {
+	ba.LinearVesting = linearVesting
+	return nil
+}
+
+func (ba BaseAuction) GetAutoRelease() bool {
+	return ba.AutoRelease
+}
+
+func (ba *BaseAuction) SetAutoRelease(autoRelease bool) error {
+	ba.AutoRelease = autoRelease
+	return nil
+}
+
+// GetBeneficiary returns the address that receives the paying coin and the
+// unsold selling coin of the auction; it is the auctioneer if not set.
+func (ba BaseAuction) GetBeneficiary() sdk.AccAddress {
+	if ba.Beneficiary == "" {
+		return ba.GetAuctioneer()
+	}
+	addr, err := sdk.AccAddressFromBech32(ba.Beneficiary)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (ba *BaseAuction) SetBeneficiary(addr sdk.AccAddress) error {
+	ba.Beneficiary = addr.String()
+	return nil
+}
+
+// GetPendingOwner returns the address proposed to take over the auction;
+// it is nil if no ownership transfer is in progress.
+func (ba BaseAuction) GetPendingOwner() sdk.AccAddress {
+	if ba.PendingOwner == "" {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(ba.PendingOwner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (ba *BaseAuction) SetPendingOwner(addr sdk.AccAddress) error {
+	ba.PendingOwner = addr.String()
+	return nil
+}
+
+func (ba BaseAuction) GetPendingBeneficiary() sdk.AccAddress {
+	if ba.PendingBeneficiary == "" {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(ba.PendingBeneficiary)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (ba *BaseAuction) SetPendingBeneficiary(addr sdk.AccAddress) error {
+	ba.PendingBeneficiary = addr.String()
+	return nil
+}
+
+// GetAdditionalPayingDenoms returns the denoms that the auction accepts besides the paying coin denom.
+func (ba BaseAuction) GetAdditionalPayingDenoms() []PayingDenom {
+	return ba.AdditionalPayingDenoms
+}
+
+func (ba *BaseAuction) SetAdditionalPayingDenoms(payingDenoms []PayingDenom) error {
+	ba.AdditionalPayingDenoms = payingDenoms
+	return nil
+}
+
 // Validate checks for errors on the Auction fields
 func (ba BaseAuction) Validate() error {
-	if ba.Type != AuctionTypeFixedPrice && ba.Type != AuctionTypeBatch {
+	if ba.Type != AuctionTypeFixedPrice && ba.Type != AuctionTypeBatch && ba.Type != AuctionTypeDutch {
 		return sdkerrors.Wrapf(ErrInvalidAuctionType, "unknown plan type: %s", ba.Type)
 	}
 	if _, err := sdk.AccAddressFromBech32(ba.Auctioneer); err != nil {
@@ -221,6 +353,47 @@ func (ba BaseAuction) Validate() error {
 	if err := ValidateVestingSchedules(ba.VestingSchedules, ba.EndTimes[len(ba.EndTimes)-1]); err != nil {
 		return err
 	}
+	if err := ba.BidFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid fee: %v", err)
+	}
+	if ba.GetSoftCap().IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "soft cap must not be negative: %s", ba.SoftCap)
+	}
+	if err := ValidateVestingSchedules(ba.BuyerVestingSchedules, ba.EndTimes[len(ba.EndTimes)-1]); err != nil {
+		return sdkerrors.Wrap(err, "invalid buyer vesting schedules")
+	}
+	if ba.LinearVesting != nil && len(ba.VestingSchedules) > 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingSchedules, "vesting schedules and linear vesting must not be set together")
+	}
+	if err := ValidateLinearVesting(ba.LinearVesting, ba.EndTimes[len(ba.EndTimes)-1]); err != nil {
+		return err
+	}
+	if ba.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(ba.Beneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address %q: %v", ba.Beneficiary, err)
+		}
+	}
+	if ba.PendingOwner != "" {
+		if _, err := sdk.AccAddressFromBech32(ba.PendingOwner); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid pending owner address %q: %v", ba.PendingOwner, err)
+		}
+	}
+	if ba.PendingBeneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(ba.PendingBeneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid pending beneficiary address %q: %v", ba.PendingBeneficiary, err)
+		}
+		if ba.PendingOwner == "" {
+			return sdkerrors.Wrap(errors.ErrInvalidRequest, "pending beneficiary must not be set without pending owner")
+		}
+	}
+	if err := ValidatePayingDenoms(ba.AdditionalPayingDenoms, ba.SellingCoin.Denom, ba.PayingCoinDenom); err != nil {
+		return err
+	}
+	for _, pd := range ba.AdditionalPayingDenoms {
+		if !pd.HasRatio() {
+			return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "conversion ratio of %s must be positive", pd.Denom)
+		}
+	}
 	return nil
 }
 
@@ -236,22 +409,104 @@ func (ba BaseAuction) ShouldAuctionClosed(t time.Time) bool {
 }
 
 // NewFixedPriceAuction returns a new fixed price auction.
-func NewFixedPriceAuction(baseAuction *BaseAuction, remainingSellingCoin sdk.Coin) *FixedPriceAuction {
+func NewFixedPriceAuction(baseAuction *BaseAuction, remainingSellingCoin sdk.Coin, saleMode SaleMode) *FixedPriceAuction {
 	return &FixedPriceAuction{
 		BaseAuction:          baseAuction,
 		RemainingSellingCoin: remainingSellingCoin,
+		SaleMode:             saleMode,
 	}
 }
 
+// IsOverflow returns true if the auction accepts bids beyond the selling coin and
+// allocates the selling coin pro rata to the bid amounts when it ends.
+func (fa FixedPriceAuction) IsOverflow() bool {
+	return fa.SaleMode == SaleModeOverflow
+}
+
 // NewBatchAuction returns a new batch auction.
-func NewBatchAuction(baseAuction *BaseAuction, minBidPrice math.LegacyDec, matchedPrice math.LegacyDec, maxExtendedRound uint32, extendedRoundRate math.LegacyDec) *BatchAuction {
+func NewBatchAuction(
+	baseAuction *BaseAuction, minBidPrice math.LegacyDec, matchedPrice math.LegacyDec,
+	maxExtendedRound uint32, extendedRoundRate math.LegacyDec, bidCancelCutoff time.Duration,
+	marginalFillMode MarginalFillMode, pricingMode PricingMode, sealedBidConfig *SealedBidConfig,
+) *BatchAuction {
 	return &BatchAuction{
 		BaseAuction:       baseAuction,
 		MinBidPrice:       minBidPrice,
 		MatchedPrice:      matchedPrice,
 		MaxExtendedRound:  maxExtendedRound,
 		ExtendedRoundRate: extendedRoundRate,
+		BidCancelCutoff:   bidCancelCutoff,
+		MarginalFillMode:  marginalFillMode,
+		PricingMode:       pricingMode,
+		SealedBidConfig:   sealedBidConfig,
+	}
+}
+
+// ShouldBidCancelAllowed returns true if the given time t is before the bid cancel cutoff.
+func (ba BatchAuction) ShouldBidCancelAllowed(t time.Time) bool {
+	ts := ba.GetEndTimes()
+	return t.Before(ts[len(ts)-1].Add(-ba.BidCancelCutoff)) // Time < LastEndTime - BidCancelCutoff
+}
+
+// IsSealedBid returns true if the auction accepts only sealed bids.
+func (ba BatchAuction) IsSealedBid() bool {
+	return ba.SealedBidConfig != nil
+}
+
+// RevealEndTime returns the time at which the reveal phase of the sealed-bid auction ends.
+func (ba BatchAuction) RevealEndTime() time.Time {
+	ts := ba.GetEndTimes()
+	return ts[len(ts)-1].Add(ba.SealedBidConfig.RevealPeriod)
+}
+
+// NewDutchAuction returns a new dutch auction.
+func NewDutchAuction(baseAuction *BaseAuction, floorPrice math.LegacyDec, decayInterval time.Duration, remainingSellingCoin sdk.Coin) *DutchAuction {
+	return &DutchAuction{
+		BaseAuction:          baseAuction,
+		FloorPrice:           floorPrice,
+		DecayInterval:        decayInterval,
+		RemainingSellingCoin: remainingSellingCoin,
+	}
+}
+
+// Validate checks for errors on the DutchAuction fields.
+func (da DutchAuction) Validate() error {
+	if err := da.BaseAuction.Validate(); err != nil {
+		return err
 	}
+	if !da.FloorPrice.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid floor price: %s", da.FloorPrice)
+	}
+	if da.FloorPrice.GT(da.StartPrice) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "floor price must not be greater than start price")
+	}
+	if da.DecayInterval < 0 {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "decay interval must not be negative")
+	}
+	return nil
+}
+
+// CurrentPrice returns the auction price at the given time t.
+// The price decays from the start price at the start time to the floor price at the end time.
+// If the decay interval is set, the price drops at every interval instead of decaying linearly.
+func (da DutchAuction) CurrentPrice(t time.Time) math.LegacyDec {
+	endTime := da.EndTimes[len(da.EndTimes)-1]
+	if !t.After(da.StartTime) {
+		return da.StartPrice
+	}
+	if !t.Before(endTime) {
+		return da.FloorPrice
+	}
+
+	elapsed := t.Sub(da.StartTime)
+	if da.DecayInterval > 0 {
+		elapsed -= elapsed % da.DecayInterval
+	}
+	duration := endTime.Sub(da.StartTime)
+
+	// StartPrice - (StartPrice - FloorPrice) * Elapsed / Duration
+	decay := da.StartPrice.Sub(da.FloorPrice).MulInt64(int64(elapsed)).QuoInt64(int64(duration))
+	return math.LegacyMaxDec(da.StartPrice.Sub(decay), da.FloorPrice)
 }
 
 // AuctionI is an interface that inherits the BaseAuction and exposes common functions
@@ -298,6 +553,36 @@ type AuctionI interface {
 	GetStatus() AuctionStatus
 	SetStatus(AuctionStatus) error
 
+	GetPausedTime() *time.Time
+	SetPausedTime(*time.Time) error
+
+	GetBidFee() sdk.Coins
+	SetBidFee(sdk.Coins) error
+
+	GetSoftCap() math.Int
+	SetSoftCap(math.Int) error
+
+	GetBuyerVestingSchedules() []VestingSchedule
+	SetBuyerVestingSchedules([]VestingSchedule) error
+
+	GetLinearVesting() *LinearVesting
+	SetLinearVesting(*LinearVesting) error
+
+	GetAutoRelease() bool
+	SetAutoRelease(bool) error
+
+	GetBeneficiary() sdk.AccAddress
+	SetBeneficiary(sdk.AccAddress) error
+
+	GetPendingOwner() sdk.AccAddress
+	SetPendingOwner(sdk.AccAddress) error
+
+	GetPendingBeneficiary() sdk.AccAddress
+	SetPendingBeneficiary(sdk.AccAddress) error
+
+	GetAdditionalPayingDenoms() []PayingDenom
+	SetAdditionalPayingDenoms([]PayingDenom) error
+
 	ShouldAuctionStarted(t time.Time) bool
 	ShouldAuctionClosed(t time.Time) bool
 
@@ -400,3 +685,8 @@ func PayingReserveAddress(auctionId uint64) sdk.AccAddress {
 func VestingReserveAddress(auctionId uint64) sdk.AccAddress {
 	return DeriveAddress(ReserveAddressType, ModuleName, VestingReserveAddressPrefix+ModuleAddressNameSplitter+fmt.Sprint(auctionId))
 }
+
+// BuyerVestingReserveAddress returns the buyer vesting reserve address with the given auction id.
+func BuyerVestingReserveAddress(auctionId uint64) sdk.AccAddress {
+	return DeriveAddress(ReserveAddressType, ModuleName, BuyerVestingReserveAddressPrefix+ModuleAddressNameSplitter+fmt.Sprint(auctionId))
+}
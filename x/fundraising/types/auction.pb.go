@@ -7,11 +7,13 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -41,18 +43,22 @@ const (
 	AuctionTypeFixedPrice AuctionType = 1
 	// AUCTION_TYPE_BATCH defines the batch auction type
 	AuctionTypeBatch AuctionType = 2
+	// AUCTION_TYPE_DUTCH defines the dutch auction type
+	AuctionTypeDutch AuctionType = 3
 )
 
 var AuctionType_name = map[int32]string{
 	0: "AUCTION_TYPE_UNSPECIFIED",
 	1: "AUCTION_TYPE_FIXED_PRICE",
 	2: "AUCTION_TYPE_BATCH",
+	3: "AUCTION_TYPE_DUTCH",
 }
 
 var AuctionType_value = map[string]int32{
 	"AUCTION_TYPE_UNSPECIFIED": 0,
 	"AUCTION_TYPE_FIXED_PRICE": 1,
 	"AUCTION_TYPE_BATCH":       2,
+	"AUCTION_TYPE_DUTCH":       3,
 }
 
 func (x AuctionType) String() string {
@@ -63,6 +69,102 @@ func (AuctionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_807e2fa5734d5f53, []int{0}
 }
 
+// MarginalFillMode enumerates the ways to fill the bids at the matched price of
+// a batch auction when their demand exceeds the remaining selling coin.
+type MarginalFillMode int32
+
+const (
+	// MARGINAL_FILL_MODE_UNSPECIFIED doesn't fill the bids at the price partially,
+	// so the auction is matched at a higher price instead
+	MarginalFillModeNone MarginalFillMode = 0
+	// MARGINAL_FILL_MODE_PRO_RATA fills the bids at the price pro rata to their
+	// demand
+	MarginalFillModeProRata MarginalFillMode = 1
+	// MARGINAL_FILL_MODE_BID_ID fills the bids at the price in the order of the
+	// bid id
+	MarginalFillModeBidId MarginalFillMode = 2
+)
+
+var MarginalFillMode_name = map[int32]string{
+	0: "MARGINAL_FILL_MODE_UNSPECIFIED",
+	1: "MARGINAL_FILL_MODE_PRO_RATA",
+	2: "MARGINAL_FILL_MODE_BID_ID",
+}
+
+var MarginalFillMode_value = map[string]int32{
+	"MARGINAL_FILL_MODE_UNSPECIFIED": 0,
+	"MARGINAL_FILL_MODE_PRO_RATA":    1,
+	"MARGINAL_FILL_MODE_BID_ID":      2,
+}
+
+func (x MarginalFillMode) String() string {
+	return proto.EnumName(MarginalFillMode_name, int32(x))
+}
+
+func (MarginalFillMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{1}
+}
+
+// PricingMode enumerates the ways to price the matched bids of a batch auction.
+type PricingMode int32
+
+const (
+	// PRICING_MODE_UNSPECIFIED makes every matched bid pay the matched price
+	PricingModeUniform PricingMode = 0
+	// PRICING_MODE_DISCRIMINATORY makes every matched bid pay its own bid price
+	PricingModeDiscriminatory PricingMode = 1
+)
+
+var PricingMode_name = map[int32]string{
+	0: "PRICING_MODE_UNSPECIFIED",
+	1: "PRICING_MODE_DISCRIMINATORY",
+}
+
+var PricingMode_value = map[string]int32{
+	"PRICING_MODE_UNSPECIFIED":    0,
+	"PRICING_MODE_DISCRIMINATORY": 1,
+}
+
+func (x PricingMode) String() string {
+	return proto.EnumName(PricingMode_name, int32(x))
+}
+
+func (PricingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{2}
+}
+
+// SaleMode enumerates the ways to allocate the selling coin of a fixed price
+// auction.
+type SaleMode int32
+
+const (
+	// SALE_MODE_UNSPECIFIED fills the bids in the order they are placed until
+	// the selling coin runs out
+	SaleModeFirstCome SaleMode = 0
+	// SALE_MODE_OVERFLOW accepts bids for any amount and allocates the selling
+	// coin pro rata to the bid amounts when the auction ends, refunding the
+	// excess paying coin
+	SaleModeOverflow SaleMode = 1
+)
+
+var SaleMode_name = map[int32]string{
+	0: "SALE_MODE_UNSPECIFIED",
+	1: "SALE_MODE_OVERFLOW",
+}
+
+var SaleMode_value = map[string]int32{
+	"SALE_MODE_UNSPECIFIED": 0,
+	"SALE_MODE_OVERFLOW":    1,
+}
+
+func (x SaleMode) String() string {
+	return proto.EnumName(SaleMode_name, int32(x))
+}
+
+func (SaleMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{3}
+}
+
 // AuctionStatus enumerates the valid status of an auction.
 type AuctionStatus int32
 
@@ -79,6 +181,13 @@ const (
 	AuctionStatusFinished AuctionStatus = 4
 	// AUCTION_STATUS_CANCELLED defines the cancelled auction status
 	AuctionStatusCancelled AuctionStatus = 5
+	// AUCTION_STATUS_PAUSED defines the paused auction status
+	AuctionStatusPaused AuctionStatus = 6
+	// AUCTION_STATUS_FAILED defines the failed auction status
+	AuctionStatusFailed AuctionStatus = 7
+	// AUCTION_STATUS_REVEALING defines the status of a sealed-bid auction whose
+	// committed bids are being revealed
+	AuctionStatusRevealing AuctionStatus = 8
 )
 
 var AuctionStatus_name = map[int32]string{
@@ -88,6 +197,9 @@ var AuctionStatus_name = map[int32]string{
 	3: "AUCTION_STATUS_VESTING",
 	4: "AUCTION_STATUS_FINISHED",
 	5: "AUCTION_STATUS_CANCELLED",
+	6: "AUCTION_STATUS_PAUSED",
+	7: "AUCTION_STATUS_FAILED",
+	8: "AUCTION_STATUS_REVEALING",
 }
 
 var AuctionStatus_value = map[string]int32{
@@ -97,6 +209,9 @@ var AuctionStatus_value = map[string]int32{
 	"AUCTION_STATUS_VESTING":     3,
 	"AUCTION_STATUS_FINISHED":    4,
 	"AUCTION_STATUS_CANCELLED":   5,
+	"AUCTION_STATUS_PAUSED":      6,
+	"AUCTION_STATUS_FAILED":      7,
+	"AUCTION_STATUS_REVEALING":   8,
 }
 
 func (x AuctionStatus) String() string {
@@ -104,7 +219,7 @@ func (x AuctionStatus) String() string {
 }
 
 func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_807e2fa5734d5f53, []int{1}
+	return fileDescriptor_807e2fa5734d5f53, []int{4}
 }
 
 // BaseAuction defines a base auction type. It contains all the necessary fields
@@ -144,6 +259,40 @@ type BaseAuction struct {
 	EndTimes []time.Time `protobuf:"bytes,12,rep,name=end_times,json=endTimes,proto3,stdtime" json:"end_times"`
 	// status specifies the auction status
 	Status AuctionStatus `protobuf:"varint,13,opt,name=status,proto3,enum=fundraising.fundraising.v1.AuctionStatus" json:"status,omitempty"`
+	// paused_time specifies the time when the auction is paused
+	// it is only set while the auction is paused
+	PausedTime *time.Time `protobuf:"bytes,14,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time,omitempty"`
+	// bid_fee specifies the fee that a bidder pays to the auctioneer for each
+	// bid placed; it is optional
+	BidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=bid_fee,json=bidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_fee"`
+	// soft_cap specifies the minimum total paying amount that the auction must
+	// raise; the auction fails and all the coins are refunded otherwise
+	SoftCap cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=soft_cap,json=softCap,proto3,customtype=cosmossdk.io/math.Int" json:"soft_cap"`
+	// buyer_vesting_schedules specifies the vesting schedules of the selling
+	// coin allocated to the bidders; the selling coin is sent to the bidders
+	// directly when it is empty
+	BuyerVestingSchedules []VestingSchedule `protobuf:"bytes,17,rep,name=buyer_vesting_schedules,json=buyerVestingSchedules,proto3" json:"buyer_vesting_schedules"`
+	// linear_vesting specifies the continuous vesting of the paying coin; it is
+	// mutually exclusive with vesting_schedules
+	LinearVesting *LinearVesting `protobuf:"bytes,18,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+	// auto_release specifies whether the vested paying coin is released to the
+	// auctioneer by the begin blocker; otherwise it is claimed by the auctioneer
+	// with MsgClaimVestedProceeds
+	AutoRelease bool `protobuf:"varint,19,opt,name=auto_release,json=autoRelease,proto3" json:"auto_release,omitempty"`
+	// beneficiary specifies the bech32-encoded address that receives the paying
+	// coin and the unsold selling coin of the auction; it is the auctioneer when
+	// it is empty
+	Beneficiary string `protobuf:"bytes,20,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// pending_owner specifies the bech32-encoded address that is proposed to
+	// take over the auction and is yet to accept the ownership
+	PendingOwner string `protobuf:"bytes,21,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// pending_beneficiary specifies the bech32-encoded beneficiary that is set
+	// when the pending owner accepts the ownership
+	PendingBeneficiary string `protobuf:"bytes,22,opt,name=pending_beneficiary,json=pendingBeneficiary,proto3" json:"pending_beneficiary,omitempty"`
+	// additional_paying_denoms specifies the denoms that bidders can pay with
+	// besides the paying coin denom; the bids in them are matched in the paying
+	// coin denom by their conversion ratios
+	AdditionalPayingDenoms []PayingDenom `protobuf:"bytes,23,rep,name=additional_paying_denoms,json=additionalPayingDenoms,proto3" json:"additional_paying_denoms"`
 }
 
 func (m *BaseAuction) Reset()         { *m = BaseAuction{} }
@@ -179,6 +328,56 @@ func (m *BaseAuction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BaseAuction proto.InternalMessageInfo
 
+// PayingDenom defines a denom that an auction accepts in addition to its
+// paying coin denom.
+type PayingDenom struct {
+	// denom specifies the denom of the paying coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ratio specifies the amount of the paying coin denom that one unit of the
+	// denom is worth
+	Ratio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *PayingDenom) Reset()         { *m = PayingDenom{} }
+func (m *PayingDenom) String() string { return proto.CompactTextString(m) }
+func (*PayingDenom) ProtoMessage()    {}
+func (*PayingDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{1}
+}
+func (m *PayingDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayingDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayingDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayingDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayingDenom.Merge(m, src)
+}
+func (m *PayingDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayingDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayingDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayingDenom proto.InternalMessageInfo
+
+func (m *PayingDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // FixedPriceAuction defines the fixed price auction type. It is the most
 // simpliest way to raise funds. An auctioneer sets the starting price for each
 // selling amounts of coin and bidders bid to purchase based on the fixed price.
@@ -186,13 +385,15 @@ type FixedPriceAuction struct {
 	*BaseAuction `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction,omitempty"`
 	// remaining_coin specifies the remaining amount of selling coin to sell
 	RemainingSellingCoin types.Coin `protobuf:"bytes,2,opt,name=remaining_selling_coin,json=remainingSellingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"remaining_selling_coin"`
+	// sale_mode specifies how the selling coin is allocated to the bidders
+	SaleMode SaleMode `protobuf:"varint,3,opt,name=sale_mode,json=saleMode,proto3,enum=fundraising.fundraising.v1.SaleMode" json:"sale_mode,omitempty"`
 }
 
 func (m *FixedPriceAuction) Reset()         { *m = FixedPriceAuction{} }
 func (m *FixedPriceAuction) String() string { return proto.CompactTextString(m) }
 func (*FixedPriceAuction) ProtoMessage()    {}
 func (*FixedPriceAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_807e2fa5734d5f53, []int{1}
+	return fileDescriptor_807e2fa5734d5f53, []int{2}
 }
 func (m *FixedPriceAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,13 +439,24 @@ type BatchAuction struct {
 	// extended_round_rate specifies the rate that decides if the auction needs
 	// another round
 	ExtendedRoundRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=extended_round_rate,json=extendedRoundRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"extended_round_rate"`
+	// bid_cancel_cutoff specifies the period before the end time in which
+	// bidders are not allowed to cancel their bids
+	BidCancelCutoff time.Duration `protobuf:"bytes,6,opt,name=bid_cancel_cutoff,json=bidCancelCutoff,proto3,stdduration" json:"bid_cancel_cutoff"`
+	// marginal_fill_mode specifies how the bids at the matched price are filled
+	// when their demand exceeds the remaining selling coin
+	MarginalFillMode MarginalFillMode `protobuf:"varint,7,opt,name=marginal_fill_mode,json=marginalFillMode,proto3,enum=fundraising.fundraising.v1.MarginalFillMode" json:"marginal_fill_mode,omitempty"`
+	// pricing_mode specifies the price that the matched bids pay
+	PricingMode PricingMode `protobuf:"varint,8,opt,name=pricing_mode,json=pricingMode,proto3,enum=fundraising.fundraising.v1.PricingMode" json:"pricing_mode,omitempty"`
+	// sealed_bid_config makes the auction accept only sealed bids that are
+	// revealed after the end time; the bids are public if it is not set
+	SealedBidConfig *SealedBidConfig `protobuf:"bytes,9,opt,name=sealed_bid_config,json=sealedBidConfig,proto3" json:"sealed_bid_config,omitempty"`
 }
 
 func (m *BatchAuction) Reset()         { *m = BatchAuction{} }
 func (m *BatchAuction) String() string { return proto.CompactTextString(m) }
 func (*BatchAuction) ProtoMessage()    {}
 func (*BatchAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_807e2fa5734d5f53, []int{2}
+	return fileDescriptor_807e2fa5734d5f53, []int{3}
 }
 func (m *BatchAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,6 +485,113 @@ func (m *BatchAuction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BatchAuction proto.InternalMessageInfo
 
+// SealedBidConfig defines the reveal phase of a sealed-bid batch auction.
+type SealedBidConfig struct {
+	// reveal_period specifies the duration after the end time during which the
+	// committed bids can be revealed
+	RevealPeriod time.Duration `protobuf:"bytes,1,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period"`
+	// forfeit_unrevealed specifies whether the collateral of the commitments that
+	// are not revealed goes to the beneficiary instead of being refunded
+	ForfeitUnrevealed bool `protobuf:"varint,2,opt,name=forfeit_unrevealed,json=forfeitUnrevealed,proto3" json:"forfeit_unrevealed,omitempty"`
+}
+
+func (m *SealedBidConfig) Reset()         { *m = SealedBidConfig{} }
+func (m *SealedBidConfig) String() string { return proto.CompactTextString(m) }
+func (*SealedBidConfig) ProtoMessage()    {}
+func (*SealedBidConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{4}
+}
+func (m *SealedBidConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidConfig.Merge(m, src)
+}
+func (m *SealedBidConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidConfig proto.InternalMessageInfo
+
+func (m *SealedBidConfig) GetRevealPeriod() time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *SealedBidConfig) GetForfeitUnrevealed() bool {
+	if m != nil {
+		return m.ForfeitUnrevealed
+	}
+	return false
+}
+
+// DutchAuction defines a descending price auction type. The price starts at
+// the start price and decays towards the floor price over the auction period.
+// A bid is filled instantly at the current price as long as the bidder is
+// willing to pay it and there is remaining selling coin to sell.
+type DutchAuction struct {
+	*BaseAuction `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction,omitempty"`
+	// floor_price specifies the lowest price that the auction price decays to
+	FloorPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=floor_price,json=floorPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"floor_price"`
+	// decay_interval specifies the interval between price drops.
+	// The price decays linearly when it is zero; otherwise, it drops stepwise
+	// at every interval.
+	DecayInterval time.Duration `protobuf:"bytes,3,opt,name=decay_interval,json=decayInterval,proto3,stdduration" json:"decay_interval"`
+	// remaining_selling_coin specifies the remaining amount of selling coin to
+	// sell
+	RemainingSellingCoin types.Coin `protobuf:"bytes,4,opt,name=remaining_selling_coin,json=remainingSellingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"remaining_selling_coin"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{5}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
 // VestingSchedule defines the vesting schedule for the owner of an auction.
 type VestingSchedule struct {
 	// release_time specifies the time for distribution of the vesting coin
@@ -285,7 +604,7 @@ func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
 func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
 func (*VestingSchedule) ProtoMessage()    {}
 func (*VestingSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_807e2fa5734d5f53, []int{3}
+	return fileDescriptor_807e2fa5734d5f53, []int{6}
 }
 func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,13 +640,87 @@ func (m *VestingSchedule) GetReleaseTime() time.Time {
 	return time.Time{}
 }
 
+// LinearVesting defines the continuous vesting of the paying coin. The paying
+// coin vests linearly from start_time to end_time and nothing is released
+// before cliff_time.
+type LinearVesting struct {
+	// start_time specifies the time at which the paying coin starts vesting
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time specifies the time at which all the paying coin is vested
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cliff_time specifies the time before which nothing is released; it is
+	// optional
+	CliffTime *time.Time `protobuf:"bytes,3,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+}
+
+func (m *LinearVesting) Reset()         { *m = LinearVesting{} }
+func (m *LinearVesting) String() string { return proto.CompactTextString(m) }
+func (*LinearVesting) ProtoMessage()    {}
+func (*LinearVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_807e2fa5734d5f53, []int{7}
+}
+func (m *LinearVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinearVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinearVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinearVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinearVesting.Merge(m, src)
+}
+func (m *LinearVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinearVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinearVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinearVesting proto.InternalMessageInfo
+
+func (m *LinearVesting) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *LinearVesting) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *LinearVesting) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("fundraising.fundraising.v1.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("fundraising.fundraising.v1.MarginalFillMode", MarginalFillMode_name, MarginalFillMode_value)
+	proto.RegisterEnum("fundraising.fundraising.v1.PricingMode", PricingMode_name, PricingMode_value)
+	proto.RegisterEnum("fundraising.fundraising.v1.SaleMode", SaleMode_name, SaleMode_value)
 	proto.RegisterEnum("fundraising.fundraising.v1.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
 	proto.RegisterType((*BaseAuction)(nil), "fundraising.fundraising.v1.BaseAuction")
+	proto.RegisterType((*PayingDenom)(nil), "fundraising.fundraising.v1.PayingDenom")
 	proto.RegisterType((*FixedPriceAuction)(nil), "fundraising.fundraising.v1.FixedPriceAuction")
 	proto.RegisterType((*BatchAuction)(nil), "fundraising.fundraising.v1.BatchAuction")
+	proto.RegisterType((*SealedBidConfig)(nil), "fundraising.fundraising.v1.SealedBidConfig")
+	proto.RegisterType((*DutchAuction)(nil), "fundraising.fundraising.v1.DutchAuction")
 	proto.RegisterType((*VestingSchedule)(nil), "fundraising.fundraising.v1.VestingSchedule")
+	proto.RegisterType((*LinearVesting)(nil), "fundraising.fundraising.v1.LinearVesting")
 }
 
 func init() {
@@ -335,76 +728,129 @@ func init() {
 }
 
 var fileDescriptor_807e2fa5734d5f53 = []byte{
-	// 1093 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x16, 0x65, 0xc5, 0xb5, 0x8f, 0xb2, 0x43, 0xb3, 0x8e, 0x4b, 0xb3, 0x80, 0x24, 0x78, 0x89,
-	0xea, 0xd6, 0x64, 0xed, 0xba, 0x3f, 0x90, 0x4e, 0xa2, 0x44, 0x27, 0x02, 0x0c, 0xc5, 0xa0, 0x64,
-	0xa3, 0xe9, 0x50, 0xe2, 0x44, 0x5e, 0xa4, 0x43, 0x44, 0x52, 0xe0, 0x51, 0xaa, 0xbd, 0x15, 0xe8,
-	0x12, 0x78, 0xca, 0x5c, 0xc0, 0x40, 0x81, 0xa2, 0x45, 0x51, 0x74, 0xc8, 0x90, 0x3f, 0x22, 0xe8,
-	0x64, 0x74, 0x2a, 0x3a, 0xd8, 0x85, 0x3d, 0x64, 0xeb, 0xdf, 0x50, 0xdc, 0x0f, 0xd5, 0x94, 0xa0,
-	0xa6, 0x89, 0x81, 0x2c, 0x36, 0x79, 0xef, 0x7d, 0xdf, 0xbd, 0xfb, 0xde, 0xf7, 0x8e, 0x02, 0xe5,
-	0x87, 0x83, 0xd0, 0x8f, 0x21, 0x26, 0x38, 0xec, 0x98, 0xe9, 0xe7, 0xe1, 0xa6, 0x09, 0x07, 0x5e,
-	0x82, 0xa3, 0xd0, 0xe8, 0xc7, 0x51, 0x12, 0xa9, 0x7a, 0x2a, 0x6a, 0xa4, 0x9f, 0x87, 0x9b, 0xfa,
-	0x12, 0x0c, 0x70, 0x18, 0x99, 0xec, 0x2f, 0x4f, 0xd7, 0x0b, 0x5e, 0x44, 0x82, 0x88, 0x98, 0x6d,
-	0x48, 0x90, 0x39, 0xdc, 0x6c, 0xa3, 0x04, 0x6e, 0x9a, 0x5e, 0x84, 0x05, 0x9d, 0xbe, 0xca, 0xe3,
-	0x2e, 0x7b, 0x33, 0xf9, 0x8b, 0x08, 0x2d, 0x77, 0xa2, 0x4e, 0xc4, 0xd7, 0xe9, 0x93, 0x58, 0x2d,
-	0x76, 0xa2, 0xa8, 0xd3, 0x43, 0x26, 0x7b, 0x6b, 0x0f, 0x1e, 0x9a, 0x09, 0x0e, 0x10, 0x49, 0x60,
-	0xd0, 0xe7, 0x09, 0x6b, 0x97, 0xb3, 0x40, 0xb6, 0x20, 0x41, 0x15, 0x5e, 0xb6, 0xba, 0x08, 0xb2,
-	0xd8, 0xd7, 0xa4, 0x92, 0x54, 0xce, 0x39, 0x59, 0xec, 0xab, 0x9f, 0x83, 0x5c, 0x72, 0xd4, 0x47,
-	0x5a, 0xb6, 0x24, 0x95, 0x17, 0xb7, 0x6e, 0x1b, 0xff, 0x7d, 0x1e, 0x43, 0x50, 0xb4, 0x8e, 0xfa,
-	0xc8, 0x61, 0x20, 0xb5, 0x00, 0x80, 0x90, 0x03, 0xa1, 0x58, 0x9b, 0x29, 0x49, 0xe5, 0x79, 0x27,
-	0xb5, 0xa2, 0x7e, 0x02, 0xde, 0x21, 0xa8, 0xd7, 0xc3, 0x61, 0xc7, 0x8d, 0x11, 0x41, 0xf1, 0x10,
-	0xb9, 0xd0, 0xf7, 0x63, 0x44, 0x88, 0x96, 0x63, 0xc9, 0xb7, 0x44, 0xd8, 0xe1, 0xd1, 0x0a, 0x0f,
-	0xaa, 0xdb, 0x60, 0xa5, 0x0f, 0x8f, 0xa6, 0xc1, 0x6e, 0x30, 0xd8, 0x32, 0x8f, 0x4e, 0xa0, 0x1c,
-	0x20, 0x93, 0x04, 0xc6, 0x89, 0xdb, 0x8f, 0xb1, 0x87, 0xb4, 0x59, 0x9a, 0x6a, 0x6d, 0x3e, 0x3f,
-	0x2b, 0x66, 0xfe, 0x3c, 0x2b, 0xbe, 0xcb, 0xc5, 0x24, 0xfe, 0x23, 0x03, 0x47, 0x66, 0x00, 0x93,
-	0xae, 0xb1, 0x8b, 0x3a, 0xd0, 0x3b, 0xaa, 0x21, 0xef, 0xf7, 0x67, 0x1b, 0x40, 0x68, 0x5d, 0x43,
-	0x9e, 0x03, 0x18, 0xcb, 0x1e, 0x25, 0x51, 0xbf, 0x95, 0x40, 0x7e, 0x74, 0x04, 0xda, 0x27, 0xed,
-	0xad, 0x92, 0x54, 0x96, 0xb7, 0x56, 0x0d, 0x91, 0x4f, 0x1b, 0x69, 0x88, 0x46, 0x1a, 0xd5, 0x08,
-	0x87, 0x96, 0x4d, 0x37, 0xfc, 0xe5, 0xbc, 0x78, 0xbb, 0x83, 0x93, 0xee, 0xa0, 0x6d, 0x78, 0x51,
-	0x20, 0x1a, 0x29, 0xfe, 0x6d, 0x10, 0xff, 0x91, 0x49, 0xe5, 0x23, 0x0c, 0xf0, 0xdd, 0x8b, 0xa7,
-	0xeb, 0xf9, 0x1e, 0x2b, 0x85, 0xed, 0x40, 0x7e, 0x7e, 0xf1, 0x74, 0x5d, 0x72, 0x64, 0xb1, 0x2b,
-	0x4d, 0x51, 0xd7, 0xc1, 0x92, 0xd0, 0x83, 0x66, 0xb8, 0x3e, 0x0a, 0xa3, 0x40, 0x9b, 0x63, 0x52,
-	0xdc, 0xe4, 0x01, 0x9a, 0x56, 0xa3, 0xcb, 0x54, 0xf3, 0x21, 0x22, 0xc9, 0x34, 0xf1, 0xe6, 0xb9,
-	0xe6, 0x22, 0x3c, 0xa1, 0xde, 0x57, 0x60, 0x69, 0x84, 0x23, 0x5e, 0x17, 0xf9, 0x83, 0x1e, 0x22,
-	0x1a, 0x28, 0xcd, 0x94, 0xe5, 0xad, 0xf7, 0x5f, 0xe6, 0x8a, 0x03, 0x0e, 0x6a, 0x0a, 0x8c, 0x95,
-	0xa3, 0xe7, 0x77, 0x94, 0xe1, 0xf8, 0x32, 0x51, 0xab, 0x80, 0xeb, 0xea, 0x52, 0x87, 0x6a, 0x32,
-	0x93, 0x51, 0x37, 0xb8, 0x7d, 0x8d, 0x91, 0x7d, 0x8d, 0xd6, 0xc8, 0xbe, 0xd6, 0x1c, 0xe5, 0x79,
-	0x72, 0x5e, 0x94, 0x9c, 0x79, 0x86, 0xa3, 0x11, 0xb5, 0x02, 0xe6, 0x51, 0xe8, 0x33, 0x0a, 0xa2,
-	0xe5, 0x59, 0x71, 0xaf, 0xc6, 0x31, 0x87, 0x42, 0x9f, 0xad, 0xab, 0x15, 0x30, 0x4b, 0x12, 0x98,
-	0x0c, 0x88, 0xb6, 0xc0, 0x2c, 0xff, 0xde, 0x2b, 0x58, 0xbe, 0xc9, 0x00, 0x8e, 0x00, 0xde, 0x51,
-	0x1e, 0x7f, 0x5f, 0xcc, 0xfc, 0xf6, 0x6c, 0x63, 0x4e, 0x84, 0xeb, 0x6b, 0x7f, 0x4b, 0x60, 0x69,
-	0x07, 0x1f, 0x22, 0x9f, 0xb9, 0x66, 0x34, 0x6b, 0x7b, 0x20, 0x4f, 0xfd, 0xe1, 0x8a, 0x89, 0x60,
-	0x53, 0x27, 0xbf, 0x7c, 0xc6, 0x52, 0xa3, 0x6a, 0xe5, 0x4e, 0xcf, 0x8a, 0x92, 0x23, 0xb7, 0x53,
-	0xd3, 0xfb, 0x8d, 0x04, 0x56, 0x62, 0x14, 0x40, 0x1c, 0xb2, 0x3e, 0xa5, 0x8d, 0x99, 0xfd, 0x3f,
-	0x63, 0x9a, 0xaf, 0x69, 0x4c, 0x67, 0xf9, 0xdf, 0x9d, 0x9a, 0x57, 0x5e, 0xbc, 0x93, 0xa3, 0x87,
-	0x5f, 0xfb, 0x69, 0x06, 0xe4, 0x2d, 0x98, 0x78, 0xdd, 0x37, 0x77, 0xd6, 0x7d, 0xb0, 0x10, 0xe0,
-	0xd0, 0x6d, 0x63, 0x5f, 0x0c, 0x74, 0xf6, 0xba, 0x03, 0x2d, 0x07, 0x38, 0xb4, 0x30, 0xef, 0x8d,
-	0x7a, 0x00, 0x16, 0x02, 0x5a, 0x38, 0x1a, 0xd1, 0xce, 0x5c, 0x97, 0x36, 0x2f, 0x78, 0x38, 0xef,
-	0x07, 0x40, 0x0d, 0xe0, 0xa1, 0x8b, 0x0e, 0x13, 0x14, 0xfa, 0xc8, 0x77, 0xe3, 0x68, 0x10, 0xfa,
-	0xec, 0x9a, 0x5b, 0x70, 0x94, 0x00, 0x1e, 0xda, 0x22, 0xe0, 0xd0, 0x75, 0x15, 0x82, 0xb7, 0xc7,
-	0x33, 0xdd, 0x18, 0x26, 0x88, 0x5f, 0x6f, 0xd7, 0xa9, 0x65, 0x09, 0xa5, 0xe9, 0x1d, 0x98, 0x20,
-	0xd1, 0xa8, 0x1f, 0x25, 0x70, 0x73, 0x62, 0x44, 0xd5, 0xbb, 0x20, 0x1f, 0xa3, 0x1e, 0xa2, 0xed,
-	0x62, 0xc3, 0x28, 0xbd, 0xc6, 0x30, 0xca, 0x02, 0xc9, 0xc6, 0xb1, 0x0e, 0x66, 0xbf, 0x46, 0xb8,
-	0xd3, 0x4d, 0xae, 0xdf, 0x1b, 0x41, 0xb0, 0xfe, 0xab, 0x04, 0xe4, 0xd4, 0x07, 0x46, 0xfd, 0x10,
-	0x68, 0x95, 0xfd, 0x6a, 0xab, 0x7e, 0xbf, 0xe1, 0xb6, 0x1e, 0xec, 0xd9, 0xee, 0x7e, 0xa3, 0xb9,
-	0x67, 0x57, 0xeb, 0x3b, 0x75, 0xbb, 0xa6, 0x64, 0x74, 0xf5, 0xf8, 0xa4, 0xb4, 0x98, 0x4a, 0x6f,
-	0xe0, 0x9e, 0xfa, 0xe9, 0x04, 0x62, 0xa7, 0xfe, 0x85, 0x5d, 0x73, 0xf7, 0x9c, 0x7a, 0xd5, 0x56,
-	0x24, 0x7d, 0xf5, 0xf8, 0xa4, 0x74, 0x2b, 0x85, 0xb8, 0x9a, 0x56, 0xda, 0xb9, 0x31, 0xa0, 0x55,
-	0x69, 0x55, 0xef, 0x29, 0x59, 0x7d, 0xf9, 0xf8, 0xa4, 0xa4, 0xa4, 0x20, 0xcc, 0xef, 0x7a, 0xee,
-	0xf1, 0x0f, 0x85, 0xcc, 0xfa, 0x79, 0x16, 0x2c, 0x8c, 0x5d, 0x0e, 0xea, 0x36, 0xd0, 0x47, 0x2c,
-	0xcd, 0x56, 0xa5, 0xb5, 0xdf, 0x9c, 0x28, 0x39, 0xcd, 0xc6, 0x21, 0xb4, 0xe8, 0x6d, 0xb0, 0x32,
-	0x81, 0x6a, 0xb6, 0x2a, 0x8d, 0x9a, 0xf5, 0x40, 0x91, 0x74, 0xed, 0xf8, 0xa4, 0xb4, 0x3c, 0x86,
-	0x68, 0x26, 0x30, 0xf4, 0xad, 0xa3, 0xe9, 0x28, 0xa7, 0x65, 0xd7, 0x94, 0xec, 0x74, 0x54, 0x9c,
-	0x20, 0x7f, 0x0a, 0xea, 0xc0, 0x6e, 0xb6, 0xea, 0x8d, 0xbb, 0xca, 0xcc, 0x14, 0x94, 0x30, 0x0d,
-	0xfd, 0x9e, 0x4c, 0xa0, 0x76, 0xea, 0x8d, 0x7a, 0xf3, 0x9e, 0x5d, 0x53, 0x72, 0x63, 0xaa, 0x72,
-	0xd8, 0x0e, 0x0e, 0x31, 0xe9, 0x22, 0x5f, 0xfd, 0xec, 0xaa, 0x1d, 0x02, 0x57, 0xad, 0x34, 0xaa,
-	0xf6, 0xee, 0xae, 0x5d, 0x53, 0x6e, 0xe8, 0xfa, 0xf1, 0x49, 0x69, 0x65, 0x0c, 0x58, 0x85, 0xa1,
-	0x87, 0x7a, 0x3d, 0xe4, 0x73, 0x85, 0xad, 0xfb, 0xcf, 0x2f, 0x0a, 0xd2, 0xe9, 0x45, 0x41, 0xfa,
-	0xeb, 0xa2, 0x20, 0x3d, 0xb9, 0x2c, 0x64, 0x4e, 0x2f, 0x0b, 0x99, 0x3f, 0x2e, 0x0b, 0x99, 0x2f,
-	0x3f, 0x4e, 0x5d, 0x60, 0xcc, 0xf4, 0x71, 0x80, 0xc3, 0x64, 0xec, 0x77, 0xda, 0xe1, 0xd8, 0x1b,
-	0xbb, 0xd3, 0xda, 0xb3, 0xcc, 0xd7, 0x1f, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x67, 0xe6, 0x53,
-	0x57, 0xdd, 0x09, 0x00, 0x00,
+	// 1937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x44, 0x0f, 0x49, 0x89, 0x1c, 0xeb, 0x63, 0x4d, 0xa3, 0x14, 0xab, 0x16,
+	0x88, 0xaa, 0xc4, 0x64, 0xa4, 0xba, 0x69, 0x90, 0x16, 0x2d, 0x96, 0x5f, 0xf2, 0x06, 0x14, 0x49,
+	0x2c, 0x29, 0xa5, 0xc9, 0xa1, 0x8b, 0xe1, 0xee, 0x90, 0x1a, 0x64, 0x3f, 0x88, 0xdd, 0xa5, 0x2c,
+	0xf5, 0xd4, 0xa2, 0x97, 0x84, 0xa7, 0x1c, 0x8b, 0x02, 0x04, 0x0a, 0x14, 0x05, 0x8a, 0xf6, 0x92,
+	0x02, 0xf9, 0x23, 0x82, 0x1e, 0x0a, 0xa3, 0xa7, 0xc2, 0x07, 0xa7, 0xb0, 0x0f, 0xf9, 0x17, 0x7a,
+	0x2c, 0xe6, 0x83, 0xd6, 0x72, 0xcd, 0x48, 0xb2, 0x8a, 0xf4, 0x62, 0xef, 0xcc, 0x7b, 0xbf, 0x37,
+	0x33, 0xef, 0xfd, 0xde, 0x07, 0x05, 0x76, 0xfb, 0x23, 0xc7, 0xf4, 0x10, 0xf1, 0x89, 0x33, 0x28,
+	0x85, 0xbf, 0xcf, 0xf6, 0x4b, 0x68, 0x64, 0x04, 0xc4, 0x75, 0x8a, 0x43, 0xcf, 0x0d, 0x5c, 0x98,
+	0x0b, 0x49, 0x8b, 0xe1, 0xef, 0xb3, 0xfd, 0x5c, 0x16, 0xd9, 0xc4, 0x71, 0x4b, 0xec, 0x5f, 0xae,
+	0x9e, 0xcb, 0x1b, 0xae, 0x6f, 0xbb, 0x7e, 0xa9, 0x87, 0x7c, 0x5c, 0x3a, 0xdb, 0xef, 0xe1, 0x00,
+	0xed, 0x97, 0x0c, 0x97, 0x08, 0x73, 0xb9, 0x7b, 0x5c, 0xae, 0xb3, 0x55, 0x89, 0x2f, 0x84, 0x68,
+	0x7d, 0xe0, 0x0e, 0x5c, 0xbe, 0x4f, 0xbf, 0xa6, 0x06, 0x07, 0xae, 0x3b, 0xb0, 0x70, 0x89, 0xad,
+	0x7a, 0xa3, 0x7e, 0xc9, 0x1c, 0x79, 0xe8, 0xf2, 0x7e, 0xb9, 0xed, 0xa8, 0x3c, 0x20, 0x36, 0xf6,
+	0x03, 0x64, 0x0f, 0xb9, 0xc2, 0xce, 0x5f, 0x53, 0x20, 0x59, 0x46, 0x3e, 0x56, 0xf8, 0xb3, 0xe0,
+	0x2a, 0x58, 0x24, 0xa6, 0x2c, 0x15, 0xa4, 0xdd, 0xb8, 0xb6, 0x48, 0x4c, 0xf8, 0x13, 0x10, 0x0f,
+	0x2e, 0x86, 0x58, 0x5e, 0x2c, 0x48, 0xbb, 0xab, 0x07, 0x6f, 0x14, 0xbf, 0xf9, 0xbd, 0x45, 0x61,
+	0xa2, 0x7b, 0x31, 0xc4, 0x1a, 0x03, 0xc1, 0x3c, 0x00, 0xc2, 0x5d, 0x18, 0x7b, 0x72, 0xac, 0x20,
+	0xed, 0xde, 0xd1, 0x42, 0x3b, 0xf0, 0x1d, 0xb0, 0xe5, 0x63, 0xcb, 0x22, 0xce, 0x40, 0xf7, 0xb0,
+	0x8f, 0xbd, 0x33, 0xac, 0x23, 0xd3, 0xf4, 0xb0, 0xef, 0xcb, 0x71, 0xa6, 0xbc, 0x21, 0xc4, 0x1a,
+	0x97, 0x2a, 0x5c, 0x08, 0x1f, 0x82, 0xcd, 0x21, 0xba, 0x98, 0x07, 0x5b, 0x62, 0xb0, 0x75, 0x2e,
+	0x8d, 0xa0, 0x34, 0x90, 0xf4, 0x03, 0xe4, 0x05, 0xfa, 0xd0, 0x23, 0x06, 0x96, 0x97, 0xa9, 0x6a,
+	0x79, 0xff, 0xcb, 0x67, 0xdb, 0x0b, 0x4f, 0x9f, 0x6d, 0xdf, 0xe7, 0xce, 0xf6, 0xcd, 0x8f, 0x8b,
+	0xc4, 0x2d, 0xd9, 0x28, 0x38, 0x2d, 0x36, 0xf0, 0x00, 0x19, 0x17, 0x55, 0x6c, 0xfc, 0xf3, 0x8b,
+	0x07, 0x40, 0xc4, 0xa2, 0x8a, 0x0d, 0x0d, 0x30, 0x2b, 0x6d, 0x6a, 0x04, 0xfe, 0x56, 0x02, 0xa9,
+	0xe9, 0x13, 0x68, 0x1c, 0xe5, 0x95, 0x82, 0xb4, 0x9b, 0x3c, 0xb8, 0x57, 0x14, 0xfa, 0x34, 0xd0,
+	0x45, 0x11, 0xe8, 0x62, 0xc5, 0x25, 0x4e, 0xb9, 0x46, 0x0f, 0xfc, 0xcb, 0x57, 0xdb, 0x6f, 0x0c,
+	0x48, 0x70, 0x3a, 0xea, 0x15, 0x0d, 0xd7, 0x16, 0x81, 0x16, 0xff, 0x3d, 0xf0, 0xcd, 0x8f, 0x4b,
+	0xd4, 0x7d, 0x3e, 0x03, 0xfc, 0xfe, 0xeb, 0xcf, 0xf7, 0x52, 0x16, 0xbb, 0x0a, 0x3b, 0xc1, 0xff,
+	0xf3, 0xd7, 0x9f, 0xef, 0x49, 0x5a, 0x52, 0x9c, 0x4a, 0x55, 0xe0, 0x1e, 0xc8, 0x0a, 0x7f, 0x50,
+	0x0d, 0xdd, 0xc4, 0x8e, 0x6b, 0xcb, 0x09, 0xe6, 0x8a, 0x35, 0x2e, 0xa0, 0x6a, 0x55, 0xba, 0x4d,
+	0x7d, 0x7e, 0x86, 0xfd, 0x60, 0x9e, 0xf3, 0xee, 0x70, 0x9f, 0x0b, 0x71, 0xc4, 0x7b, 0xbf, 0x04,
+	0xd9, 0x29, 0xce, 0x37, 0x4e, 0xb1, 0x39, 0xb2, 0xb0, 0x2f, 0x83, 0x42, 0x6c, 0x37, 0x79, 0xf0,
+	0xe6, 0x55, 0xac, 0x38, 0xe1, 0xa0, 0x8e, 0xc0, 0x94, 0xe3, 0xf4, 0xfd, 0x5a, 0xe6, 0x6c, 0x76,
+	0xdb, 0x87, 0x15, 0xc0, 0xfd, 0xaa, 0x53, 0x86, 0xca, 0x49, 0xe6, 0xc6, 0x5c, 0x91, 0xd3, 0xb7,
+	0x38, 0xa5, 0x6f, 0xb1, 0x3b, 0xa5, 0x6f, 0x39, 0x41, 0xed, 0x7c, 0xf6, 0xd5, 0xb6, 0xa4, 0xdd,
+	0x61, 0x38, 0x2a, 0x81, 0x0a, 0xb8, 0x83, 0x1d, 0x93, 0x99, 0xf0, 0xe5, 0x54, 0x21, 0x76, 0x63,
+	0x1b, 0x09, 0xec, 0x98, 0x6c, 0x1f, 0x2a, 0x60, 0xd9, 0x0f, 0x50, 0x30, 0xf2, 0xe5, 0x34, 0xa3,
+	0xfc, 0x0f, 0x6e, 0x40, 0xf9, 0x0e, 0x03, 0x68, 0x02, 0x08, 0x15, 0x90, 0x1c, 0xa2, 0x91, 0x8f,
+	0xf9, 0x45, 0xe4, 0xd5, 0x6b, 0xdf, 0x12, 0x67, 0x77, 0x00, 0x1c, 0xc4, 0x1e, 0xf2, 0x2b, 0xb0,
+	0xd2, 0x23, 0xa6, 0xde, 0xc7, 0x58, 0x5e, 0x2b, 0xc4, 0xae, 0x66, 0x54, 0x5d, 0x30, 0x6a, 0xf7,
+	0x86, 0x8c, 0xf2, 0xbf, 0x81, 0x52, 0xcb, 0x3d, 0x62, 0xd6, 0x31, 0x86, 0x75, 0x90, 0xf0, 0xdd,
+	0x7e, 0xa0, 0x1b, 0x68, 0x28, 0x67, 0x58, 0x92, 0xbc, 0x29, 0x92, 0x64, 0xe3, 0xd5, 0x24, 0x51,
+	0x9d, 0x20, 0x94, 0x1e, 0xaa, 0x13, 0x68, 0x2b, 0x14, 0x5c, 0x41, 0x43, 0x48, 0xc0, 0x56, 0x6f,
+	0x74, 0x81, 0x3d, 0xfd, 0x55, 0xde, 0x64, 0x6f, 0xcb, 0x9b, 0x0d, 0x66, 0xf1, 0x24, 0x4a, 0x9e,
+	0x36, 0x58, 0xb5, 0x88, 0x83, 0xd1, 0xcb, 0xb3, 0x64, 0xc8, 0x9c, 0x7e, 0x65, 0xf0, 0x1a, 0x0c,
+	0x21, 0x6c, 0x69, 0x69, 0x2b, 0xbc, 0x84, 0xdf, 0x05, 0x29, 0x34, 0x0a, 0x5c, 0xdd, 0xc3, 0x16,
+	0x46, 0x3e, 0x96, 0xef, 0x16, 0xa4, 0xdd, 0x84, 0x96, 0xa4, 0x7b, 0x1a, 0xdf, 0x82, 0x05, 0x90,
+	0xec, 0x61, 0x07, 0xf7, 0x89, 0x41, 0x90, 0x77, 0x21, 0xaf, 0xb3, 0xec, 0x09, 0x6f, 0xc1, 0xef,
+	0x81, 0xf4, 0x10, 0x3b, 0x26, 0x7d, 0xbb, 0xfb, 0xd8, 0xc1, 0x9e, 0xbc, 0xc1, 0x74, 0x52, 0x62,
+	0xb3, 0x45, 0xf7, 0x60, 0x09, 0xdc, 0x9d, 0x2a, 0x85, 0xcd, 0x6d, 0x32, 0x55, 0x28, 0x44, 0xe5,
+	0x90, 0xd5, 0x01, 0x90, 0x91, 0x69, 0x12, 0x4a, 0x3c, 0x64, 0xe9, 0x22, 0xf1, 0x59, 0xce, 0xfb,
+	0xf2, 0x16, 0x73, 0xec, 0x95, 0x65, 0xba, 0xcd, 0x00, 0xac, 0x18, 0x08, 0xa7, 0x6e, 0x5e, 0x9a,
+	0x0b, 0x09, 0xfd, 0xf7, 0x32, 0x9f, 0xfc, 0x61, 0x7b, 0xe1, 0xef, 0x5f, 0x3c, 0x48, 0x08, 0x9a,
+	0xab, 0x3b, 0x16, 0x48, 0x86, 0x34, 0xe0, 0x3a, 0x58, 0xe2, 0xb5, 0x46, 0x62, 0x97, 0xe5, 0x0b,
+	0x78, 0x08, 0x96, 0x58, 0x0f, 0x92, 0x17, 0x6f, 0x5b, 0x61, 0x39, 0x7e, 0xe7, 0x6f, 0x8b, 0x20,
+	0x5b, 0x27, 0xe7, 0xd8, 0x64, 0xb5, 0x76, 0xda, 0xa1, 0xda, 0x20, 0x45, 0x73, 0x40, 0x17, 0x7d,
+	0x84, 0x9d, 0x7d, 0xcd, 0x93, 0x43, 0x0d, 0xae, 0x1c, 0x7f, 0xf2, 0x6c, 0x5b, 0xd2, 0x92, 0xbd,
+	0xcb, 0x2d, 0xf8, 0x6b, 0x09, 0x6c, 0x7a, 0xd8, 0x46, 0xc4, 0x61, 0x2c, 0x0d, 0x97, 0xf3, 0xc5,
+	0xeb, 0xca, 0x79, 0xe9, 0x35, 0xcb, 0xb9, 0xb6, 0xfe, 0xf2, 0xa4, 0x4e, 0xa8, 0x82, 0x2b, 0xe0,
+	0x8e, 0x8f, 0x2c, 0xac, 0xdb, 0xae, 0x89, 0x59, 0xa3, 0x5c, 0x3d, 0xf8, 0xfe, 0x55, 0x2f, 0xea,
+	0x20, 0x0b, 0x1f, 0xb9, 0x26, 0xd6, 0x12, 0xbe, 0xf8, 0x7a, 0x2f, 0x4e, 0xa3, 0xb5, 0xf3, 0x9f,
+	0x25, 0x90, 0x2a, 0xa3, 0xc0, 0x38, 0xfd, 0xf6, 0xdc, 0x75, 0x0c, 0xd2, 0x36, 0x71, 0x74, 0x5a,
+	0x9f, 0x78, 0x27, 0xbd, 0x75, 0x9c, 0x93, 0x36, 0x71, 0xca, 0x84, 0x87, 0x17, 0x9e, 0x80, 0xb4,
+	0x4d, 0x2f, 0x8e, 0xa7, 0x66, 0x63, 0xb7, 0x35, 0x9b, 0x12, 0x76, 0xb8, 0xdd, 0xb7, 0x00, 0xb4,
+	0xd1, 0xb9, 0x8e, 0xcf, 0x03, 0xec, 0x98, 0xd8, 0xd4, 0x3d, 0x77, 0xe4, 0x98, 0x6c, 0xbe, 0x48,
+	0x6b, 0x19, 0x1b, 0x9d, 0xd7, 0x84, 0x40, 0xa3, 0xfb, 0x10, 0x81, 0xbb, 0xb3, 0x9a, 0xba, 0x87,
+	0x02, 0x2c, 0x2f, 0xdd, 0xf6, 0x2e, 0x59, 0x1c, 0x36, 0xaf, 0xa1, 0x00, 0xc3, 0x16, 0xc8, 0x52,
+	0xdf, 0x19, 0xc8, 0x31, 0xb0, 0xa5, 0x1b, 0xa3, 0xc0, 0xed, 0xf7, 0xe5, 0x65, 0x41, 0xb4, 0x68,
+	0x93, 0xa8, 0x8a, 0x79, 0x8e, 0xf7, 0xaa, 0xdf, 0xd1, 0x3e, 0xb1, 0xd6, 0x23, 0x66, 0x85, 0x81,
+	0x2b, 0x0c, 0x0b, 0x3f, 0xa2, 0x2f, 0xf4, 0x06, 0x84, 0x96, 0x83, 0x3e, 0xb1, 0x2c, 0xce, 0xa2,
+	0x15, 0xc6, 0xa2, 0xb7, 0xae, 0x0a, 0xf4, 0x91, 0x40, 0xd5, 0x89, 0x65, 0x31, 0x36, 0x65, 0xec,
+	0xc8, 0x0e, 0x7c, 0x1f, 0xa4, 0x68, 0x34, 0x68, 0x42, 0x30, 0xab, 0x89, 0xeb, 0xe7, 0xc0, 0x36,
+	0xd7, 0x67, 0x06, 0x93, 0xc3, 0xcb, 0x05, 0xfc, 0x00, 0x64, 0x7d, 0x8c, 0x2c, 0x6c, 0x32, 0xee,
+	0x18, 0xae, 0xd3, 0x27, 0x03, 0x36, 0x74, 0x5c, 0xd3, 0x0a, 0x3a, 0x0c, 0x54, 0x26, 0x66, 0x85,
+	0x41, 0xb4, 0x35, 0x7f, 0x76, 0x43, 0x50, 0x7f, 0x2c, 0x81, 0xb5, 0x88, 0x2a, 0x7c, 0x04, 0xd2,
+	0x1e, 0x3e, 0xc3, 0xb4, 0x4e, 0x62, 0x8f, 0xb8, 0xa6, 0x2c, 0xdd, 0xdc, 0xcf, 0x29, 0x8e, 0x6c,
+	0x33, 0x20, 0x7c, 0x00, 0x60, 0xdf, 0xf5, 0xfa, 0x98, 0x04, 0xfa, 0xc8, 0xe1, 0x12, 0x6c, 0x32,
+	0xea, 0x27, 0xb4, 0xac, 0x90, 0x1c, 0xbf, 0x14, 0xec, 0x7c, 0x1a, 0x03, 0xa9, 0xea, 0xe8, 0x5b,
+	0xcd, 0x43, 0x0d, 0x24, 0xfb, 0x96, 0xeb, 0x7a, 0xff, 0x6b, 0x16, 0x02, 0x66, 0x85, 0x27, 0xcb,
+	0xfb, 0x60, 0xd5, 0xc4, 0x06, 0xba, 0xd0, 0x89, 0x13, 0x60, 0xef, 0x0c, 0x59, 0x72, 0xec, 0xe6,
+	0x0e, 0x4b, 0x33, 0xa8, 0x2a, 0x90, 0x57, 0x95, 0xd5, 0xf8, 0xff, 0xa7, 0xac, 0x0a, 0x62, 0xfc,
+	0x49, 0x02, 0x6b, 0x91, 0x91, 0x01, 0x1e, 0x82, 0x94, 0x68, 0xed, 0x7c, 0x48, 0x93, 0x5e, 0x63,
+	0xe0, 0x4c, 0x0a, 0x24, 0x95, 0x41, 0x15, 0x2c, 0x3f, 0xc6, 0x64, 0x70, 0x1a, 0xdc, 0x3e, 0x00,
+	0xc2, 0xc0, 0xce, 0x53, 0x09, 0xa4, 0x67, 0x86, 0x92, 0xc8, 0x50, 0x2c, 0xdd, 0x6e, 0x28, 0xfe,
+	0x39, 0x48, 0x4c, 0x87, 0x62, 0x79, 0xf1, 0x35, 0x4c, 0xac, 0x88, 0x99, 0x98, 0xde, 0xc2, 0xb0,
+	0x48, 0xbf, 0xcf, 0x4d, 0xc4, 0x6e, 0x64, 0x42, 0xe2, 0xb7, 0x60, 0x38, 0x2a, 0xd9, 0x7b, 0x2a,
+	0x81, 0x64, 0xe8, 0x17, 0x22, 0x7c, 0x1b, 0xc8, 0xca, 0x71, 0xa5, 0xab, 0xb6, 0x9a, 0x7a, 0xf7,
+	0xc3, 0x76, 0x4d, 0x3f, 0x6e, 0x76, 0xda, 0xb5, 0x8a, 0x5a, 0x57, 0x6b, 0xd5, 0xcc, 0x42, 0x0e,
+	0x8e, 0x27, 0x85, 0xd5, 0x90, 0x7a, 0x93, 0x58, 0xf0, 0xc7, 0x11, 0x44, 0x5d, 0xfd, 0x45, 0xad,
+	0xaa, 0xb7, 0x35, 0xb5, 0x52, 0xcb, 0x48, 0xb9, 0x7b, 0xe3, 0x49, 0x61, 0x23, 0x84, 0xb8, 0x1c,
+	0x1c, 0x68, 0x07, 0x98, 0x01, 0x96, 0x95, 0x6e, 0xe5, 0x51, 0x66, 0x31, 0xb7, 0x3e, 0x9e, 0x14,
+	0x32, 0x21, 0x08, 0xeb, 0x9b, 0xaf, 0x68, 0x57, 0x8f, 0xa9, 0x76, 0xec, 0x15, 0x6d, 0x96, 0xdd,
+	0xb9, 0xf8, 0x27, 0x7f, 0xcc, 0x2f, 0xec, 0xfd, 0x43, 0x02, 0x99, 0x68, 0x31, 0x85, 0x3f, 0x05,
+	0xf9, 0x23, 0x45, 0x3b, 0x54, 0x9b, 0x4a, 0x43, 0xaf, 0xab, 0x8d, 0x86, 0x7e, 0xd4, 0xaa, 0x46,
+	0xdf, 0x29, 0x8f, 0x27, 0x85, 0xf5, 0x28, 0xb2, 0xe9, 0x3a, 0x14, 0x7d, 0x7f, 0x0e, 0xba, 0xad,
+	0xb5, 0x74, 0x4d, 0xe9, 0x2a, 0x19, 0x29, 0x77, 0x7f, 0x3c, 0x29, 0x6c, 0x45, 0xa1, 0x6d, 0xcf,
+	0xd5, 0x50, 0x80, 0xe0, 0xbb, 0xe0, 0xde, 0x1c, 0x74, 0x59, 0xad, 0xea, 0x6a, 0x35, 0xb3, 0xc8,
+	0x9d, 0x15, 0xc5, 0x96, 0x89, 0xa9, 0x9a, 0xe2, 0x41, 0x9f, 0x4a, 0x20, 0x19, 0xaa, 0xe3, 0xf0,
+	0x21, 0x90, 0xa9, 0xa3, 0xd5, 0xe6, 0xe1, 0xbc, 0x57, 0x6c, 0x8e, 0x27, 0x05, 0x18, 0x52, 0x3f,
+	0x76, 0x48, 0xdf, 0xf5, 0x6c, 0xf8, 0x33, 0x70, 0x7f, 0x06, 0x55, 0x55, 0x3b, 0x15, 0x4d, 0x3d,
+	0x52, 0x9b, 0x4a, 0xb7, 0xa5, 0x7d, 0x98, 0x91, 0x72, 0xdf, 0x19, 0x4f, 0x0a, 0xf7, 0x42, 0xc0,
+	0x2a, 0xf1, 0x0d, 0x8f, 0xd8, 0xc4, 0x41, 0x81, 0xeb, 0x5d, 0x88, 0xbb, 0x0c, 0x41, 0x62, 0x3a,
+	0xee, 0xc0, 0xb7, 0xc1, 0x46, 0x47, 0x69, 0xd4, 0xe6, 0x5d, 0x62, 0x63, 0x3c, 0x29, 0x64, 0xa7,
+	0x8a, 0x75, 0xe2, 0xf9, 0x41, 0xc5, 0xb5, 0x59, 0xf0, 0x2f, 0x11, 0xad, 0x93, 0x9a, 0x56, 0x6f,
+	0xb4, 0x3e, 0xc8, 0x48, 0x3c, 0x9c, 0x53, 0xf5, 0xd6, 0x19, 0xf6, 0xfa, 0x96, 0xfb, 0x58, 0x9c,
+	0xf8, 0x9b, 0x38, 0x48, 0xcf, 0xfc, 0xb4, 0x83, 0x0f, 0x41, 0x6e, 0x4a, 0x8a, 0x4e, 0x57, 0xe9,
+	0x1e, 0x77, 0x22, 0x87, 0x87, 0xc9, 0xc1, 0x21, 0x94, 0xb1, 0x0f, 0xc1, 0x66, 0x04, 0xd5, 0xe9,
+	0x2a, 0xcd, 0x6a, 0x99, 0x3e, 0x9d, 0x45, 0x7e, 0x06, 0xd1, 0x09, 0x90, 0x63, 0x96, 0x2f, 0xe6,
+	0xa3, 0xb4, 0x6e, 0x8d, 0x06, 0x6e, 0x2e, 0xca, 0x0b, 0xb0, 0x39, 0x07, 0x75, 0x52, 0xeb, 0x74,
+	0xd5, 0xe6, 0x61, 0x26, 0x36, 0x07, 0x35, 0x2d, 0x30, 0xef, 0x80, 0xad, 0x08, 0xaa, 0xae, 0x36,
+	0xd5, 0xce, 0xa3, 0x5a, 0x35, 0x13, 0x9f, 0x49, 0x29, 0x0e, 0xab, 0x13, 0x87, 0xf8, 0xa7, 0xd8,
+	0x84, 0xef, 0x02, 0x39, 0x82, 0xab, 0x28, 0xcd, 0x4a, 0xad, 0xd1, 0xa8, 0x55, 0x33, 0x4b, 0xb9,
+	0xdc, 0x78, 0x52, 0xd8, 0x9c, 0x01, 0xf2, 0x79, 0xc5, 0xc2, 0x26, 0x3c, 0x00, 0x1b, 0x11, 0x64,
+	0x5b, 0x39, 0xee, 0xd4, 0xaa, 0x99, 0xe5, 0xdc, 0xd6, 0x78, 0x52, 0xb8, 0x3b, 0x03, 0x6b, 0xb3,
+	0x5f, 0xc4, 0x73, 0x30, 0x75, 0x45, 0xa5, 0x47, 0xad, 0xcc, 0xc1, 0xd4, 0x11, 0xb1, 0xe6, 0xde,
+	0x50, 0xab, 0x9d, 0xd4, 0x94, 0x06, 0xf5, 0x48, 0x62, 0xce, 0x0d, 0x35, 0xd6, 0xb9, 0x89, 0x33,
+	0xe0, 0x1c, 0x28, 0xb7, 0xbe, 0x7c, 0x9e, 0x97, 0x9e, 0x3c, 0xcf, 0x4b, 0xff, 0x7e, 0x9e, 0x97,
+	0x3e, 0x7b, 0x91, 0x5f, 0x78, 0xf2, 0x22, 0xbf, 0xf0, 0xaf, 0x17, 0xf9, 0x85, 0x8f, 0x7e, 0x14,
+	0xea, 0x49, 0x6c, 0xb6, 0xf3, 0x6c, 0xe2, 0x04, 0x33, 0x7f, 0x27, 0x3c, 0x9f, 0x59, 0xb1, 0x36,
+	0xd5, 0x5b, 0x66, 0x95, 0xf2, 0x87, 0xff, 0x1d, 0x00, 0x85, 0x14, 0xf9, 0x65, 0x5d, 0x14, 0x00,
+	0x00,
 }
 
 func (m *BaseAuction) Marshal() (dAtA []byte, err error) {
@@ -427,6 +873,127 @@ func (m *BaseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for iNdEx := len(m.AdditionalPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalPayingDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.PendingBeneficiary) > 0 {
+		i -= len(m.PendingBeneficiary)
+		copy(dAtA[i:], m.PendingBeneficiary)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.PendingBeneficiary)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.AutoRelease {
+		i--
+		if m.AutoRelease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LinearVesting != nil {
+		{
+			size, err := m.LinearVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.BuyerVestingSchedules) > 0 {
+		for iNdEx := len(m.BuyerVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size := m.SoftCap.Size()
+		i -= size
+		if _, err := m.SoftCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.BidFee) > 0 {
+		for iNdEx := len(m.BidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PausedTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PausedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PausedTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuction(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Status != 0 {
 		i = encodeVarintAuction(dAtA, i, uint64(m.Status))
 		i--
@@ -444,12 +1011,12 @@ func (m *BaseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x62
 		}
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintAuction(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintAuction(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.VestingSchedules) > 0 {
@@ -534,7 +1101,7 @@ func (m *BaseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FixedPriceAuction) Marshal() (dAtA []byte, err error) {
+func (m *PayingDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -544,42 +1111,37 @@ func (m *FixedPriceAuction) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
+func (m *PayingDenom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PayingDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.RemainingSellingCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.BaseAuction != nil {
-		{
-			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuction(dAtA, i, uint64(size))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
+func (m *FixedPriceAuction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -589,26 +1151,106 @@ func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
+func (m *FixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.SaleMode != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.SaleMode))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
-		size := m.ExtendedRoundRate.Size()
-		i -= size
-		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.RemainingSellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x12
+	if m.BaseAuction != nil {
+		{
+			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SealedBidConfig != nil {
+		{
+			size, err := m.SealedBidConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PricingMode != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.PricingMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MarginalFillMode != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.MarginalFillMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BidCancelCutoff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BidCancelCutoff):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintAuction(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExtendedRoundRate.Size()
+		i -= size
+		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.MaxExtendedRound != 0 {
 		i = encodeVarintAuction(dAtA, i, uint64(m.MaxExtendedRound))
 		i--
@@ -649,6 +1291,110 @@ func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SealedBidConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForfeitUnrevealed {
+		i--
+		if m.ForfeitUnrevealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintAuction(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemainingSellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DecayInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DecayInterval):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FloorPrice.Size()
+		i -= size
+		if _, err := m.FloorPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BaseAuction != nil {
+		{
+			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -679,12 +1425,61 @@ func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
-	if err6 != nil {
-		return 0, err6
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintAuction(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LinearVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinearVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinearVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CliffTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintAuction(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintAuction(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n6
-	i = encodeVarintAuction(dAtA, i, uint64(n6))
+	i -= n17
+	i = encodeVarintAuction(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -754,6 +1549,64 @@ func (m *BaseAuction) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovAuction(uint64(m.Status))
 	}
+	if m.PausedTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PausedTime)
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if len(m.BidFee) > 0 {
+		for _, e := range m.BidFee {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	l = m.SoftCap.Size()
+	n += 2 + l + sovAuction(uint64(l))
+	if len(m.BuyerVestingSchedules) > 0 {
+		for _, e := range m.BuyerVestingSchedules {
+			l = e.Size()
+			n += 2 + l + sovAuction(uint64(l))
+		}
+	}
+	if m.LinearVesting != nil {
+		l = m.LinearVesting.Size()
+		n += 2 + l + sovAuction(uint64(l))
+	}
+	if m.AutoRelease {
+		n += 3
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 2 + l + sovAuction(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 2 + l + sovAuction(uint64(l))
+	}
+	l = len(m.PendingBeneficiary)
+	if l > 0 {
+		n += 2 + l + sovAuction(uint64(l))
+	}
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for _, e := range m.AdditionalPayingDenoms {
+			l = e.Size()
+			n += 2 + l + sovAuction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayingDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -769,6 +1622,9 @@ func (m *FixedPriceAuction) Size() (n int) {
 	}
 	l = m.RemainingSellingCoin.Size()
 	n += 1 + l + sovAuction(uint64(l))
+	if m.SaleMode != 0 {
+		n += 1 + sovAuction(uint64(m.SaleMode))
+	}
 	return n
 }
 
@@ -791,6 +1647,51 @@ func (m *BatchAuction) Size() (n int) {
 	}
 	l = m.ExtendedRoundRate.Size()
 	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BidCancelCutoff)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.MarginalFillMode != 0 {
+		n += 1 + sovAuction(uint64(m.MarginalFillMode))
+	}
+	if m.PricingMode != 0 {
+		n += 1 + sovAuction(uint64(m.PricingMode))
+	}
+	if m.SealedBidConfig != nil {
+		l = m.SealedBidConfig.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	return n
+}
+
+func (m *SealedBidConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.ForfeitUnrevealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAuction != nil {
+		l = m.BaseAuction.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DecayInterval)
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.RemainingSellingCoin.Size()
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -807,6 +1708,23 @@ func (m *VestingSchedule) Size() (n int) {
 	return n
 }
 
+func (m *LinearVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	return n
+}
+
 func sovAuction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1227,11 +2145,903 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuction(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PausedTime == nil {
+				m.PausedTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidFee = append(m.BidFee, types.Coin{})
+			if err := m.BidFee[len(m.BidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestingSchedules = append(m.BuyerVestingSchedules, VestingSchedule{})
+			if err := m.BuyerVestingSchedules[len(m.BuyerVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVesting == nil {
+				m.LinearVesting = &LinearVesting{}
+			}
+			if err := m.LinearVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRelease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRelease = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPayingDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalPayingDenoms = append(m.AdditionalPayingDenoms, PayingDenom{})
+			if err := m.AdditionalPayingDenoms[len(m.AdditionalPayingDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayingDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayingDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayingDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedPriceAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAuction == nil {
+				m.BaseAuction = &BaseAuction{}
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleMode", wireType)
+			}
+			m.SaleMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaleMode |= SaleMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAuction == nil {
+				m.BaseAuction = &BaseAuction{}
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			}
+			m.MaxExtendedRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCancelCutoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BidCancelCutoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginalFillMode", wireType)
+			}
+			m.MarginalFillMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginalFillMode |= MarginalFillMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingMode", wireType)
+			}
+			m.PricingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingMode |= PricingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SealedBidConfig == nil {
+				m.SealedBidConfig = &SealedBidConfig{}
+			}
+			if err := m.SealedBidConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuction
@@ -1248,7 +3058,7 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
+func (m *SealedBidConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1271,15 +3081,15 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FixedPriceAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealedBidConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealedBidConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1306,18 +3116,15 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAuction == nil {
-				m.BaseAuction = &BaseAuction{}
-			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitUnrevealed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1327,25 +3134,12 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RemainingSellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ForfeitUnrevealed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1367,7 +3161,7 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchAuction) Unmarshal(dAtA []byte) error {
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,10 +3184,10 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1434,7 +3228,7 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,15 +3256,15 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1480,31 +3274,30 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DecayInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
 			}
-			m.MaxExtendedRound = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1514,14 +3307,111 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1549,7 +3439,7 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1574,7 +3464,7 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+func (m *LinearVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1597,15 +3487,15 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinearVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinearVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1632,15 +3522,15 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1650,23 +3540,58 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
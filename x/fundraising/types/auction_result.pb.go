@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fundraising/fundraising/v1/auction_result.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuctionResult defines the final matching result of an auction that is
+// stored when the auction is closed.
+type AuctionResult struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// matched_price specifies the clearing price of the auction
+	MatchedPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=matched_price,json=matchedPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"matched_price"`
+	// matched_len specifies the number of the matched bids
+	MatchedLen int64 `protobuf:"varint,3,opt,name=matched_len,json=matchedLen,proto3" json:"matched_len,omitempty"`
+	// sold_coin specifies the total amount of the selling coin sold to the bidders
+	SoldCoin types.Coin `protobuf:"bytes,4,opt,name=sold_coin,json=soldCoin,proto3" json:"sold_coin"`
+	// paid_coin specifies the total amount of the paying coin paid by the
+	// bidders; the coins of the additional paying denoms are counted at their
+	// conversion ratios
+	PaidCoin types.Coin `protobuf:"bytes,5,opt,name=paid_coin,json=paidCoin,proto3" json:"paid_coin"`
+	// refunded_coin specifies the total amount of the paying coin refunded to
+	// the bidders; the coins of the additional paying denoms are counted at their
+	// conversion ratios
+	RefundedCoin types.Coin `protobuf:"bytes,6,opt,name=refunded_coin,json=refundedCoin,proto3" json:"refunded_coin"`
+	// bidder_allocations specifies the settlement of each bidder sorted by the bidder address
+	BidderAllocations []BidderAllocation `protobuf:"bytes,7,rep,name=bidder_allocations,json=bidderAllocations,proto3" json:"bidder_allocations"`
+	// closed_time specifies the time when the auction is closed
+	ClosedTime time.Time `protobuf:"bytes,8,opt,name=closed_time,json=closedTime,proto3,stdtime" json:"closed_time"`
+	// vested_coin specifies the paying coin of the paying coin denom moved into
+	// the vesting reserve account to be released by the vesting queues; it is
+	// zero without vesting
+	VestedCoin types.Coin `protobuf:"bytes,9,opt,name=vested_coin,json=vestedCoin,proto3" json:"vested_coin"`
+	// paid_coins specifies the paying coins paid by the bidders in each denom
+	// that the auction accepts
+	PaidCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=paid_coins,json=paidCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid_coins"`
+	// refunded_coins specifies the paying coins refunded to the bidders in each
+	// denom that the auction accepts
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+	// vested_coins specifies the paying coins moved into the vesting reserve
+	// account in each denom that the auction accepts
+	VestedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=vested_coins,json=vestedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested_coins"`
+}
+
+func (m *AuctionResult) Reset()         { *m = AuctionResult{} }
+func (m *AuctionResult) String() string { return proto.CompactTextString(m) }
+func (*AuctionResult) ProtoMessage()    {}
+func (*AuctionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a46bdd121079c5, []int{0}
+}
+func (m *AuctionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionResult.Merge(m, src)
+}
+func (m *AuctionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionResult proto.InternalMessageInfo
+
+func (m *AuctionResult) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *AuctionResult) GetMatchedLen() int64 {
+	if m != nil {
+		return m.MatchedLen
+	}
+	return 0
+}
+
+func (m *AuctionResult) GetSoldCoin() types.Coin {
+	if m != nil {
+		return m.SoldCoin
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionResult) GetPaidCoin() types.Coin {
+	if m != nil {
+		return m.PaidCoin
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionResult) GetRefundedCoin() types.Coin {
+	if m != nil {
+		return m.RefundedCoin
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionResult) GetBidderAllocations() []BidderAllocation {
+	if m != nil {
+		return m.BidderAllocations
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetClosedTime() time.Time {
+	if m != nil {
+		return m.ClosedTime
+	}
+	return time.Time{}
+}
+
+func (m *AuctionResult) GetVestedCoin() types.Coin {
+	if m != nil {
+		return m.VestedCoin
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionResult) GetPaidCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PaidCoins
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetVestedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VestedCoins
+	}
+	return nil
+}
+
+// BidderAllocation defines the settlement of a bidder in an auction.
+type BidderAllocation struct {
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// allocated_coin specifies the selling coin allocated to the bidder
+	AllocatedCoin types.Coin `protobuf:"bytes,2,opt,name=allocated_coin,json=allocatedCoin,proto3" json:"allocated_coin"`
+	// paid_coin specifies the paying coin paid by the bidder for the allocated
+	// coin; the coins of the additional paying denoms are counted at their
+	// conversion ratios
+	PaidCoin types.Coin `protobuf:"bytes,3,opt,name=paid_coin,json=paidCoin,proto3" json:"paid_coin"`
+	// refunded_coin specifies the paying coin refunded to the bidder; the coins
+	// of the additional paying denoms are counted at their conversion ratios
+	RefundedCoin types.Coin `protobuf:"bytes,4,opt,name=refunded_coin,json=refundedCoin,proto3" json:"refunded_coin"`
+	// paid_coins specifies the paying coins paid by the bidder in each denom
+	// that the bidder reserved
+	PaidCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=paid_coins,json=paidCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid_coins"`
+	// refunded_coins specifies the paying coins refunded to the bidder in each
+	// denom that the bidder reserved
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+}
+
+func (m *BidderAllocation) Reset()         { *m = BidderAllocation{} }
+func (m *BidderAllocation) String() string { return proto.CompactTextString(m) }
+func (*BidderAllocation) ProtoMessage()    {}
+func (*BidderAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a46bdd121079c5, []int{1}
+}
+func (m *BidderAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidderAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidderAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidderAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidderAllocation.Merge(m, src)
+}
+func (m *BidderAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidderAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidderAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidderAllocation proto.InternalMessageInfo
+
+func (m *BidderAllocation) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BidderAllocation) GetAllocatedCoin() types.Coin {
+	if m != nil {
+		return m.AllocatedCoin
+	}
+	return types.Coin{}
+}
+
+func (m *BidderAllocation) GetPaidCoin() types.Coin {
+	if m != nil {
+		return m.PaidCoin
+	}
+	return types.Coin{}
+}
+
+func (m *BidderAllocation) GetRefundedCoin() types.Coin {
+	if m != nil {
+		return m.RefundedCoin
+	}
+	return types.Coin{}
+}
+
+func (m *BidderAllocation) GetPaidCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PaidCoins
+	}
+	return nil
+}
+
+func (m *BidderAllocation) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuctionResult)(nil), "fundraising.fundraising.v1.AuctionResult")
+	proto.RegisterType((*BidderAllocation)(nil), "fundraising.fundraising.v1.BidderAllocation")
+}
+
+func init() {
+	proto.RegisterFile("fundraising/fundraising/v1/auction_result.proto", fileDescriptor_48a46bdd121079c5)
+}
+
+var fileDescriptor_48a46bdd121079c5 = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0xd0, 0x52, 0xe9, 0x6c, 0x4b, 0x64, 0xc3, 0x61, 0xa9, 0x71, 0xdb, 0x70, 0x6a, 0x88,
+	0xcc, 0x5a, 0x8c, 0x3f, 0xa0, 0x15, 0x4d, 0x50, 0x12, 0x4d, 0x35, 0x1e, 0xbc, 0x34, 0xbb, 0x3b,
+	0xc3, 0x32, 0x61, 0x77, 0xa7, 0xd9, 0x99, 0x36, 0x72, 0xf3, 0xe0, 0x81, 0x23, 0x67, 0x7f, 0x81,
+	0x31, 0x31, 0xe1, 0xc0, 0x8f, 0xe0, 0x48, 0x38, 0x19, 0x0f, 0x60, 0xe0, 0xc0, 0xdf, 0x30, 0xf3,
+	0xb1, 0xd8, 0x62, 0x34, 0xa9, 0x31, 0xe8, 0xa5, 0x9d, 0x79, 0xdf, 0x79, 0x9e, 0x79, 0xe6, 0x7d,
+	0x9f, 0x99, 0x85, 0xde, 0xd6, 0x30, 0xc5, 0x99, 0x4f, 0x39, 0x4d, 0xa3, 0x89, 0xf1, 0xa8, 0xed,
+	0xf9, 0xc3, 0x50, 0x50, 0x96, 0xf6, 0x33, 0xc2, 0x87, 0xb1, 0x40, 0x83, 0x8c, 0x09, 0x66, 0xd7,
+	0xc7, 0x16, 0xa1, 0xf1, 0xf1, 0xa8, 0x5d, 0x5f, 0xf0, 0x13, 0x9a, 0x32, 0x4f, 0xfd, 0xea, 0xe5,
+	0x75, 0x37, 0x64, 0x3c, 0x61, 0xdc, 0x0b, 0x7c, 0x4e, 0xbc, 0x51, 0x3b, 0x20, 0xc2, 0x6f, 0x7b,
+	0x21, 0xa3, 0xa9, 0xc9, 0x2f, 0xe9, 0x7c, 0x5f, 0xcd, 0x3c, 0x3d, 0x31, 0xa9, 0xc5, 0x88, 0x45,
+	0x4c, 0xc7, 0xe5, 0xc8, 0x44, 0x1b, 0x11, 0x63, 0x51, 0x4c, 0x3c, 0x35, 0x0b, 0x86, 0x5b, 0x9e,
+	0xa0, 0x09, 0xe1, 0xc2, 0x4f, 0x06, 0x7a, 0xc1, 0xf2, 0xde, 0x1c, 0xac, 0x75, 0xb4, 0xf2, 0x9e,
+	0x12, 0x6e, 0xdf, 0x85, 0x30, 0x3f, 0x0a, 0xc5, 0x0e, 0x68, 0x82, 0x56, 0xa9, 0x57, 0x31, 0x91,
+	0x0d, 0x6c, 0xbf, 0x86, 0xb5, 0xc4, 0x17, 0xe1, 0x36, 0xc1, 0xfd, 0x41, 0x46, 0x43, 0xe2, 0xcc,
+	0x34, 0x41, 0xab, 0xd2, 0x6d, 0x1f, 0x9d, 0x36, 0x0a, 0x5f, 0x4f, 0x1b, 0x77, 0xb4, 0x28, 0x8e,
+	0x77, 0x10, 0x65, 0x5e, 0xe2, 0x8b, 0x6d, 0xb4, 0x49, 0x22, 0x3f, 0xdc, 0x5d, 0x27, 0xe1, 0xc9,
+	0xe1, 0x2a, 0x34, 0x9a, 0xd7, 0x49, 0xd8, 0xab, 0x1a, 0x9e, 0x17, 0x92, 0xc6, 0x6e, 0x40, 0x2b,
+	0xe7, 0x8d, 0x49, 0xea, 0x14, 0x9b, 0xa0, 0x55, 0xec, 0x41, 0x13, 0xda, 0x24, 0xa9, 0xdd, 0x81,
+	0x15, 0xce, 0x62, 0xdc, 0x97, 0xe5, 0x70, 0x4a, 0x4d, 0xd0, 0xb2, 0xd6, 0x96, 0x90, 0xa1, 0x93,
+	0xf5, 0x42, 0xa6, 0x5e, 0xe8, 0x11, 0xa3, 0x69, 0xb7, 0x22, 0xf5, 0x7c, 0xbc, 0x3c, 0x58, 0x01,
+	0xbd, 0x39, 0x09, 0x93, 0x41, 0x49, 0x31, 0xf0, 0xa9, 0xa1, 0x98, 0x9d, 0x86, 0x42, 0xc2, 0x14,
+	0xc5, 0x06, 0xac, 0x65, 0x44, 0x36, 0x92, 0x18, 0x9a, 0xf2, 0x14, 0x34, 0xd5, 0x1c, 0xaa, 0xa8,
+	0xb6, 0xa0, 0x1d, 0x50, 0x8c, 0x49, 0xd6, 0xf7, 0xe3, 0x98, 0x85, 0xbe, 0x2c, 0x30, 0x77, 0x6e,
+	0x35, 0x8b, 0x2d, 0x6b, 0xed, 0x1e, 0xfa, 0xb5, 0x71, 0x50, 0x57, 0xa1, 0x3a, 0x57, 0xa0, 0xf1,
+	0x2d, 0x16, 0x82, 0x6b, 0x49, 0x6e, 0x3f, 0x85, 0x56, 0x18, 0x33, 0x4e, 0x70, 0x5f, 0x36, 0xdf,
+	0x99, 0x53, 0x82, 0xeb, 0x48, 0x3b, 0x03, 0xe5, 0xce, 0x40, 0xaf, 0x72, 0x67, 0x74, 0x6b, 0x92,
+	0x6e, 0xff, 0xac, 0x01, 0x34, 0x25, 0xd4, 0x68, 0x99, 0xb7, 0x1f, 0x43, 0x6b, 0x44, 0xb8, 0xc8,
+	0x0f, 0x5f, 0x99, 0xe2, 0xf0, 0x50, 0x03, 0xd5, 0xd1, 0xdf, 0x01, 0x08, 0xaf, 0x3a, 0xc1, 0x1d,
+	0xd8, 0x2c, 0xfe, 0x9e, 0xe6, 0x89, 0xa4, 0xf9, 0x74, 0xd6, 0x68, 0x45, 0x54, 0x6c, 0x0f, 0x03,
+	0x14, 0xb2, 0xc4, 0xb8, 0xdf, 0xfc, 0xad, 0x72, 0xbc, 0xe3, 0x89, 0xdd, 0x01, 0xe1, 0x0a, 0xc0,
+	0x3f, 0x5c, 0x1e, 0xac, 0x54, 0x63, 0x65, 0x3c, 0xbd, 0x87, 0xd6, 0x50, 0xc9, 0xfb, 0xc8, 0xed,
+	0x3d, 0x00, 0xe7, 0x27, 0x3a, 0xc9, 0x1d, 0xeb, 0xa6, 0x64, 0xd4, 0xc6, 0x7d, 0xc0, 0xed, 0xf7,
+	0x00, 0x56, 0xc7, 0xaa, 0xca, 0x9d, 0xea, 0x4d, 0x09, 0xb1, 0x7e, 0xf4, 0x84, 0x2f, 0x7f, 0x2e,
+	0xc1, 0xdb, 0xd7, 0xad, 0x65, 0xdf, 0x87, 0x65, 0xed, 0x28, 0xf5, 0x12, 0x54, 0xba, 0xce, 0xc9,
+	0xe1, 0xea, 0xa2, 0xd1, 0xd5, 0xc1, 0x38, 0x23, 0x9c, 0xbf, 0x14, 0x19, 0x4d, 0xa3, 0x9e, 0x59,
+	0x67, 0x3f, 0x83, 0xf3, 0xc6, 0xcf, 0xb9, 0x4b, 0x66, 0xa6, 0x70, 0x49, 0xed, 0x0a, 0xfb, 0xf3,
+	0x8d, 0x2d, 0xfe, 0x9d, 0x1b, 0x5b, 0xfa, 0xe3, 0x1b, 0x7b, 0xcd, 0xb6, 0xb3, 0xff, 0x87, 0x6d,
+	0xcb, 0xff, 0xc6, 0xb6, 0xdd, 0xe7, 0x47, 0xe7, 0x2e, 0x38, 0x3e, 0x77, 0xc1, 0xb7, 0x73, 0x17,
+	0xec, 0x5f, 0xb8, 0x85, 0xe3, 0x0b, 0xb7, 0xf0, 0xe5, 0xc2, 0x2d, 0xbc, 0x79, 0x38, 0xb6, 0x91,
+	0x20, 0x29, 0x26, 0x59, 0x42, 0x53, 0x31, 0xf1, 0xc1, 0x7c, 0x3b, 0x31, 0x53, 0x7b, 0x07, 0x65,
+	0xf5, 0x16, 0x3d, 0xf8, 0x3e, 0x00, 0x78, 0x37, 0x86, 0x97, 0x66, 0x07, 0x00, 0x00,
+}
+
+func (m *AuctionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestedCoins) > 0 {
+		for iNdEx := len(m.VestedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PaidCoins) > 0 {
+		for iNdEx := len(m.PaidCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.VestedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuctionResult(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if len(m.BidderAllocations) > 0 {
+		for iNdEx := len(m.BidderAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.RefundedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.PaidCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.SoldCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MatchedLen != 0 {
+		i = encodeVarintAuctionResult(dAtA, i, uint64(m.MatchedLen))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MatchedPrice.Size()
+		i -= size
+		if _, err := m.MatchedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintAuctionResult(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidderAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidderAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidderAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PaidCoins) > 0 {
+		for iNdEx := len(m.PaidCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.RefundedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PaidCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.AllocatedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuctionResult(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuctionResult(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuctionResult(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuctionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovAuctionResult(uint64(m.AuctionId))
+	}
+	l = m.MatchedPrice.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	if m.MatchedLen != 0 {
+		n += 1 + sovAuctionResult(uint64(m.MatchedLen))
+	}
+	l = m.SoldCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	l = m.PaidCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	l = m.RefundedCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	if len(m.BidderAllocations) > 0 {
+		for _, e := range m.BidderAllocations {
+			l = e.Size()
+			n += 1 + l + sovAuctionResult(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime)
+	n += 1 + l + sovAuctionResult(uint64(l))
+	l = m.VestedCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	if len(m.PaidCoins) > 0 {
+		for _, e := range m.PaidCoins {
+			l = e.Size()
+			n += 1 + l + sovAuctionResult(uint64(l))
+		}
+	}
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovAuctionResult(uint64(l))
+		}
+	}
+	if len(m.VestedCoins) > 0 {
+		for _, e := range m.VestedCoins {
+			l = e.Size()
+			n += 1 + l + sovAuctionResult(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BidderAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuctionResult(uint64(l))
+	}
+	l = m.AllocatedCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	l = m.PaidCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	l = m.RefundedCoin.Size()
+	n += 1 + l + sovAuctionResult(uint64(l))
+	if len(m.PaidCoins) > 0 {
+		for _, e := range m.PaidCoins {
+			l = e.Size()
+			n += 1 + l + sovAuctionResult(uint64(l))
+		}
+	}
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovAuctionResult(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuctionResult(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuctionResult(x uint64) (n int) {
+	return sovAuctionResult(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuctionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctionResult
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedLen", wireType)
+			}
+			m.MatchedLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchedLen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoldCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaidCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderAllocations = append(m.BidderAllocations, BidderAllocation{})
+			if err := m.BidderAllocations[len(m.BidderAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidCoins = append(m.PaidCoins, types.Coin{})
+			if err := m.PaidCoins[len(m.PaidCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestedCoins = append(m.VestedCoins, types.Coin{})
+			if err := m.VestedCoins[len(m.VestedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctionResult(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidderAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctionResult
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidderAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidderAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocatedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaidCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidCoins = append(m.PaidCoins, types.Coin{})
+			if err := m.PaidCoins[len(m.PaidCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctionResult(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctionResult
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuctionResult(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuctionResult
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuctionResult
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuctionResult
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuctionResult
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuctionResult
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuctionResult        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuctionResult          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuctionResult = fmt.Errorf("proto: unexpected end of group")
+)
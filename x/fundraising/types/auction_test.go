@@ -32,8 +32,16 @@ func TestUnpackAuction(t *testing.T) {
 			types.MustParseRFC3339("2022-01-01T00:00:00Z"),
 			[]time.Time{types.MustParseRFC3339("2022-02-01T00:00:00Z")},
 			types.AuctionStatusStarted,
+			sdk.NewCoins(),
+			math.ZeroInt(),
+			[]types.VestingSchedule{},
+			nil,
+			false,
+			"",
+			nil,
 		),
 		sdk.NewInt64Coin("denom3", 1_000_000_000_000),
+		types.SaleModeFirstCome,
 	)
 
 	a, err := types.PackAuction(auction)
@@ -107,8 +115,16 @@ func TestUnpackAuctionJSON(t *testing.T) {
 			time.Now().AddDate(0, 0, -1),
 			[]time.Time{time.Now().AddDate(0, 1, -1)},
 			types.AuctionStatusStarted,
+			sdk.NewCoins(),
+			math.ZeroInt(),
+			[]types.VestingSchedule{},
+			nil,
+			false,
+			"",
+			nil,
 		),
 		sdk.NewInt64Coin("denom2", 1_000_000_000_000),
+		types.SaleModeFirstCome,
 	)
 
 	a, err := types.PackAuction(auction)
@@ -147,8 +163,16 @@ func TestUnpackAuctions(t *testing.T) {
 				time.Now().AddDate(0, 0, -1),
 				[]time.Time{time.Now().AddDate(0, 1, -1)},
 				types.AuctionStatusStarted,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
 			),
 			sdk.NewInt64Coin("denom2", 1_000_000_000_000),
+			types.SaleModeFirstCome,
 		),
 		types.NewBatchAuction(
 			types.NewBaseAuction(
@@ -165,11 +189,22 @@ func TestUnpackAuctions(t *testing.T) {
 				time.Now().AddDate(0, 0, -1),
 				[]time.Time{time.Now().AddDate(0, 1, -1)},
 				types.AuctionStatusStarted,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
 			),
 			math.LegacyMustNewDecFromStr("0.1"),
 			math.LegacyZeroDec(),
 			uint32(3),
 			math.LegacyMustNewDecFromStr("0.15"),
+			0,
+			types.MarginalFillModeNone,
+			types.PricingModeUniform,
+			nil,
 		),
 	}
 
@@ -264,6 +299,93 @@ func TestShouldAuctionClosed(t *testing.T) {
 	}
 }
 
+func TestAuctionBeneficiary(t *testing.T) {
+	auctioneer := sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer")))
+	beneficiary := sdk.AccAddress(crypto.AddressHash([]byte("Beneficiary")))
+	auction := types.BaseAuction{
+		Id:                    1,
+		Type:                  types.AuctionTypeFixedPrice,
+		Auctioneer:            auctioneer.String(),
+		SellingReserveAddress: types.SellingReserveAddress(1).String(),
+		PayingReserveAddress:  types.PayingReserveAddress(1).String(),
+		StartPrice:            math.LegacyMustNewDecFromStr("0.5"),
+		SellingCoin:           sdk.NewInt64Coin("denom3", 1_000_000_000_000),
+		PayingCoinDenom:       "denom4",
+		VestingReserveAddress: types.VestingReserveAddress(1).String(),
+		VestingSchedules:      []types.VestingSchedule{},
+		StartTime:             types.MustParseRFC3339("2021-12-01T00:00:00Z"),
+		EndTimes:              []time.Time{types.MustParseRFC3339("2021-12-15T00:00:00Z")},
+		Status:                types.AuctionStatusStandBy,
+	}
+
+	// The auctioneer is the beneficiary if not set
+	require.Equal(t, auctioneer, auction.GetBeneficiary())
+	require.NoError(t, auction.Validate())
+
+	require.NoError(t, auction.SetBeneficiary(beneficiary))
+	require.Equal(t, beneficiary, auction.GetBeneficiary())
+	require.NoError(t, auction.Validate())
+
+	auction.Beneficiary = "invalid"
+	require.ErrorContains(t, auction.Validate(), "invalid beneficiary address")
+
+	auction.Beneficiary = ""
+	require.NoError(t, auction.SetPendingBeneficiary(beneficiary))
+	require.ErrorContains(t, auction.Validate(), "pending beneficiary must not be set without pending owner")
+
+	require.NoError(t, auction.SetPendingOwner(beneficiary))
+	require.NoError(t, auction.Validate())
+}
+
+func TestDutchAuctionCurrentPrice(t *testing.T) {
+	baseAuction := types.NewBaseAuction(
+		1,
+		types.AuctionTypeDutch,
+		sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+		types.SellingReserveAddress(1).String(),
+		types.PayingReserveAddress(1).String(),
+		math.LegacyMustNewDecFromStr("2.0"),
+		sdk.NewInt64Coin("denom3", 1_000_000_000_000),
+		"denom4",
+		types.VestingReserveAddress(1).String(),
+		[]types.VestingSchedule{},
+		types.MustParseRFC3339("2021-12-01T00:00:00Z"),
+		[]time.Time{types.MustParseRFC3339("2021-12-11T00:00:00Z")},
+		types.AuctionStatusStarted,
+		sdk.NewCoins(),
+		math.ZeroInt(),
+		[]types.VestingSchedule{},
+		nil,
+		false,
+		"",
+		nil,
+	)
+
+	for _, tc := range []struct {
+		name          string
+		decayInterval time.Duration
+		currentTime   string
+		expected      string
+	}{
+		{"linear: before start", 0, "2021-11-30T00:00:00Z", "2.0"},
+		{"linear: at start", 0, "2021-12-01T00:00:00Z", "2.0"},
+		{"linear: in the middle", 0, "2021-12-06T00:00:00Z", "1.5"},
+		{"linear: a quarter", 0, "2021-12-03T12:00:00Z", "1.75"},
+		{"linear: at end", 0, "2021-12-11T00:00:00Z", "1.0"},
+		{"linear: after end", 0, "2021-12-20T00:00:00Z", "1.0"},
+		{"stepwise: before the first step", 48 * time.Hour, "2021-12-02T23:59:59Z", "2.0"},
+		{"stepwise: at the first step", 48 * time.Hour, "2021-12-03T00:00:00Z", "1.8"},
+		{"stepwise: between steps", 48 * time.Hour, "2021-12-06T00:00:00Z", "1.6"},
+		{"stepwise: after end", 48 * time.Hour, "2021-12-11T00:00:01Z", "1.0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			auction := types.NewDutchAuction(baseAuction, math.LegacyMustNewDecFromStr("1.0"), tc.decayInterval, baseAuction.SellingCoin)
+			price := auction.CurrentPrice(types.MustParseRFC3339(tc.currentTime))
+			require.True(t, math.LegacyMustNewDecFromStr(tc.expected).Equal(price), price.String())
+		})
+	}
+}
+
 func TestSellingReserveAddress(t *testing.T) {
 	for _, tc := range []struct {
 		auctionId uint64
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -47,3 +50,53 @@ func (b Bid) ConvertToPayingAmount(denom string) (amount math.Int) {
 	}
 	return math.LegacyNewDecFromInt(b.Coin.Amount).Mul(b.Price).Ceil().TruncateInt() // BidAmount * BidPrice
 }
+
+// GetPayingDenom returns the denom that the bid reserves for the auction with the given paying coin denom.
+func (b Bid) GetPayingDenom(payingCoinDenom string) string {
+	if b.PayingDenom != "" {
+		return b.PayingDenom
+	}
+	return payingCoinDenom
+}
+
+// Normalize returns the bid with its coin converted into the paying coin denom of the auction
+// when it is a coin of an additional paying denom, so that it can be matched with the other bids.
+func (b Bid) Normalize(auction AuctionI) Bid {
+	if b.PayingDenom == "" || b.Coin.Denom != b.PayingDenom {
+		return b
+	}
+	ratio, _ := PayingDenomRatio(auction, b.PayingDenom)
+	b.Coin = sdk.NewCoin(auction.GetPayingCoinDenom(), NormalizePayingAmount(b.Coin.Amount, ratio))
+	return b
+}
+
+// ReservedPayingCoin returns the paying coin that the bid reserves in its paying denom.
+func (b Bid) ReservedPayingCoin(auction AuctionI) sdk.Coin {
+	payingDenom := b.GetPayingDenom(auction.GetPayingCoinDenom())
+	if b.Coin.Denom == payingDenom {
+		return b.Coin
+	}
+	payingAmt := b.ConvertToPayingAmount(auction.GetPayingCoinDenom())
+	if payingDenom == auction.GetPayingCoinDenom() {
+		return sdk.NewCoin(payingDenom, payingAmt)
+	}
+	ratio, _ := PayingDenomRatio(auction, payingDenom)
+	return sdk.NewCoin(payingDenom, DenormalizePayingAmount(payingAmt, ratio))
+}
+
+// BidCommitmentHash returns the hash that a bidder commits for a sealed bid.
+// It is the SHA-256 hash of "{auctionId}/{bidder}/{bidType}/{price}/{coin}/{salt}",
+// where the bid type is the name of the enum and the price has 18 decimal places.
+func BidCommitmentHash(auctionId uint64, bidder string, bidType BidType, price math.LegacyDec, coin sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s/%s/%s", auctionId, bidder, bidType, price, coin, salt)))
+	return hash[:]
+}
+
+// GetBidder returns the address of the bidder that committed the bid.
+func (c BidCommitment) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(c.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -41,6 +42,8 @@ const (
 	// BID_TYPE_BATCH_MANY defines a bid type for How-Many-Coins-to-Buy of a batch
 	// auction
 	BidTypeBatchMany BidType = 3
+	// BID_TYPE_DUTCH defines a bid type for a dutch auction type
+	BidTypeDutch BidType = 4
 )
 
 var BidType_name = map[int32]string{
@@ -48,6 +51,7 @@ var BidType_name = map[int32]string{
 	1: "BID_TYPE_FIXED_PRICE",
 	2: "BID_TYPE_BATCH_WORTH",
 	3: "BID_TYPE_BATCH_MANY",
+	4: "BID_TYPE_DUTCH",
 }
 
 var BidType_value = map[string]int32{
@@ -55,6 +59,7 @@ var BidType_value = map[string]int32{
 	"BID_TYPE_FIXED_PRICE": 1,
 	"BID_TYPE_BATCH_WORTH": 2,
 	"BID_TYPE_BATCH_MANY":  3,
+	"BID_TYPE_DUTCH":       4,
 }
 
 func (x BidType) String() string {
@@ -102,7 +107,7 @@ type Bid struct {
 	// id specifies an index of a bid for the bidder
 	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
-	// is how-many-coins
+	// is how-many-coins, 4 is dutch
 	Type BidType `protobuf:"varint,4,opt,name=type,proto3,enum=fundraising.fundraising.v1.BidType" json:"type,omitempty"`
 	// price specifies the bid price in which price the bidder places the bid
 	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
@@ -110,10 +115,17 @@ type Bid struct {
 	// for a fixed price auction, the denom is of the paying coin.
 	// for a batch auction of how-much-worth, the denom is of the paying coin.
 	// for a batch auction of how-many-coins, the denom is of the selling coin.
+	// for a dutch auction, the denom is either of the paying or selling coin.
 	Coin types.Coin `protobuf:"bytes,6,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
 	// is_matched specifies the bid that is a winning bid and enables the bidder
 	// to purchase the selling coin
 	IsMatched bool `protobuf:"varint,7,opt,name=is_matched,json=isMatched,proto3" json:"is_matched,omitempty"`
+	// escrowed_fee specifies the place bid fee held in the module account until
+	// the batch auction is closed; it is refunded if the bid is not matched
+	EscrowedFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=escrowed_fee,json=escrowedFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed_fee"`
+	// paying_denom specifies the additional paying denom of the auction that the
+	// bid reserves; it is empty when the bid reserves the paying coin denom
+	PayingDenom string `protobuf:"bytes,9,opt,name=paying_denom,json=payingDenom,proto3" json:"paying_denom,omitempty"`
 }
 
 func (m *Bid) Reset()         { *m = Bid{} }
@@ -149,10 +161,60 @@ func (m *Bid) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bid proto.InternalMessageInfo
 
+// BidCommitment defines a sealed bid committed for a sealed-bid batch auction.
+// The bid stays hidden until the bidder reveals it during the reveal phase.
+type BidCommitment struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// id specifies the index of the commitment within the auction
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// bidder specifies the bech32-encoded address that committed the bid
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// hash specifies the SHA-256 hash of the sealed bid and its salt
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// collateral specifies the paying coin deposited with the commitment; it
+	// must cover the paying coin that the revealed bid reserves
+	Collateral types.Coin `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral"`
+}
+
+func (m *BidCommitment) Reset()         { *m = BidCommitment{} }
+func (m *BidCommitment) String() string { return proto.CompactTextString(m) }
+func (*BidCommitment) ProtoMessage()    {}
+func (*BidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_527f99309e242c82, []int{1}
+}
+func (m *BidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidCommitment.Merge(m, src)
+}
+func (m *BidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidCommitment proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("fundraising.fundraising.v1.BidType", BidType_name, BidType_value)
 	proto.RegisterEnum("fundraising.fundraising.v1.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterType((*Bid)(nil), "fundraising.fundraising.v1.Bid")
+	proto.RegisterType((*BidCommitment)(nil), "fundraising.fundraising.v1.BidCommitment")
 }
 
 func init() {
@@ -160,46 +222,56 @@ func init() {
 }
 
 var fileDescriptor_527f99309e242c82 = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x4b, 0xdc, 0x4e,
-	0x14, 0xc7, 0x33, 0xeb, 0xaa, 0x3f, 0xc7, 0x1f, 0xb2, 0xa6, 0x2a, 0x31, 0xa5, 0xd9, 0xd0, 0x16,
-	0xba, 0x2c, 0x98, 0xb8, 0x2b, 0xa5, 0xd0, 0xdb, 0x66, 0x13, 0xeb, 0x42, 0xd5, 0x25, 0xbb, 0xc5,
-	0xda, 0x4b, 0xc8, 0x66, 0xa6, 0xbb, 0x83, 0x6e, 0x66, 0xc9, 0x44, 0x31, 0xff, 0x81, 0xec, 0xa9,
-	0xe7, 0xc2, 0x42, 0xa1, 0x97, 0xd2, 0x93, 0x87, 0xfe, 0x11, 0xd2, 0x93, 0xf4, 0x54, 0x7a, 0xb0,
-	0x45, 0x0f, 0xd2, 0xff, 0xa2, 0x64, 0x32, 0x87, 0x58, 0xe9, 0x25, 0x99, 0xf7, 0xde, 0xf7, 0x33,
-	0x6f, 0xde, 0x77, 0x18, 0xf8, 0xf8, 0xed, 0x51, 0x88, 0x22, 0x9f, 0x30, 0x12, 0xf6, 0xcd, 0xfc,
-	0xfa, 0xb8, 0x66, 0xf6, 0x08, 0x32, 0x46, 0x11, 0x8d, 0xa9, 0xac, 0xe6, 0x2a, 0x46, 0x7e, 0x7d,
-	0x5c, 0x53, 0x17, 0xfd, 0x21, 0x09, 0xa9, 0xc9, 0xbf, 0x99, 0x5c, 0xd5, 0x02, 0xca, 0x86, 0x94,
-	0x99, 0x3d, 0x9f, 0x61, 0xf3, 0xb8, 0xd6, 0xc3, 0xb1, 0x5f, 0x33, 0x03, 0x4a, 0x42, 0x51, 0x5f,
-	0xcd, 0xea, 0x1e, 0x8f, 0xcc, 0x2c, 0x10, 0xa5, 0xa5, 0x3e, 0xed, 0xd3, 0x2c, 0x9f, 0xae, 0xb2,
-	0xec, 0xc3, 0xdf, 0x05, 0x38, 0x65, 0x11, 0x24, 0x3f, 0x80, 0xd0, 0x3f, 0x0a, 0x62, 0x42, 0x43,
-	0x8f, 0x20, 0x05, 0xe8, 0xa0, 0x52, 0x74, 0xe7, 0x44, 0xa6, 0x85, 0xe4, 0x15, 0x38, 0xd3, 0x23,
-	0x08, 0xe1, 0x48, 0x29, 0xe8, 0xa0, 0x32, 0xe7, 0x8a, 0x48, 0x5e, 0x80, 0x05, 0x82, 0x94, 0x29,
-	0x2e, 0x2f, 0x10, 0x24, 0x3f, 0x83, 0xc5, 0x38, 0x19, 0x61, 0xa5, 0xa8, 0x83, 0xca, 0x42, 0xfd,
-	0x91, 0xf1, 0xef, 0xe9, 0x0c, 0x8b, 0xa0, 0x6e, 0x32, 0xc2, 0x2e, 0x07, 0xe4, 0x17, 0x70, 0x7a,
-	0x14, 0x91, 0x00, 0x2b, 0xd3, 0xe9, 0xfe, 0x56, 0xed, 0xfc, 0xb2, 0x2c, 0xfd, 0xb8, 0x2c, 0xdf,
-	0xcf, 0x46, 0x60, 0xe8, 0xc0, 0x20, 0xd4, 0x1c, 0xfa, 0xf1, 0xc0, 0x78, 0x89, 0xfb, 0x7e, 0x90,
-	0xd8, 0x38, 0xf8, 0xf6, 0x65, 0x0d, 0x8a, 0x09, 0x6d, 0x1c, 0xb8, 0x19, 0x2f, 0xc7, 0xb0, 0x98,
-	0xfa, 0xa1, 0xcc, 0xe8, 0xa0, 0x32, 0x5f, 0x5f, 0x35, 0x84, 0x22, 0x35, 0xcc, 0x10, 0x86, 0x19,
-	0x4d, 0x4a, 0x42, 0xcb, 0x49, 0x5b, 0x7c, 0xfe, 0x59, 0x7e, 0xd2, 0x27, 0xf1, 0xe0, 0xa8, 0x67,
-	0x04, 0x74, 0x28, 0x0c, 0x13, 0xbf, 0x35, 0x86, 0x0e, 0xcc, 0xf4, 0x70, 0x8c, 0x03, 0xef, 0x6f,
-	0xce, 0xaa, 0xff, 0x1f, 0xf2, 0xe6, 0x5e, 0xda, 0x81, 0x7d, 0xba, 0x39, 0xab, 0x02, 0x97, 0x77,
-	0x4b, 0xed, 0x23, 0xcc, 0x1b, 0xfa, 0x71, 0x30, 0xc0, 0x48, 0x99, 0xd5, 0x41, 0xe5, 0x3f, 0x77,
-	0x8e, 0xb0, 0xed, 0x2c, 0xf1, 0xbc, 0x78, 0xfa, 0xa1, 0x2c, 0x55, 0xbf, 0x02, 0x38, 0x2b, 0xa6,
-	0x96, 0x2b, 0x70, 0xc9, 0x6a, 0xd9, 0x5e, 0x77, 0xbf, 0xed, 0x78, 0xaf, 0x76, 0x3a, 0x6d, 0xa7,
-	0xd9, 0xda, 0x6c, 0x39, 0x76, 0x49, 0x52, 0x17, 0xc6, 0x13, 0x1d, 0x0a, 0xd9, 0x0e, 0x39, 0x94,
-	0xcd, 0x9c, 0x72, 0xb3, 0xf5, 0xda, 0xb1, 0xbd, 0xb6, 0xdb, 0x6a, 0x3a, 0x25, 0xa0, 0x2e, 0x8f,
-	0x27, 0xfa, 0xa2, 0x50, 0x6e, 0x92, 0x13, 0x8c, 0xda, 0xdc, 0x81, 0x3c, 0x60, 0x35, 0xba, 0xcd,
-	0x2d, 0x6f, 0x6f, 0xd7, 0xed, 0x6e, 0x95, 0x0a, 0xb7, 0x00, 0x2b, 0x3d, 0xda, 0x1e, 0x8d, 0xe2,
-	0x81, 0xbc, 0x06, 0xef, 0xfd, 0x05, 0x6c, 0x37, 0x76, 0xf6, 0x4b, 0x53, 0xea, 0xd2, 0x78, 0xa2,
-	0x97, 0xf2, 0xfa, 0x6d, 0x3f, 0x4c, 0xd4, 0xe2, 0xe9, 0x47, 0x4d, 0xaa, 0x26, 0x70, 0xbe, 0x81,
-	0x50, 0x84, 0x19, 0xe3, 0xf3, 0xd4, 0xe0, 0x72, 0xc3, 0xb6, 0x5d, 0xa7, 0xd3, 0xc9, 0xf6, 0xd9,
-	0xa8, 0x7b, 0xd6, 0x7e, 0xd7, 0xe9, 0x94, 0x24, 0x75, 0x65, 0x3c, 0xd1, 0xe5, 0x9c, 0x76, 0xa3,
-	0x6e, 0x25, 0x31, 0x66, 0x77, 0x90, 0xfa, 0xba, 0x40, 0xc0, 0x1d, 0xa4, 0xbe, 0xce, 0x91, 0xac,
-	0xb5, 0xb5, 0x7b, 0x7e, 0xa5, 0x81, 0x8b, 0x2b, 0x0d, 0xfc, 0xba, 0xd2, 0xc0, 0xbb, 0x6b, 0x4d,
-	0xba, 0xb8, 0xd6, 0xa4, 0xef, 0xd7, 0x9a, 0xf4, 0xe6, 0x69, 0xee, 0x2e, 0x63, 0x1c, 0x22, 0x1c,
-	0x0d, 0x49, 0x18, 0xdf, 0x7a, 0x7d, 0x27, 0xb7, 0x22, 0x7e, 0xbd, 0xbd, 0x19, 0xfe, 0x16, 0x36,
-	0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x56, 0x83, 0x81, 0xb3, 0x03, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x8b, 0xdb, 0x46,
+	0x14, 0x96, 0x6c, 0x65, 0x13, 0xcf, 0xba, 0x8b, 0x32, 0xdd, 0x04, 0x45, 0xa5, 0x5a, 0x35, 0x0d,
+	0xd4, 0x2c, 0xac, 0xb4, 0xf6, 0x52, 0x0a, 0xbd, 0xad, 0x2c, 0xb9, 0x6b, 0xe8, 0x6e, 0x16, 0xd9,
+	0x21, 0xdd, 0x5e, 0x84, 0xac, 0x99, 0xd8, 0x43, 0x2c, 0x8d, 0xd1, 0xcc, 0x6e, 0xe3, 0x7b, 0x0f,
+	0xc1, 0xa7, 0x42, 0x6f, 0x05, 0x43, 0xa1, 0x97, 0xd2, 0x53, 0x0e, 0xf9, 0x23, 0x72, 0x5c, 0x72,
+	0x2a, 0x3d, 0xa4, 0x65, 0xf7, 0x90, 0x6b, 0xff, 0x84, 0xa2, 0xd1, 0xd4, 0x68, 0x1b, 0x92, 0xe6,
+	0x62, 0xbf, 0x1f, 0xdf, 0xa7, 0xf7, 0xde, 0xa7, 0x0f, 0x81, 0x7b, 0x8f, 0x4e, 0x33, 0x94, 0xc7,
+	0x84, 0x91, 0x6c, 0xec, 0x56, 0xe3, 0xb3, 0xb6, 0x3b, 0x22, 0xc8, 0x99, 0xe5, 0x94, 0x53, 0x68,
+	0x56, 0x3a, 0x4e, 0x35, 0x3e, 0x6b, 0x9b, 0x37, 0xe3, 0x94, 0x64, 0xd4, 0x15, 0xbf, 0x25, 0xdc,
+	0xb4, 0x12, 0xca, 0x52, 0xca, 0xdc, 0x51, 0xcc, 0xb0, 0x7b, 0xd6, 0x1e, 0x61, 0x1e, 0xb7, 0xdd,
+	0x84, 0x92, 0x4c, 0xf6, 0xef, 0x94, 0xfd, 0x48, 0x64, 0x6e, 0x99, 0xc8, 0xd6, 0xe6, 0x98, 0x8e,
+	0x69, 0x59, 0x2f, 0xa2, 0xb2, 0x7a, 0xf7, 0x47, 0x0d, 0xd4, 0x3d, 0x82, 0xe0, 0xc7, 0x00, 0xc4,
+	0xa7, 0x09, 0x27, 0x34, 0x8b, 0x08, 0x32, 0x54, 0x5b, 0x6d, 0x69, 0x61, 0x43, 0x56, 0xfa, 0x08,
+	0xde, 0x06, 0x6b, 0x23, 0x82, 0x10, 0xce, 0x8d, 0x9a, 0xad, 0xb6, 0x1a, 0xa1, 0xcc, 0xe0, 0x06,
+	0xa8, 0x11, 0x64, 0xd4, 0x05, 0xbc, 0x46, 0x10, 0xfc, 0x02, 0x68, 0x7c, 0x3e, 0xc3, 0x86, 0x66,
+	0xab, 0xad, 0x8d, 0xce, 0xa7, 0xce, 0xdb, 0xaf, 0x73, 0x3c, 0x82, 0x86, 0xf3, 0x19, 0x0e, 0x05,
+	0x01, 0x7e, 0x05, 0xae, 0xcd, 0x72, 0x92, 0x60, 0xe3, 0x5a, 0xf1, 0x7c, 0xaf, 0xfd, 0xe2, 0xd5,
+	0x96, 0xf2, 0xc7, 0xab, 0xad, 0x8f, 0xca, 0x13, 0x18, 0x7a, 0xec, 0x10, 0xea, 0xa6, 0x31, 0x9f,
+	0x38, 0x5f, 0xe3, 0x71, 0x9c, 0xcc, 0x7d, 0x9c, 0xbc, 0x7c, 0xbe, 0x03, 0xe4, 0x85, 0x3e, 0x4e,
+	0xc2, 0x92, 0x0f, 0x39, 0xd0, 0x0a, 0x3d, 0x8c, 0x35, 0x5b, 0x6d, 0xad, 0x77, 0xee, 0x38, 0x12,
+	0x51, 0x08, 0xe6, 0x48, 0xc1, 0x9c, 0x2e, 0x25, 0x99, 0x17, 0x14, 0x23, 0x7e, 0xfb, 0x73, 0xeb,
+	0xb3, 0x31, 0xe1, 0x93, 0xd3, 0x91, 0x93, 0xd0, 0x54, 0x0a, 0x26, 0xff, 0x76, 0x18, 0x7a, 0xec,
+	0x16, 0xcb, 0x31, 0x41, 0xf8, 0xe9, 0xf5, 0xb3, 0xed, 0xe6, 0x54, 0x0c, 0x8f, 0x8a, 0x09, 0xec,
+	0xd7, 0xd7, 0xcf, 0xb6, 0xd5, 0x50, 0x4c, 0x2b, 0xe4, 0x23, 0x2c, 0x4a, 0x63, 0x9e, 0x4c, 0x30,
+	0x32, 0xae, 0xdb, 0x6a, 0xeb, 0x46, 0xd8, 0x20, 0xec, 0xb0, 0x2c, 0xc0, 0xef, 0x55, 0xd0, 0xc4,
+	0x2c, 0xc9, 0xe9, 0x77, 0x18, 0x45, 0x8f, 0x30, 0x36, 0x6e, 0xd8, 0xf5, 0x77, 0x6f, 0xd7, 0x93,
+	0xdb, 0xb5, 0xde, 0x73, 0x3b, 0xf6, 0x96, 0xf5, 0xd6, 0xff, 0x1d, 0xdb, 0xc3, 0x18, 0x7e, 0x02,
+	0x9a, 0xb3, 0x78, 0x4e, 0xb2, 0x71, 0x84, 0x70, 0x46, 0x53, 0xa3, 0x21, 0xde, 0xe5, 0x7a, 0x59,
+	0xf3, 0x8b, 0xd2, 0x97, 0xda, 0xd3, 0x9f, 0xb7, 0x94, 0xbb, 0xe7, 0x2a, 0xf8, 0xc0, 0x23, 0xa8,
+	0x4b, 0xd3, 0x94, 0xf0, 0x14, 0x67, 0xfc, 0xff, 0xfc, 0x51, 0xfa, 0xa0, 0xb6, 0xf2, 0xc1, 0xee,
+	0xca, 0x2f, 0x75, 0xf1, 0x3e, 0x8d, 0x97, 0xcf, 0x77, 0x36, 0xe5, 0xb1, 0xfb, 0x08, 0xe5, 0x98,
+	0xb1, 0x01, 0xcf, 0x49, 0x36, 0x5e, 0x39, 0x09, 0x02, 0x6d, 0x12, 0xb3, 0x89, 0x70, 0x4e, 0x33,
+	0x14, 0x31, 0xf4, 0x01, 0x48, 0xe8, 0x74, 0x1a, 0x73, 0x9c, 0xc7, 0x53, 0xe1, 0x8c, 0x77, 0x6a,
+	0xd6, 0x28, 0x34, 0x2b, 0xcf, 0xae, 0xf0, 0xca, 0x93, 0xb6, 0xff, 0x56, 0xc1, 0x75, 0x69, 0x39,
+	0xd8, 0x02, 0x9b, 0x5e, 0xdf, 0x8f, 0x86, 0x27, 0xc7, 0x41, 0xf4, 0xe0, 0x68, 0x70, 0x1c, 0x74,
+	0xfb, 0xbd, 0x7e, 0xe0, 0xeb, 0x8a, 0xb9, 0xb1, 0x58, 0xda, 0x40, 0xc2, 0x8e, 0xc8, 0x14, 0xba,
+	0x15, 0x64, 0xaf, 0xff, 0x4d, 0xe0, 0x47, 0xc7, 0x61, 0xbf, 0x1b, 0xe8, 0xaa, 0x79, 0x6b, 0xb1,
+	0xb4, 0x6f, 0x4a, 0x64, 0x8f, 0x3c, 0xc1, 0xe8, 0x58, 0xd8, 0xaf, 0x4a, 0xf0, 0xf6, 0x87, 0xdd,
+	0x83, 0xe8, 0xe1, 0xfd, 0x70, 0x78, 0xa0, 0xd7, 0xae, 0x10, 0xbc, 0xc2, 0x17, 0x0f, 0x69, 0xce,
+	0x27, 0x70, 0x07, 0x7c, 0xf8, 0x1f, 0xc2, 0xe1, 0xfe, 0xd1, 0x89, 0x5e, 0x37, 0x37, 0x17, 0x4b,
+	0x5b, 0xaf, 0xe2, 0x0f, 0xe3, 0x6c, 0x0e, 0xef, 0x81, 0x8d, 0x15, 0xdc, 0x7f, 0x30, 0xec, 0x1e,
+	0xe8, 0x9a, 0xa9, 0x2f, 0x96, 0x76, 0x53, 0x22, 0xfd, 0x53, 0x9e, 0x4c, 0x4c, 0xed, 0xe9, 0x2f,
+	0x96, 0xb2, 0x3d, 0x07, 0xeb, 0x52, 0x6b, 0x71, 0x75, 0x1b, 0xdc, 0xda, 0xf7, 0xfd, 0x30, 0x18,
+	0x0c, 0x4a, 0xfa, 0x5e, 0x27, 0xf2, 0x4e, 0x86, 0xc1, 0x40, 0x57, 0xcc, 0xdb, 0x8b, 0xa5, 0x0d,
+	0x2b, 0xd8, 0xbd, 0x8e, 0x37, 0xe7, 0x98, 0xbd, 0x41, 0xe9, 0xec, 0x4a, 0x8a, 0xfa, 0x06, 0xa5,
+	0xb3, 0x2b, 0x28, 0xe5, 0x68, 0xef, 0xfe, 0x8b, 0x0b, 0x4b, 0x3d, 0xbf, 0xb0, 0xd4, 0xbf, 0x2e,
+	0x2c, 0xf5, 0x87, 0x4b, 0x4b, 0x39, 0xbf, 0xb4, 0x94, 0xdf, 0x2f, 0x2d, 0xe5, 0xdb, 0xcf, 0x2b,
+	0x86, 0xe6, 0x38, 0x43, 0x38, 0x4f, 0x49, 0xc6, 0xaf, 0x7c, 0x20, 0x9f, 0x5c, 0xc9, 0x84, 0xc7,
+	0x47, 0x6b, 0xe2, 0x73, 0xb5, 0xf7, 0xcf, 0x00, 0xc8, 0x76, 0x47, 0x9b, 0x56, 0x05, 0x00, 0x00,
 }
 
 func (m *Bid) Marshal() (dAtA []byte, err error) {
@@ -222,6 +294,27 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PayingDenom) > 0 {
+		i -= len(m.PayingDenom)
+		copy(dAtA[i:], m.PayingDenom)
+		i = encodeVarintBid(dAtA, i, uint64(len(m.PayingDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.EscrowedFee) > 0 {
+		for iNdEx := len(m.EscrowedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.IsMatched {
 		i--
 		if m.IsMatched {
@@ -277,6 +370,63 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBid(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintBid(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintBid(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintBid(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBid(v)
 	base := offset
@@ -314,6 +464,41 @@ func (m *Bid) Size() (n int) {
 	if m.IsMatched {
 		n += 2
 	}
+	if len(m.EscrowedFee) > 0 {
+		for _, e := range m.EscrowedFee {
+			l = e.Size()
+			n += 1 + l + sovBid(uint64(l))
+		}
+	}
+	l = len(m.PayingDenom)
+	if l > 0 {
+		n += 1 + l + sovBid(uint64(l))
+	}
+	return n
+}
+
+func (m *BidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovBid(uint64(m.AuctionId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBid(uint64(m.Id))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovBid(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBid(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovBid(uint64(l))
 	return n
 }
 
@@ -528,6 +713,259 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMatched = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedFee = append(m.EscrowedFee, types.Coin{})
+			if err := m.EscrowedFee[len(m.EscrowedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBid
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBid(dAtA[iNdEx:])
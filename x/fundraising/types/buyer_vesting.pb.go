@@ -0,0 +1,480 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fundraising/fundraising/v1/buyer_vesting.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BuyerVesting defines the selling coin allocated to a bidder that is locked
+// in the buyer vesting reserve account and unlocked by the buyer vesting
+// schedules of the auction.
+type BuyerVesting struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// allocated_coin specifies the selling coin allocated to the bidder
+	AllocatedCoin types.Coin `protobuf:"bytes,3,opt,name=allocated_coin,json=allocatedCoin,proto3" json:"allocated_coin"`
+	// claimed_coin specifies the selling coin already claimed by the bidder
+	ClaimedCoin types.Coin `protobuf:"bytes,4,opt,name=claimed_coin,json=claimedCoin,proto3" json:"claimed_coin"`
+}
+
+func (m *BuyerVesting) Reset()         { *m = BuyerVesting{} }
+func (m *BuyerVesting) String() string { return proto.CompactTextString(m) }
+func (*BuyerVesting) ProtoMessage()    {}
+func (*BuyerVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec0866a22a99322, []int{0}
+}
+func (m *BuyerVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyerVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyerVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuyerVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerVesting.Merge(m, src)
+}
+func (m *BuyerVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyerVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyerVesting proto.InternalMessageInfo
+
+func (m *BuyerVesting) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *BuyerVesting) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BuyerVesting) GetAllocatedCoin() types.Coin {
+	if m != nil {
+		return m.AllocatedCoin
+	}
+	return types.Coin{}
+}
+
+func (m *BuyerVesting) GetClaimedCoin() types.Coin {
+	if m != nil {
+		return m.ClaimedCoin
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*BuyerVesting)(nil), "fundraising.fundraising.v1.BuyerVesting")
+}
+
+func init() {
+	proto.RegisterFile("fundraising/fundraising/v1/buyer_vesting.proto", fileDescriptor_eec0866a22a99322)
+}
+
+var fileDescriptor_eec0866a22a99322 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xcd, 0x4a, 0x2b, 0x31,
+	0x14, 0x9e, 0xdc, 0x5b, 0x0a, 0x4d, 0x7b, 0x2f, 0x38, 0x74, 0x31, 0x2d, 0x18, 0x8b, 0xab, 0x22,
+	0x98, 0x38, 0x8a, 0x0f, 0x60, 0x5d, 0x88, 0xb8, 0x10, 0x2a, 0xb8, 0x70, 0x33, 0x64, 0x26, 0x71,
+	0x0c, 0x74, 0x92, 0x92, 0x64, 0x06, 0xfb, 0x16, 0x3e, 0x86, 0x4b, 0x17, 0x3e, 0x44, 0x97, 0xc5,
+	0x95, 0x2b, 0x91, 0x76, 0xe1, 0x13, 0xb8, 0x97, 0x99, 0x89, 0xd2, 0x2e, 0xdd, 0x84, 0x73, 0xbe,
+	0x9f, 0x93, 0xef, 0x24, 0x10, 0xdf, 0xe6, 0x92, 0x69, 0x2a, 0x8c, 0x90, 0x29, 0x59, 0xaf, 0x8b,
+	0x90, 0xc4, 0xf9, 0x8c, 0xeb, 0xa8, 0xe0, 0xc6, 0x0a, 0x99, 0xe2, 0xa9, 0x56, 0x56, 0xf9, 0xfd,
+	0x35, 0xcd, 0xba, 0x17, 0x17, 0x61, 0x7f, 0x8b, 0x66, 0x42, 0x2a, 0x52, 0x9d, 0xb5, 0xbc, 0x8f,
+	0x12, 0x65, 0x32, 0x65, 0x48, 0x4c, 0x0d, 0x27, 0x45, 0x18, 0x73, 0x4b, 0x43, 0x92, 0x28, 0x21,
+	0x1d, 0xdf, 0xab, 0xf9, 0xa8, 0xea, 0x48, 0xdd, 0x38, 0xaa, 0x9b, 0xaa, 0x54, 0xd5, 0x78, 0x59,
+	0xd5, 0xe8, 0xee, 0x27, 0x80, 0x9d, 0x51, 0x99, 0xeb, 0xba, 0x8e, 0xe5, 0x6f, 0x43, 0x48, 0xf3,
+	0xc4, 0x0a, 0x25, 0x23, 0xc1, 0x02, 0x30, 0x00, 0xc3, 0xc6, 0xb8, 0xe5, 0x90, 0x73, 0xe6, 0x1f,
+	0xc0, 0x66, 0x2c, 0x18, 0xe3, 0x3a, 0xf8, 0x33, 0x00, 0xc3, 0xd6, 0x28, 0x78, 0x79, 0xde, 0xef,
+	0xba, 0x7b, 0x4e, 0x18, 0xd3, 0xdc, 0x98, 0x2b, 0xab, 0x85, 0x4c, 0xc7, 0x4e, 0xe7, 0x5f, 0xc0,
+	0xff, 0x74, 0x32, 0x51, 0x09, 0xb5, 0x9c, 0x45, 0x65, 0xd4, 0xe0, 0xef, 0x00, 0x0c, 0xdb, 0x87,
+	0x3d, 0xec, 0x6c, 0xe5, 0x2e, 0xd8, 0xed, 0x82, 0x4f, 0x95, 0x90, 0xa3, 0xd6, 0xfc, 0x6d, 0xc7,
+	0x7b, 0xfc, 0x78, 0xda, 0x03, 0xe3, 0x7f, 0x3f, 0xde, 0x92, 0xf1, 0xcf, 0x60, 0x27, 0x99, 0x50,
+	0x91, 0x7d, 0x8f, 0x6a, 0xfc, 0x62, 0x54, 0xdb, 0x39, 0x2b, 0xfc, 0x72, 0xbe, 0x44, 0x60, 0xb1,
+	0x44, 0xe0, 0x7d, 0x89, 0xc0, 0xc3, 0x0a, 0x79, 0x8b, 0x15, 0xf2, 0x5e, 0x57, 0xc8, 0xbb, 0x39,
+	0x4e, 0x85, 0xbd, 0xcb, 0x63, 0x9c, 0xa8, 0x8c, 0x58, 0x2e, 0x19, 0xd7, 0x99, 0x90, 0x76, 0xe3,
+	0x2f, 0xef, 0x37, 0x3a, 0x3b, 0x9b, 0x72, 0x13, 0x37, 0xab, 0xf7, 0x3c, 0xfa, 0x1a, 0x00, 0xdc,
+	0x99, 0x3c, 0x01, 0x01, 0x02, 0x00, 0x00,
+}
+
+func (m *BuyerVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyerVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyerVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyerVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.AllocatedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyerVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintBuyerVesting(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintBuyerVesting(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBuyerVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBuyerVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BuyerVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovBuyerVesting(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovBuyerVesting(uint64(l))
+	}
+	l = m.AllocatedCoin.Size()
+	n += 1 + l + sovBuyerVesting(uint64(l))
+	l = m.ClaimedCoin.Size()
+	n += 1 + l + sovBuyerVesting(uint64(l))
+	return n
+}
+
+func sovBuyerVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBuyerVesting(x uint64) (n int) {
+	return sovBuyerVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BuyerVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyerVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyerVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyerVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyerVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyerVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyerVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocatedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyerVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyerVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyerVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBuyerVesting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBuyerVesting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBuyerVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBuyerVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBuyerVesting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBuyerVesting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBuyerVesting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBuyerVesting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBuyerVesting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBuyerVesting = fmt.Errorf("proto: unexpected end of group")
+)
@@ -13,8 +13,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateFixedPriceAuction{},
 		&MsgCreateBatchAuction{},
+		&MsgCreateDutchAuction{},
 		&MsgCancelAuction{},
+		&MsgPauseAuction{},
+		&MsgResumeAuction{},
+		&MsgCloseAuctionEarly{},
+		&MsgForceCancelAuction{},
 		&MsgPlaceBid{},
+		&MsgCancelBid{},
+		&MsgClaimBuyerVesting{},
+		&MsgClaimVestedProceeds{},
+		&MsgTransferAuctionOwnership{},
+		&MsgAcceptAuctionOwnership{},
+		&MsgCommitBid{},
+		&MsgRevealBid{},
 		&MsgAddAllowedBidder{},
 		&MsgUpdateParams{},
 	)
@@ -23,6 +35,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*AuctionI)(nil),
 		&FixedPriceAuction{},
 		&BatchAuction{},
+		&DutchAuction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
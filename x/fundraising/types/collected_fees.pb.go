@@ -0,0 +1,574 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fundraising/fundraising/v1/collected_fees.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CollectedFees defines the fees collected for an auction.
+type CollectedFees struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// creation_fee specifies the fee paid by the auctioneer to create the auction
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee"`
+	// place_bid_fee specifies the total fee paid by the bidders to place bids
+	PlaceBidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=place_bid_fee,json=placeBidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"place_bid_fee"`
+	// settlement_fee specifies the fee charged on the raised paying coin when
+	// the auction is settled
+	SettlementFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=settlement_fee,json=settlementFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settlement_fee"`
+	// auctioneer_bid_fee specifies the total bid fee paid by the bidders to the
+	// auctioneer
+	AuctioneerBidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=auctioneer_bid_fee,json=auctioneerBidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"auctioneer_bid_fee"`
+}
+
+func (m *CollectedFees) Reset()         { *m = CollectedFees{} }
+func (m *CollectedFees) String() string { return proto.CompactTextString(m) }
+func (*CollectedFees) ProtoMessage()    {}
+func (*CollectedFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293cc8926f39004, []int{0}
+}
+func (m *CollectedFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectedFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedFees.Merge(m, src)
+}
+func (m *CollectedFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectedFees proto.InternalMessageInfo
+
+func (m *CollectedFees) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *CollectedFees) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
+func (m *CollectedFees) GetPlaceBidFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PlaceBidFee
+	}
+	return nil
+}
+
+func (m *CollectedFees) GetSettlementFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SettlementFee
+	}
+	return nil
+}
+
+func (m *CollectedFees) GetAuctioneerBidFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AuctioneerBidFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CollectedFees)(nil), "fundraising.fundraising.v1.CollectedFees")
+}
+
+func init() {
+	proto.RegisterFile("fundraising/fundraising/v1/collected_fees.proto", fileDescriptor_8293cc8926f39004)
+}
+
+var fileDescriptor_8293cc8926f39004 = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xb1, 0x6e, 0xe2, 0x30,
+	0x18, 0xc7, 0xe3, 0x83, 0x3b, 0xe9, 0x0c, 0x9c, 0xee, 0xa2, 0x1b, 0x28, 0x52, 0x03, 0xea, 0x84,
+	0x90, 0x1a, 0x8b, 0x56, 0x7d, 0x01, 0x90, 0x90, 0x3a, 0x55, 0x62, 0xec, 0x12, 0x39, 0xce, 0x47,
+	0x6a, 0x35, 0xb1, 0x51, 0x6c, 0x50, 0xd9, 0x5b, 0xa9, 0x5b, 0x3b, 0xf7, 0x09, 0xaa, 0x4e, 0x3c,
+	0x06, 0x23, 0x63, 0xd5, 0xa1, 0xad, 0x60, 0xe0, 0x35, 0xaa, 0x38, 0xa1, 0xc0, 0x0b, 0xb0, 0x24,
+	0xff, 0x7c, 0xf9, 0xec, 0xdf, 0xdf, 0x7f, 0xdb, 0x98, 0x0c, 0x46, 0x22, 0x48, 0x28, 0x57, 0x5c,
+	0x84, 0x3b, 0x7a, 0xdc, 0x26, 0x4c, 0x46, 0x11, 0x30, 0x0d, 0x81, 0x37, 0x00, 0x50, 0xee, 0x30,
+	0x91, 0x5a, 0xda, 0xb5, 0xad, 0x26, 0x77, 0x5b, 0x8f, 0xdb, 0xb5, 0x7f, 0x34, 0xe6, 0x42, 0x12,
+	0xf3, 0xcc, 0xda, 0x6b, 0x0e, 0x93, 0x2a, 0x96, 0x8a, 0xf8, 0x54, 0x01, 0x19, 0xb7, 0x7d, 0xd0,
+	0x34, 0x9d, 0x98, 0x8b, 0xfc, 0xff, 0xff, 0x50, 0x86, 0xd2, 0x48, 0x92, 0xaa, 0xac, 0x7a, 0xf4,
+	0x56, 0xc4, 0x95, 0xee, 0x9a, 0xde, 0x03, 0x50, 0xf6, 0x21, 0xc6, 0x74, 0xc4, 0x34, 0x97, 0xc2,
+	0xe3, 0x41, 0x15, 0x35, 0x50, 0xb3, 0xd8, 0xff, 0x9d, 0x57, 0xce, 0x03, 0xfb, 0x16, 0xe1, 0x32,
+	0x4b, 0x80, 0x9a, 0x86, 0x01, 0x40, 0xf5, 0x47, 0xa3, 0xd0, 0x2c, 0x9d, 0x1c, 0xb8, 0x19, 0xde,
+	0x4d, 0xf1, 0x6e, 0x8e, 0x77, 0xbb, 0x92, 0x8b, 0x4e, 0x6f, 0xf6, 0x5e, 0xb7, 0x5e, 0x3e, 0xea,
+	0xcd, 0x90, 0xeb, 0xab, 0x91, 0xef, 0x32, 0x19, 0x93, 0xdc, 0x6b, 0xf6, 0x3a, 0x56, 0xc1, 0x35,
+	0xd1, 0x93, 0x21, 0x28, 0x33, 0x40, 0x3d, 0xad, 0xa6, 0xad, 0x72, 0x04, 0x21, 0x65, 0x13, 0x2f,
+	0x5d, 0x80, 0x7a, 0x5e, 0x4d, 0x5b, 0xa8, 0x5f, 0x5a, 0x63, 0x7b, 0x00, 0xf6, 0x1d, 0xc2, 0x95,
+	0x61, 0x44, 0x19, 0x78, 0x3e, 0x37, 0xa9, 0x55, 0x0b, 0x7b, 0xf3, 0x61, 0xb8, 0x1d, 0x9e, 0xc6,
+	0x65, 0xdf, 0x23, 0xfc, 0x47, 0x81, 0xd6, 0x11, 0xc4, 0x20, 0xb4, 0x31, 0x52, 0xdc, 0x97, 0x91,
+	0xca, 0x06, 0x9c, 0x5a, 0x79, 0x40, 0xd8, 0xce, 0xf7, 0x09, 0x20, 0xf9, 0xce, 0xe5, 0xe7, 0xbe,
+	0xec, 0xfc, 0xdd, 0xc0, 0xb3, 0x70, 0x3a, 0x17, 0xb3, 0x85, 0x83, 0xe6, 0x0b, 0x07, 0x7d, 0x2e,
+	0x1c, 0xf4, 0xb8, 0x74, 0xac, 0xf9, 0xd2, 0xb1, 0x5e, 0x97, 0x8e, 0x75, 0x79, 0xb6, 0xc5, 0xd2,
+	0x20, 0x02, 0x48, 0x62, 0x2e, 0xf4, 0xce, 0xb5, 0xb8, 0xd9, 0xf9, 0x32, 0x78, 0xff, 0x97, 0x39,
+	0xb4, 0xa7, 0x5f, 0x03, 0x00, 0x6f, 0x46, 0x91, 0x01, 0x4c, 0x03, 0x00, 0x00,
+}
+
+func (m *CollectedFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctioneerBidFee) > 0 {
+		for iNdEx := len(m.AuctioneerBidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctioneerBidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollectedFees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SettlementFee) > 0 {
+		for iNdEx := len(m.SettlementFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollectedFees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PlaceBidFee) > 0 {
+		for iNdEx := len(m.PlaceBidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlaceBidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollectedFees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollectedFees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintCollectedFees(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCollectedFees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCollectedFees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CollectedFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovCollectedFees(uint64(m.AuctionId))
+	}
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovCollectedFees(uint64(l))
+		}
+	}
+	if len(m.PlaceBidFee) > 0 {
+		for _, e := range m.PlaceBidFee {
+			l = e.Size()
+			n += 1 + l + sovCollectedFees(uint64(l))
+		}
+	}
+	if len(m.SettlementFee) > 0 {
+		for _, e := range m.SettlementFee {
+			l = e.Size()
+			n += 1 + l + sovCollectedFees(uint64(l))
+		}
+	}
+	if len(m.AuctioneerBidFee) > 0 {
+		for _, e := range m.AuctioneerBidFee {
+			l = e.Size()
+			n += 1 + l + sovCollectedFees(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCollectedFees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCollectedFees(x uint64) (n int) {
+	return sovCollectedFees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CollectedFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollectedFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectedFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectedFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceBidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlaceBidFee = append(m.PlaceBidFee, types.Coin{})
+			if err := m.PlaceBidFee[len(m.PlaceBidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementFee = append(m.SettlementFee, types.Coin{})
+			if err := m.SettlementFee[len(m.SettlementFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctioneerBidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctioneerBidFee = append(m.AuctioneerBidFee, types.Coin{})
+			if err := m.AuctioneerBidFee[len(m.AuctioneerBidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollectedFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollectedFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCollectedFees(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCollectedFees
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCollectedFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCollectedFees
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCollectedFees
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCollectedFees
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCollectedFees        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCollectedFees          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCollectedFees = fmt.Errorf("proto: unexpected end of group")
+)
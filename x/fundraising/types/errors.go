@@ -21,4 +21,11 @@ var (
 	ErrOverMaxBidAmountLimit       = sdkerrors.Register(ModuleName, 1111, "over maximum bid amount limit")
 	ErrInsufficientRemainingAmount = sdkerrors.Register(ModuleName, 1112, "insufficient remaining amount")
 	ErrInsufficientMinBidPrice     = sdkerrors.Register(ModuleName, 1113, "insufficient bid price")
+	ErrBidCancelNotAllowed         = sdkerrors.Register(ModuleName, 1114, "bid cancel not allowed")
+	ErrDenomNotAllowed             = sdkerrors.Register(ModuleName, 1115, "denom not allowed")
+	ErrNothingToClaim              = sdkerrors.Register(ModuleName, 1116, "nothing to claim")
+	ErrSealedBidAuction            = sdkerrors.Register(ModuleName, 1117, "auction only accepts sealed bids")
+	ErrNotSealedBidAuction         = sdkerrors.Register(ModuleName, 1118, "auction doesn't accept sealed bids")
+	ErrInvalidBidReveal            = sdkerrors.Register(ModuleName, 1119, "invalid bid reveal")
+	ErrInvalidPayingDenoms         = sdkerrors.Register(ModuleName, 1120, "invalid paying denoms")
 )
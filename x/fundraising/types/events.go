@@ -4,8 +4,15 @@ package types
 const (
 	EventTypeCreateFixedPriceAuction = "create_fixed_price_auction"
 	EventTypeCreateBatchAuction      = "create_batch_auction"
+	EventTypeCreateDutchAuction      = "create_dutch_auction"
 	EventTypeCancelAuction           = "cancel_auction"
+	EventTypePauseAuction            = "pause_auction"
+	EventTypeResumeAuction           = "resume_auction"
+	EventTypeCloseAuctionEarly       = "close_auction_early"
+	EventTypeForceCancelAuction      = "force_cancel_auction"
 	EventTypePlaceBid                = "place_bid"
+	EventTypeCancelBid               = "cancel_bid"
+	EventTypeTransitionFailed        = "auction_transition_failed"
 
 	AttributeKeyAuctionId             = "auction_id" //nolint:golint
 	AttributeKeyAuctioneerAddress     = "auctioneer_address"
@@ -21,10 +28,39 @@ const (
 	AttributeKeyStartTime             = "start_time"
 	AttributeKeyEndTime               = "end_time"
 	AttributeKeyBidderAddress         = "bidder_address"
+	AttributeKeyBidId                 = "bid_id" //nolint:golint
 	AttributeKeyBidPrice              = "bid_price"
 	AttributeKeyBidCoin               = "bid_coin"
 	AttributeKeyBidAmount             = "bid_amount"
 	AttributeKeyMinBidPrice           = "min_bid_price"
 	AttributeKeyMaxExtendedRound      = "maximum_extended_round"
 	AttributeKeyExtendedRoundRate     = "extended_round_rate"
+	AttributeKeyFloorPrice            = "floor_price"
+	AttributeKeyDecayInterval         = "decay_interval"
+	AttributeKeyBidCancelCutoff       = "bid_cancel_cutoff"
+	AttributeKeyMarginalFillMode      = "marginal_fill_mode"
+	AttributeKeyPricingMode           = "pricing_mode"
+	AttributeKeySaleMode              = "sale_mode"
+	AttributeKeySealedBid             = "sealed_bid"
+	AttributeKeyRefundCoin            = "refund_coin"
+	AttributeKeyError                 = "error"
+	AttributeKeyAttempts              = "attempts"
+	AttributeKeySender                = "sender"
+)
+
+// Sources of the refunds in EventAuctionForceCancelled.
+const (
+	RefundSourceSellingReserve      = "selling_reserve"
+	RefundSourcePayingReserve       = "paying_reserve"
+	RefundSourceVestingReserve      = "vesting_reserve"
+	RefundSourceBidFeeEscrow        = "bid_fee_escrow"
+	RefundSourceBuyerVestingReserve = "buyer_vesting_reserve"
+)
+
+// Types of the fees in EventFeeCollected and CollectedFees.
+const (
+	FeeTypeCreation      = "creation"
+	FeeTypePlaceBid      = "place_bid"
+	FeeTypeSettlement    = "settlement"
+	FeeTypeAuctioneerBid = "auctioneer_bid"
 )
@@ -22,6 +22,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
@@ -30,6 +31,12 @@ type DistrKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
+// PriceSource defines the expected interface of a price source that provides the conversion ratio
+// of a denom into another, which is the amount of the base denom that one unit of the denom is worth (noalias)
+type PriceSource interface {
+	ConversionRatio(ctx context.Context, denom, baseDenom string) (math.LegacyDec, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -120,6 +127,13 @@ type FundraisingHooks interface {
 		coin sdk.Coin,
 	) error
 
+	BeforeBidCanceled(
+		ctx context.Context,
+		auctionId uint64,
+		bidId uint64,
+		bidder string,
+	) error
+
 	BeforeAllowedBiddersAdded(
 		ctx context.Context,
 		allowedBidders []AllowedBidder,
@@ -140,6 +154,38 @@ type FundraisingHooks interface {
 	) error
 }
 
+// DutchAuctionHooks event hooks for dutch auction objects (noalias)
+// The fundraising hooks may implement it optionally, so that the existing
+// implementations of FundraisingHooks don't need to be changed.
+type DutchAuctionHooks interface {
+	BeforeDutchAuctionCreated(
+		ctx context.Context,
+		auctioneer string,
+		startPrice math.LegacyDec,
+		floorPrice math.LegacyDec,
+		sellingCoin sdk.Coin,
+		payingCoinDenom string,
+		vestingSchedules []VestingSchedule,
+		decayInterval time.Duration,
+		startTime time.Time,
+		endTime time.Time,
+	) error
+
+	AfterDutchAuctionCreated(
+		ctx context.Context,
+		auctionId uint64,
+		auctioneer string,
+		startPrice math.LegacyDec,
+		floorPrice math.LegacyDec,
+		sellingCoin sdk.Coin,
+		payingCoinDenom string,
+		vestingSchedules []VestingSchedule,
+		decayInterval time.Duration,
+		startTime time.Time,
+		endTime time.Time,
+	) error
+}
+
 type FundraisingHooksWrapper struct{ FundraisingHooks }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
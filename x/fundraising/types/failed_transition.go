@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// TransitionRetryBaseDelay is the delay of the first retry of a failed auction state transition.
+	// The delay doubles on every failed attempt up to TransitionRetryMaxDelay.
+	TransitionRetryBaseDelay = 5 * time.Second
+
+	// TransitionRetryMaxDelay is the maximum delay between the retries of a failed auction state transition.
+	TransitionRetryMaxDelay = 24 * time.Hour
+)
+
+// RetryDelay returns the delay after which the failed auction state transition is retried,
+// which grows exponentially with the number of the failed attempts.
+func (f FailedTransition) RetryDelay() time.Duration {
+	delay := TransitionRetryBaseDelay
+	for i := uint64(1); i < f.Attempts; i++ {
+		delay *= 2
+		if delay >= TransitionRetryMaxDelay {
+			return TransitionRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// Validate validates FailedTransition.
+func (f FailedTransition) Validate() error {
+	if f.Attempts == 0 {
+		return fmt.Errorf("attempts must be positive: %d", f.Attempts)
+	}
+	if f.Time.IsZero() {
+		return fmt.Errorf("time of the failure must be set")
+	}
+	return nil
+}
@@ -0,0 +1,531 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fundraising/fundraising/v1/failed_transition.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FailedTransition defines the record of an auction state transition that failed
+// in the begin blocker. The transition is retried in the next block.
+type FailedTransition struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// auction_status specifies the status of the auction when the transition failed
+	AuctionStatus AuctionStatus `protobuf:"varint,2,opt,name=auction_status,json=auctionStatus,proto3,enum=fundraising.fundraising.v1.AuctionStatus" json:"auction_status,omitempty"`
+	// error specifies the error message of the failure
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// height specifies the block height of the last failure
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time specifies the block time of the last failure
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// attempts specifies the number of consecutive failed attempts
+	Attempts uint64 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *FailedTransition) Reset()         { *m = FailedTransition{} }
+func (m *FailedTransition) String() string { return proto.CompactTextString(m) }
+func (*FailedTransition) ProtoMessage()    {}
+func (*FailedTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fe06d9bdda79a94, []int{0}
+}
+func (m *FailedTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTransition.Merge(m, src)
+}
+func (m *FailedTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTransition proto.InternalMessageInfo
+
+func (m *FailedTransition) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *FailedTransition) GetAuctionStatus() AuctionStatus {
+	if m != nil {
+		return m.AuctionStatus
+	}
+	return AuctionStatusNil
+}
+
+func (m *FailedTransition) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedTransition) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FailedTransition) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *FailedTransition) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FailedTransition)(nil), "fundraising.fundraising.v1.FailedTransition")
+}
+
+func init() {
+	proto.RegisterFile("fundraising/fundraising/v1/failed_transition.proto", fileDescriptor_2fe06d9bdda79a94)
+}
+
+var fileDescriptor_2fe06d9bdda79a94 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0x87, 0xb3, 0xfd, 0x47, 0xbb, 0x62, 0x91, 0x50, 0x24, 0x04, 0x4c, 0x83, 0xa7, 0x78, 0xd9,
+	0xd0, 0x8a, 0xe0, 0xd5, 0x1e, 0x04, 0x4f, 0x4a, 0xec, 0xc9, 0x4b, 0xd9, 0x36, 0xdb, 0x74, 0xa1,
+	0xc9, 0x86, 0xdd, 0x49, 0xd1, 0x27, 0xf0, 0xda, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x2a, 0xed, 0x8b,
+	0x48, 0x37, 0x69, 0x4d, 0x0f, 0x7a, 0xfb, 0x7d, 0xb3, 0x33, 0xc3, 0x7c, 0x2c, 0xee, 0x4f, 0xb3,
+	0x24, 0x94, 0x94, 0x2b, 0x9e, 0x44, 0x7e, 0x39, 0x2f, 0x7a, 0xfe, 0x94, 0xf2, 0x39, 0x0b, 0x47,
+	0x20, 0x69, 0xa2, 0x38, 0x70, 0x91, 0x90, 0x54, 0x0a, 0x10, 0xa6, 0x5d, 0xea, 0x23, 0xe5, 0xbc,
+	0xe8, 0xd9, 0xde, 0x3f, 0xfb, 0x68, 0x36, 0xf9, 0xdd, 0x62, 0x77, 0x22, 0x11, 0x09, 0x1d, 0xfd,
+	0x5d, 0x2a, 0xaa, 0xdd, 0x48, 0x88, 0x68, 0xce, 0x7c, 0x4d, 0xe3, 0x6c, 0xea, 0x03, 0x8f, 0x99,
+	0x02, 0x1a, 0xa7, 0x79, 0xc3, 0xe5, 0x7b, 0x05, 0x9f, 0xdd, 0xeb, 0xc3, 0x86, 0x87, 0xbb, 0xcc,
+	0x0b, 0x8c, 0x8b, 0xe5, 0x23, 0x1e, 0x5a, 0xc8, 0x45, 0x5e, 0x2d, 0x68, 0x15, 0x95, 0x87, 0xd0,
+	0x7c, 0xc2, 0xed, 0xfd, 0xb3, 0x02, 0x0a, 0x99, 0xb2, 0x2a, 0x2e, 0xf2, 0xda, 0xfd, 0x2b, 0xf2,
+	0xb7, 0x09, 0xb9, 0xcb, 0x27, 0x9e, 0xf5, 0x40, 0x70, 0x4a, 0xcb, 0x68, 0x76, 0x70, 0x9d, 0x49,
+	0x29, 0xa4, 0x55, 0x75, 0x91, 0xd7, 0x0a, 0x72, 0x30, 0xcf, 0x71, 0x63, 0xc6, 0x78, 0x34, 0x03,
+	0xab, 0xe6, 0x22, 0xaf, 0x1a, 0x14, 0x64, 0xde, 0xe2, 0xda, 0x4e, 0xc3, 0xaa, 0xbb, 0xc8, 0x3b,
+	0xe9, 0xdb, 0x24, 0x77, 0x24, 0x7b, 0x47, 0x32, 0xdc, 0x3b, 0x0e, 0x9a, 0xab, 0xcf, 0xae, 0xb1,
+	0xfc, 0xea, 0xa2, 0x40, 0x4f, 0x98, 0x36, 0x6e, 0x52, 0x00, 0x16, 0xa7, 0xa0, 0xac, 0x86, 0xd6,
+	0x3a, 0xf0, 0xe0, 0x71, 0xb5, 0x71, 0xd0, 0x7a, 0xe3, 0xa0, 0xef, 0x8d, 0x83, 0x96, 0x5b, 0xc7,
+	0x58, 0x6f, 0x1d, 0xe3, 0x63, 0xeb, 0x18, 0x2f, 0x37, 0x11, 0x87, 0x59, 0x36, 0x26, 0x13, 0x11,
+	0xfb, 0xc0, 0x92, 0x90, 0xc9, 0x98, 0x27, 0x70, 0xf4, 0x1d, 0xaf, 0x47, 0x04, 0x6f, 0x29, 0x53,
+	0xe3, 0x86, 0x3e, 0xe8, 0xfa, 0x67, 0x00, 0x87, 0xa8, 0xe4, 0x1f, 0x14, 0x02, 0x00, 0x00,
+}
+
+func (m *FailedTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintFailedTransition(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFailedTransition(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintFailedTransition(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFailedTransition(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionStatus != 0 {
+		i = encodeVarintFailedTransition(dAtA, i, uint64(m.AuctionStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFailedTransition(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFailedTransition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFailedTransition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFailedTransition(uint64(m.AuctionId))
+	}
+	if m.AuctionStatus != 0 {
+		n += 1 + sovFailedTransition(uint64(m.AuctionStatus))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFailedTransition(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFailedTransition(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFailedTransition(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovFailedTransition(uint64(m.Attempts))
+	}
+	return n
+}
+
+func sovFailedTransition(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFailedTransition(x uint64) (n int) {
+	return sovFailedTransition(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFailedTransition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			m.AuctionStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionStatus |= AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedTransition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedTransition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFailedTransition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedTransition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFailedTransition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFailedTransition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFailedTransition(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFailedTransition
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedTransition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFailedTransition
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFailedTransition
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFailedTransition
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFailedTransition        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFailedTransition          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFailedTransition = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func TestFailedTransition_RetryDelay(t *testing.T) {
+	for _, tc := range []struct {
+		attempts uint64
+		expected time.Duration
+	}{
+		{0, 5 * time.Second},
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{3, 20 * time.Second},
+		{15, 81920 * time.Second},
+		{16, 24 * time.Hour},
+		{1000, 24 * time.Hour},
+	} {
+		failed := types.FailedTransition{Attempts: tc.attempts}
+		require.Equal(t, tc.expected, failed.RetryDelay())
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -13,10 +14,15 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		AllowedBidderList: []AllowedBidder{},
-		VestingQueueList:  []VestingQueue{},
-		BidList:           []Bid{},
-		AuctionList:       []*codectypes.Any{},
+		AllowedBidderList:    []AllowedBidder{},
+		VestingQueueList:     []VestingQueue{},
+		BidList:              []Bid{},
+		AuctionList:          []*codectypes.Any{},
+		AuctionResultList:    []AuctionResult{},
+		CollectedFeesList:    []CollectedFees{},
+		BuyerVestingList:     []BuyerVesting{},
+		BidCommitmentList:    []BidCommitment{},
+		FailedTransitionList: []FailedTransition{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -39,16 +45,24 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
-	// Check for duplicated index in vestingQueue
+	// Check for duplicated index and release time in vestingQueue
 	vestingQueueIndexMap := make(map[string]struct{})
+	vestingQueueReleaseTimeMap := make(map[string]struct{})
 
 	for _, elem := range gs.VestingQueueList {
-		index := fmt.Sprint(elem.AuctionId)
+		index := fmt.Sprint(elem.AuctionId, "/", elem.Sequence)
 		if _, ok := vestingQueueIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for vestingQueue")
 		}
 		vestingQueueIndexMap[index] = struct{}{}
 
+		// Each paying denom of an auction is vested by its own vesting queues
+		releaseTime := fmt.Sprint(elem.AuctionId, "/", elem.PayingCoin.Denom, "/", elem.ReleaseTime.UnixNano())
+		if _, ok := vestingQueueReleaseTimeMap[releaseTime]; ok {
+			return fmt.Errorf("duplicated release time for vestingQueue of auction %d", elem.AuctionId)
+		}
+		vestingQueueReleaseTimeMap[releaseTime] = struct{}{}
+
 		if err := elem.Validate(); err != nil {
 			return err
 		}
@@ -83,6 +97,73 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicated ID in auctionResult
+	auctionResultIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AuctionResultList {
+		if _, ok := auctionResultIdMap[elem.AuctionId]; ok {
+			return fmt.Errorf("duplicated id for auctionResult")
+		}
+		auctionResultIdMap[elem.AuctionId] = true
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated ID in collectedFees
+	collectedFeesIdMap := make(map[uint64]bool)
+	for _, elem := range gs.CollectedFeesList {
+		if _, ok := collectedFeesIdMap[elem.AuctionId]; ok {
+			return fmt.Errorf("duplicated id for collectedFees")
+		}
+		collectedFeesIdMap[elem.AuctionId] = true
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in buyerVesting
+	buyerVestingIndexMap := make(map[string]struct{})
+	for _, elem := range gs.BuyerVestingList {
+		index := fmt.Sprintf("%d/%s", elem.AuctionId, elem.Bidder)
+		if _, ok := buyerVestingIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for buyerVesting")
+		}
+		buyerVestingIndexMap[index] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in bidCommitment
+	bidCommitmentIndexMap := make(map[string]struct{})
+	for _, elem := range gs.BidCommitmentList {
+		index := fmt.Sprint(elem.AuctionId, "/", elem.Id)
+		if _, ok := bidCommitmentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for bidCommitment")
+		}
+		bidCommitmentIndexMap[index] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated ID in failedTransition
+	failedTransitionIdMap := make(map[uint64]bool)
+	for _, elem := range gs.FailedTransitionList {
+		if _, ok := failedTransitionIdMap[elem.AuctionId]; ok {
+			return fmt.Errorf("duplicated id for failedTransition")
+		}
+		failedTransitionIdMap[elem.AuctionId] = true
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -102,6 +183,31 @@ func (b Bid) Validate() error {
 	if !b.Coin.Amount.IsPositive() {
 		return fmt.Errorf("coin amount must be positive: %s", b.Coin.Amount.String())
 	}
+	if err := b.EscrowedFee.Validate(); err != nil {
+		return fmt.Errorf("escrowed fee is invalid: %v", err)
+	}
+	if b.PayingDenom != "" {
+		if err := sdk.ValidateDenom(b.PayingDenom); err != nil {
+			return fmt.Errorf("paying denom is invalid: %v", err)
+		}
+	}
+	return nil
+}
+
+// Validate validates BidCommitment.
+func (c BidCommitment) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Bidder); err != nil {
+		return err
+	}
+	if len(c.Hash) != sha256.Size {
+		return fmt.Errorf("hash must be %d bytes: %d", sha256.Size, len(c.Hash))
+	}
+	if err := c.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !c.Collateral.Amount.IsPositive() {
+		return fmt.Errorf("collateral amount must be positive: %s", c.Collateral.Amount.String())
+	}
 	return nil
 }
 
@@ -113,5 +219,72 @@ func (q VestingQueue) Validate() error {
 	if err := q.PayingCoin.Validate(); err != nil {
 		return fmt.Errorf("paying coin is invalid: %v", err)
 	}
+	if q.ReleasedCoin != nil {
+		if err := q.ReleasedCoin.Validate(); err != nil {
+			return fmt.Errorf("released coin is invalid: %v", err)
+		}
+		if q.ReleasedCoin.Denom != q.PayingCoin.Denom || q.ReleasedCoin.Amount.GT(q.PayingCoin.Amount) {
+			return fmt.Errorf("released coin %s must not exceed paying coin %s", q.ReleasedCoin, q.PayingCoin)
+		}
+	}
+	return nil
+}
+
+// Validate validates AuctionResult.
+func (r AuctionResult) Validate() error {
+	if r.MatchedPrice.IsNil() || r.MatchedPrice.IsNegative() {
+		return fmt.Errorf("matched price must not be negative: %s", r.MatchedPrice)
+	}
+	for _, coin := range []sdk.Coin{r.SoldCoin, r.PaidCoin, r.RefundedCoin} {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+	if !r.VestedCoin.Amount.IsNil() {
+		if err := r.VestedCoin.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, coins := range []sdk.Coins{r.PaidCoins, r.RefundedCoins, r.VestedCoins} {
+		if err := coins.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, allocation := range r.BidderAllocations {
+		if _, err := sdk.AccAddressFromBech32(allocation.Bidder); err != nil {
+			return err
+		}
+		for _, coins := range []sdk.Coins{allocation.PaidCoins, allocation.RefundedCoins} {
+			if err := coins.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate validates CollectedFees.
+func (f CollectedFees) Validate() error {
+	for _, fee := range []sdk.Coins{f.CreationFee, f.PlaceBidFee, f.SettlementFee, f.AuctioneerBidFee} {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate validates BuyerVesting.
+func (v BuyerVesting) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Bidder); err != nil {
+		return err
+	}
+	for _, coin := range []sdk.Coin{v.AllocatedCoin, v.ClaimedCoin} {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+	if v.ClaimedCoin.Denom != v.AllocatedCoin.Denom || v.ClaimedCoin.Amount.GT(v.AllocatedCoin.Amount) {
+		return fmt.Errorf("claimed coin %s must not exceed allocated coin %s", v.ClaimedCoin, v.AllocatedCoin)
+	}
 	return nil
 }
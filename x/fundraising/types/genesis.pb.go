@@ -39,6 +39,19 @@ type GenesisState struct {
 	BidList []Bid `protobuf:"bytes,4,rep,name=bidList,proto3" json:"bidList"`
 	// vestingQueueList define the vesting queue records used for genesis state
 	VestingQueueList []VestingQueue `protobuf:"bytes,5,rep,name=vestingQueueList,proto3" json:"vestingQueueList"`
+	// auctionResultList define the final matching results of the closed auctions
+	AuctionResultList []AuctionResult `protobuf:"bytes,6,rep,name=auctionResultList,proto3" json:"auctionResultList"`
+	// collectedFeesList define the fees collected for the auctions
+	CollectedFeesList []CollectedFees `protobuf:"bytes,7,rep,name=collectedFeesList,proto3" json:"collectedFeesList"`
+	// buyerVestingList define the selling coin locked for the bidders by the
+	// buyer vesting schedules
+	BuyerVestingList []BuyerVesting `protobuf:"bytes,8,rep,name=buyerVestingList,proto3" json:"buyerVestingList"`
+	// bidCommitmentList define the sealed bids committed for the sealed-bid
+	// auctions that are not revealed yet
+	BidCommitmentList []BidCommitment `protobuf:"bytes,9,rep,name=bidCommitmentList,proto3" json:"bidCommitmentList"`
+	// failedTransitionList define the auction state transitions that failed in
+	// the begin blocker and are waiting to be retried
+	FailedTransitionList []FailedTransition `protobuf:"bytes,10,rep,name=failedTransitionList,proto3" json:"failedTransitionList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +122,41 @@ func (m *GenesisState) GetVestingQueueList() []VestingQueue {
 	return nil
 }
 
+func (m *GenesisState) GetAuctionResultList() []AuctionResult {
+	if m != nil {
+		return m.AuctionResultList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollectedFeesList() []CollectedFees {
+	if m != nil {
+		return m.CollectedFeesList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBuyerVestingList() []BuyerVesting {
+	if m != nil {
+		return m.BuyerVestingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBidCommitmentList() []BidCommitment {
+	if m != nil {
+		return m.BidCommitmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedTransitionList() []FailedTransition {
+	if m != nil {
+		return m.FailedTransitionList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fundraising.fundraising.v1.GenesisState")
 }
@@ -118,34 +166,43 @@ func init() {
 }
 
 var fileDescriptor_e9af9a15032fb486 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0xeb, 0xd3, 0x30,
-	0x18, 0xc6, 0x5b, 0x37, 0xa7, 0x76, 0x3b, 0xb8, 0xb2, 0xc3, 0xdc, 0xa1, 0x1b, 0x43, 0xb0, 0x0a,
-	0x26, 0x6c, 0xe2, 0x59, 0x56, 0x14, 0x11, 0x04, 0x75, 0x82, 0x87, 0x81, 0x8c, 0x74, 0xc9, 0x62,
-	0x60, 0x4d, 0x66, 0x93, 0x4e, 0xf7, 0x2d, 0xbc, 0xf9, 0x15, 0x3c, 0x7a, 0xf0, 0x43, 0x0c, 0x4f,
-	0x3b, 0x7a, 0x12, 0xd9, 0x0e, 0x7e, 0x8d, 0x3f, 0x4b, 0x52, 0xe8, 0x18, 0xff, 0x5c, 0xca, 0xfb,
-	0xf2, 0x3e, 0xcf, 0xaf, 0x4f, 0xdf, 0xb7, 0x41, 0xbc, 0x2c, 0x38, 0xce, 0x11, 0x93, 0x8c, 0x53,
-	0x58, 0xad, 0x37, 0x23, 0x48, 0x09, 0x27, 0x92, 0x49, 0xb0, 0xce, 0x85, 0x12, 0x61, 0xaf, 0x32,
-	0x05, 0xd5, 0x7a, 0x33, 0xea, 0xb5, 0x51, 0xc6, 0xb8, 0x80, 0xfa, 0x69, 0xe4, 0xbd, 0x7b, 0x0b,
-	0x21, 0x33, 0x21, 0xe7, 0xba, 0x83, 0xa6, 0xb1, 0x23, 0xe8, 0x78, 0x27, 0x5a, 0xad, 0xc4, 0x17,
-	0x82, 0xe7, 0x29, 0xc3, 0x98, 0xe4, 0xd6, 0xe0, 0x0a, 0x89, 0x8a, 0x85, 0x62, 0x82, 0x5b, 0xe5,
-	0x7d, 0x87, 0x32, 0x65, 0xd8, 0xaa, 0x1e, 0x38, 0x54, 0x6b, 0x94, 0xa3, 0xac, 0x4c, 0x0a, 0x1c,
-	0xc2, 0x0d, 0x91, 0x8a, 0x71, 0x3a, 0xff, 0x5c, 0x90, 0x82, 0x58, 0x7d, 0x87, 0x0a, 0x2a, 0xcc,
-	0x17, 0x9f, 0xaa, 0x72, 0x15, 0x54, 0x08, 0xba, 0x22, 0x50, 0x77, 0x69, 0xb1, 0x84, 0x88, 0x6f,
-	0xcd, 0x68, 0xf8, 0xbd, 0x16, 0xb4, 0x5e, 0x9a, 0x35, 0xbf, 0x57, 0x48, 0x91, 0xf0, 0x45, 0xd0,
-	0x30, 0x09, 0xba, 0xfe, 0xc0, 0x8f, 0x9b, 0xe3, 0x21, 0xb8, 0x7e, 0xed, 0xe0, 0xad, 0x56, 0x26,
-	0x77, 0x76, 0x7f, 0xfb, 0xde, 0x8f, 0xff, 0x3f, 0x1f, 0xf9, 0x53, 0x6b, 0x0e, 0x9f, 0x07, 0x4d,
-	0xbb, 0x98, 0xd7, 0x4c, 0xaa, 0xee, 0x8d, 0x41, 0x2d, 0x6e, 0x8e, 0x3b, 0xc0, 0x04, 0x01, 0x65,
-	0x10, 0x30, 0xe1, 0xdb, 0xa4, 0xf5, 0xfb, 0xd7, 0xe3, 0xdb, 0x13, 0x23, 0x7e, 0x35, 0xad, 0xda,
-	0xc2, 0x8f, 0x41, 0xdb, 0xde, 0x23, 0xd1, 0xe7, 0xd0, 0xac, 0x9a, 0x66, 0x3d, 0x74, 0xe5, 0x9a,
-	0x54, 0x4d, 0x49, 0xfd, 0x14, 0x6f, 0x7a, 0x49, 0x0a, 0x9f, 0x05, 0xb7, 0x52, 0x86, 0x35, 0xb4,
-	0xae, 0xa1, 0x7d, 0x17, 0x34, 0x61, 0xd8, 0xa2, 0x4a, 0x57, 0x38, 0x0b, 0xee, 0xda, 0x2b, 0xbc,
-	0x3b, 0x1d, 0x41, 0x93, 0x6e, 0x6a, 0x52, 0xec, 0x22, 0x7d, 0xa8, 0x78, 0x2c, 0xf2, 0x82, 0x93,
-	0xbc, 0xd9, 0x1d, 0x22, 0x7f, 0x7f, 0x88, 0xfc, 0x7f, 0x87, 0xc8, 0xff, 0x76, 0x8c, 0xbc, 0xfd,
-	0x31, 0xf2, 0xfe, 0x1c, 0x23, 0x6f, 0xf6, 0x94, 0x32, 0xf5, 0xa9, 0x48, 0xc1, 0x42, 0x64, 0x50,
-	0x11, 0x8e, 0x49, 0x9e, 0x31, 0xae, 0xce, 0x7e, 0x8f, 0xaf, 0x67, 0x9d, 0xda, 0xae, 0x89, 0x4c,
-	0x1b, 0x7a, 0xeb, 0x4f, 0xae, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xc6, 0x83, 0x0e, 0x72, 0x03,
-	0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x5a, 0xd2, 0xd6, 0xed, 0x81, 0x5a, 0x39, 0x94, 0x1c, 0xdc, 0xaa, 0x42, 0x22,
+	0x20, 0xb0, 0xd5, 0x20, 0xce, 0x28, 0x2e, 0x14, 0x21, 0x21, 0x01, 0x01, 0x71, 0xa8, 0x84, 0x2c,
+	0x3b, 0x3b, 0x31, 0x2b, 0xd9, 0xbb, 0xc1, 0xbb, 0x0e, 0xe4, 0x2d, 0x78, 0x0c, 0x8e, 0x1c, 0x78,
+	0x88, 0x8a, 0x53, 0x8f, 0x9c, 0x10, 0x4a, 0x0e, 0xbc, 0x02, 0x47, 0xe4, 0xd9, 0x75, 0xb4, 0x4d,
+	0xc0, 0xc9, 0xc5, 0xda, 0x95, 0xe7, 0xff, 0xe6, 0x9f, 0xd9, 0x19, 0xbb, 0x33, 0x2c, 0x18, 0xc9,
+	0x23, 0x2a, 0x28, 0x4b, 0x7c, 0xf3, 0x3c, 0x3e, 0xf1, 0x13, 0x60, 0x20, 0xa8, 0xf0, 0x46, 0x39,
+	0x97, 0xdc, 0x69, 0x1b, 0x7f, 0x3d, 0xf3, 0x3c, 0x3e, 0x69, 0xef, 0x47, 0x19, 0x65, 0xdc, 0xc7,
+	0xaf, 0x0a, 0x6f, 0xdf, 0x1c, 0x70, 0x91, 0x71, 0x11, 0xe2, 0xcd, 0x57, 0x17, 0xfd, 0xcb, 0xaf,
+	0xc9, 0x19, 0xa5, 0x29, 0xff, 0x08, 0x24, 0x8c, 0x29, 0x21, 0x90, 0x6b, 0x41, 0x9d, 0xc9, 0xa8,
+	0x18, 0x48, 0xca, 0xd9, 0x3a, 0x68, 0x15, 0x19, 0xe6, 0x20, 0x8a, 0x54, 0x6a, 0xc1, 0xad, 0x1a,
+	0x41, 0x4c, 0x89, 0x8e, 0xf2, 0xea, 0xa2, 0x8a, 0x09, 0xe4, 0xe1, 0x18, 0x84, 0x2c, 0x9b, 0xb1,
+	0xda, 0xc6, 0x80, 0xa7, 0x29, 0x0c, 0x24, 0x90, 0x70, 0x08, 0x50, 0xb5, 0xa4, 0x5b, 0x23, 0x18,
+	0x46, 0x34, 0x05, 0x12, 0xca, 0x3c, 0x62, 0x82, 0x1a, 0xb5, 0xde, 0xae, 0xd1, 0x8c, 0xa2, 0x3c,
+	0xca, 0xc4, 0x1a, 0xee, 0xb5, 0xef, 0xf0, 0x43, 0x01, 0x05, 0xe8, 0xf8, 0x56, 0xc2, 0x13, 0x8e,
+	0x47, 0xbf, 0x3c, 0x55, 0x0f, 0x9a, 0x70, 0x9e, 0xa4, 0xe0, 0xe3, 0x2d, 0x2e, 0x86, 0x7e, 0xc4,
+	0x26, 0xea, 0xd7, 0xf1, 0x9f, 0xa6, 0xbd, 0xf7, 0x54, 0x0d, 0xcb, 0x6b, 0x19, 0x49, 0x70, 0x9e,
+	0xd8, 0x4d, 0xe5, 0xe0, 0xc0, 0x3a, 0xb2, 0x3a, 0xbb, 0xdd, 0x63, 0xef, 0xff, 0xc3, 0xe3, 0xbd,
+	0xc4, 0xc8, 0x60, 0xe7, 0xe2, 0xe7, 0x61, 0xe3, 0xcb, 0xef, 0xaf, 0x77, 0xad, 0xbe, 0x16, 0x3b,
+	0x8f, 0xed, 0x5d, 0xfd, 0x68, 0xcf, 0xa9, 0x90, 0x07, 0xd7, 0x8e, 0x36, 0x3a, 0xbb, 0xdd, 0x96,
+	0xa7, 0x8c, 0x78, 0x95, 0x11, 0xaf, 0xc7, 0x26, 0xc1, 0xde, 0xf7, 0x6f, 0xf7, 0xb7, 0x7b, 0x2a,
+	0xf8, 0x59, 0xdf, 0x94, 0x39, 0xef, 0xec, 0x7d, 0x3d, 0x55, 0x01, 0x0e, 0x15, 0xb2, 0x36, 0x90,
+	0x75, 0xa7, 0xce, 0x57, 0xcf, 0x14, 0x05, 0x9b, 0xa5, 0xbd, 0xfe, 0x32, 0xc9, 0x79, 0x64, 0x6f,
+	0xc5, 0x94, 0x20, 0x74, 0x13, 0xa1, 0x87, 0x75, 0xd0, 0x80, 0x12, 0x8d, 0xaa, 0x54, 0xce, 0xb9,
+	0x7d, 0x43, 0xbf, 0xc2, 0xab, 0xf2, 0x11, 0x90, 0x74, 0x1d, 0x49, 0x9d, 0x3a, 0xd2, 0x5b, 0x43,
+	0xa3, 0x91, 0x4b, 0x1c, 0xac, 0x5d, 0xb5, 0xa2, 0x8f, 0x53, 0x8f, 0xf0, 0xe6, 0x1a, 0xb5, 0x9b,
+	0xa2, 0x79, 0xed, 0x8b, 0xa4, 0x12, 0x3f, 0x1f, 0xe7, 0x33, 0x00, 0x81, 0xf8, 0xad, 0xd5, 0xf8,
+	0x53, 0x53, 0x54, 0xe1, 0x97, 0x48, 0x65, 0x67, 0x70, 0xbb, 0x74, 0xa9, 0x48, 0xdf, 0x5e, 0xdd,
+	0x99, 0xc0, 0xd0, 0x54, 0x9d, 0x59, 0xe4, 0x94, 0xd6, 0x63, 0x4a, 0x4e, 0x79, 0x96, 0x51, 0x99,
+	0x01, 0x53, 0x9d, 0xd9, 0x59, 0x6d, 0x3d, 0x30, 0x45, 0x95, 0xf5, 0x25, 0x92, 0x33, 0xb4, 0x5b,
+	0x6a, 0x6f, 0xdf, 0xcc, 0xd7, 0x16, 0x33, 0xd8, 0x98, 0xe1, 0x5e, 0x5d, 0x86, 0xb3, 0x05, 0x9d,
+	0x4e, 0xf2, 0x4f, 0x5e, 0xf0, 0xe2, 0x62, 0xea, 0x5a, 0x97, 0x53, 0xd7, 0xfa, 0x35, 0x75, 0xad,
+	0xcf, 0x33, 0xb7, 0x71, 0x39, 0x73, 0x1b, 0x3f, 0x66, 0x6e, 0xe3, 0xfc, 0x61, 0x42, 0xe5, 0xfb,
+	0x22, 0xf6, 0x06, 0x3c, 0xf3, 0x25, 0x30, 0x02, 0x79, 0x46, 0x99, 0xbc, 0xb2, 0xff, 0x9f, 0xae,
+	0xdc, 0xe4, 0x64, 0x04, 0x22, 0x6e, 0xe2, 0x5a, 0x3d, 0xf8, 0x3b, 0x00, 0x6c, 0xb8, 0x05, 0x07,
+	0x19, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -168,6 +225,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedTransitionList) > 0 {
+		for iNdEx := len(m.FailedTransitionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedTransitionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BidCommitmentList) > 0 {
+		for iNdEx := len(m.BidCommitmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidCommitmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BuyerVestingList) > 0 {
+		for iNdEx := len(m.BuyerVestingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CollectedFeesList) > 0 {
+		for iNdEx := len(m.CollectedFeesList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFeesList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AuctionResultList) > 0 {
+		for iNdEx := len(m.AuctionResultList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionResultList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.VestingQueueList) > 0 {
 		for iNdEx := len(m.VestingQueueList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -280,6 +407,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AuctionResultList) > 0 {
+		for _, e := range m.AuctionResultList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollectedFeesList) > 0 {
+		for _, e := range m.CollectedFeesList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BuyerVestingList) > 0 {
+		for _, e := range m.BuyerVestingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidCommitmentList) > 0 {
+		for _, e := range m.BidCommitmentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedTransitionList) > 0 {
+		for _, e := range m.FailedTransitionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -487,6 +644,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionResultList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionResultList = append(m.AuctionResultList, AuctionResult{})
+			if err := m.AuctionResultList[len(m.AuctionResultList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFeesList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFeesList = append(m.CollectedFeesList, CollectedFees{})
+			if err := m.CollectedFeesList[len(m.CollectedFeesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestingList = append(m.BuyerVestingList, BuyerVesting{})
+			if err := m.BuyerVestingList[len(m.BuyerVestingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCommitmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidCommitmentList = append(m.BidCommitmentList, BidCommitment{})
+			if err := m.BidCommitmentList[len(m.BidCommitmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransitionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedTransitionList = append(m.FailedTransitionList, FailedTransition{})
+			if err := m.FailedTransitionList[len(m.FailedTransitionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
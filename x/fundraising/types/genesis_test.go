@@ -41,6 +41,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			Status:    types.AuctionStatusStarted,
 		},
 		sdk.NewInt64Coin("denom1", 1_000_000_000_000),
+		types.SaleModeFirstCome,
 	)
 
 	validAllowedBidder := types.AllowedBidder{
@@ -65,6 +66,24 @@ func TestGenesisState_Validate(t *testing.T) {
 		Released:    false,
 	}
 
+	validAuctionResult := types.AuctionResult{
+		AuctionId:    1,
+		MatchedPrice: math.LegacyMustNewDecFromStr("0.5"),
+		MatchedLen:   1,
+		SoldCoin:     sdk.NewInt64Coin("denom1", 100_000_000),
+		PaidCoin:     sdk.NewInt64Coin("denom2", 50_000_000),
+		RefundedCoin: sdk.NewInt64Coin("denom2", 0),
+		BidderAllocations: []types.BidderAllocation{
+			{
+				Bidder:        validAddr.String(),
+				AllocatedCoin: sdk.NewInt64Coin("denom1", 100_000_000),
+				PaidCoin:      sdk.NewInt64Coin("denom2", 50_000_000),
+				RefundedCoin:  sdk.NewInt64Coin("denom2", 0),
+			},
+		},
+		ClosedTime: types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+	}
+
 	tests := []struct {
 		desc      string
 		configure func(*types.GenesisState)
@@ -89,6 +108,22 @@ func TestGenesisState_Validate(t *testing.T) {
 				genState.AllowedBidderList = []types.AllowedBidder{validAllowedBidder}
 				genState.BidList = []types.Bid{validBid}
 				genState.VestingQueueList = []types.VestingQueue{validVestingQueue}
+				genState.AuctionResultList = []types.AuctionResult{validAuctionResult}
+				genState.CollectedFeesList = []types.CollectedFees{
+					{
+						AuctionId:     1,
+						CreationFee:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100_000_000)),
+						SettlementFee: sdk.NewCoins(sdk.NewInt64Coin("denom2", 500_000)),
+					},
+				}
+				genState.BuyerVestingList = []types.BuyerVesting{
+					{
+						AuctionId:     1,
+						Bidder:        validAddr.String(),
+						AllocatedCoin: sdk.NewInt64Coin("denom1", 1_000_000),
+						ClaimedCoin:   sdk.NewInt64Coin("denom1", 250_000),
+					},
+				}
 
 				// TODO fix when add a new field
 				// this line is used by starport scaffolding # types/genesis/validField
@@ -124,6 +159,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						Status:    types.AuctionStatusStarted,
 					},
 					sdk.NewInt64Coin("denom1", 1_000_000_000_000),
+					types.SaleModeFirstCome,
 				))
 
 				genState.AuctionList = []*codectypes.Any{auctionAny}
@@ -159,6 +195,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						Status:    types.AuctionStatusStarted,
 					},
 					sdk.NewInt64Coin("denom1", 1_000_000_000_000),
+					types.SaleModeFirstCome,
 				))
 
 				genState.AuctionList = []*codectypes.Any{auctionAny}
@@ -194,6 +231,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						Status:    types.AuctionStatusStarted,
 					},
 					sdk.NewInt64Coin("denom1", 1_000_000_000_000),
+					types.SaleModeFirstCome,
 				))
 
 				genState.AuctionList = []*codectypes.Any{auctionAny}
@@ -229,6 +267,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						Status:    types.AuctionStatusStarted,
 					},
 					sdk.NewInt64Coin("denom1", 1_000_000_000_000),
+					types.SaleModeFirstCome,
 				))
 
 				genState.AuctionList = []*codectypes.Any{auctionAny}
@@ -293,6 +332,34 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid vesting queues of the same auction",
+			configure: func(genState *types.GenesisState) {
+				secondVestingQueue := validVestingQueue
+				secondVestingQueue.Sequence = 1
+				secondVestingQueue.ReleaseTime = types.MustParseRFC3339("2023-06-20T00:00:00Z")
+				genState.VestingQueueList = []types.VestingQueue{validVestingQueue, secondVestingQueue}
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated vesting queue",
+			configure: func(genState *types.GenesisState) {
+				secondVestingQueue := validVestingQueue
+				secondVestingQueue.ReleaseTime = types.MustParseRFC3339("2023-06-20T00:00:00Z")
+				genState.VestingQueueList = []types.VestingQueue{validVestingQueue, secondVestingQueue}
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated vesting queue release time",
+			configure: func(genState *types.GenesisState) {
+				secondVestingQueue := validVestingQueue
+				secondVestingQueue.Sequence = 1
+				genState.VestingQueueList = []types.VestingQueue{validVestingQueue, secondVestingQueue}
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid vesting queue - invalid auctioneer address",
 			configure: func(genState *types.GenesisState) {
@@ -310,6 +377,171 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid vesting queue - released coin exceeds paying coin",
+			configure: func(genState *types.GenesisState) {
+				releasedCoin := sdk.NewInt64Coin("denom2", 100_000_001)
+				genState.VestingQueueList = []types.VestingQueue{
+					{
+						AuctionId:    2,
+						Auctioneer:   validAddr.String(),
+						PayingCoin:   sdk.NewInt64Coin("denom2", 100_000_000),
+						ReleaseTime:  types.MustParseRFC3339("2022-12-20T00:00:00Z"),
+						ReleasedCoin: &releasedCoin,
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auction result",
+			configure: func(genState *types.GenesisState) {
+				genState.AuctionResultList = []types.AuctionResult{validAuctionResult, validAuctionResult}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction result - invalid bidder address",
+			configure: func(genState *types.GenesisState) {
+				result := validAuctionResult
+				result.BidderAllocations = []types.BidderAllocation{
+					{
+						Bidder:        "invalid",
+						AllocatedCoin: sdk.NewInt64Coin("denom1", 100_000_000),
+						PaidCoin:      sdk.NewInt64Coin("denom2", 50_000_000),
+						RefundedCoin:  sdk.NewInt64Coin("denom2", 0),
+					},
+				}
+				genState.AuctionResultList = []types.AuctionResult{result}
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated collected fees",
+			configure: func(genState *types.GenesisState) {
+				genState.CollectedFeesList = []types.CollectedFees{{AuctionId: 1}, {AuctionId: 1}}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid collected fees - invalid coins",
+			configure: func(genState *types.GenesisState) {
+				genState.CollectedFeesList = []types.CollectedFees{
+					{AuctionId: 1, PlaceBidFee: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated buyer vesting",
+			configure: func(genState *types.GenesisState) {
+				buyerVesting := types.BuyerVesting{
+					AuctionId:     1,
+					Bidder:        validAddr.String(),
+					AllocatedCoin: sdk.NewInt64Coin("denom1", 1_000_000),
+					ClaimedCoin:   sdk.NewInt64Coin("denom1", 0),
+				}
+				genState.BuyerVestingList = []types.BuyerVesting{buyerVesting, buyerVesting}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid buyer vesting - claimed coin exceeds allocated coin",
+			configure: func(genState *types.GenesisState) {
+				genState.BuyerVestingList = []types.BuyerVesting{
+					{
+						AuctionId:     1,
+						Bidder:        validAddr.String(),
+						AllocatedCoin: sdk.NewInt64Coin("denom1", 1_000_000),
+						ClaimedCoin:   sdk.NewInt64Coin("denom1", 1_000_001),
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid bid commitment",
+			configure: func(genState *types.GenesisState) {
+				genState.BidCommitmentList = []types.BidCommitment{
+					{
+						AuctionId:  1,
+						Id:         1,
+						Bidder:     validAddr.String(),
+						Hash:       make([]byte, 32),
+						Collateral: sdk.NewInt64Coin("denom2", 1_000_000),
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated bid commitment",
+			configure: func(genState *types.GenesisState) {
+				commitment := types.BidCommitment{
+					AuctionId:  1,
+					Id:         1,
+					Bidder:     validAddr.String(),
+					Hash:       make([]byte, 32),
+					Collateral: sdk.NewInt64Coin("denom2", 1_000_000),
+				}
+				genState.BidCommitmentList = []types.BidCommitment{commitment, commitment}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bid commitment - short hash",
+			configure: func(genState *types.GenesisState) {
+				genState.BidCommitmentList = []types.BidCommitment{
+					{
+						AuctionId:  1,
+						Id:         1,
+						Bidder:     validAddr.String(),
+						Hash:       make([]byte, 16),
+						Collateral: sdk.NewInt64Coin("denom2", 1_000_000),
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid failed transition",
+			configure: func(genState *types.GenesisState) {
+				genState.FailedTransitionList = []types.FailedTransition{
+					{
+						AuctionId: 1,
+						Error:     "error",
+						Height:    10,
+						Time:      types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+						Attempts:  3,
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated failed transition",
+			configure: func(genState *types.GenesisState) {
+				failed := types.FailedTransition{
+					AuctionId: 1,
+					Time:      types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+					Attempts:  1,
+				}
+				genState.FailedTransitionList = []types.FailedTransition{failed, failed}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid failed transition - zero attempts",
+			configure: func(genState *types.GenesisState) {
+				genState.FailedTransitionList = []types.FailedTransition{
+					{
+						AuctionId: 1,
+						Time:      types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+					},
+				}
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -10,7 +10,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ FundraisingHooks = MultiFundraisingHooks{}
+var (
+	_ FundraisingHooks  = MultiFundraisingHooks{}
+	_ DutchAuctionHooks = MultiFundraisingHooks{}
+)
 
 // MultiFundraisingHooks combines multiple fundraising hooks.
 // All hook functions are run in array sequence
@@ -142,6 +145,78 @@ func (h MultiFundraisingHooks) AfterBatchAuctionCreated(
 	return nil
 }
 
+func (h MultiFundraisingHooks) BeforeDutchAuctionCreated(
+	ctx context.Context,
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	for i := range h {
+		dh, ok := h[i].(DutchAuctionHooks)
+		if !ok {
+			continue
+		}
+		if err := dh.BeforeDutchAuctionCreated(
+			ctx,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			decayInterval,
+			startTime,
+			endTime,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiFundraisingHooks) AfterDutchAuctionCreated(
+	ctx context.Context,
+	auctionId uint64,
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	for i := range h {
+		dh, ok := h[i].(DutchAuctionHooks)
+		if !ok {
+			continue
+		}
+		if err := dh.AfterDutchAuctionCreated(
+			ctx,
+			auctionId,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			decayInterval,
+			startTime,
+			endTime,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiFundraisingHooks) BeforeAuctionCanceled(
 	ctx context.Context,
 	auctionId uint64,
@@ -189,6 +264,20 @@ func (h MultiFundraisingHooks) BeforeBidModified(
 	return nil
 }
 
+func (h MultiFundraisingHooks) BeforeBidCanceled(
+	ctx context.Context,
+	auctionId uint64,
+	bidId uint64,
+	bidder string,
+) error {
+	for i := range h {
+		if err := h[i].BeforeBidCanceled(ctx, auctionId, bidId, bidder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiFundraisingHooks) BeforeAllowedBiddersAdded(
 	ctx context.Context,
 	allowedBidders []AllowedBidder,
@@ -25,6 +25,8 @@ var (
 	BidKey = collections.NewPrefix("bid/value/")
 	// BidCountKey is the prefix to retrieve all Bid cound
 	BidCountKey = collections.NewPrefix("bid/count/")
+	// BidsByBidderKey is the prefix to retrieve all Bid keys by bidder
+	BidsByBidderKey = collections.NewPrefix("bid/bidder/")
 
 	// AuctionKey is the prefix to retrieve all Auction
 	AuctionKey = collections.NewPrefix("auction/value/")
@@ -35,8 +37,31 @@ var (
 	AllowedBidderKey = collections.NewPrefix("AllowedBidder/value/")
 
 	// VestingQueueKey is the prefix to retrieve all VestingQueue
-	VestingQueueKey = collections.NewPrefix("VestingQueue/value/")
+	VestingQueueKey = collections.NewPrefix("VestingQueue/seq/")
+	// LegacyVestingQueueKey is the prefix of the VestingQueue keyed by the release time
+	// before consensus version 5
+	LegacyVestingQueueKey = collections.NewPrefix("VestingQueue/value/")
+
+	// AuctionQueueKey is the prefix to retrieve all AuctionQueue
+	AuctionQueueKey = collections.NewPrefix("AuctionQueue/value/")
+
+	// FailedTransitionKey is the prefix to retrieve all FailedTransition
+	FailedTransitionKey = collections.NewPrefix("FailedTransition/value/")
+
+	// AuctionResultKey is the prefix to retrieve all AuctionResult
+	AuctionResultKey = collections.NewPrefix("AuctionResult/value/")
 
 	// MatchedBidsLenKey is the prefix to retrieve all MatchedBidsLen
 	MatchedBidsLenKey = collections.NewPrefix("MatchedBidsLen/value/")
+
+	// CollectedFeesKey is the prefix to retrieve all CollectedFees
+	CollectedFeesKey = collections.NewPrefix("CollectedFees/value/")
+
+	// BuyerVestingKey is the prefix to retrieve all BuyerVesting
+	BuyerVestingKey = collections.NewPrefix("BuyerVesting/value/")
+
+	// BidCommitmentKey is the prefix to retrieve all BidCommitment
+	BidCommitmentKey = collections.NewPrefix("BidCommitment/value/")
+	// BidCommitmentCountKey is the prefix to retrieve all BidCommitment count
+	BidCommitmentCountKey = collections.NewPrefix("BidCommitment/count/")
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"sort"
+
 	"cosmossdk.io/math"
 )
 
@@ -9,15 +11,34 @@ type MatchResult struct {
 	MatchedAmount       math.Int
 	MatchedBids         []Bid
 	MatchResultByBidder map[string]*BidderMatchResult
+	PartialFills        []PartialFill
 }
 
 type BidderMatchResult struct {
 	PayingAmount  math.Int
 	MatchedAmount math.Int
+	// PayingAmountByDenom splits the paying amount by the paying denom of the bids,
+	// where the paying coin denom of the auction is the empty key
+	PayingAmountByDenom map[string]math.Int
+}
+
+// PartialFill is a bid at the match price that is filled only partially because the demand
+// at the price exceeds the remaining selling amount.
+type PartialFill struct {
+	Bid          Bid
+	FilledAmount math.Int
+	FillRatio    math.LegacyDec
 }
 
 // Match returns the match result for all bids that correspond with the auction.
-func Match(matchPrice math.LegacyDec, prices []math.LegacyDec, bidsByPrice map[string][]Bid, sellingAmt math.Int, allowedBidders []AllowedBidder) (res *MatchResult, matched bool) {
+// The bids above the match price are always filled fully. The bids at the match price are
+// filled partially by the given fill mode when their demand exceeds the remaining selling amount,
+// and the match fails in that case if the mode is MarginalFillModeNone.
+// The matched bids pay the match price, or their own bid price under PricingModeDiscriminatory.
+func Match(
+	matchPrice math.LegacyDec, prices []math.LegacyDec, bidsByPrice map[string][]Bid,
+	sellingAmt math.Int, allowedBidders []AllowedBidder, fillMode MarginalFillMode, pricingMode PricingMode,
+) (res *MatchResult, matched bool) {
 	res = &MatchResult{
 		MatchPrice:          matchPrice,
 		MatchedAmount:       math.ZeroInt(),
@@ -34,43 +55,175 @@ func Match(matchPrice math.LegacyDec, prices []math.LegacyDec, bidsByPrice map[s
 			break
 		}
 
-		for _, bid := range bidsByPrice[price.String()] {
+		bids := bidsByPrice[price.String()]
+
+		payingPrice := matchPrice
+		if pricingMode == PricingModeDiscriminatory {
+			payingPrice = price
+		}
+
+		// Find out how much each bid at the price level would buy with the biddable amount left
+		// after the bids before it
+		usedAmtByBidder := map[string]math.Int{}
+		reqAmts := make([]math.Int, len(bids))
+		totalReqAmt := math.ZeroInt()
+		for i, bid := range bids {
 			var bidAmt math.Int
 			switch bid.Type {
 			case BidTypeBatchWorth:
-				bidAmt = math.LegacyNewDecFromInt(bid.Coin.Amount).QuoTruncate(matchPrice).TruncateInt()
+				bidAmt = math.LegacyNewDecFromInt(bid.Coin.Amount).QuoTruncate(payingPrice).TruncateInt()
 			case BidTypeBatchMany:
 				bidAmt = bid.Coin.Amount
+			default:
+				bidAmt = math.ZeroInt()
 			}
-			biddableAmt := biddableAmtByBidder[bid.Bidder]
-			matchAmt := math.MinInt(bidAmt, biddableAmtByBidder[bid.Bidder])
+			biddableAmt, ok := biddableAmtByBidder[bid.Bidder]
+			if !ok {
+				biddableAmt = math.ZeroInt()
+			}
+			usedAmt, ok := usedAmtByBidder[bid.Bidder]
+			if !ok {
+				usedAmt = math.ZeroInt()
+			}
+			reqAmts[i] = math.MinInt(bidAmt, biddableAmt.Sub(usedAmt))
+			usedAmtByBidder[bid.Bidder] = usedAmt.Add(reqAmts[i])
+			totalReqAmt = totalReqAmt.Add(reqAmts[i])
+		}
 
-			if res.MatchedAmount.Add(matchAmt).GT(sellingAmt) {
-				// Including this bid will exceed the auction's selling amount.
+		fillAmts := reqAmts
+		remainingAmt := sellingAmt.Sub(res.MatchedAmount)
+		if totalReqAmt.GT(remainingAmt) {
+			// Including the bids at the price level will exceed the auction's selling amount.
+			// Only the level at the match price can be filled partially, and only when there is
+			// some selling amount left for it; otherwise a higher price sells the same amount.
+			if !price.Equal(matchPrice) || fillMode == MarginalFillModeNone || !remainingAmt.IsPositive() {
 				return nil, false
 			}
+			fillAmts = fillMarginalLevel(bids, reqAmts, totalReqAmt, remainingAmt, fillMode)
+		}
 
-			payingAmt := matchPrice.MulInt(matchAmt).Ceil().TruncateInt()
+		for i, bid := range bids {
+			matchAmt := fillAmts[i]
+			payingAmt := payingPrice.MulInt(matchAmt).Ceil().TruncateInt()
 
 			bidderRes, ok := res.MatchResultByBidder[bid.Bidder]
 			if !ok {
 				bidderRes = &BidderMatchResult{
-					PayingAmount:  math.ZeroInt(),
-					MatchedAmount: math.ZeroInt(),
+					PayingAmount:        math.ZeroInt(),
+					MatchedAmount:       math.ZeroInt(),
+					PayingAmountByDenom: map[string]math.Int{},
 				}
 				res.MatchResultByBidder[bid.Bidder] = bidderRes
 			}
 			bidderRes.MatchedAmount = bidderRes.MatchedAmount.Add(matchAmt)
 			bidderRes.PayingAmount = bidderRes.PayingAmount.Add(payingAmt)
+			if amt, ok := bidderRes.PayingAmountByDenom[bid.PayingDenom]; ok {
+				payingAmt = payingAmt.Add(amt)
+			}
+			bidderRes.PayingAmountByDenom[bid.PayingDenom] = payingAmt
 
 			if matchAmt.IsPositive() {
-				biddableAmtByBidder[bid.Bidder] = biddableAmt.Sub(matchAmt)
+				biddableAmtByBidder[bid.Bidder] = biddableAmtByBidder[bid.Bidder].Sub(matchAmt)
 				res.MatchedBids = append(res.MatchedBids, bid)
 				res.MatchedAmount = res.MatchedAmount.Add(matchAmt)
 				matched = true
+
+				if matchAmt.LT(reqAmts[i]) {
+					res.PartialFills = append(res.PartialFills, PartialFill{
+						Bid:          bid,
+						FilledAmount: matchAmt,
+						FillRatio:    math.LegacyNewDecFromInt(matchAmt).QuoInt(reqAmts[i]),
+					})
+				}
 			}
 		}
 	}
 
 	return res, matched
 }
+
+// fillMarginalLevel distributes the remaining selling amount to the bids at the match price
+// by the fill mode and returns the filled amount of each bid. The pro rata shares are truncated,
+// and the dust left by the truncation goes to the bids one by one in the order of the bid id.
+func fillMarginalLevel(bids []Bid, reqAmts []math.Int, totalReqAmt, remainingAmt math.Int, fillMode MarginalFillMode) []math.Int {
+	fillAmts := make([]math.Int, len(bids))
+	unfilledAmt := remainingAmt
+	for i := range bids {
+		fillAmts[i] = math.ZeroInt()
+		if fillMode == MarginalFillModeProRata {
+			fillAmts[i] = reqAmts[i].Mul(remainingAmt).Quo(totalReqAmt)
+			unfilledAmt = unfilledAmt.Sub(fillAmts[i])
+		}
+	}
+
+	order := make([]int, len(bids))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bids[order[i]].Id < bids[order[j]].Id
+	})
+
+	for _, i := range order {
+		if !unfilledAmt.IsPositive() {
+			break
+		}
+		amt := math.MinInt(reqAmts[i].Sub(fillAmts[i]), unfilledAmt)
+		if fillMode == MarginalFillModeProRata {
+			// Each truncated share loses less than one, so one more each covers the dust
+			amt = math.MinInt(amt, math.OneInt())
+		}
+		fillAmts[i] = fillAmts[i].Add(amt)
+		unfilledAmt = unfilledAmt.Sub(amt)
+	}
+
+	return fillAmts
+}
+
+// BidBook returns the cumulative demand of the selling coin at each price level of the bids.
+// The demand at a price is the amount of the selling coin that the bids at or above the price
+// would buy if the auction is matched at the price, capped by the maximum bid amount of each bidder.
+func BidBook(prices []math.LegacyDec, bidsByPrice map[string][]Bid, allowedBidders []AllowedBidder) []BidBookLevel {
+	maxBidAmtByBidder := map[string]math.Int{}
+	for _, allowedBidder := range allowedBidders {
+		maxBidAmtByBidder[allowedBidder.Bidder] = allowedBidder.MaxBidAmount
+	}
+
+	levels := make([]BidBookLevel, 0, len(prices))
+	for i, levelPrice := range prices {
+		bidAmtByBidder := map[string]math.Int{}
+		for _, price := range prices[:i+1] {
+			for _, bid := range bidsByPrice[price.String()] {
+				var bidAmt math.Int
+				switch bid.Type {
+				case BidTypeBatchWorth:
+					bidAmt = math.LegacyNewDecFromInt(bid.Coin.Amount).QuoTruncate(levelPrice).TruncateInt()
+				case BidTypeBatchMany:
+					bidAmt = bid.Coin.Amount
+				default:
+					continue
+				}
+				if amt, ok := bidAmtByBidder[bid.Bidder]; ok {
+					bidAmt = bidAmt.Add(amt)
+				}
+				bidAmtByBidder[bid.Bidder] = bidAmt
+			}
+		}
+
+		cumulativeAmt := math.ZeroInt()
+		for bidder, bidAmt := range bidAmtByBidder {
+			maxBidAmt, ok := maxBidAmtByBidder[bidder]
+			if !ok {
+				maxBidAmt = math.ZeroInt()
+			}
+			cumulativeAmt = cumulativeAmt.Add(math.MinInt(bidAmt, maxBidAmt))
+		}
+
+		levels = append(levels, BidBookLevel{
+			Price:            levelPrice,
+			CumulativeAmount: cumulativeAmt,
+		})
+	}
+
+	return levels
+}
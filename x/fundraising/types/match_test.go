@@ -81,8 +81,9 @@ func TestMatch(t *testing.T) {
 			[]uint64{1},
 			map[string]*types.BidderMatchResult{
 				bidders[0]: {
-					PayingAmount:  math.NewInt(100_000000),
-					MatchedAmount: math.NewInt(100_000000),
+					PayingAmount:        math.NewInt(100_000000),
+					MatchedAmount:       math.NewInt(100_000000),
+					PayingAmountByDenom: map[string]math.Int{"": math.NewInt(100_000000)},
 				},
 			},
 		},
@@ -101,8 +102,9 @@ func TestMatch(t *testing.T) {
 			[]uint64{1},
 			map[string]*types.BidderMatchResult{
 				bidders[0]: {
-					PayingAmount:  math.NewInt(50_000000),
-					MatchedAmount: math.NewInt(50_000000),
+					PayingAmount:        math.NewInt(50_000000),
+					MatchedAmount:       math.NewInt(50_000000),
+					PayingAmountByDenom: map[string]math.Int{"": math.NewInt(50_000000)},
 				},
 			},
 		},
@@ -130,7 +132,7 @@ func TestMatch(t *testing.T) {
 				})
 			}
 			prices, bidsByPrice := types.BidsByPrice(tc.bids)
-			matchRes, matched := types.Match(tc.matchPrice, prices, bidsByPrice, tc.sellingCoinAmt, allowedBidders)
+			matchRes, matched := types.Match(tc.matchPrice, prices, bidsByPrice, tc.sellingCoinAmt, allowedBidders, types.MarginalFillModeNone, types.PricingModeUniform)
 			require.Equal(t, tc.matched, matched)
 			if matched {
 				require.True(math.IntEq(t, tc.matchedAmt, matchRes.MatchedAmount))
@@ -144,3 +146,156 @@ func TestMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestMatch_MarginalFill(t *testing.T) {
+	bidders := []string{testAddr(0).String(), testAddr(1).String(), testAddr(2).String(), testAddr(3).String()}
+	bids := []types.Bid{
+		{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchMany, Price: parseDec("2.0"), Coin: sdk.NewInt64Coin("selling", 40)},
+		{Id: 2, Bidder: bidders[1], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin("selling", 30)},
+		{Id: 3, Bidder: bidders[2], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin("selling", 30)},
+		{Id: 4, Bidder: bidders[3], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin("selling", 30)},
+	}
+	var allowedBidders []types.AllowedBidder
+	for i := range bidders {
+		allowedBidders = append(allowedBidders, types.NewAllowedBidder(1, testAddr(i), math.NewInt(1000)))
+	}
+	prices, bidsByPrice := types.BidsByPrice(bids)
+
+	for _, tc := range []struct {
+		name          string
+		fillMode      types.MarginalFillMode
+		sellingAmt    math.Int
+		matched       bool
+		matchedBidIds []uint64
+		partialFills  map[uint64]math.Int
+	}{
+		{
+			"no partial fill",
+			types.MarginalFillModeNone,
+			math.NewInt(90),
+			false,
+			nil, nil,
+		},
+		{
+			"pro rata",
+			types.MarginalFillModeProRata,
+			math.NewInt(90),
+			true,
+			[]uint64{1, 2, 3, 4},
+			map[uint64]math.Int{2: math.NewInt(17), 3: math.NewInt(17), 4: math.NewInt(16)},
+		},
+		{
+			"bid id",
+			types.MarginalFillModeBidId,
+			math.NewInt(90),
+			true,
+			[]uint64{1, 2, 3},
+			map[uint64]math.Int{3: math.NewInt(20)},
+		},
+		{
+			"nothing left for the marginal price",
+			types.MarginalFillModeProRata,
+			math.NewInt(40),
+			false,
+			nil, nil,
+		},
+		{
+			"demand fits the selling amount",
+			types.MarginalFillModeProRata,
+			math.NewInt(130),
+			true,
+			[]uint64{1, 2, 3, 4},
+			map[uint64]math.Int{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			matchRes, matched := types.Match(parseDec("1.0"), prices, bidsByPrice, tc.sellingAmt, allowedBidders, tc.fillMode, types.PricingModeUniform)
+			require.Equal(t, tc.matched, matched)
+			if !matched {
+				return
+			}
+			require.True(math.IntEq(t, math.MinInt(tc.sellingAmt, math.NewInt(130)), matchRes.MatchedAmount))
+
+			var matchedBidIds []uint64
+			for _, bid := range matchRes.MatchedBids {
+				matchedBidIds = append(matchedBidIds, bid.Id)
+			}
+			require.ElementsMatch(t, tc.matchedBidIds, matchedBidIds)
+
+			require.Len(t, matchRes.PartialFills, len(tc.partialFills))
+			for _, fill := range matchRes.PartialFills {
+				filledAmt, ok := tc.partialFills[fill.Bid.Id]
+				require.True(t, ok)
+				require.True(math.IntEq(t, filledAmt, fill.FilledAmount))
+				require.Equal(t, math.LegacyNewDecFromInt(filledAmt).QuoInt64(30), fill.FillRatio)
+				require.True(math.IntEq(t, filledAmt, matchRes.MatchResultByBidder[fill.Bid.Bidder].MatchedAmount))
+			}
+		})
+	}
+}
+
+func TestMatch_PricingMode(t *testing.T) {
+	bidders := []string{testAddr(0).String(), testAddr(1).String()}
+	bids := []types.Bid{
+		{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchWorth, Price: parseDec("2.0"), Coin: sdk.NewInt64Coin("paying", 200)},
+		{Id: 2, Bidder: bidders[1], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin("selling", 50)},
+	}
+	allowedBidders := []types.AllowedBidder{
+		types.NewAllowedBidder(1, testAddr(0), math.NewInt(1000)),
+		types.NewAllowedBidder(1, testAddr(1), math.NewInt(1000)),
+	}
+	prices, bidsByPrice := types.BidsByPrice(bids)
+
+	for _, tc := range []struct {
+		name                string
+		pricingMode         types.PricingMode
+		matchResultByBidder map[string]*types.BidderMatchResult
+	}{
+		{
+			"uniform",
+			types.PricingModeUniform,
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {PayingAmount: math.NewInt(200), MatchedAmount: math.NewInt(200), PayingAmountByDenom: map[string]math.Int{"": math.NewInt(200)}},
+				bidders[1]: {PayingAmount: math.NewInt(50), MatchedAmount: math.NewInt(50), PayingAmountByDenom: map[string]math.Int{"": math.NewInt(50)}},
+			},
+		},
+		{
+			"discriminatory",
+			types.PricingModeDiscriminatory,
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {PayingAmount: math.NewInt(200), MatchedAmount: math.NewInt(100), PayingAmountByDenom: map[string]math.Int{"": math.NewInt(200)}},
+				bidders[1]: {PayingAmount: math.NewInt(50), MatchedAmount: math.NewInt(50), PayingAmountByDenom: map[string]math.Int{"": math.NewInt(50)}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			matchRes, matched := types.Match(parseDec("1.0"), prices, bidsByPrice, math.NewInt(1000), allowedBidders, types.MarginalFillModeNone, tc.pricingMode)
+			require.True(t, matched)
+			require.Equal(t, tc.matchResultByBidder, matchRes.MatchResultByBidder)
+		})
+	}
+}
+
+func TestBidBook(t *testing.T) {
+	bidders := []string{testAddr(0).String(), testAddr(1).String(), testAddr(2).String()}
+	bids := []types.Bid{
+		{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchWorth, Price: parseDec("2.0"), Coin: sdk.NewInt64Coin("paying", 200)},
+		{Id: 2, Bidder: bidders[1], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin("selling", 50)},
+		{Id: 3, Bidder: bidders[2], Type: types.BidTypeBatchMany, Price: parseDec("2.0"), Coin: sdk.NewInt64Coin("selling", 30)},
+	}
+	allowedBidders := []types.AllowedBidder{
+		types.NewAllowedBidder(1, testAddr(0), math.NewInt(100)),
+		types.NewAllowedBidder(1, testAddr(1), math.NewInt(1000)),
+	}
+
+	prices, bidsByPrice := types.BidsByPrice(bids)
+	levels := types.BidBook(prices, bidsByPrice, allowedBidders)
+	require.Equal(t, []types.BidBookLevel{
+		// The bid of the bidder who is not allowed doesn't count
+		{Price: parseDec("2.0"), CumulativeAmount: math.NewInt(100)},
+		// The worth bid is evaluated at the level price and capped by the maximum bid amount
+		{Price: parseDec("1.0"), CumulativeAmount: math.NewInt(150)},
+	}, levels)
+
+	require.Empty(t, types.BidBook(nil, nil, allowedBidders))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -27,6 +28,78 @@ func (msg MsgCancelAuction) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgPauseAuction(sender string, auctionId uint64) *MsgPauseAuction {
+	return &MsgPauseAuction{
+		Sender:    sender,
+		AuctionId: auctionId,
+	}
+}
+
+func (msg MsgPauseAuction) Type() string {
+	return sdk.MsgTypeURL(&MsgPauseAuction{})
+}
+
+func (msg MsgPauseAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	return nil
+}
+
+func NewMsgResumeAuction(sender string, auctionId uint64) *MsgResumeAuction {
+	return &MsgResumeAuction{
+		Sender:    sender,
+		AuctionId: auctionId,
+	}
+}
+
+func (msg MsgResumeAuction) Type() string {
+	return sdk.MsgTypeURL(&MsgResumeAuction{})
+}
+
+func (msg MsgResumeAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	return nil
+}
+
+func NewMsgCloseAuctionEarly(sender string, auctionId uint64) *MsgCloseAuctionEarly {
+	return &MsgCloseAuctionEarly{
+		Sender:    sender,
+		AuctionId: auctionId,
+	}
+}
+
+func (msg MsgCloseAuctionEarly) Type() string {
+	return sdk.MsgTypeURL(&MsgCloseAuctionEarly{})
+}
+
+func (msg MsgCloseAuctionEarly) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	return nil
+}
+
+func NewMsgForceCancelAuction(authority string, auctionId uint64) *MsgForceCancelAuction {
+	return &MsgForceCancelAuction{
+		Authority: authority,
+		AuctionId: auctionId,
+	}
+}
+
+func (msg MsgForceCancelAuction) Type() string {
+	return sdk.MsgTypeURL(&MsgForceCancelAuction{})
+}
+
+func (msg MsgForceCancelAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	return nil
+}
+
 func NewMsgCreateBatchAuction(
 	auctioneer string,
 	startPrice math.LegacyDec,
@@ -38,18 +111,40 @@ func NewMsgCreateBatchAuction(
 	extendedRoundRate math.LegacyDec,
 	startTime time.Time,
 	endTime time.Time,
+	bidCancelCutoff time.Duration,
+	bidFee sdk.Coins,
+	softCap math.Int,
+	buyerVestingSchedules []VestingSchedule,
+	linearVesting *LinearVesting,
+	autoRelease bool,
+	beneficiary string,
+	marginalFillMode MarginalFillMode,
+	pricingMode PricingMode,
+	sealedBidConfig *SealedBidConfig,
+	additionalPayingDenoms []PayingDenom,
 ) *MsgCreateBatchAuction {
 	return &MsgCreateBatchAuction{
-		Auctioneer:        auctioneer,
-		StartPrice:        startPrice,
-		MinBidPrice:       minBidPrice,
-		SellingCoin:       sellingCoin,
-		PayingCoinDenom:   payingCoinDenom,
-		VestingSchedules:  vestingSchedules,
-		MaxExtendedRound:  maxExtendedRound,
-		ExtendedRoundRate: extendedRoundRate,
-		StartTime:         startTime,
-		EndTime:           endTime,
+		Auctioneer:             auctioneer,
+		StartPrice:             startPrice,
+		MinBidPrice:            minBidPrice,
+		SellingCoin:            sellingCoin,
+		PayingCoinDenom:        payingCoinDenom,
+		VestingSchedules:       vestingSchedules,
+		MaxExtendedRound:       maxExtendedRound,
+		ExtendedRoundRate:      extendedRoundRate,
+		StartTime:              startTime,
+		EndTime:                endTime,
+		BidCancelCutoff:        bidCancelCutoff,
+		BidFee:                 bidFee,
+		SoftCap:                softCap,
+		BuyerVestingSchedules:  buyerVestingSchedules,
+		LinearVesting:          linearVesting,
+		AutoRelease:            autoRelease,
+		Beneficiary:            beneficiary,
+		MarginalFillMode:       marginalFillMode,
+		PricingMode:            pricingMode,
+		SealedBidConfig:        sealedBidConfig,
+		AdditionalPayingDenoms: additionalPayingDenoms,
 	}
 }
 
@@ -61,6 +156,11 @@ func (msg MsgCreateBatchAuction) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
 		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
 	}
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address: %v", err)
+		}
+	}
 	if !msg.StartPrice.IsPositive() {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "start price must be positive")
 	}
@@ -79,12 +179,48 @@ func (msg MsgCreateBatchAuction) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.PayingCoinDenom); err != nil {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid paying coin denom: %v", err)
 	}
+	if err := ValidatePayingDenoms(msg.AdditionalPayingDenoms, msg.SellingCoin.Denom, msg.PayingCoinDenom); err != nil {
+		return err
+	}
 	if !msg.EndTime.After(msg.StartTime) {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "end time must be set after start time")
 	}
 	if !msg.ExtendedRoundRate.IsPositive() {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "extend rate must be positive")
 	}
+	if msg.BidCancelCutoff < 0 {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "bid cancel cutoff must not be negative")
+	}
+	if _, ok := MarginalFillMode_name[int32(msg.MarginalFillMode)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid marginal fill mode: %d", msg.MarginalFillMode)
+	}
+	if _, ok := PricingMode_name[int32(msg.PricingMode)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid pricing mode: %d", msg.PricingMode)
+	}
+	if msg.SealedBidConfig != nil {
+		if msg.SealedBidConfig.RevealPeriod <= 0 {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "reveal period must be positive")
+		}
+		// The extended rounds work against sniping on the public bids, which sealed bids already prevent
+		if msg.MaxExtendedRound > 0 {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "sealed-bid auction must not have extended rounds")
+		}
+	}
+	if err := msg.BidFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid fee: %v", err)
+	}
+	if !msg.SoftCap.IsNil() && msg.SoftCap.IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "soft cap must not be negative")
+	}
+	if err := ValidateVestingSchedules(msg.BuyerVestingSchedules, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(err, "invalid buyer vesting schedules")
+	}
+	if msg.LinearVesting != nil {
+		if len(msg.VestingSchedules) > 0 {
+			return sdkerrors.Wrap(ErrInvalidVestingSchedules, "vesting schedules and linear vesting must not be set together")
+		}
+		return ValidateLinearVesting(msg.LinearVesting, msg.EndTime)
+	}
 	return ValidateVestingSchedules(msg.VestingSchedules, msg.EndTime)
 }
 
@@ -96,15 +232,31 @@ func NewMsgCreateFixedPriceAuction(
 	vestingSchedules []VestingSchedule,
 	startTime time.Time,
 	endTime time.Time,
+	bidFee sdk.Coins,
+	softCap math.Int,
+	buyerVestingSchedules []VestingSchedule,
+	linearVesting *LinearVesting,
+	autoRelease bool,
+	beneficiary string,
+	additionalPayingDenoms []PayingDenom,
+	saleMode SaleMode,
 ) *MsgCreateFixedPriceAuction {
 	return &MsgCreateFixedPriceAuction{
-		Auctioneer:       auctioneer,
-		StartPrice:       startPrice,
-		SellingCoin:      sellingCoin,
-		PayingCoinDenom:  payingCoinDenom,
-		VestingSchedules: vestingSchedules,
-		StartTime:        startTime,
-		EndTime:          endTime,
+		Auctioneer:             auctioneer,
+		StartPrice:             startPrice,
+		SellingCoin:            sellingCoin,
+		PayingCoinDenom:        payingCoinDenom,
+		VestingSchedules:       vestingSchedules,
+		StartTime:              startTime,
+		EndTime:                endTime,
+		BidFee:                 bidFee,
+		SoftCap:                softCap,
+		BuyerVestingSchedules:  buyerVestingSchedules,
+		LinearVesting:          linearVesting,
+		AutoRelease:            autoRelease,
+		Beneficiary:            beneficiary,
+		AdditionalPayingDenoms: additionalPayingDenoms,
+		SaleMode:               saleMode,
 	}
 }
 
@@ -116,6 +268,11 @@ func (msg MsgCreateFixedPriceAuction) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
 		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
 	}
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address: %v", err)
+		}
+	}
 	if !msg.StartPrice.IsPositive() {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "start price must be positive")
 	}
@@ -131,9 +288,138 @@ func (msg MsgCreateFixedPriceAuction) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.PayingCoinDenom); err != nil {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid paying coin denom: %v", err)
 	}
+	if err := ValidatePayingDenoms(msg.AdditionalPayingDenoms, msg.SellingCoin.Denom, msg.PayingCoinDenom); err != nil {
+		return err
+	}
 	if !msg.EndTime.After(msg.StartTime) {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "end time must be set after start time")
 	}
+	if _, ok := SaleMode_name[int32(msg.SaleMode)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid sale mode: %d", msg.SaleMode)
+	}
+	if err := msg.BidFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid fee: %v", err)
+	}
+	if !msg.SoftCap.IsNil() && msg.SoftCap.IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "soft cap must not be negative")
+	}
+	if !msg.SoftCap.IsNil() && msg.SoftCap.GT(math.LegacyNewDecFromInt(msg.SellingCoin.Amount).Mul(msg.StartPrice).Ceil().TruncateInt()) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "soft cap must not exceed the selling coin amount at the start price")
+	}
+	if err := ValidateVestingSchedules(msg.BuyerVestingSchedules, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(err, "invalid buyer vesting schedules")
+	}
+	if msg.LinearVesting != nil {
+		if len(msg.VestingSchedules) > 0 {
+			return sdkerrors.Wrap(ErrInvalidVestingSchedules, "vesting schedules and linear vesting must not be set together")
+		}
+		return ValidateLinearVesting(msg.LinearVesting, msg.EndTime)
+	}
+	return ValidateVestingSchedules(msg.VestingSchedules, msg.EndTime)
+}
+
+func NewMsgCreateDutchAuction(
+	auctioneer string,
+	startPrice math.LegacyDec,
+	floorPrice math.LegacyDec,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []VestingSchedule,
+	decayInterval time.Duration,
+	startTime time.Time,
+	endTime time.Time,
+	bidFee sdk.Coins,
+	softCap math.Int,
+	buyerVestingSchedules []VestingSchedule,
+	linearVesting *LinearVesting,
+	autoRelease bool,
+	beneficiary string,
+	additionalPayingDenoms []PayingDenom,
+) *MsgCreateDutchAuction {
+	return &MsgCreateDutchAuction{
+		Auctioneer:             auctioneer,
+		StartPrice:             startPrice,
+		FloorPrice:             floorPrice,
+		SellingCoin:            sellingCoin,
+		PayingCoinDenom:        payingCoinDenom,
+		VestingSchedules:       vestingSchedules,
+		DecayInterval:          decayInterval,
+		StartTime:              startTime,
+		EndTime:                endTime,
+		BidFee:                 bidFee,
+		SoftCap:                softCap,
+		BuyerVestingSchedules:  buyerVestingSchedules,
+		LinearVesting:          linearVesting,
+		AutoRelease:            autoRelease,
+		Beneficiary:            beneficiary,
+		AdditionalPayingDenoms: additionalPayingDenoms,
+	}
+}
+
+func (msg MsgCreateDutchAuction) Type() string {
+	return sdk.MsgTypeURL(&MsgCreateDutchAuction{})
+}
+
+func (msg MsgCreateDutchAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address: %v", err)
+		}
+	}
+	if !msg.StartPrice.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "start price must be positive")
+	}
+	if !msg.FloorPrice.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "floor price must be positive")
+	}
+	if !msg.FloorPrice.LT(msg.StartPrice) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "floor price must be lower than start price")
+	}
+	if err := msg.SellingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid selling coin: %v", err)
+	}
+	if !msg.SellingCoin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "selling coin amount must be positive")
+	}
+	if msg.SellingCoin.Denom == msg.PayingCoinDenom {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "selling coin denom must not be the same as paying coin denom")
+	}
+	if err := sdk.ValidateDenom(msg.PayingCoinDenom); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid paying coin denom: %v", err)
+	}
+	if err := ValidatePayingDenoms(msg.AdditionalPayingDenoms, msg.SellingCoin.Denom, msg.PayingCoinDenom); err != nil {
+		return err
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "end time must be set after start time")
+	}
+	if msg.DecayInterval < 0 {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "decay interval must not be negative")
+	}
+	if msg.DecayInterval > msg.EndTime.Sub(msg.StartTime) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "decay interval must not exceed the auction period")
+	}
+	if err := msg.BidFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid fee: %v", err)
+	}
+	if !msg.SoftCap.IsNil() && msg.SoftCap.IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "soft cap must not be negative")
+	}
+	if !msg.SoftCap.IsNil() && msg.SoftCap.GT(math.LegacyNewDecFromInt(msg.SellingCoin.Amount).Mul(msg.StartPrice).Ceil().TruncateInt()) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "soft cap must not exceed the selling coin amount at the start price")
+	}
+	if err := ValidateVestingSchedules(msg.BuyerVestingSchedules, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(err, "invalid buyer vesting schedules")
+	}
+	if msg.LinearVesting != nil {
+		if len(msg.VestingSchedules) > 0 {
+			return sdkerrors.Wrap(ErrInvalidVestingSchedules, "vesting schedules and linear vesting must not be set together")
+		}
+		return ValidateLinearVesting(msg.LinearVesting, msg.EndTime)
+	}
 	return ValidateVestingSchedules(msg.VestingSchedules, msg.EndTime)
 }
 
@@ -143,13 +429,15 @@ func NewMsgPlaceBid(
 	bidType BidType,
 	price math.LegacyDec,
 	coin sdk.Coin,
+	payingDenom string,
 ) *MsgPlaceBid {
 	return &MsgPlaceBid{
-		Bidder:    bidder,
-		AuctionId: auctionId,
-		BidType:   bidType,
-		Price:     price,
-		Coin:      coin,
+		Bidder:      bidder,
+		AuctionId:   auctionId,
+		BidType:     bidType,
+		Price:       price,
+		Coin:        coin,
+		PayingDenom: payingDenom,
 	}
 }
 
@@ -171,9 +459,220 @@ func (msg MsgPlaceBid) ValidateBasic() error {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid coin amount: %s", msg.Coin.Amount.String())
 	}
 	if msg.BidType != BidTypeFixedPrice && msg.BidType != BidTypeBatchWorth &&
-		msg.BidType != BidTypeBatchMany {
+		msg.BidType != BidTypeBatchMany && msg.BidType != BidTypeDutch {
 		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid type: %T", msg.BidType.String())
 	}
+	if msg.PayingDenom != "" {
+		if err := sdk.ValidateDenom(msg.PayingDenom); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid paying denom: %v", err)
+		}
+	}
+	return nil
+}
+
+func NewMsgCancelBid(
+	auctionId uint64,
+	bidder string,
+	bidId uint64,
+) *MsgCancelBid {
+	return &MsgCancelBid{
+		AuctionId: auctionId,
+		Bidder:    bidder,
+		BidId:     bidId,
+	}
+}
+
+func (msg MsgCancelBid) Type() string {
+	return sdk.MsgTypeURL(&MsgCancelBid{})
+}
+
+func (msg MsgCancelBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	return nil
+}
+
+func NewMsgClaimBuyerVesting(
+	auctionId uint64,
+	bidder string,
+) *MsgClaimBuyerVesting {
+	return &MsgClaimBuyerVesting{
+		AuctionId: auctionId,
+		Bidder:    bidder,
+	}
+}
+
+func (msg MsgClaimBuyerVesting) Type() string {
+	return sdk.MsgTypeURL(&MsgClaimBuyerVesting{})
+}
+
+func (msg MsgClaimBuyerVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	return nil
+}
+
+func NewMsgClaimVestedProceeds(
+	auctioneer string,
+	auctionId uint64,
+	beneficiary string,
+) *MsgClaimVestedProceeds {
+	return &MsgClaimVestedProceeds{
+		Auctioneer:  auctioneer,
+		AuctionId:   auctionId,
+		Beneficiary: beneficiary,
+	}
+}
+
+func (msg MsgClaimVestedProceeds) Type() string {
+	return sdk.MsgTypeURL(&MsgClaimVestedProceeds{})
+}
+
+func (msg MsgClaimVestedProceeds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address: %v", err)
+		}
+	}
+	return nil
+}
+
+func NewMsgTransferAuctionOwnership(
+	auctioneer string,
+	auctionId uint64,
+	newOwner string,
+	newBeneficiary string,
+) *MsgTransferAuctionOwnership {
+	return &MsgTransferAuctionOwnership{
+		Auctioneer:     auctioneer,
+		AuctionId:      auctionId,
+		NewOwner:       newOwner,
+		NewBeneficiary: newBeneficiary,
+	}
+}
+
+func (msg MsgTransferAuctionOwnership) Type() string {
+	return sdk.MsgTypeURL(&MsgTransferAuctionOwnership{})
+}
+
+func (msg MsgTransferAuctionOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid new owner address: %v", err)
+	}
+	if msg.NewBeneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewBeneficiary); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid new beneficiary address: %v", err)
+		}
+	}
+	return nil
+}
+
+func NewMsgAcceptAuctionOwnership(
+	newOwner string,
+	auctionId uint64,
+) *MsgAcceptAuctionOwnership {
+	return &MsgAcceptAuctionOwnership{
+		NewOwner:  newOwner,
+		AuctionId: auctionId,
+	}
+}
+
+func (msg MsgAcceptAuctionOwnership) Type() string {
+	return sdk.MsgTypeURL(&MsgAcceptAuctionOwnership{})
+}
+
+func (msg MsgAcceptAuctionOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid new owner address: %v", err)
+	}
+	return nil
+}
+
+func NewMsgCommitBid(
+	bidder string,
+	auctionId uint64,
+	hash []byte,
+	collateral sdk.Coin,
+) *MsgCommitBid {
+	return &MsgCommitBid{
+		Bidder:     bidder,
+		AuctionId:  auctionId,
+		Hash:       hash,
+		Collateral: collateral,
+	}
+}
+
+func (msg MsgCommitBid) Type() string {
+	return sdk.MsgTypeURL(&MsgCommitBid{})
+}
+
+func (msg MsgCommitBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if len(msg.Hash) != sha256.Size {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "hash must be %d bytes", sha256.Size)
+	}
+	if err := msg.Collateral.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid collateral: %v", err)
+	}
+	if !msg.Collateral.Amount.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "collateral amount must be positive")
+	}
+	return nil
+}
+
+func NewMsgRevealBid(
+	bidder string,
+	auctionId uint64,
+	commitmentId uint64,
+	bidType BidType,
+	price math.LegacyDec,
+	coin sdk.Coin,
+	salt string,
+) *MsgRevealBid {
+	return &MsgRevealBid{
+		Bidder:       bidder,
+		AuctionId:    auctionId,
+		CommitmentId: commitmentId,
+		BidType:      bidType,
+		Price:        price,
+		Coin:         coin,
+		Salt:         salt,
+	}
+}
+
+func (msg MsgRevealBid) Type() string {
+	return sdk.MsgTypeURL(&MsgRevealBid{})
+}
+
+func (msg MsgRevealBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if msg.BidType != BidTypeBatchWorth && msg.BidType != BidTypeBatchMany {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid type: %s", msg.BidType)
+	}
+	if !msg.Price.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "bid price must be positive value")
+	}
+	if err := msg.Coin.Validate(); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid bid coin: %v", err)
+	}
+	if !msg.Coin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid coin amount: %s", msg.Coin.Amount.String())
+	}
+	if msg.Salt == "" {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "salt must not be empty")
+	}
 	return nil
 }
 
@@ -27,6 +27,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -39,6 +47,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -51,6 +67,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -63,6 +87,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -75,6 +107,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(-1, 0, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -92,6 +132,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -109,6 +157,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -126,6 +182,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(1, 0, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -147,6 +211,14 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -168,6 +240,87 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
+			),
+		},
+		{
+			"soft cap must not exceed the selling coin amount at the start price: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.NewInt(5_000_000_000_001),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
+			),
+		},
+		{
+			"vesting schedules and linear vesting must not be set together: invalid vesting schedules",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 2, 0),
+						math.LegacyOneDec(),
+					},
+				},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				&types.LinearVesting{
+					StartTime: time.Now().AddDate(0, 1, 0),
+					EndTime:   time.Now().AddDate(1, 1, 0),
+				},
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
+			),
+		},
+		{
+			"invalid buyer vesting schedules: release time must be set after the end time: invalid vesting schedules",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{
+					{
+						time.Now(),
+						math.LegacyOneDec(),
+					},
+				},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
 			),
 		},
 		{
@@ -180,6 +333,94 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeFirstCome,
+			),
+		},
+		{
+			"invalid beneficiary address: decoding bech32 failed: invalid bech32 string length 7: invalid address",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"invalid",
+				nil,
+				types.SaleModeFirstCome,
+			),
+		},
+		{
+			"paying denom must not be the same as paying coin denom: invalid paying denoms",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				[]types.PayingDenom{types.NewPayingDenom("denom1", math.LegacyOneDec())},
+				types.SaleModeFirstCome,
+			),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleModeOverflow,
+			),
+		},
+		{
+			"invalid sale mode: 2: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+				types.SaleMode(2),
 			),
 		},
 	}
@@ -214,6 +455,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -229,6 +481,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -244,6 +507,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -259,6 +533,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -274,6 +559,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -289,6 +585,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(-1, 0, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -309,6 +616,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -329,6 +647,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -349,6 +678,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(1, 0, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -373,6 +713,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -397,6 +748,17 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -412,6 +774,95 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("-0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
+			),
+		},
+		{
+			"bid cancel cutoff must not be negative: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				math.LegacyMustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				math.LegacyMustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				-time.Hour,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
+			),
+		},
+		{
+			"invalid marginal fill mode: 3: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				math.LegacyMustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				math.LegacyMustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillMode(3),
+				types.PricingModeUniform,
+				nil,
+				nil,
+			),
+		},
+		{
+			"soft cap must not be negative: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				math.LegacyMustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				math.LegacyMustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.NewInt(-1),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
 			),
 		},
 		{
@@ -427,6 +878,95 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 				math.LegacyMustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				nil,
+				nil,
+			),
+		},
+		{
+			"", // empty means no error expected,
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				math.LegacyMustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(0),
+				math.LegacyMustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				&types.SealedBidConfig{RevealPeriod: 24 * time.Hour, ForfeitUnrevealed: true},
+				nil,
+			),
+		},
+		{
+			"reveal period must be positive: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				math.LegacyMustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(0),
+				math.LegacyMustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				&types.SealedBidConfig{},
+				nil,
+			),
+		},
+		{
+			"sealed-bid auction must not have extended rounds: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("0.5"),
+				math.LegacyMustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				math.LegacyMustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				0,
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				types.MarginalFillModeNone,
+				types.PricingModeUniform,
+				&types.SealedBidConfig{RevealPeriod: 24 * time.Hour},
+				nil,
 			),
 		},
 	}
@@ -443,21 +983,187 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 	}
 }
 
-func TestMsgCancelAuction(t *testing.T) {
+func TestMsgCreateDutchAuction(t *testing.T) {
 	testCases := []struct {
 		expectedErr string
-		msg         *types.MsgCancelAuction
+		msg         *types.MsgCreateDutchAuction
 	}{
 		{
-			"", // empty means no error expected
-			types.NewMsgCancelAuction(
+			"", // empty means no error expected,
+			types.NewMsgCreateDutchAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
-				uint64(1),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				0,
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
 			),
 		},
 		{
-			"invalid auctioneer address: empty address string is not allowed: invalid address",
-			types.NewMsgCancelAuction(
+			"",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Hour,
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+			),
+		},
+		{
+			"floor price must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				0,
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+			),
+		},
+		{
+			"floor price must be lower than start price: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("1.0"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				0,
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+			),
+		},
+		{
+			"selling coin amount must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 0),
+				"denom1",
+				[]types.VestingSchedule{},
+				0,
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+			),
+		},
+		{
+			"decay interval must not be negative: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				-time.Hour,
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+			),
+		},
+		{
+			"decay interval must not exceed the auction period: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				math.LegacyMustNewDecFromStr("1.0"),
+				math.LegacyMustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				24*time.Hour,
+				time.Now(),
+				time.Now().Add(time.Hour),
+				sdk.NewCoins(),
+				math.ZeroInt(),
+				[]types.VestingSchedule{},
+				nil,
+				false,
+				"",
+				nil,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateDutchAuction{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCancelAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCancelAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCancelAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid auctioneer address: empty address string is not allowed: invalid address",
+			types.NewMsgCancelAuction(
 				"",
 				uint64(1),
 			),
@@ -489,6 +1195,7 @@ func TestMsgPlaceBid(t *testing.T) {
 				types.BidTypeBatchWorth,
 				math.LegacyOneDec(),
 				sdk.NewInt64Coin("denom2", 1000000),
+				"",
 			),
 		},
 		{
@@ -499,6 +1206,7 @@ func TestMsgPlaceBid(t *testing.T) {
 				types.BidTypeBatchWorth,
 				math.LegacyZeroDec(),
 				sdk.NewInt64Coin("denom2", 1000000),
+				"",
 			),
 		},
 		{
@@ -509,6 +1217,7 @@ func TestMsgPlaceBid(t *testing.T) {
 				types.BidTypeBatchWorth,
 				math.LegacyOneDec(),
 				sdk.NewInt64Coin("denom2", 0),
+				"",
 			),
 		},
 		{
@@ -519,6 +1228,29 @@ func TestMsgPlaceBid(t *testing.T) {
 				types.BidTypeBatchWorth,
 				math.LegacyOneDec(),
 				sdk.NewInt64Coin("denom2", 1000000),
+				"",
+			),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgPlaceBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				types.BidTypeBatchMany,
+				math.LegacyOneDec(),
+				sdk.NewInt64Coin("denom1", 1000000),
+				"denom3",
+			),
+		},
+		{
+			"invalid paying denom: invalid denom: !: invalid request",
+			types.NewMsgPlaceBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				types.BidTypeBatchMany,
+				math.LegacyOneDec(),
+				sdk.NewInt64Coin("denom1", 1000000),
+				"!",
 			),
 		},
 	}
@@ -594,6 +1326,41 @@ func TestMsgModifyBid(t *testing.T) {
 	}
 }
 
+func TestMsgCancelBid(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCancelBid
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCancelBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgCancelBid(
+				uint64(1),
+				"",
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCancelBid{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestAddAllowedBidder(t *testing.T) {
 	testCases := []struct {
 		expectedErr string
@@ -633,3 +1400,366 @@ func TestAddAllowedBidder(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgClaimBuyerVesting(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimBuyerVesting
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimBuyerVesting(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+			),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgClaimBuyerVesting(
+				uint64(1),
+				"",
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimBuyerVesting{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimVestedProceeds(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimVestedProceeds
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimVestedProceeds(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				uint64(1),
+				"",
+			),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimVestedProceeds(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Beneficiary"))).String(),
+			),
+		},
+		{
+			"invalid auctioneer address: empty address string is not allowed: invalid address",
+			types.NewMsgClaimVestedProceeds(
+				"",
+				uint64(1),
+				"",
+			),
+		},
+		{
+			"invalid beneficiary address: decoding bech32 failed: invalid bech32 string length 7: invalid address",
+			types.NewMsgClaimVestedProceeds(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				uint64(1),
+				"invalid",
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimVestedProceeds{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgTransferAuctionOwnership(t *testing.T) {
+	auctioneer := sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String()
+	newOwner := sdk.AccAddress(crypto.AddressHash([]byte("NewOwner"))).String()
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgTransferAuctionOwnership
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgTransferAuctionOwnership(auctioneer, uint64(1), newOwner, ""),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgTransferAuctionOwnership(auctioneer, uint64(1), newOwner, sdk.AccAddress(crypto.AddressHash([]byte("Beneficiary"))).String()),
+		},
+		{
+			"invalid auctioneer address: empty address string is not allowed: invalid address",
+			types.NewMsgTransferAuctionOwnership("", uint64(1), newOwner, ""),
+		},
+		{
+			"invalid new owner address: empty address string is not allowed: invalid address",
+			types.NewMsgTransferAuctionOwnership(auctioneer, uint64(1), "", ""),
+		},
+		{
+			"invalid new beneficiary address: decoding bech32 failed: invalid bech32 string length 7: invalid address",
+			types.NewMsgTransferAuctionOwnership(auctioneer, uint64(1), newOwner, "invalid"),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgTransferAuctionOwnership{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgAcceptAuctionOwnership(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgAcceptAuctionOwnership
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgAcceptAuctionOwnership(sdk.AccAddress(crypto.AddressHash([]byte("NewOwner"))).String(), uint64(1)),
+		},
+		{
+			"invalid new owner address: empty address string is not allowed: invalid address",
+			types.NewMsgAcceptAuctionOwnership("", uint64(1)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgAcceptAuctionOwnership{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgPauseAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgPauseAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgPauseAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Sender"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid sender address: empty address string is not allowed: invalid address",
+			types.NewMsgPauseAuction(
+				"",
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgPauseAuction{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgResumeAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgResumeAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgResumeAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Sender"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid sender address: empty address string is not allowed: invalid address",
+			types.NewMsgResumeAuction(
+				"",
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgResumeAuction{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCloseAuctionEarly(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCloseAuctionEarly
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCloseAuctionEarly(
+				sdk.AccAddress(crypto.AddressHash([]byte("Sender"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid sender address: empty address string is not allowed: invalid address",
+			types.NewMsgCloseAuctionEarly(
+				"",
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCloseAuctionEarly{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgForceCancelAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgForceCancelAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgForceCancelAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Authority"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid authority address: empty address string is not allowed: invalid address",
+			types.NewMsgForceCancelAuction(
+				"",
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgForceCancelAuction{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCommitBid(t *testing.T) {
+	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String()
+	hash := types.BidCommitmentHash(1, bidder, types.BidTypeBatchWorth, math.LegacyOneDec(), sdk.NewInt64Coin("denom1", 1_000_000), "salt")
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCommitBid
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCommitBid(bidder, uint64(1), hash, sdk.NewInt64Coin("denom1", 1_000_000)),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgCommitBid("", uint64(1), hash, sdk.NewInt64Coin("denom1", 1_000_000)),
+		},
+		{
+			"hash must be 32 bytes: invalid request",
+			types.NewMsgCommitBid(bidder, uint64(1), hash[:16], sdk.NewInt64Coin("denom1", 1_000_000)),
+		},
+		{
+			"collateral amount must be positive: invalid request",
+			types.NewMsgCommitBid(bidder, uint64(1), hash, sdk.NewInt64Coin("denom1", 0)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCommitBid{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgRevealBid(t *testing.T) {
+	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String()
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgRevealBid
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgRevealBid(bidder, uint64(1), uint64(1), types.BidTypeBatchWorth, math.LegacyOneDec(), sdk.NewInt64Coin("denom1", 1_000_000), "salt"),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgRevealBid("", uint64(1), uint64(1), types.BidTypeBatchWorth, math.LegacyOneDec(), sdk.NewInt64Coin("denom1", 1_000_000), "salt"),
+		},
+		{
+			"invalid bid type: BID_TYPE_FIXED_PRICE: invalid request",
+			types.NewMsgRevealBid(bidder, uint64(1), uint64(1), types.BidTypeFixedPrice, math.LegacyOneDec(), sdk.NewInt64Coin("denom1", 1_000_000), "salt"),
+		},
+		{
+			"bid price must be positive value: invalid request",
+			types.NewMsgRevealBid(bidder, uint64(1), uint64(1), types.BidTypeBatchMany, math.LegacyZeroDec(), sdk.NewInt64Coin("denom2", 1_000_000), "salt"),
+		},
+		{
+			"salt must not be empty: invalid request",
+			types.NewMsgRevealBid(bidder, uint64(1), uint64(1), types.BidTypeBatchWorth, math.LegacyOneDec(), sdk.NewInt64Coin("denom1", 1_000_000), ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgRevealBid{}, tc.msg)
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
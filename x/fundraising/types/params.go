@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,15 +13,25 @@ const (
 	// when they create an auction
 	MaxNumVestingSchedules = 100
 
+	// MaxNumAdditionalPayingDenoms is the maximum number of additional paying denoms in an auction
+	MaxNumAdditionalPayingDenoms = 10
+
 	// MaxExtendedRound is the maximum extend rounds for a batch auction to have
 	// It prevents from a batch auction to extend its rounds forever
 	MaxExtendedRound = 30
 )
 
 var (
-	DefaultAuctionCreationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100_000_000)))
-	DefaultPlaceBidFee        = sdk.Coins{}
-	DefaultExtendedPeriod     = uint32(1)
+	DefaultAuctionCreationFee    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100_000_000)))
+	DefaultPlaceBidFee           = sdk.Coins{}
+	DefaultExtendedPeriod        = uint32(1)
+	DefaultAllowedPayingDenoms   = []string(nil)
+	DefaultBlockedSellingDenoms  = []string(nil)
+	DefaultSettlementFeeRate     = math.LegacyZeroDec()
+	DefaultFeeTreasuryRate       = math.LegacyZeroDec()
+	DefaultFeeBurnRate           = math.LegacyZeroDec()
+	DefaultFeeTreasuryAddress    = ""
+	DefaultRefundUnmatchedBidFee = false
 )
 
 // NewParams creates a new Params instance.
@@ -27,13 +39,42 @@ func NewParams(
 	auctionCreationFee,
 	placeBidFee sdk.Coins,
 	extendedPeriod uint32,
+	allowedPayingDenoms,
+	blockedSellingDenoms []string,
+	settlementFeeRate,
+	feeTreasuryRate,
+	feeBurnRate math.LegacyDec,
+	feeTreasuryAddress string,
+	refundUnmatchedBidFee bool,
 ) Params {
-	return Params{AuctionCreationFee: auctionCreationFee, PlaceBidFee: placeBidFee, ExtendedPeriod: extendedPeriod}
+	return Params{
+		AuctionCreationFee:    auctionCreationFee,
+		PlaceBidFee:           placeBidFee,
+		ExtendedPeriod:        extendedPeriod,
+		AllowedPayingDenoms:   allowedPayingDenoms,
+		BlockedSellingDenoms:  blockedSellingDenoms,
+		SettlementFeeRate:     settlementFeeRate,
+		FeeTreasuryRate:       feeTreasuryRate,
+		FeeBurnRate:           feeBurnRate,
+		FeeTreasuryAddress:    feeTreasuryAddress,
+		RefundUnmatchedBidFee: refundUnmatchedBidFee,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultAuctionCreationFee, DefaultPlaceBidFee, DefaultExtendedPeriod)
+	return NewParams(
+		DefaultAuctionCreationFee,
+		DefaultPlaceBidFee,
+		DefaultExtendedPeriod,
+		DefaultAllowedPayingDenoms,
+		DefaultBlockedSellingDenoms,
+		DefaultSettlementFeeRate,
+		DefaultFeeTreasuryRate,
+		DefaultFeeBurnRate,
+		DefaultFeeTreasuryAddress,
+		DefaultRefundUnmatchedBidFee,
+	)
 }
 
 // Validate validates the set of params.
@@ -47,6 +88,18 @@ func (p Params) Validate() error {
 	if err := validateExtendedPeriod(p.ExtendedPeriod); err != nil {
 		return err
 	}
+	if err := validateDenoms(p.AllowedPayingDenoms); err != nil {
+		return fmt.Errorf("invalid allowed paying denoms: %w", err)
+	}
+	if err := validateDenoms(p.BlockedSellingDenoms); err != nil {
+		return fmt.Errorf("invalid blocked selling denoms: %w", err)
+	}
+	if err := validateFeeRate(p.SettlementFeeRate); err != nil {
+		return fmt.Errorf("invalid settlement fee rate: %w", err)
+	}
+	if err := validateFeeDistribution(p.FeeTreasuryRate, p.FeeBurnRate, p.FeeTreasuryAddress); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -62,3 +115,97 @@ func validatePlaceBidFee(v sdk.Coins) error {
 func validateExtendedPeriod(uint32) error {
 	return nil
 }
+
+func validateDenoms(v []string) error {
+	seen := make(map[string]struct{}, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+func validateFeeRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("rate must be in the range [0, 1): %s", v)
+	}
+	return nil
+}
+
+func validateFeeDistribution(treasuryRate, burnRate math.LegacyDec, treasuryAddress string) error {
+	treasuryRate, burnRate = decOrZero(treasuryRate), decOrZero(burnRate)
+	if treasuryRate.IsNegative() {
+		return fmt.Errorf("fee treasury rate must not be negative: %s", treasuryRate)
+	}
+	if burnRate.IsNegative() {
+		return fmt.Errorf("fee burn rate must not be negative: %s", burnRate)
+	}
+	if treasuryRate.Add(burnRate).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("sum of fee treasury rate and fee burn rate must not be greater than 1: %s", treasuryRate.Add(burnRate))
+	}
+	if treasuryRate.IsPositive() || treasuryAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(treasuryAddress); err != nil {
+			return fmt.Errorf("invalid fee treasury address: %w", err)
+		}
+	}
+	return nil
+}
+
+// decOrZero returns zero for the nil value of the params that were stored before the field existed.
+func decOrZero(v math.LegacyDec) math.LegacyDec {
+	if v.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return v
+}
+
+// SplitFee splits the fee into the parts that go to the community pool, the treasury and the burn.
+// The treasury and burned parts are truncated and the rest goes to the community pool.
+func (p Params) SplitFee(fee sdk.Coins) (communityPool, treasury, burned sdk.Coins) {
+	treasuryRate, burnRate := decOrZero(p.FeeTreasuryRate), decOrZero(p.FeeBurnRate)
+	for _, coin := range fee {
+		treasuryAmt := math.LegacyNewDecFromInt(coin.Amount).MulTruncate(treasuryRate).TruncateInt()
+		burnAmt := math.LegacyNewDecFromInt(coin.Amount).MulTruncate(burnRate).TruncateInt()
+		treasury = treasury.Add(sdk.NewCoin(coin.Denom, treasuryAmt))
+		burned = burned.Add(sdk.NewCoin(coin.Denom, burnAmt))
+		communityPool = communityPool.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(treasuryAmt).Sub(burnAmt)))
+	}
+	return communityPool, treasury, burned
+}
+
+// SettlementFee returns the settlement fee charged on the given raised paying coin.
+func (p Params) SettlementFee(raised sdk.Coin) sdk.Coin {
+	feeAmt := math.LegacyNewDecFromInt(raised.Amount).MulTruncate(decOrZero(p.SettlementFeeRate)).TruncateInt()
+	return sdk.NewCoin(raised.Denom, feeAmt)
+}
+
+// IsPayingDenomAllowed returns true if an auction can be paid with the given denom.
+func (p Params) IsPayingDenomAllowed(denom string) bool {
+	if len(p.AllowedPayingDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedPayingDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSellingDenomBlocked returns true if the given denom can't be sold in an auction.
+func (p Params) IsSellingDenomBlocked(denom string) bool {
+	for _, blocked := range p.BlockedSellingDenoms {
+		if blocked == denom {
+			return true
+		}
+	}
+	return false
+}
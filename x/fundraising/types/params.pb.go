@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -40,6 +41,28 @@ type Params struct {
 	// extended_period specifies the extended period that determines how long
 	// the extended auction round lasts
 	ExtendedPeriod uint32 `protobuf:"varint,3,opt,name=extended_period,json=extendedPeriod,proto3" json:"extended_period,omitempty"`
+	// allowed_paying_denoms specifies the denoms that an auction can be paid
+	// with; any denom is allowed when it is empty
+	AllowedPayingDenoms []string `protobuf:"bytes,4,rep,name=allowed_paying_denoms,json=allowedPayingDenoms,proto3" json:"allowed_paying_denoms,omitempty"`
+	// blocked_selling_denoms specifies the denoms that can't be sold in an
+	// auction, such as the staking denom
+	BlockedSellingDenoms []string `protobuf:"bytes,5,rep,name=blocked_selling_denoms,json=blockedSellingDenoms,proto3" json:"blocked_selling_denoms,omitempty"`
+	// settlement_fee_rate specifies the rate of the raised paying coin that is
+	// charged as a fee when an auction is settled
+	SettlementFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=settlement_fee_rate,json=settlementFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"settlement_fee_rate"`
+	// fee_treasury_rate specifies the rate of the collected fees that is sent
+	// to fee_treasury_address
+	FeeTreasuryRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=fee_treasury_rate,json=feeTreasuryRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_treasury_rate"`
+	// fee_burn_rate specifies the rate of the collected fees that is burned;
+	// the rest of the collected fees goes to the community pool
+	FeeBurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=fee_burn_rate,json=feeBurnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_rate"`
+	// fee_treasury_address specifies the bech32-encoded address of the treasury
+	// that receives the fee_treasury_rate of the collected fees
+	FeeTreasuryAddress string `protobuf:"bytes,9,opt,name=fee_treasury_address,json=feeTreasuryAddress,proto3" json:"fee_treasury_address,omitempty"`
+	// refund_unmatched_bid_fee specifies whether the place bid fees of batch
+	// auctions are escrowed in the module account until the auction is closed,
+	// so that the fees of the unmatched bids are refunded to the bidders
+	RefundUnmatchedBidFee bool `protobuf:"varint,10,opt,name=refund_unmatched_bid_fee,json=refundUnmatchedBidFee,proto3" json:"refund_unmatched_bid_fee,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +119,34 @@ func (m *Params) GetExtendedPeriod() uint32 {
 	return 0
 }
 
+func (m *Params) GetAllowedPayingDenoms() []string {
+	if m != nil {
+		return m.AllowedPayingDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedSellingDenoms() []string {
+	if m != nil {
+		return m.BlockedSellingDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetFeeTreasuryAddress() string {
+	if m != nil {
+		return m.FeeTreasuryAddress
+	}
+	return ""
+}
+
+func (m *Params) GetRefundUnmatchedBidFee() bool {
+	if m != nil {
+		return m.RefundUnmatchedBidFee
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fundraising.fundraising.v1.Params")
 }
@@ -105,31 +156,45 @@ func init() {
 }
 
 var fileDescriptor_3ee333e6a32caa0f = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x31, 0x4f, 0xc2, 0x40,
-	0x18, 0xed, 0x41, 0xc2, 0x50, 0x44, 0x63, 0xc3, 0x00, 0x0c, 0x85, 0xb8, 0x80, 0x24, 0xf6, 0x52,
-	0x8d, 0x8b, 0x23, 0x24, 0xac, 0x12, 0x46, 0x97, 0xe6, 0xda, 0x7e, 0xd4, 0x8b, 0xf4, 0xae, 0xe9,
-	0x15, 0x02, 0x3f, 0xc0, 0xc5, 0xc9, 0xc4, 0xcd, 0xc9, 0xd1, 0x38, 0xf1, 0x33, 0x18, 0x19, 0x9d,
-	0xd4, 0xc0, 0x80, 0xbf, 0xc1, 0xc9, 0xf4, 0x7a, 0x26, 0x75, 0x70, 0x75, 0x69, 0xdf, 0xf7, 0xde,
-	0xdd, 0xf7, 0xde, 0xdd, 0x77, 0x7a, 0x7b, 0x3c, 0x65, 0x7e, 0x4c, 0xa8, 0xa0, 0x2c, 0xc0, 0x79,
-	0x3c, 0xb3, 0x71, 0x44, 0x62, 0x12, 0x0a, 0x2b, 0x8a, 0x79, 0xc2, 0x8d, 0x46, 0x4e, 0xb4, 0xf2,
-	0x78, 0x66, 0x37, 0x0e, 0x49, 0x48, 0x19, 0xc7, 0xf2, 0x9b, 0x2d, 0x6f, 0x98, 0x1e, 0x17, 0x21,
-	0x17, 0xd8, 0x25, 0x02, 0xf0, 0xcc, 0x76, 0x21, 0x21, 0x36, 0xf6, 0x38, 0x65, 0x4a, 0xaf, 0x67,
-	0xba, 0x23, 0x2b, 0x9c, 0x15, 0x4a, 0xaa, 0x06, 0x3c, 0xe0, 0x19, 0x9f, 0xa2, 0x8c, 0x3d, 0xfa,
-	0x2a, 0xe8, 0xa5, 0xa1, 0x0c, 0x64, 0x3c, 0x20, 0xbd, 0x4a, 0xa6, 0x5e, 0x42, 0x39, 0x73, 0xbc,
-	0x18, 0x88, 0x04, 0x63, 0x80, 0x1a, 0x6a, 0x15, 0x3b, 0xe5, 0xd3, 0xba, 0xa5, 0xda, 0xa5, 0xde,
-	0x96, 0xf2, 0xb6, 0xfa, 0x9c, 0xb2, 0xde, 0x60, 0xf5, 0xd6, 0xd4, 0x5e, 0xde, 0x9b, 0x9d, 0x80,
-	0x26, 0xd7, 0x53, 0xd7, 0xf2, 0x78, 0xa8, 0xbc, 0xd5, 0xef, 0x44, 0xf8, 0x37, 0x38, 0x59, 0x44,
-	0x20, 0xe4, 0x06, 0xf1, 0xb8, 0x5b, 0x76, 0xf7, 0x26, 0x10, 0x10, 0x6f, 0xe1, 0xa4, 0xe9, 0xc5,
-	0xf3, 0x6e, 0xd9, 0x45, 0x23, 0x43, 0xd9, 0xf7, 0x95, 0xfb, 0x00, 0xc0, 0xb8, 0x45, 0x7a, 0x25,
-	0x9a, 0x10, 0x0f, 0x1c, 0x97, 0xfa, 0x32, 0x4e, 0xe1, 0xbf, 0xe2, 0x94, 0xa5, 0x6f, 0x8f, 0xfa,
-	0x69, 0x8e, 0xb6, 0x7e, 0x00, 0xf3, 0x04, 0x98, 0x0f, 0xbe, 0x13, 0x41, 0x4c, 0xb9, 0x5f, 0x2b,
-	0xb6, 0x50, 0xa7, 0x32, 0xda, 0xff, 0xa1, 0x87, 0x92, 0xbd, 0x38, 0xfe, 0x7c, 0x6a, 0xa2, 0xbb,
-	0xdd, 0xb2, 0xdb, 0xca, 0xcf, 0x7d, 0xfe, 0xeb, 0x15, 0x64, 0x37, 0xde, 0xbb, 0x5c, 0x6d, 0x4c,
-	0xb4, 0xde, 0x98, 0xe8, 0x63, 0x63, 0xa2, 0xfb, 0xad, 0xa9, 0xad, 0xb7, 0xa6, 0xf6, 0xba, 0x35,
-	0xb5, 0xab, 0xf3, 0x5c, 0x74, 0xd9, 0x3d, 0x0e, 0x29, 0x4b, 0xf0, 0xdf, 0x1d, 0xe5, 0x69, 0xdc,
-	0x92, 0x1c, 0xea, 0xd9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x41, 0xef, 0x15, 0x7f, 0x02,
-	0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x4f, 0x14, 0x4f,
+	0x14, 0xbf, 0xfd, 0xf2, 0x95, 0x1f, 0x83, 0x48, 0x58, 0x0e, 0xb3, 0x60, 0xb2, 0x77, 0xb1, 0xe1,
+	0x24, 0x61, 0x37, 0x87, 0x1a, 0x13, 0x3b, 0x0f, 0x42, 0x61, 0x4c, 0x24, 0x8b, 0x34, 0x26, 0x66,
+	0x33, 0xbb, 0xf3, 0x6e, 0x99, 0xb0, 0x3b, 0x73, 0x99, 0x99, 0x45, 0xae, 0x35, 0xb1, 0xb1, 0x32,
+	0xb1, 0xb3, 0xb2, 0x34, 0x56, 0x14, 0xfc, 0x11, 0x94, 0x84, 0xca, 0x58, 0xa0, 0x81, 0xe2, 0xfc,
+	0x33, 0xcc, 0xce, 0x0c, 0xba, 0x14, 0x36, 0x14, 0x36, 0x77, 0x6f, 0xde, 0x67, 0xde, 0xe7, 0xf3,
+	0x99, 0x97, 0xf7, 0x16, 0x2d, 0xf7, 0x4b, 0x46, 0x04, 0xa6, 0x92, 0xb2, 0x2c, 0xac, 0xc7, 0xfb,
+	0xdd, 0x70, 0x80, 0x05, 0x2e, 0x64, 0x30, 0x10, 0x5c, 0x71, 0x77, 0xa9, 0x06, 0x06, 0xf5, 0x78,
+	0xbf, 0xbb, 0x34, 0x87, 0x0b, 0xca, 0x78, 0xa8, 0x7f, 0xcd, 0xf5, 0x25, 0x3f, 0xe5, 0xb2, 0xe0,
+	0x32, 0x4c, 0xb0, 0x84, 0x70, 0xbf, 0x9b, 0x80, 0xc2, 0xdd, 0x30, 0xe5, 0x94, 0x59, 0x7c, 0xd1,
+	0xe0, 0xb1, 0x3e, 0x85, 0xe6, 0x60, 0xa1, 0x66, 0xc6, 0x33, 0x6e, 0xf2, 0x55, 0x64, 0xb2, 0x77,
+	0xdf, 0x4c, 0xa0, 0xf1, 0x2d, 0x6d, 0xc8, 0xfd, 0xe0, 0xa0, 0x26, 0x2e, 0x53, 0x45, 0x39, 0x8b,
+	0x53, 0x01, 0x58, 0x07, 0x7d, 0x00, 0xcf, 0x69, 0x8f, 0x75, 0xa6, 0xd7, 0x16, 0x03, 0x4b, 0x57,
+	0x69, 0x07, 0x56, 0x3b, 0x58, 0xe7, 0x94, 0xf5, 0x36, 0x8f, 0xcf, 0x5a, 0x8d, 0x2f, 0xdf, 0x5b,
+	0x9d, 0x8c, 0xaa, 0xdd, 0x32, 0x09, 0x52, 0x5e, 0x58, 0x6d, 0xfb, 0xb7, 0x2a, 0xc9, 0x5e, 0xa8,
+	0x86, 0x03, 0x90, 0xba, 0x40, 0x7e, 0x1c, 0x1d, 0xae, 0xdc, 0xcc, 0x21, 0xc3, 0xe9, 0x30, 0xae,
+	0xdc, 0xcb, 0xcf, 0xa3, 0xc3, 0x15, 0x27, 0x72, 0xad, 0xfc, 0xba, 0x55, 0xdf, 0x04, 0x70, 0xdf,
+	0x3a, 0x68, 0x66, 0x90, 0xe3, 0x14, 0xe2, 0x84, 0x12, 0x6d, 0xe7, 0xbf, 0x7f, 0x65, 0x67, 0x5a,
+	0xeb, 0xf6, 0x28, 0xa9, 0x7c, 0x2c, 0xa3, 0x59, 0x38, 0x50, 0xc0, 0x08, 0x90, 0x78, 0x00, 0x82,
+	0x72, 0xe2, 0x8d, 0xb5, 0x9d, 0xce, 0x4c, 0x74, 0xeb, 0x32, 0xbd, 0xa5, 0xb3, 0xee, 0x1a, 0x5a,
+	0xc0, 0x79, 0xce, 0x5f, 0x57, 0xf7, 0xf0, 0x90, 0xb2, 0x2c, 0x26, 0xc0, 0x78, 0x21, 0xbd, 0xff,
+	0xdb, 0x63, 0x9d, 0xa9, 0x68, 0xde, 0x82, 0x5b, 0x1a, 0xdb, 0xd0, 0x90, 0xfb, 0x00, 0xdd, 0x4e,
+	0x72, 0x9e, 0xee, 0x01, 0x89, 0x25, 0xe4, 0x79, 0xad, 0xe8, 0x86, 0x2e, 0x6a, 0x5a, 0x74, 0xdb,
+	0x80, 0xb6, 0x0a, 0xa3, 0x79, 0x09, 0x4a, 0xe5, 0x50, 0x00, 0x53, 0x55, 0x6b, 0x62, 0x81, 0x15,
+	0x78, 0xe3, 0x6d, 0xa7, 0x33, 0xd5, 0xeb, 0x56, 0x4d, 0xf8, 0x76, 0xd6, 0xba, 0x63, 0x9e, 0x2c,
+	0xc9, 0x5e, 0x40, 0x79, 0x58, 0x60, 0xb5, 0x1b, 0x3c, 0xd3, 0x2f, 0xdd, 0x80, 0xf4, 0xf4, 0x68,
+	0x15, 0xd9, 0x2e, 0x6e, 0x40, 0x1a, 0xcd, 0xfd, 0x61, 0xdb, 0x04, 0x88, 0xb0, 0x02, 0xf7, 0x15,
+	0x9a, 0xab, 0x78, 0x95, 0x00, 0x2c, 0x4b, 0x31, 0x34, 0x02, 0x13, 0xd7, 0x15, 0x98, 0xed, 0x03,
+	0xbc, 0xb0, 0x54, 0x9a, 0x7e, 0x07, 0xcd, 0x54, 0xf4, 0x49, 0x29, 0x98, 0xa1, 0x9e, 0xbc, 0x2e,
+	0xf5, 0x74, 0x1f, 0xa0, 0x57, 0x0a, 0xa6, 0x69, 0x9f, 0xa2, 0xe6, 0x15, 0xd7, 0x98, 0x10, 0x01,
+	0x52, 0x7a, 0x53, 0x9a, 0xdd, 0x3b, 0x3d, 0x5a, 0x6d, 0xda, 0xd2, 0x27, 0x06, 0xd9, 0x56, 0x82,
+	0xb2, 0x2c, 0x72, 0x6b, 0xfe, 0x2c, 0xe2, 0x3e, 0x42, 0x9e, 0x80, 0x6a, 0x31, 0xe3, 0x92, 0x15,
+	0x58, 0xa5, 0xbb, 0x40, 0x7e, 0x4f, 0x22, 0x6a, 0x3b, 0x9d, 0xc9, 0x68, 0xc1, 0xe0, 0x3b, 0x97,
+	0xb0, 0x19, 0x98, 0xc7, 0xf7, 0x7e, 0x7e, 0x6a, 0x39, 0xef, 0x46, 0x87, 0x2b, 0xed, 0xfa, 0xfe,
+	0x1f, 0x5c, 0xf9, 0x1a, 0x98, 0xcd, 0xeb, 0x3d, 0x3f, 0x3e, 0xf7, 0x9d, 0x93, 0x73, 0xdf, 0xf9,
+	0x71, 0xee, 0x3b, 0xef, 0x2f, 0xfc, 0xc6, 0xc9, 0x85, 0xdf, 0xf8, 0x7a, 0xe1, 0x37, 0x5e, 0x3e,
+	0xac, 0x8d, 0xb0, 0x9e, 0x32, 0x51, 0x50, 0xa6, 0xc2, 0xbf, 0x33, 0xea, 0xa9, 0x4e, 0xc6, 0xf5,
+	0x72, 0xdf, 0xff, 0x35, 0x00, 0x2b, 0xea, 0xd7, 0x49, 0x87, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -170,6 +235,37 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ExtendedPeriod != that1.ExtendedPeriod {
 		return false
 	}
+	if len(this.AllowedPayingDenoms) != len(that1.AllowedPayingDenoms) {
+		return false
+	}
+	for i := range this.AllowedPayingDenoms {
+		if this.AllowedPayingDenoms[i] != that1.AllowedPayingDenoms[i] {
+			return false
+		}
+	}
+	if len(this.BlockedSellingDenoms) != len(that1.BlockedSellingDenoms) {
+		return false
+	}
+	for i := range this.BlockedSellingDenoms {
+		if this.BlockedSellingDenoms[i] != that1.BlockedSellingDenoms[i] {
+			return false
+		}
+	}
+	if !this.SettlementFeeRate.Equal(that1.SettlementFeeRate) {
+		return false
+	}
+	if !this.FeeTreasuryRate.Equal(that1.FeeTreasuryRate) {
+		return false
+	}
+	if !this.FeeBurnRate.Equal(that1.FeeBurnRate) {
+		return false
+	}
+	if this.FeeTreasuryAddress != that1.FeeTreasuryAddress {
+		return false
+	}
+	if this.RefundUnmatchedBidFee != that1.RefundUnmatchedBidFee {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -192,6 +288,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundUnmatchedBidFee {
+		i--
+		if m.RefundUnmatchedBidFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeTreasuryAddress) > 0 {
+		i -= len(m.FeeTreasuryAddress)
+		copy(dAtA[i:], m.FeeTreasuryAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeTreasuryAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.FeeBurnRate.Size()
+		i -= size
+		if _, err := m.FeeBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeeTreasuryRate.Size()
+		i -= size
+		if _, err := m.FeeTreasuryRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SettlementFeeRate.Size()
+		i -= size
+		if _, err := m.SettlementFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockedSellingDenoms) > 0 {
+		for iNdEx := len(m.BlockedSellingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedSellingDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedSellingDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BlockedSellingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedPayingDenoms) > 0 {
+		for iNdEx := len(m.AllowedPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPayingDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedPayingDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedPayingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ExtendedPeriod != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ExtendedPeriod))
 		i--
@@ -260,6 +421,31 @@ func (m *Params) Size() (n int) {
 	if m.ExtendedPeriod != 0 {
 		n += 1 + sovParams(uint64(m.ExtendedPeriod))
 	}
+	if len(m.AllowedPayingDenoms) > 0 {
+		for _, s := range m.AllowedPayingDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BlockedSellingDenoms) > 0 {
+		for _, s := range m.BlockedSellingDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.SettlementFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeTreasuryRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeBurnRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeTreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RefundUnmatchedBidFee {
+		n += 2
+	}
 	return n
 }
 
@@ -385,6 +571,224 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPayingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPayingDenoms = append(m.AllowedPayingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedSellingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedSellingDenoms = append(m.BlockedSellingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTreasuryRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTreasuryRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundUnmatchedBidFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundUnmatchedBidFee = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -12,7 +13,7 @@ import (
 func TestParams(t *testing.T) {
 	defaultParams := types.DefaultParams()
 
-	paramsStr := `auction_creation_fee:<denom:"stake" amount:"100000000" > extended_period:1 `
+	paramsStr := `auction_creation_fee:<denom:"stake" amount:"100000000" > extended_period:1 settlement_fee_rate:"0" fee_treasury_rate:"0" fee_burn_rate:"0" `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
 
@@ -38,6 +39,77 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"AllowedPayingDenoms",
+			func(params *types.Params) {
+				params.AllowedPayingDenoms = []string{"denom1", "denom2"}
+				params.BlockedSellingDenoms = []string{"stake"}
+			},
+			"",
+		},
+		{
+			"InvalidAllowedPayingDenom",
+			func(params *types.Params) {
+				params.AllowedPayingDenoms = []string{"1denom"}
+			},
+			"invalid allowed paying denoms: invalid denom: 1denom",
+		},
+		{
+			"DuplicateBlockedSellingDenom",
+			func(params *types.Params) {
+				params.BlockedSellingDenoms = []string{"stake", "stake"}
+			},
+			"invalid blocked selling denoms: duplicate denom stake",
+		},
+		{
+			"FeeDistribution",
+			func(params *types.Params) {
+				params.SettlementFeeRate = math.LegacyMustNewDecFromStr("0.01")
+				params.FeeTreasuryRate = math.LegacyMustNewDecFromStr("0.3")
+				params.FeeBurnRate = math.LegacyMustNewDecFromStr("0.2")
+				params.FeeTreasuryAddress = sdk.AccAddress("treasury").String()
+			},
+			"",
+		},
+		{
+			"NilFeeRates",
+			func(params *types.Params) {
+				params.SettlementFeeRate = math.LegacyDec{}
+				params.FeeTreasuryRate = math.LegacyDec{}
+				params.FeeBurnRate = math.LegacyDec{}
+			},
+			"",
+		},
+		{
+			"InvalidSettlementFeeRate",
+			func(params *types.Params) {
+				params.SettlementFeeRate = math.LegacyOneDec()
+			},
+			"invalid settlement fee rate: rate must be in the range [0, 1): 1.000000000000000000",
+		},
+		{
+			"NegativeFeeBurnRate",
+			func(params *types.Params) {
+				params.FeeBurnRate = math.LegacyMustNewDecFromStr("-0.1")
+			},
+			"fee burn rate must not be negative: -0.100000000000000000",
+		},
+		{
+			"FeeRatesExceedOne",
+			func(params *types.Params) {
+				params.FeeTreasuryRate = math.LegacyMustNewDecFromStr("0.6")
+				params.FeeBurnRate = math.LegacyMustNewDecFromStr("0.5")
+				params.FeeTreasuryAddress = sdk.AccAddress("treasury").String()
+			},
+			"sum of fee treasury rate and fee burn rate must not be greater than 1: 1.100000000000000000",
+		},
+		{
+			"MissingFeeTreasuryAddress",
+			func(params *types.Params) {
+				params.FeeTreasuryRate = math.LegacyMustNewDecFromStr("0.5")
+			},
+			"invalid fee treasury address: empty address string is not allowed",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -54,3 +126,17 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsSplitFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeeTreasuryRate = math.LegacyMustNewDecFromStr("0.333")
+	params.FeeBurnRate = math.LegacyMustNewDecFromStr("0.25")
+
+	communityPool, treasury, burned := params.SplitFee(sdk.NewCoins(sdk.NewInt64Coin("denom1", 1001)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 333)), treasury)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 250)), burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 418)), communityPool)
+
+	params.SettlementFeeRate = math.LegacyMustNewDecFromStr("0.015")
+	require.Equal(t, sdk.NewInt64Coin("denom1", 15), params.SettlementFee(sdk.NewInt64Coin("denom1", 1001)))
+}
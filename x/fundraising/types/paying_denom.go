@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPayingDenom returns a new PayingDenom.
+func NewPayingDenom(denom string, ratio math.LegacyDec) PayingDenom {
+	return PayingDenom{
+		Denom: denom,
+		Ratio: ratio,
+	}
+}
+
+// HasRatio returns true if the conversion ratio of the paying denom is set.
+// The ratio of a paying denom without one is filled in by the price source when the auction is created.
+func (pd PayingDenom) HasRatio() bool {
+	return !pd.Ratio.IsNil() && !pd.Ratio.IsZero()
+}
+
+// ValidatePayingDenoms validates the additional paying denoms of an auction.
+// The denoms must be unique and differ from the selling and the paying coin denom of the auction.
+// The ratios must not be negative; a zero ratio is left to be filled in by the price source.
+func ValidatePayingDenoms(payingDenoms []PayingDenom, sellingCoinDenom, payingCoinDenom string) error {
+	if len(payingDenoms) > MaxNumAdditionalPayingDenoms {
+		return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "exceed maximum number of additional paying denoms")
+	}
+
+	seen := make(map[string]bool, len(payingDenoms))
+	for _, pd := range payingDenoms {
+		if err := sdk.ValidateDenom(pd.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "invalid paying denom: %v", err)
+		}
+		if pd.Denom == sellingCoinDenom {
+			return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "paying denom must not be the same as selling coin denom")
+		}
+		if pd.Denom == payingCoinDenom {
+			return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "paying denom must not be the same as paying coin denom")
+		}
+		if seen[pd.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "duplicate paying denom %s", pd.Denom)
+		}
+		seen[pd.Denom] = true
+
+		if !pd.Ratio.IsNil() && pd.Ratio.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidPayingDenoms, "conversion ratio of %s must not be negative", pd.Denom)
+		}
+	}
+
+	return nil
+}
+
+// PayingDenoms returns all the denoms that the auction accepts, starting with the paying coin denom.
+func PayingDenoms(auction AuctionI) []string {
+	denoms := []string{auction.GetPayingCoinDenom()}
+	for _, pd := range auction.GetAdditionalPayingDenoms() {
+		denoms = append(denoms, pd.Denom)
+	}
+	return denoms
+}
+
+// PayingDenomRatio returns the conversion ratio of the denom into the paying coin denom of the auction
+// and whether the auction accepts the denom. The ratio of the paying coin denom is one.
+func PayingDenomRatio(auction AuctionI, denom string) (math.LegacyDec, bool) {
+	if denom == auction.GetPayingCoinDenom() {
+		return math.LegacyOneDec(), true
+	}
+	for _, pd := range auction.GetAdditionalPayingDenoms() {
+		if pd.Denom == denom {
+			return pd.Ratio, true
+		}
+	}
+	return math.LegacyDec{}, false
+}
+
+// NormalizePayingAmount converts the amount of a paying denom into the paying coin denom by the ratio.
+// Note that it is truncated so that the bidder is never credited more than the coin is worth.
+func NormalizePayingAmount(amount math.Int, ratio math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).MulTruncate(ratio).TruncateInt()
+}
+
+// DenormalizePayingAmount converts the amount of the paying coin denom into a paying denom by the ratio.
+// Note that it is rounded up so that the bidder always pays for the whole amount.
+func DenormalizePayingAmount(amount math.Int, ratio math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).Quo(ratio).Ceil().TruncateInt()
+}
@@ -0,0 +1,158 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func TestValidatePayingDenoms(t *testing.T) {
+	tooMany := make([]types.PayingDenom, types.MaxNumAdditionalPayingDenoms+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewPayingDenom(sdk.DefaultBondDenom+string(rune('a'+i)), math.LegacyOneDec())
+	}
+
+	for _, tc := range []struct {
+		name         string
+		payingDenoms []types.PayingDenom
+		expectedErr  string
+	}{
+		{
+			"valid",
+			[]types.PayingDenom{
+				types.NewPayingDenom("denom3", math.LegacyMustNewDecFromStr("0.5")),
+				types.NewPayingDenom("denom4", math.LegacyZeroDec()),
+			},
+			"",
+		},
+		{
+			"no paying denoms",
+			nil,
+			"",
+		},
+		{
+			"invalid denom",
+			[]types.PayingDenom{types.NewPayingDenom("!", math.LegacyOneDec())},
+			"invalid paying denom: invalid denom: !: invalid paying denoms",
+		},
+		{
+			"selling coin denom",
+			[]types.PayingDenom{types.NewPayingDenom("denom1", math.LegacyOneDec())},
+			"paying denom must not be the same as selling coin denom: invalid paying denoms",
+		},
+		{
+			"paying coin denom",
+			[]types.PayingDenom{types.NewPayingDenom("denom2", math.LegacyOneDec())},
+			"paying denom must not be the same as paying coin denom: invalid paying denoms",
+		},
+		{
+			"duplicate denom",
+			[]types.PayingDenom{
+				types.NewPayingDenom("denom3", math.LegacyOneDec()),
+				types.NewPayingDenom("denom3", math.LegacyMustNewDecFromStr("2")),
+			},
+			"duplicate paying denom denom3: invalid paying denoms",
+		},
+		{
+			"negative ratio",
+			[]types.PayingDenom{types.NewPayingDenom("denom3", math.LegacyMustNewDecFromStr("-1"))},
+			"conversion ratio of denom3 must not be negative: invalid paying denoms",
+		},
+		{
+			"too many paying denoms",
+			tooMany,
+			"exceed maximum number of additional paying denoms: invalid paying denoms",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidatePayingDenoms(tc.payingDenoms, "denom1", "denom2")
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPayingDenomRatio(t *testing.T) {
+	auction := types.NewFixedPriceAuction(
+		types.NewBaseAuction(
+			1,
+			types.AuctionTypeFixedPrice,
+			sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+			types.SellingReserveAddress(1).String(),
+			types.PayingReserveAddress(1).String(),
+			math.LegacyOneDec(),
+			sdk.NewInt64Coin("denom1", 1_000_000),
+			"denom2",
+			types.VestingReserveAddress(1).String(),
+			[]types.VestingSchedule{},
+			time.Now(),
+			[]time.Time{time.Now().AddDate(0, 1, 0)},
+			types.AuctionStatusStarted,
+			sdk.NewCoins(),
+			math.ZeroInt(),
+			[]types.VestingSchedule{},
+			nil,
+			false,
+			"",
+			[]types.PayingDenom{types.NewPayingDenom("denom3", math.LegacyMustNewDecFromStr("0.5"))},
+		),
+		sdk.NewInt64Coin("denom1", 1_000_000),
+		types.SaleModeFirstCome,
+	)
+
+	require.Equal(t, []string{"denom2", "denom3"}, types.PayingDenoms(auction))
+
+	ratio, ok := types.PayingDenomRatio(auction, "denom2")
+	require.True(t, ok)
+	require.Equal(t, math.LegacyOneDec(), ratio)
+
+	ratio, ok = types.PayingDenomRatio(auction, "denom3")
+	require.True(t, ok)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), ratio)
+
+	_, ok = types.PayingDenomRatio(auction, "denom4")
+	require.False(t, ok)
+
+	// A bid in an additional paying denom reserves the coin itself, while a bid for the selling coin
+	// reserves the paying amount converted back into the denom
+	bid := types.Bid{
+		Price:       math.LegacyMustNewDecFromStr("0.3"),
+		Coin:        sdk.NewInt64Coin("denom3", 1_001),
+		PayingDenom: "denom3",
+	}
+	require.Equal(t, sdk.NewInt64Coin("denom2", 500), bid.Normalize(auction).Coin)
+	require.Equal(t, sdk.NewInt64Coin("denom3", 1_001), bid.ReservedPayingCoin(auction))
+
+	bid = types.Bid{
+		Price:       math.LegacyMustNewDecFromStr("0.3"),
+		Coin:        sdk.NewInt64Coin("denom1", 1_001),
+		PayingDenom: "denom3",
+	}
+	require.Equal(t, bid, bid.Normalize(auction))
+	require.Equal(t, sdk.NewInt64Coin("denom3", 602), bid.ReservedPayingCoin(auction))
+}
+
+func TestNormalizePayingAmount(t *testing.T) {
+	for _, tc := range []struct {
+		amt          math.Int
+		ratio        math.LegacyDec
+		normalized   math.Int
+		denormalized math.Int
+	}{
+		{math.NewInt(1_000), math.LegacyOneDec(), math.NewInt(1_000), math.NewInt(1_000)},
+		{math.NewInt(1_001), math.LegacyMustNewDecFromStr("0.5"), math.NewInt(500), math.NewInt(2_002)},
+		{math.NewInt(1_000), math.LegacyMustNewDecFromStr("3"), math.NewInt(3_000), math.NewInt(334)},
+	} {
+		require.Equal(t, tc.normalized, types.NormalizePayingAmount(tc.amt, tc.ratio))
+		require.Equal(t, tc.denormalized, types.DenormalizePayingAmount(tc.amt, tc.ratio))
+	}
+}
@@ -5,9 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -116,6 +118,102 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryAllowedDenomsRequest is request type for the Query/AllowedDenoms RPC
+// method.
+type QueryAllowedDenomsRequest struct {
+}
+
+func (m *QueryAllowedDenomsRequest) Reset()         { *m = QueryAllowedDenomsRequest{} }
+func (m *QueryAllowedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsRequest) ProtoMessage()    {}
+func (*QueryAllowedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{2}
+}
+func (m *QueryAllowedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsRequest.Merge(m, src)
+}
+func (m *QueryAllowedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenomsRequest proto.InternalMessageInfo
+
+// QueryAllowedDenomsResponse is response type for the Query/AllowedDenoms RPC
+// method.
+type QueryAllowedDenomsResponse struct {
+	// allowed_paying_denoms specifies the denoms that an auction can be paid
+	// with; any denom is allowed when it is empty
+	AllowedPayingDenoms []string `protobuf:"bytes,1,rep,name=allowed_paying_denoms,json=allowedPayingDenoms,proto3" json:"allowed_paying_denoms,omitempty"`
+	// blocked_selling_denoms specifies the denoms that can't be sold in an
+	// auction
+	BlockedSellingDenoms []string `protobuf:"bytes,2,rep,name=blocked_selling_denoms,json=blockedSellingDenoms,proto3" json:"blocked_selling_denoms,omitempty"`
+}
+
+func (m *QueryAllowedDenomsResponse) Reset()         { *m = QueryAllowedDenomsResponse{} }
+func (m *QueryAllowedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsResponse) ProtoMessage()    {}
+func (*QueryAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{3}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsResponse.Merge(m, src)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedDenomsResponse) GetAllowedPayingDenoms() []string {
+	if m != nil {
+		return m.AllowedPayingDenoms
+	}
+	return nil
+}
+
+func (m *QueryAllowedDenomsResponse) GetBlockedSellingDenoms() []string {
+	if m != nil {
+		return m.BlockedSellingDenoms
+	}
+	return nil
+}
+
 // QueryAllAuctionRequest is request type for the Query/Auctions RPC method.
 type QueryAllAuctionRequest struct {
 	Status     string             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -127,7 +225,7 @@ func (m *QueryAllAuctionRequest) Reset()         { *m = QueryAllAuctionRequest{}
 func (m *QueryAllAuctionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAuctionRequest) ProtoMessage()    {}
 func (*QueryAllAuctionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{2}
+	return fileDescriptor_8be3ab6819f1d50c, []int{4}
 }
 func (m *QueryAllAuctionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +287,7 @@ func (m *QueryAllAuctionResponse) Reset()         { *m = QueryAllAuctionResponse
 func (m *QueryAllAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAuctionResponse) ProtoMessage()    {}
 func (*QueryAllAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{3}
+	return fileDescriptor_8be3ab6819f1d50c, []int{5}
 }
 func (m *QueryAllAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +339,7 @@ func (m *QueryGetAuctionRequest) Reset()         { *m = QueryGetAuctionRequest{}
 func (m *QueryGetAuctionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAuctionRequest) ProtoMessage()    {}
 func (*QueryGetAuctionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{4}
+	return fileDescriptor_8be3ab6819f1d50c, []int{6}
 }
 func (m *QueryGetAuctionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +384,7 @@ func (m *QueryGetAuctionResponse) Reset()         { *m = QueryGetAuctionResponse
 func (m *QueryGetAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAuctionResponse) ProtoMessage()    {}
 func (*QueryGetAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{5}
+	return fileDescriptor_8be3ab6819f1d50c, []int{7}
 }
 func (m *QueryGetAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,24 +420,23 @@ func (m *QueryGetAuctionResponse) GetAuction() *types.Any {
 	return nil
 }
 
-// QueryAllowedBidderRequest is the request type for the Query/AllowedBidder RPC method.
-type QueryAllAllowedBidderRequest struct {
-	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryCollectedFeesRequest is the request type for the Query/CollectedFees RPC method.
+type QueryCollectedFeesRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *QueryAllAllowedBidderRequest) Reset()         { *m = QueryAllAllowedBidderRequest{} }
-func (m *QueryAllAllowedBidderRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAllowedBidderRequest) ProtoMessage()    {}
-func (*QueryAllAllowedBidderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{6}
+func (m *QueryCollectedFeesRequest) Reset()         { *m = QueryCollectedFeesRequest{} }
+func (m *QueryCollectedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesRequest) ProtoMessage()    {}
+func (*QueryCollectedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{8}
 }
-func (m *QueryAllAllowedBidderRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollectedFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAllowedBidderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollectedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAllowedBidderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollectedFeesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -349,51 +446,42 @@ func (m *QueryAllAllowedBidderRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAllowedBidderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAllowedBidderRequest.Merge(m, src)
+func (m *QueryCollectedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesRequest.Merge(m, src)
 }
-func (m *QueryAllAllowedBidderRequest) XXX_Size() int {
+func (m *QueryCollectedFeesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAllowedBidderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAllowedBidderRequest.DiscardUnknown(m)
+func (m *QueryCollectedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAllowedBidderRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollectedFeesRequest proto.InternalMessageInfo
 
-func (m *QueryAllAllowedBidderRequest) GetAuctionId() uint64 {
+func (m *QueryCollectedFeesRequest) GetAuctionId() uint64 {
 	if m != nil {
 		return m.AuctionId
 	}
 	return 0
 }
 
-func (m *QueryAllAllowedBidderRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryAllowedBidderResponse is the response type for the Query/AllowedBidder RPC method.
-type QueryAllAllowedBidderResponse struct {
-	AllowedBidder []AllowedBidder `protobuf:"bytes,1,rep,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
-	// pagination defines the pagination in the response
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryCollectedFeesResponse is the response type for the Query/CollectedFees RPC method.
+type QueryCollectedFeesResponse struct {
+	CollectedFees CollectedFees `protobuf:"bytes,1,opt,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
 }
 
-func (m *QueryAllAllowedBidderResponse) Reset()         { *m = QueryAllAllowedBidderResponse{} }
-func (m *QueryAllAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAllowedBidderResponse) ProtoMessage()    {}
-func (*QueryAllAllowedBidderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{7}
+func (m *QueryCollectedFeesResponse) Reset()         { *m = QueryCollectedFeesResponse{} }
+func (m *QueryCollectedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesResponse) ProtoMessage()    {}
+func (*QueryCollectedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{9}
 }
-func (m *QueryAllAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollectedFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollectedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAllowedBidderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollectedFeesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -403,51 +491,42 @@ func (m *QueryAllAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAllowedBidderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAllowedBidderResponse.Merge(m, src)
+func (m *QueryCollectedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesResponse.Merge(m, src)
 }
-func (m *QueryAllAllowedBidderResponse) XXX_Size() int {
+func (m *QueryCollectedFeesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAllowedBidderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAllowedBidderResponse.DiscardUnknown(m)
+func (m *QueryCollectedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAllowedBidderResponse proto.InternalMessageInfo
-
-func (m *QueryAllAllowedBidderResponse) GetAllowedBidder() []AllowedBidder {
-	if m != nil {
-		return m.AllowedBidder
-	}
-	return nil
-}
+var xxx_messageInfo_QueryCollectedFeesResponse proto.InternalMessageInfo
 
-func (m *QueryAllAllowedBidderResponse) GetPagination() *query.PageResponse {
+func (m *QueryCollectedFeesResponse) GetCollectedFees() CollectedFees {
 	if m != nil {
-		return m.Pagination
+		return m.CollectedFees
 	}
-	return nil
+	return CollectedFees{}
 }
 
-// QueryAllowedBidderRequest is the request type for the Query/AllowedBidder RPC
-// method.
-type QueryGetAllowedBidderRequest struct {
+// QueryAuctionResultRequest is the request type for the Query/AuctionResult RPC method.
+type QueryAuctionResultRequest struct {
 	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *QueryGetAllowedBidderRequest) Reset()         { *m = QueryGetAllowedBidderRequest{} }
-func (m *QueryGetAllowedBidderRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllowedBidderRequest) ProtoMessage()    {}
-func (*QueryGetAllowedBidderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{8}
+func (m *QueryAuctionResultRequest) Reset()         { *m = QueryAuctionResultRequest{} }
+func (m *QueryAuctionResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionResultRequest) ProtoMessage()    {}
+func (*QueryAuctionResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{10}
 }
-func (m *QueryGetAllowedBidderRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAuctionResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllowedBidderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAuctionResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllowedBidderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAuctionResultRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -457,50 +536,42 @@ func (m *QueryGetAllowedBidderRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllowedBidderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllowedBidderRequest.Merge(m, src)
+func (m *QueryAuctionResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionResultRequest.Merge(m, src)
 }
-func (m *QueryGetAllowedBidderRequest) XXX_Size() int {
+func (m *QueryAuctionResultRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllowedBidderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllowedBidderRequest.DiscardUnknown(m)
+func (m *QueryAuctionResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionResultRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllowedBidderRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAuctionResultRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllowedBidderRequest) GetAuctionId() uint64 {
+func (m *QueryAuctionResultRequest) GetAuctionId() uint64 {
 	if m != nil {
 		return m.AuctionId
 	}
 	return 0
 }
 
-func (m *QueryGetAllowedBidderRequest) GetBidder() string {
-	if m != nil {
-		return m.Bidder
-	}
-	return ""
-}
-
-// QueryAllowedBidderResponse is the response type for the Query/AllowedBidder
-// RPC method.
-type QueryGetAllowedBidderResponse struct {
-	AllowedBidder AllowedBidder `protobuf:"bytes,1,opt,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
+// QueryAuctionResultResponse is the response type for the Query/AuctionResult RPC method.
+type QueryAuctionResultResponse struct {
+	AuctionResult AuctionResult `protobuf:"bytes,1,opt,name=auction_result,json=auctionResult,proto3" json:"auction_result"`
 }
 
-func (m *QueryGetAllowedBidderResponse) Reset()         { *m = QueryGetAllowedBidderResponse{} }
-func (m *QueryGetAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllowedBidderResponse) ProtoMessage()    {}
-func (*QueryGetAllowedBidderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{9}
+func (m *QueryAuctionResultResponse) Reset()         { *m = QueryAuctionResultResponse{} }
+func (m *QueryAuctionResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionResultResponse) ProtoMessage()    {}
+func (*QueryAuctionResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{11}
 }
-func (m *QueryGetAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAuctionResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAuctionResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllowedBidderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAuctionResultResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -510,43 +581,43 @@ func (m *QueryGetAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllowedBidderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllowedBidderResponse.Merge(m, src)
+func (m *QueryAuctionResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionResultResponse.Merge(m, src)
 }
-func (m *QueryGetAllowedBidderResponse) XXX_Size() int {
+func (m *QueryAuctionResultResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllowedBidderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllowedBidderResponse.DiscardUnknown(m)
+func (m *QueryAuctionResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionResultResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllowedBidderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAuctionResultResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllowedBidderResponse) GetAllowedBidder() AllowedBidder {
+func (m *QueryAuctionResultResponse) GetAuctionResult() AuctionResult {
 	if m != nil {
-		return m.AllowedBidder
+		return m.AuctionResult
 	}
-	return AllowedBidder{}
+	return AuctionResult{}
 }
 
-// QueryGetBidRequest is the request type for the Query/Sequence RPC method.
-type QueryGetBidRequest struct {
+// QueryBidderAllocationRequest is the request type for the Query/BidderAllocation RPC method.
+type QueryBidderAllocationRequest struct {
 	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	BidId     uint64 `protobuf:"varint,2,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *QueryGetBidRequest) Reset()         { *m = QueryGetBidRequest{} }
-func (m *QueryGetBidRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBidRequest) ProtoMessage()    {}
-func (*QueryGetBidRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{10}
+func (m *QueryBidderAllocationRequest) Reset()         { *m = QueryBidderAllocationRequest{} }
+func (m *QueryBidderAllocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAllocationRequest) ProtoMessage()    {}
+func (*QueryBidderAllocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{12}
 }
-func (m *QueryGetBidRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBidderAllocationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBidderAllocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBidRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBidderAllocationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -556,50 +627,49 @@ func (m *QueryGetBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBidRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBidRequest.Merge(m, src)
+func (m *QueryBidderAllocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAllocationRequest.Merge(m, src)
 }
-func (m *QueryGetBidRequest) XXX_Size() int {
+func (m *QueryBidderAllocationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBidRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBidRequest.DiscardUnknown(m)
+func (m *QueryBidderAllocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAllocationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBidRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBidderAllocationRequest proto.InternalMessageInfo
 
-func (m *QueryGetBidRequest) GetAuctionId() uint64 {
+func (m *QueryBidderAllocationRequest) GetAuctionId() uint64 {
 	if m != nil {
 		return m.AuctionId
 	}
 	return 0
 }
 
-func (m *QueryGetBidRequest) GetBidId() uint64 {
+func (m *QueryBidderAllocationRequest) GetBidder() string {
 	if m != nil {
-		return m.BidId
+		return m.Bidder
 	}
-	return 0
+	return ""
 }
 
-// QueryGetBidResponse is response type for the Query/Sequence RPC method.
-type QueryGetBidResponse struct {
-	// bid specifies specific bid
-	Bid Bid `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid"`
+// QueryBidderAllocationResponse is the response type for the Query/BidderAllocation RPC method.
+type QueryBidderAllocationResponse struct {
+	BidderAllocation BidderAllocation `protobuf:"bytes,1,opt,name=bidder_allocation,json=bidderAllocation,proto3" json:"bidder_allocation"`
 }
 
-func (m *QueryGetBidResponse) Reset()         { *m = QueryGetBidResponse{} }
-func (m *QueryGetBidResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBidResponse) ProtoMessage()    {}
-func (*QueryGetBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{11}
+func (m *QueryBidderAllocationResponse) Reset()         { *m = QueryBidderAllocationResponse{} }
+func (m *QueryBidderAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAllocationResponse) ProtoMessage()    {}
+func (*QueryBidderAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{13}
 }
-func (m *QueryGetBidResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBidderAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBidderAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBidResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBidderAllocationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -609,45 +679,43 @@ func (m *QueryGetBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBidResponse.Merge(m, src)
+func (m *QueryBidderAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAllocationResponse.Merge(m, src)
 }
-func (m *QueryGetBidResponse) XXX_Size() int {
+func (m *QueryBidderAllocationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBidResponse.DiscardUnknown(m)
+func (m *QueryBidderAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAllocationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBidResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBidderAllocationResponse proto.InternalMessageInfo
 
-func (m *QueryGetBidResponse) GetBid() Bid {
+func (m *QueryBidderAllocationResponse) GetBidderAllocation() BidderAllocation {
 	if m != nil {
-		return m.Bid
+		return m.BidderAllocation
 	}
-	return Bid{}
+	return BidderAllocation{}
 }
 
-// QueryBidsRequest is request type for the Query/Bids RPC method.
-type QueryAllBidRequest struct {
-	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	Bidder     string             `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
-	IsMatched  string             `protobuf:"bytes,3,opt,name=is_matched,json=isMatched,proto3" json:"is_matched,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryBuyerVestingRequest is the request type for the Query/BuyerVesting RPC method.
+type QueryBuyerVestingRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *QueryAllBidRequest) Reset()         { *m = QueryAllBidRequest{} }
-func (m *QueryAllBidRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBidRequest) ProtoMessage()    {}
-func (*QueryAllBidRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{12}
+func (m *QueryBuyerVestingRequest) Reset()         { *m = QueryBuyerVestingRequest{} }
+func (m *QueryBuyerVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyerVestingRequest) ProtoMessage()    {}
+func (*QueryBuyerVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{14}
 }
-func (m *QueryAllBidRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBuyerVestingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBuyerVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBidRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBuyerVestingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -657,66 +725,56 @@ func (m *QueryAllBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBidRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBidRequest.Merge(m, src)
+func (m *QueryBuyerVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyerVestingRequest.Merge(m, src)
 }
-func (m *QueryAllBidRequest) XXX_Size() int {
+func (m *QueryBuyerVestingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBidRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBidRequest.DiscardUnknown(m)
+func (m *QueryBuyerVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyerVestingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBidRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBuyerVestingRequest proto.InternalMessageInfo
 
-func (m *QueryAllBidRequest) GetAuctionId() uint64 {
+func (m *QueryBuyerVestingRequest) GetAuctionId() uint64 {
 	if m != nil {
 		return m.AuctionId
 	}
 	return 0
 }
 
-func (m *QueryAllBidRequest) GetBidder() string {
+func (m *QueryBuyerVestingRequest) GetBidder() string {
 	if m != nil {
 		return m.Bidder
 	}
 	return ""
 }
 
-func (m *QueryAllBidRequest) GetIsMatched() string {
-	if m != nil {
-		return m.IsMatched
-	}
-	return ""
+// QueryBuyerVestingResponse is the response type for the Query/BuyerVesting RPC method.
+type QueryBuyerVestingResponse struct {
+	BuyerVesting BuyerVesting `protobuf:"bytes,1,opt,name=buyer_vesting,json=buyerVesting,proto3" json:"buyer_vesting"`
+	// locked_coin specifies the selling coin that is not unlocked yet
+	LockedCoin types1.Coin `protobuf:"bytes,2,opt,name=locked_coin,json=lockedCoin,proto3" json:"locked_coin"`
+	// unlocked_coin specifies the selling coin unlocked so far, including the
+	// claimed one
+	UnlockedCoin types1.Coin `protobuf:"bytes,3,opt,name=unlocked_coin,json=unlockedCoin,proto3" json:"unlocked_coin"`
+	// claimable_coin specifies the unlocked selling coin that is not claimed yet
+	ClaimableCoin types1.Coin `protobuf:"bytes,4,opt,name=claimable_coin,json=claimableCoin,proto3" json:"claimable_coin"`
 }
 
-func (m *QueryAllBidRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
+func (m *QueryBuyerVestingResponse) Reset()         { *m = QueryBuyerVestingResponse{} }
+func (m *QueryBuyerVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyerVestingResponse) ProtoMessage()    {}
+func (*QueryBuyerVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{15}
 }
-
-// QueryBidsResponse is response type for the Query/Bids RPC method.
-type QueryAllBidResponse struct {
-	// bids specifies the existing bids
-	Bid []Bid `protobuf:"bytes,1,rep,name=bid,proto3" json:"bid"`
-	// pagination defines the pagination in the response
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-}
-
-func (m *QueryAllBidResponse) Reset()         { *m = QueryAllBidResponse{} }
-func (m *QueryAllBidResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBidResponse) ProtoMessage()    {}
-func (*QueryAllBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{13}
-}
-func (m *QueryAllBidResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBuyerVestingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBuyerVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBidResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBuyerVestingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -726,50 +784,65 @@ func (m *QueryAllBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBidResponse.Merge(m, src)
+func (m *QueryBuyerVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyerVestingResponse.Merge(m, src)
 }
-func (m *QueryAllBidResponse) XXX_Size() int {
+func (m *QueryBuyerVestingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBidResponse.DiscardUnknown(m)
+func (m *QueryBuyerVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyerVestingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBidResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBuyerVestingResponse proto.InternalMessageInfo
 
-func (m *QueryAllBidResponse) GetBid() []Bid {
+func (m *QueryBuyerVestingResponse) GetBuyerVesting() BuyerVesting {
 	if m != nil {
-		return m.Bid
+		return m.BuyerVesting
 	}
-	return nil
+	return BuyerVesting{}
 }
 
-func (m *QueryAllBidResponse) GetPagination() *query.PageResponse {
+func (m *QueryBuyerVestingResponse) GetLockedCoin() types1.Coin {
 	if m != nil {
-		return m.Pagination
+		return m.LockedCoin
 	}
-	return nil
+	return types1.Coin{}
 }
 
-// QueryAllVestingQueueRequest is request type for the Query/Vestings RPC method.
-type QueryAllVestingQueueRequest struct {
-	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryBuyerVestingResponse) GetUnlockedCoin() types1.Coin {
+	if m != nil {
+		return m.UnlockedCoin
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryAllVestingQueueRequest) Reset()         { *m = QueryAllVestingQueueRequest{} }
-func (m *QueryAllVestingQueueRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllVestingQueueRequest) ProtoMessage()    {}
-func (*QueryAllVestingQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{14}
+func (m *QueryBuyerVestingResponse) GetClaimableCoin() types1.Coin {
+	if m != nil {
+		return m.ClaimableCoin
+	}
+	return types1.Coin{}
 }
-func (m *QueryAllVestingQueueRequest) XXX_Unmarshal(b []byte) error {
+
+// QuerySimulateMatchingRequest is the request type for the Query/SimulateMatching RPC method.
+type QuerySimulateMatchingRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is an optional bech32-encoded address of the bidder to simulate the allocation for
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (m *QuerySimulateMatchingRequest) Reset()         { *m = QuerySimulateMatchingRequest{} }
+func (m *QuerySimulateMatchingRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateMatchingRequest) ProtoMessage()    {}
+func (*QuerySimulateMatchingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{16}
+}
+func (m *QuerySimulateMatchingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllVestingQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateMatchingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllVestingQueueRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateMatchingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -779,51 +852,58 @@ func (m *QueryAllVestingQueueRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllVestingQueueRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllVestingQueueRequest.Merge(m, src)
+func (m *QuerySimulateMatchingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateMatchingRequest.Merge(m, src)
 }
-func (m *QueryAllVestingQueueRequest) XXX_Size() int {
+func (m *QuerySimulateMatchingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllVestingQueueRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllVestingQueueRequest.DiscardUnknown(m)
+func (m *QuerySimulateMatchingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateMatchingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllVestingQueueRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateMatchingRequest proto.InternalMessageInfo
 
-func (m *QueryAllVestingQueueRequest) GetAuctionId() uint64 {
+func (m *QuerySimulateMatchingRequest) GetAuctionId() uint64 {
 	if m != nil {
 		return m.AuctionId
 	}
 	return 0
 }
 
-func (m *QueryAllVestingQueueRequest) GetPagination() *query.PageRequest {
+func (m *QuerySimulateMatchingRequest) GetBidder() string {
 	if m != nil {
-		return m.Pagination
+		return m.Bidder
 	}
-	return nil
+	return ""
 }
 
-// QueryAllVestingQueueResponse is response type for the Query/Vestings RPC method.
-type QueryAllVestingQueueResponse struct {
-	// vestings specifies the existing vestings
-	VestingQueue []VestingQueue      `protobuf:"bytes,1,rep,name=vestingQueue,proto3" json:"vestingQueue"`
-	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QuerySimulateMatchingResponse is the response type for the Query/SimulateMatching RPC method.
+type QuerySimulateMatchingResponse struct {
+	// matched_price specifies the hypothetical clearing price of the auction
+	MatchedPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=matched_price,json=matchedPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"matched_price"`
+	// matched_amount specifies the hypothetical amount of the selling coin to be sold
+	MatchedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=matched_amount,json=matchedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"matched_amount"`
+	// matched_len specifies the hypothetical number of the matched bids
+	MatchedLen int64 `protobuf:"varint,3,opt,name=matched_len,json=matchedLen,proto3" json:"matched_len,omitempty"`
+	// bidder_allocation specifies what the bidder would receive if the bidder is given
+	BidderAllocation *BidderAllocation `protobuf:"bytes,4,opt,name=bidder_allocation,json=bidderAllocation,proto3" json:"bidder_allocation,omitempty"`
+	// pricing_mode specifies the price that the matched bids pay
+	PricingMode PricingMode `protobuf:"varint,5,opt,name=pricing_mode,json=pricingMode,proto3,enum=fundraising.fundraising.v1.PricingMode" json:"pricing_mode,omitempty"`
 }
 
-func (m *QueryAllVestingQueueResponse) Reset()         { *m = QueryAllVestingQueueResponse{} }
-func (m *QueryAllVestingQueueResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllVestingQueueResponse) ProtoMessage()    {}
-func (*QueryAllVestingQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8be3ab6819f1d50c, []int{15}
+func (m *QuerySimulateMatchingResponse) Reset()         { *m = QuerySimulateMatchingResponse{} }
+func (m *QuerySimulateMatchingResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateMatchingResponse) ProtoMessage()    {}
+func (*QuerySimulateMatchingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{17}
 }
-func (m *QueryAllVestingQueueResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySimulateMatchingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllVestingQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateMatchingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllVestingQueueResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateMatchingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -833,1366 +913,5501 @@ func (m *QueryAllVestingQueueResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllVestingQueueResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllVestingQueueResponse.Merge(m, src)
+func (m *QuerySimulateMatchingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateMatchingResponse.Merge(m, src)
 }
-func (m *QueryAllVestingQueueResponse) XXX_Size() int {
+func (m *QuerySimulateMatchingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllVestingQueueResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllVestingQueueResponse.DiscardUnknown(m)
+func (m *QuerySimulateMatchingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateMatchingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllVestingQueueResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateMatchingResponse proto.InternalMessageInfo
 
-func (m *QueryAllVestingQueueResponse) GetVestingQueue() []VestingQueue {
+func (m *QuerySimulateMatchingResponse) GetMatchedLen() int64 {
 	if m != nil {
-		return m.VestingQueue
+		return m.MatchedLen
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryAllVestingQueueResponse) GetPagination() *query.PageResponse {
+func (m *QuerySimulateMatchingResponse) GetBidderAllocation() *BidderAllocation {
 	if m != nil {
-		return m.Pagination
+		return m.BidderAllocation
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "fundraising.fundraising.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fundraising.fundraising.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAllAuctionRequest)(nil), "fundraising.fundraising.v1.QueryAllAuctionRequest")
-	proto.RegisterType((*QueryAllAuctionResponse)(nil), "fundraising.fundraising.v1.QueryAllAuctionResponse")
-	proto.RegisterType((*QueryGetAuctionRequest)(nil), "fundraising.fundraising.v1.QueryGetAuctionRequest")
-	proto.RegisterType((*QueryGetAuctionResponse)(nil), "fundraising.fundraising.v1.QueryGetAuctionResponse")
-	proto.RegisterType((*QueryAllAllowedBidderRequest)(nil), "fundraising.fundraising.v1.QueryAllAllowedBidderRequest")
-	proto.RegisterType((*QueryAllAllowedBidderResponse)(nil), "fundraising.fundraising.v1.QueryAllAllowedBidderResponse")
-	proto.RegisterType((*QueryGetAllowedBidderRequest)(nil), "fundraising.fundraising.v1.QueryGetAllowedBidderRequest")
-	proto.RegisterType((*QueryGetAllowedBidderResponse)(nil), "fundraising.fundraising.v1.QueryGetAllowedBidderResponse")
-	proto.RegisterType((*QueryGetBidRequest)(nil), "fundraising.fundraising.v1.QueryGetBidRequest")
-	proto.RegisterType((*QueryGetBidResponse)(nil), "fundraising.fundraising.v1.QueryGetBidResponse")
-	proto.RegisterType((*QueryAllBidRequest)(nil), "fundraising.fundraising.v1.QueryAllBidRequest")
-	proto.RegisterType((*QueryAllBidResponse)(nil), "fundraising.fundraising.v1.QueryAllBidResponse")
-	proto.RegisterType((*QueryAllVestingQueueRequest)(nil), "fundraising.fundraising.v1.QueryAllVestingQueueRequest")
-	proto.RegisterType((*QueryAllVestingQueueResponse)(nil), "fundraising.fundraising.v1.QueryAllVestingQueueResponse")
+func (m *QuerySimulateMatchingResponse) GetPricingMode() PricingMode {
+	if m != nil {
+		return m.PricingMode
+	}
+	return PricingModeUniform
 }
 
-func init() {
-	proto.RegisterFile("fundraising/fundraising/v1/query.proto", fileDescriptor_8be3ab6819f1d50c)
+// QueryBidBookRequest is the request type for the Query/BidBook RPC method.
+type QueryBidBookRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-var fileDescriptor_8be3ab6819f1d50c = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0xa9, 0x8b, 0x27, 0x01, 0xb5, 0x43, 0x08, 0xa9, 0x69, 0xdd, 0x6a, 0x84, 0xda,
-	0x50, 0x94, 0x1d, 0xc5, 0x51, 0x95, 0x80, 0x2a, 0x84, 0x0d, 0xc5, 0x32, 0xa2, 0x34, 0x5d, 0x89,
-	0x82, 0x10, 0x92, 0x35, 0x9b, 0x9d, 0x6e, 0x47, 0xb2, 0x77, 0x1d, 0xef, 0xae, 0x4b, 0x54, 0xf5,
-	0x82, 0xe0, 0xc6, 0x01, 0xa9, 0x07, 0xae, 0x3d, 0x22, 0x71, 0xa9, 0x10, 0x12, 0x48, 0x5c, 0x38,
-	0x56, 0x9c, 0x22, 0x71, 0x81, 0x0b, 0x82, 0x04, 0x89, 0xbf, 0x81, 0x76, 0xe6, 0x2d, 0xd9, 0xb5,
-	0xe3, 0xcd, 0xda, 0xb1, 0xb8, 0x24, 0x3b, 0xb3, 0xf3, 0xe6, 0x7d, 0xdf, 0xf7, 0xde, 0xcc, 0xb7,
-	0xc6, 0x97, 0xef, 0x86, 0xae, 0xdd, 0xe3, 0xd2, 0x97, 0xae, 0xc3, 0x92, 0xcf, 0xfd, 0x35, 0xb6,
-	0x13, 0x8a, 0xde, 0xae, 0xd1, 0xed, 0x79, 0x81, 0x47, 0xca, 0x89, 0x77, 0x46, 0xf2, 0xb9, 0xbf,
-	0x56, 0x3e, 0xcb, 0x3b, 0xd2, 0xf5, 0x98, 0xfa, 0xab, 0x97, 0x97, 0xaf, 0x6e, 0x7b, 0x7e, 0xc7,
-	0xf3, 0x99, 0xc5, 0x7d, 0xa1, 0xf7, 0x61, 0xfd, 0x35, 0x4b, 0x04, 0x7c, 0x8d, 0x75, 0xb9, 0x23,
-	0x5d, 0x1e, 0x48, 0xcf, 0x85, 0xb5, 0xe7, 0xf4, 0xda, 0x96, 0x1a, 0x31, 0x3d, 0x80, 0x57, 0x2c,
-	0x03, 0x1d, 0x6f, 0xb7, 0xbd, 0xfb, 0xc2, 0x6e, 0x59, 0xd2, 0xb6, 0x45, 0x0f, 0x02, 0x56, 0xb2,
-	0x02, 0xc2, 0xed, 0x44, 0xd6, 0x97, 0x33, 0x56, 0x5a, 0xd2, 0x86, 0x55, 0x57, 0x32, 0x56, 0x75,
-	0x79, 0x8f, 0x77, 0x62, 0xa4, 0x46, 0xc6, 0xc2, 0xbe, 0xf0, 0x03, 0xe9, 0x3a, 0xad, 0x9d, 0x50,
-	0x84, 0x02, 0xd6, 0x2f, 0x3a, 0x9e, 0xe3, 0x69, 0xc6, 0xd1, 0x13, 0xcc, 0x9e, 0x77, 0x3c, 0xcf,
-	0x69, 0x0b, 0xc6, 0xbb, 0x92, 0x71, 0xd7, 0xf5, 0x02, 0xa5, 0x53, 0x9c, 0xe3, 0x1c, 0xbc, 0x55,
-	0x23, 0x2b, 0xbc, 0xcb, 0xb8, 0x0b, 0xe5, 0xa1, 0x8b, 0x98, 0xdc, 0x8e, 0x54, 0xde, 0x52, 0x98,
-	0x4c, 0xb1, 0x13, 0x0a, 0x3f, 0xa0, 0x9f, 0xe0, 0xe7, 0x53, 0xb3, 0x7e, 0xd7, 0x73, 0x7d, 0x41,
-	0x6e, 0xe0, 0xa2, 0xc6, 0xbe, 0x8c, 0x2e, 0xa1, 0x95, 0xf9, 0x2a, 0x35, 0x46, 0x17, 0xd7, 0xd0,
-	0xb1, 0xf5, 0xd2, 0xd3, 0x3f, 0x2e, 0xce, 0x7c, 0xf3, 0xcf, 0x93, 0xab, 0xc8, 0x84, 0x60, 0xfa,
-	0x25, 0xc2, 0x4b, 0x6a, 0xfb, 0x5a, 0xbb, 0x5d, 0xd3, 0xda, 0x42, 0x62, 0xb2, 0x84, 0x8b, 0x7e,
-	0xc0, 0x83, 0x50, 0x67, 0x28, 0x99, 0x30, 0x22, 0x04, 0xcf, 0x05, 0xbb, 0x5d, 0xb1, 0x5c, 0x50,
-	0xb3, 0xea, 0x99, 0xbc, 0x83, 0xf1, 0x61, 0x4b, 0x2c, 0xcf, 0x2a, 0x44, 0x97, 0x0d, 0x68, 0x83,
-	0xa8, 0x7f, 0x0c, 0xdd, 0x87, 0xd0, 0x3f, 0xc6, 0x16, 0x77, 0x04, 0xe4, 0x31, 0x13, 0x91, 0xf4,
-	0x31, 0xc2, 0x2f, 0x0e, 0xc1, 0x01, 0xc6, 0xd7, 0xf1, 0x69, 0xa8, 0xfe, 0x32, 0xba, 0x34, 0xbb,
-	0x32, 0x5f, 0x5d, 0x34, 0xb4, 0x96, 0x46, 0xac, 0xa5, 0x51, 0x73, 0x77, 0xeb, 0x0b, 0xbf, 0x7c,
-	0xbf, 0xfa, 0x0c, 0xc4, 0x36, 0xcd, 0x38, 0x84, 0x34, 0x52, 0x08, 0x0b, 0x0a, 0xe1, 0x95, 0x63,
-	0x11, 0xea, 0xd4, 0x29, 0x88, 0x1b, 0x20, 0x58, 0x43, 0x04, 0x03, 0x82, 0x5d, 0xc0, 0x18, 0xb2,
-	0xb5, 0xa4, 0xad, 0x44, 0x9b, 0x33, 0x4b, 0x30, 0xd3, 0xb4, 0xe9, 0x87, 0x40, 0x2d, 0x19, 0x78,
-	0x14, 0x35, 0x34, 0x26, 0x35, 0xfa, 0x05, 0xc2, 0xe7, 0xff, 0x13, 0x4d, 0x1f, 0xa8, 0xba, 0x3a,
-	0x4f, 0xf9, 0x80, 0x0d, 0x14, 0xaf, 0x30, 0x71, 0xf1, 0x7e, 0x46, 0xf8, 0xc2, 0x08, 0x1c, 0xc0,
-	0xf3, 0x0e, 0x7e, 0x2e, 0x7d, 0xe2, 0xa1, 0x92, 0xaf, 0x64, 0x35, 0x6f, 0x6a, 0xab, 0xfa, 0x5c,
-	0xd4, 0xc3, 0xe6, 0xb3, 0x3c, 0x39, 0x39, 0xbd, 0xe2, 0x7e, 0x00, 0x4a, 0x46, 0x35, 0x9a, 0x40,
-	0xc9, 0x25, 0x5c, 0x04, 0x5e, 0xfa, 0x70, 0xc0, 0x88, 0xde, 0x07, 0x61, 0x86, 0xb7, 0xcd, 0x10,
-	0x06, 0x9d, 0x5c, 0x18, 0xfa, 0x2e, 0x5c, 0x29, 0x0d, 0x11, 0xd4, 0xa5, 0x9d, 0x93, 0xc5, 0x0b,
-	0x8a, 0x45, 0xf4, 0xaa, 0xa0, 0x5e, 0x9d, 0xb2, 0xa4, 0xdd, 0xb4, 0xe9, 0xfb, 0x70, 0x11, 0xc5,
-	0x7b, 0x01, 0xf4, 0x0d, 0x3c, 0x6b, 0xc1, 0x2e, 0xf3, 0xd5, 0x8b, 0x59, 0x78, 0xeb, 0xd2, 0x06,
-	0x94, 0x51, 0x04, 0xfd, 0x0e, 0x01, 0xb8, 0x5a, 0xbb, 0x9d, 0x1f, 0xdc, 0x08, 0x89, 0xa3, 0x30,
-	0xe9, 0xb7, 0x3a, 0x3c, 0xd8, 0xbe, 0x27, 0x6c, 0x75, 0x03, 0x95, 0xcc, 0x92, 0xf4, 0x6f, 0xea,
-	0x89, 0x81, 0x1e, 0x9f, 0x9b, 0xb8, 0xc7, 0xbf, 0x46, 0xa0, 0x42, 0x0c, 0x7a, 0x50, 0x85, 0xd9,
-	0xf1, 0x54, 0x98, 0x5e, 0xeb, 0x7e, 0x8e, 0xf0, 0x4b, 0x31, 0xb2, 0x3b, 0xda, 0xac, 0x6e, 0x47,
-	0x5e, 0xf5, 0x3f, 0x5f, 0x02, 0x3f, 0x25, 0x2e, 0xa3, 0x34, 0x0c, 0x50, 0xca, 0xc4, 0x0b, 0xfd,
-	0xc4, 0x3c, 0x48, 0xb6, 0x92, 0x25, 0x59, 0x72, 0x1f, 0xd0, 0x2e, 0xb5, 0xc7, 0xd4, 0x44, 0xac,
-	0x3e, 0x5a, 0xc0, 0xa7, 0x14, 0x7a, 0xf2, 0x18, 0xe1, 0xa2, 0xb6, 0x4d, 0x62, 0x64, 0x61, 0x1b,
-	0x76, 0xec, 0x32, 0xcb, 0xbd, 0x5e, 0x23, 0xa0, 0xd7, 0x3e, 0xfb, 0xf5, 0xef, 0x47, 0x05, 0x46,
-	0x56, 0x59, 0x20, 0x5c, 0x5b, 0xf4, 0x3a, 0xd2, 0x0d, 0xd8, 0xb1, 0x1f, 0x2d, 0xe4, 0x5b, 0x84,
-	0xe7, 0xdf, 0x93, 0x7e, 0xec, 0x26, 0xa4, 0x7a, 0x6c, 0xde, 0x21, 0x93, 0x2f, 0xaf, 0x8f, 0x15,
-	0x03, 0x78, 0xd7, 0x15, 0xde, 0x55, 0xf2, 0x6a, 0x1e, 0xbc, 0xb1, 0x01, 0xff, 0x80, 0x30, 0x3e,
-	0xb4, 0xbe, 0x1c, 0x60, 0x87, 0x0c, 0x36, 0x07, 0xd8, 0x61, 0x6f, 0xa5, 0x6f, 0x2a, 0xb0, 0xaf,
-	0x93, 0xcd, 0x31, 0xc0, 0xb2, 0x07, 0x87, 0x47, 0xe5, 0x21, 0xf9, 0x1d, 0xe1, 0xb3, 0x4a, 0xe7,
-	0x94, 0xe7, 0x6c, 0xe6, 0x52, 0xee, 0x08, 0x13, 0x29, 0xbf, 0x36, 0x41, 0x24, 0x90, 0xb9, 0xa5,
-	0xc8, 0x34, 0x49, 0x63, 0x52, 0x32, 0x03, 0x5f, 0xdc, 0xe4, 0x2f, 0x84, 0xcf, 0x0c, 0xba, 0x52,
-	0x0e, 0x6a, 0x23, 0xfc, 0x31, 0x07, 0xb5, 0x51, 0x16, 0x48, 0x3f, 0x52, 0xd4, 0x4c, 0xb2, 0x35,
-	0x25, 0x6a, 0xec, 0x81, 0xfe, 0xff, 0x90, 0x3c, 0x41, 0xf8, 0x74, 0x54, 0xbf, 0xba, 0xb4, 0x73,
-	0x9c, 0xe5, 0x94, 0x1b, 0xe5, 0x38, 0xcb, 0x69, 0x23, 0xa0, 0x6f, 0x2b, 0x1a, 0x6f, 0x90, 0xeb,
-	0x13, 0xd3, 0x88, 0x5c, 0xe1, 0x47, 0x84, 0x8b, 0xda, 0x67, 0x73, 0x20, 0x4e, 0x99, 0x7b, 0x0e,
-	0xc4, 0x69, 0x03, 0xa7, 0x37, 0x15, 0xe2, 0x06, 0xb9, 0x71, 0x12, 0xc4, 0x4a, 0x6d, 0x75, 0x5a,
-	0xf6, 0x10, 0x3e, 0x13, 0xa9, 0x9d, 0xbc, 0xb4, 0xc9, 0x46, 0x1e, 0x19, 0x8f, 0x70, 0xad, 0xf2,
-	0xe6, 0xf8, 0x81, 0x40, 0xab, 0xa9, 0x68, 0xbd, 0x45, 0x6a, 0x13, 0xd3, 0x02, 0x8b, 0xf1, 0xeb,
-	0xb7, 0x9e, 0xee, 0x57, 0xd0, 0xde, 0x7e, 0x05, 0xfd, 0xb9, 0x5f, 0x41, 0x5f, 0x1d, 0x54, 0x66,
-	0xf6, 0x0e, 0x2a, 0x33, 0xbf, 0x1d, 0x54, 0x66, 0x3e, 0xbe, 0xe6, 0xc8, 0xe0, 0x5e, 0x68, 0x19,
-	0xdb, 0x5e, 0x67, 0x54, 0x9a, 0x4f, 0x53, 0xa3, 0xe8, 0xd7, 0x92, 0x6f, 0x15, 0xd5, 0x67, 0xfd,
-	0xfa, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xf4, 0xe8, 0xe1, 0xb9, 0x0f, 0x00, 0x00,
+func (m *QueryBidBookRequest) Reset()         { *m = QueryBidBookRequest{} }
+func (m *QueryBidBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidBookRequest) ProtoMessage()    {}
+func (*QueryBidBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Auction items.
-	ListAuction(ctx context.Context, in *QueryAllAuctionRequest, opts ...grpc.CallOption) (*QueryAllAuctionResponse, error)
-	GetAuction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error)
-	// Queries a list of AllowedBidder items.
-	ListAllowedBidder(ctx context.Context, in *QueryAllAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllAllowedBidderResponse, error)
-	GetAllowedBidder(ctx context.Context, in *QueryGetAllowedBidderRequest, opts ...grpc.CallOption) (*QueryGetAllowedBidderResponse, error)
-	// Queries a list of Bid items.
-	ListBid(ctx context.Context, in *QueryAllBidRequest, opts ...grpc.CallOption) (*QueryAllBidResponse, error)
-	GetBid(ctx context.Context, in *QueryGetBidRequest, opts ...grpc.CallOption) (*QueryGetBidResponse, error)
-	// Queries a list of VestingQueue items.
-	ListVestingQueue(ctx context.Context, in *QueryAllVestingQueueRequest, opts ...grpc.CallOption) (*QueryAllVestingQueueResponse, error)
+func (m *QueryBidBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBidBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBidBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidBookRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBidBookRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ListAuction(ctx context.Context, in *QueryAllAuctionRequest, opts ...grpc.CallOption) (*QueryAllAuctionResponse, error) {
-	out := new(QueryAllAuctionResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBidBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidBookRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAuction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error) {
-	out := new(QueryGetAuctionResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/GetAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryBidBookRequest proto.InternalMessageInfo
 
-func (c *queryClient) ListAllowedBidder(ctx context.Context, in *QueryAllAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllAllowedBidderResponse, error) {
-	out := new(QueryAllAllowedBidderResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListAllowedBidder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBidBookRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetAllowedBidder(ctx context.Context, in *QueryGetAllowedBidderRequest, opts ...grpc.CallOption) (*QueryGetAllowedBidderResponse, error) {
-	out := new(QueryGetAllowedBidderResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/GetAllowedBidder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBidBookResponse is the response type for the Query/BidBook RPC method.
+type QueryBidBookResponse struct {
+	// selling_coin specifies the selling coin of the auction
+	SellingCoin types1.Coin `protobuf:"bytes,1,opt,name=selling_coin,json=sellingCoin,proto3" json:"selling_coin"`
+	// levels specifies the price levels of the bids in descending order of the price
+	Levels []BidBookLevel `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels"`
 }
 
-func (c *queryClient) ListBid(ctx context.Context, in *QueryAllBidRequest, opts ...grpc.CallOption) (*QueryAllBidResponse, error) {
-	out := new(QueryAllBidResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListBid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBidBookResponse) Reset()         { *m = QueryBidBookResponse{} }
+func (m *QueryBidBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidBookResponse) ProtoMessage()    {}
+func (*QueryBidBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{19}
 }
-
-func (c *queryClient) GetBid(ctx context.Context, in *QueryGetBidRequest, opts ...grpc.CallOption) (*QueryGetBidResponse, error) {
-	out := new(QueryGetBidResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/GetBid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBidBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBidBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidBookResponse.Merge(m, src)
+}
+func (m *QueryBidBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidBookResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListVestingQueue(ctx context.Context, in *QueryAllVestingQueueRequest, opts ...grpc.CallOption) (*QueryAllVestingQueueResponse, error) {
-	out := new(QueryAllVestingQueueResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListVestingQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBidBookResponse proto.InternalMessageInfo
+
+func (m *QueryBidBookResponse) GetSellingCoin() types1.Coin {
+	if m != nil {
+		return m.SellingCoin
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Auction items.
-	ListAuction(context.Context, *QueryAllAuctionRequest) (*QueryAllAuctionResponse, error)
-	GetAuction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error)
-	// Queries a list of AllowedBidder items.
-	ListAllowedBidder(context.Context, *QueryAllAllowedBidderRequest) (*QueryAllAllowedBidderResponse, error)
-	GetAllowedBidder(context.Context, *QueryGetAllowedBidderRequest) (*QueryGetAllowedBidderResponse, error)
-	// Queries a list of Bid items.
-	ListBid(context.Context, *QueryAllBidRequest) (*QueryAllBidResponse, error)
-	GetBid(context.Context, *QueryGetBidRequest) (*QueryGetBidResponse, error)
-	// Queries a list of VestingQueue items.
-	ListVestingQueue(context.Context, *QueryAllVestingQueueRequest) (*QueryAllVestingQueueResponse, error)
+func (m *QueryBidBookResponse) GetLevels() []BidBookLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// BidBookLevel defines the demand of the selling coin at a price level of the bids.
+type BidBookLevel struct {
+	// price specifies the bid price of the level
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// cumulative_amount specifies the amount of the selling coin demanded by the bids
+	// at or above the price, capped by the maximum bid amount of each bidder
+	CumulativeAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cumulative_amount,json=cumulativeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_amount"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *BidBookLevel) Reset()         { *m = BidBookLevel{} }
+func (m *BidBookLevel) String() string { return proto.CompactTextString(m) }
+func (*BidBookLevel) ProtoMessage()    {}
+func (*BidBookLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{20}
 }
-func (*UnimplementedQueryServer) ListAuction(ctx context.Context, req *QueryAllAuctionRequest) (*QueryAllAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAuction not implemented")
-}
-func (*UnimplementedQueryServer) GetAuction(ctx context.Context, req *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuction not implemented")
+func (m *BidBookLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListAllowedBidder(ctx context.Context, req *QueryAllAllowedBidderRequest) (*QueryAllAllowedBidderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedBidder not implemented")
-}
-func (*UnimplementedQueryServer) GetAllowedBidder(ctx context.Context, req *QueryGetAllowedBidderRequest) (*QueryGetAllowedBidderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedBidder not implemented")
+func (m *BidBookLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidBookLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListBid(ctx context.Context, req *QueryAllBidRequest) (*QueryAllBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBid not implemented")
+func (m *BidBookLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidBookLevel.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetBid(ctx context.Context, req *QueryGetBidRequest) (*QueryGetBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBid not implemented")
+func (m *BidBookLevel) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListVestingQueue(ctx context.Context, req *QueryAllVestingQueueRequest) (*QueryAllVestingQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVestingQueue not implemented")
+func (m *BidBookLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidBookLevel.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_BidBookLevel proto.InternalMessageInfo
+
+// QueryAllowedBidderRequest is the request type for the Query/AllowedBidder RPC method.
+type QueryAllAllowedBidderRequest struct {
+	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllAllowedBidderRequest) Reset()         { *m = QueryAllAllowedBidderRequest{} }
+func (m *QueryAllAllowedBidderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowedBidderRequest) ProtoMessage()    {}
+func (*QueryAllAllowedBidderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{21}
+}
+func (m *QueryAllAllowedBidderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowedBidderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowedBidderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllAllowedBidderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowedBidderRequest.Merge(m, src)
+}
+func (m *QueryAllAllowedBidderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowedBidderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowedBidderRequest.DiscardUnknown(m)
 }
 
-func _Query_ListAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAuctionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/ListAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListAuction(ctx, req.(*QueryAllAuctionRequest))
+var xxx_messageInfo_QueryAllAllowedBidderRequest proto.InternalMessageInfo
+
+func (m *QueryAllAllowedBidderRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAuctionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/GetAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAuction(ctx, req.(*QueryGetAuctionRequest))
+func (m *QueryAllAllowedBidderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAllowedBidderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListAllowedBidder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/ListAllowedBidder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListAllowedBidder(ctx, req.(*QueryAllAllowedBidderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAllowedBidderResponse is the response type for the Query/AllowedBidder RPC method.
+type QueryAllAllowedBidderResponse struct {
+	AllowedBidder []AllowedBidder `protobuf:"bytes,1,rep,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllowedBidderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllowedBidder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/GetAllowedBidder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllowedBidder(ctx, req.(*QueryGetAllowedBidderRequest))
+func (m *QueryAllAllowedBidderResponse) Reset()         { *m = QueryAllAllowedBidderResponse{} }
+func (m *QueryAllAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowedBidderResponse) ProtoMessage()    {}
+func (*QueryAllAllowedBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{22}
+}
+func (m *QueryAllAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowedBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllAllowedBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowedBidderResponse.Merge(m, src)
+}
+func (m *QueryAllAllowedBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowedBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowedBidderResponse.DiscardUnknown(m)
 }
 
-func _Query_ListBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/ListBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListBid(ctx, req.(*QueryAllBidRequest))
+var xxx_messageInfo_QueryAllAllowedBidderResponse proto.InternalMessageInfo
+
+func (m *QueryAllAllowedBidderResponse) GetAllowedBidder() []AllowedBidder {
+	if m != nil {
+		return m.AllowedBidder
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/GetBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBid(ctx, req.(*QueryGetBidRequest))
+func (m *QueryAllAllowedBidderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListVestingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVestingQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListVestingQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Query/ListVestingQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListVestingQueue(ctx, req.(*QueryAllVestingQueueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAllowedBidderRequest is the request type for the Query/AllowedBidder RPC
+// method.
+type QueryGetAllowedBidderRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fundraising.fundraising.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListAuction",
-			Handler:    _Query_ListAuction_Handler,
-		},
-		{
-			MethodName: "GetAuction",
-			Handler:    _Query_GetAuction_Handler,
-		},
-		{
-			MethodName: "ListAllowedBidder",
-			Handler:    _Query_ListAllowedBidder_Handler,
-		},
-		{
-			MethodName: "GetAllowedBidder",
-			Handler:    _Query_GetAllowedBidder_Handler,
-		},
-		{
-			MethodName: "ListBid",
-			Handler:    _Query_ListBid_Handler,
-		},
-		{
-			MethodName: "GetBid",
-			Handler:    _Query_GetBid_Handler,
-		},
-		{
-			MethodName: "ListVestingQueue",
-			Handler:    _Query_ListVestingQueue_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fundraising/fundraising/v1/query.proto",
+func (m *QueryGetAllowedBidderRequest) Reset()         { *m = QueryGetAllowedBidderRequest{} }
+func (m *QueryGetAllowedBidderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowedBidderRequest) ProtoMessage()    {}
+func (*QueryGetAllowedBidderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{23}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllowedBidderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowedBidderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowedBidderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAllowedBidderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowedBidderRequest.Merge(m, src)
+}
+func (m *QueryGetAllowedBidderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowedBidderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowedBidderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryGetAllowedBidderRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllowedBidderRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllowedBidderRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllowedBidderResponse is the response type for the Query/AllowedBidder
+// RPC method.
+type QueryGetAllowedBidderResponse struct {
+	AllowedBidder AllowedBidder `protobuf:"bytes,1,opt,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetAllowedBidderResponse) Reset()         { *m = QueryGetAllowedBidderResponse{} }
+func (m *QueryGetAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowedBidderResponse) ProtoMessage()    {}
+func (*QueryGetAllowedBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{24}
+}
+func (m *QueryGetAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowedBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAllowedBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowedBidderResponse.Merge(m, src)
+}
+func (m *QueryGetAllowedBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowedBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowedBidderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllAuctionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllowedBidderResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllowedBidderResponse) GetAllowedBidder() AllowedBidder {
+	if m != nil {
+		return m.AllowedBidder
 	}
-	return dAtA[:n], nil
+	return AllowedBidder{}
 }
 
-func (m *QueryAllAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetBidRequest is the request type for the Query/Sequence RPC method.
+type QueryGetBidRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	BidId     uint64 `protobuf:"varint,2,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
 }
 
-func (m *QueryAllAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetBidRequest) Reset()         { *m = QueryGetBidRequest{} }
+func (m *QueryGetBidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBidRequest) ProtoMessage()    {}
+func (*QueryGetBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{25}
+}
+func (m *QueryGetBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBidRequest.Merge(m, src)
 }
-
-func (m *QueryAllAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Auction) > 0 {
-		for iNdEx := len(m.Auction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Auction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryGetBidRequest proto.InternalMessageInfo
+
+func (m *QueryGetBidRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetAuctionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetBidRequest) GetBidId() uint64 {
+	if m != nil {
+		return m.BidId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetBidResponse is response type for the Query/Sequence RPC method.
+type QueryGetBidResponse struct {
+	// bid specifies specific bid
+	Bid Bid `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid"`
 }
 
-func (m *QueryGetAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetBidResponse) Reset()         { *m = QueryGetBidResponse{} }
+func (m *QueryGetBidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBidResponse) ProtoMessage()    {}
+func (*QueryGetBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{26}
+}
+func (m *QueryGetBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBidResponse.Merge(m, src)
+}
+func (m *QueryGetBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBidResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetBidResponse proto.InternalMessageInfo
+
+func (m *QueryGetBidResponse) GetBid() Bid {
+	if m != nil {
+		return m.Bid
 	}
-	return dAtA[:n], nil
+	return Bid{}
 }
 
-func (m *QueryGetAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBidsRequest is request type for the Query/Bids RPC method.
+type QueryAllBidRequest struct {
+	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder     string             `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	IsMatched  string             `protobuf:"bytes,3,opt,name=is_matched,json=isMatched,proto3" json:"is_matched,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Auction != nil {
-		{
-			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllBidRequest) Reset()         { *m = QueryAllBidRequest{} }
+func (m *QueryAllBidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBidRequest) ProtoMessage()    {}
+func (*QueryAllBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{27}
+}
+func (m *QueryAllBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBidRequest.Merge(m, src)
+}
+func (m *QueryAllBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllAllowedBidderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllBidRequest proto.InternalMessageInfo
+
+func (m *QueryAllBidRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllAllowedBidderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllBidRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-func (m *QueryAllAllowedBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAllBidRequest) GetIsMatched() string {
+	if m != nil {
+		return m.IsMatched
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllBidRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBidsResponse is response type for the Query/Bids RPC method.
+type QueryAllBidResponse struct {
+	// bids specifies the existing bids
+	Bid []Bid `protobuf:"bytes,1,rep,name=bid,proto3" json:"bid"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllBidResponse) Reset()         { *m = QueryAllBidResponse{} }
+func (m *QueryAllBidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBidResponse) ProtoMessage()    {}
+func (*QueryAllBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{28}
+}
+func (m *QueryAllBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.AllowedBidder) > 0 {
-		for iNdEx := len(m.AllowedBidder) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowedBidder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAllBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBidResponse.Merge(m, src)
+}
+func (m *QueryAllBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBidResponse proto.InternalMessageInfo
+
+func (m *QueryAllBidResponse) GetBid() []Bid {
+	if m != nil {
+		return m.Bid
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetAllowedBidderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllBidResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAllowedBidderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBidsByBidderRequest is request type for the Query/ListBidsByBidder RPC method.
+type QueryBidsByBidderRequest struct {
+	Bidder     string             `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAllowedBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryBidsByBidderRequest) Reset()         { *m = QueryBidsByBidderRequest{} }
+func (m *QueryBidsByBidderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidsByBidderRequest) ProtoMessage()    {}
+func (*QueryBidsByBidderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{29}
+}
+func (m *QueryBidsByBidderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidsByBidderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidsByBidderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryBidsByBidderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidsByBidderRequest.Merge(m, src)
+}
+func (m *QueryBidsByBidderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidsByBidderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidsByBidderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidsByBidderRequest proto.InternalMessageInfo
+
+func (m *QueryBidsByBidderRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBidsByBidderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBidsByBidderResponse is response type for the Query/ListBidsByBidder RPC method.
+type QueryBidsByBidderResponse struct {
+	// bid specifies the bids placed by the bidder
+	Bid []Bid `protobuf:"bytes,1,rep,name=bid,proto3" json:"bid"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBidsByBidderResponse) Reset()         { *m = QueryBidsByBidderResponse{} }
+func (m *QueryBidsByBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidsByBidderResponse) ProtoMessage()    {}
+func (*QueryBidsByBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{30}
+}
+func (m *QueryBidsByBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidsByBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidsByBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetBidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBidsByBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidsByBidderResponse.Merge(m, src)
 }
-
-func (m *QueryGetBidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBidsByBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidsByBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidsByBidderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BidId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryBidsByBidderResponse proto.InternalMessageInfo
+
+func (m *QueryBidsByBidderResponse) GetBid() []Bid {
+	if m != nil {
+		return m.Bid
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBidsByBidderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllFailedTransitionRequest is request type for the Query/ListFailedTransition RPC method.
+type QueryAllFailedTransitionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllFailedTransitionRequest) Reset()         { *m = QueryAllFailedTransitionRequest{} }
+func (m *QueryAllFailedTransitionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFailedTransitionRequest) ProtoMessage()    {}
+func (*QueryAllFailedTransitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{31}
+}
+func (m *QueryAllFailedTransitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFailedTransitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFailedTransitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllFailedTransitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFailedTransitionRequest.Merge(m, src)
+}
+func (m *QueryAllFailedTransitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFailedTransitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFailedTransitionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllFailedTransitionRequest proto.InternalMessageInfo
+
+func (m *QueryAllFailedTransitionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllBidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllFailedTransitionResponse is response type for the Query/ListFailedTransition RPC method.
+type QueryAllFailedTransitionResponse struct {
+	// failed_transition specifies the auction state transitions that failed and wait for a retry
+	FailedTransition []FailedTransition `protobuf:"bytes,1,rep,name=failed_transition,json=failedTransition,proto3" json:"failed_transition"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllFailedTransitionResponse) Reset()         { *m = QueryAllFailedTransitionResponse{} }
+func (m *QueryAllFailedTransitionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFailedTransitionResponse) ProtoMessage()    {}
+func (*QueryAllFailedTransitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{32}
+}
+func (m *QueryAllFailedTransitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFailedTransitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFailedTransitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IsMatched) > 0 {
-		i -= len(m.IsMatched)
-		copy(dAtA[i:], m.IsMatched)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsMatched)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryAllFailedTransitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFailedTransitionResponse.Merge(m, src)
+}
+func (m *QueryAllFailedTransitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFailedTransitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFailedTransitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFailedTransitionResponse proto.InternalMessageInfo
+
+func (m *QueryAllFailedTransitionResponse) GetFailedTransition() []FailedTransition {
+	if m != nil {
+		return m.FailedTransition
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllFailedTransitionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllVestingQueueRequest is request type for the Query/Vestings RPC method.
+type QueryAllVestingQueueRequest struct {
+	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bid) > 0 {
-		for iNdEx := len(m.Bid) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllVestingQueueRequest) Reset()         { *m = QueryAllVestingQueueRequest{} }
+func (m *QueryAllVestingQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVestingQueueRequest) ProtoMessage()    {}
+func (*QueryAllVestingQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{33}
+}
+func (m *QueryAllVestingQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVestingQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVestingQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllVestingQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllVestingQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVestingQueueRequest.Merge(m, src)
 }
-
-func (m *QueryAllVestingQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllVestingQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVestingQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVestingQueueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllVestingQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryAllVestingQueueRequest proto.InternalMessageInfo
+
+func (m *QueryAllVestingQueueRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllVestingQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllVestingQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllVestingQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllVestingQueueResponse is response type for the Query/Vestings RPC method.
+type QueryAllVestingQueueResponse struct {
+	// vestings specifies the existing vestings
+	VestingQueue []VestingQueue      `protobuf:"bytes,1,rep,name=vestingQueue,proto3" json:"vestingQueue"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllVestingQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VestingQueue) > 0 {
-		for iNdEx := len(m.VestingQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllVestingQueueResponse) Reset()         { *m = QueryAllVestingQueueResponse{} }
+func (m *QueryAllVestingQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVestingQueueResponse) ProtoMessage()    {}
+func (*QueryAllVestingQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be3ab6819f1d50c, []int{34}
+}
+func (m *QueryAllVestingQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVestingQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVestingQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAllVestingQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVestingQueueResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllVestingQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVestingQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVestingQueueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllVestingQueueResponse proto.InternalMessageInfo
+
+func (m *QueryAllVestingQueueResponse) GetVestingQueue() []VestingQueue {
+	if m != nil {
+		return m.VestingQueue
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllVestingQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Auction) > 0 {
-		for _, e := range m.Auction {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "fundraising.fundraising.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fundraising.fundraising.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllowedDenomsRequest)(nil), "fundraising.fundraising.v1.QueryAllowedDenomsRequest")
+	proto.RegisterType((*QueryAllowedDenomsResponse)(nil), "fundraising.fundraising.v1.QueryAllowedDenomsResponse")
+	proto.RegisterType((*QueryAllAuctionRequest)(nil), "fundraising.fundraising.v1.QueryAllAuctionRequest")
+	proto.RegisterType((*QueryAllAuctionResponse)(nil), "fundraising.fundraising.v1.QueryAllAuctionResponse")
+	proto.RegisterType((*QueryGetAuctionRequest)(nil), "fundraising.fundraising.v1.QueryGetAuctionRequest")
+	proto.RegisterType((*QueryGetAuctionResponse)(nil), "fundraising.fundraising.v1.QueryGetAuctionResponse")
+	proto.RegisterType((*QueryCollectedFeesRequest)(nil), "fundraising.fundraising.v1.QueryCollectedFeesRequest")
+	proto.RegisterType((*QueryCollectedFeesResponse)(nil), "fundraising.fundraising.v1.QueryCollectedFeesResponse")
+	proto.RegisterType((*QueryAuctionResultRequest)(nil), "fundraising.fundraising.v1.QueryAuctionResultRequest")
+	proto.RegisterType((*QueryAuctionResultResponse)(nil), "fundraising.fundraising.v1.QueryAuctionResultResponse")
+	proto.RegisterType((*QueryBidderAllocationRequest)(nil), "fundraising.fundraising.v1.QueryBidderAllocationRequest")
+	proto.RegisterType((*QueryBidderAllocationResponse)(nil), "fundraising.fundraising.v1.QueryBidderAllocationResponse")
+	proto.RegisterType((*QueryBuyerVestingRequest)(nil), "fundraising.fundraising.v1.QueryBuyerVestingRequest")
+	proto.RegisterType((*QueryBuyerVestingResponse)(nil), "fundraising.fundraising.v1.QueryBuyerVestingResponse")
+	proto.RegisterType((*QuerySimulateMatchingRequest)(nil), "fundraising.fundraising.v1.QuerySimulateMatchingRequest")
+	proto.RegisterType((*QuerySimulateMatchingResponse)(nil), "fundraising.fundraising.v1.QuerySimulateMatchingResponse")
+	proto.RegisterType((*QueryBidBookRequest)(nil), "fundraising.fundraising.v1.QueryBidBookRequest")
+	proto.RegisterType((*QueryBidBookResponse)(nil), "fundraising.fundraising.v1.QueryBidBookResponse")
+	proto.RegisterType((*BidBookLevel)(nil), "fundraising.fundraising.v1.BidBookLevel")
+	proto.RegisterType((*QueryAllAllowedBidderRequest)(nil), "fundraising.fundraising.v1.QueryAllAllowedBidderRequest")
+	proto.RegisterType((*QueryAllAllowedBidderResponse)(nil), "fundraising.fundraising.v1.QueryAllAllowedBidderResponse")
+	proto.RegisterType((*QueryGetAllowedBidderRequest)(nil), "fundraising.fundraising.v1.QueryGetAllowedBidderRequest")
+	proto.RegisterType((*QueryGetAllowedBidderResponse)(nil), "fundraising.fundraising.v1.QueryGetAllowedBidderResponse")
+	proto.RegisterType((*QueryGetBidRequest)(nil), "fundraising.fundraising.v1.QueryGetBidRequest")
+	proto.RegisterType((*QueryGetBidResponse)(nil), "fundraising.fundraising.v1.QueryGetBidResponse")
+	proto.RegisterType((*QueryAllBidRequest)(nil), "fundraising.fundraising.v1.QueryAllBidRequest")
+	proto.RegisterType((*QueryAllBidResponse)(nil), "fundraising.fundraising.v1.QueryAllBidResponse")
+	proto.RegisterType((*QueryBidsByBidderRequest)(nil), "fundraising.fundraising.v1.QueryBidsByBidderRequest")
+	proto.RegisterType((*QueryBidsByBidderResponse)(nil), "fundraising.fundraising.v1.QueryBidsByBidderResponse")
+	proto.RegisterType((*QueryAllFailedTransitionRequest)(nil), "fundraising.fundraising.v1.QueryAllFailedTransitionRequest")
+	proto.RegisterType((*QueryAllFailedTransitionResponse)(nil), "fundraising.fundraising.v1.QueryAllFailedTransitionResponse")
+	proto.RegisterType((*QueryAllVestingQueueRequest)(nil), "fundraising.fundraising.v1.QueryAllVestingQueueRequest")
+	proto.RegisterType((*QueryAllVestingQueueResponse)(nil), "fundraising.fundraising.v1.QueryAllVestingQueueResponse")
 }
 
-func (m *QueryGetAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	return n
+func init() {
+	proto.RegisterFile("fundraising/fundraising/v1/query.proto", fileDescriptor_8be3ab6819f1d50c)
 }
 
-func (m *QueryGetAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Auction != nil {
-		l = m.Auction.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var fileDescriptor_8be3ab6819f1d50c = []byte{
+	// 1971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdf, 0x6f, 0x1c, 0x57,
+	0x15, 0xce, 0xb5, 0x1d, 0x07, 0x9f, 0x5d, 0x47, 0xce, 0x8d, 0x93, 0xae, 0x27, 0x89, 0x1d, 0x8d,
+	0x50, 0x63, 0x5a, 0xbc, 0x53, 0x6f, 0xe2, 0x38, 0x2e, 0x81, 0xb2, 0x9b, 0x1f, 0xcb, 0x06, 0x87,
+	0x38, 0x63, 0x68, 0x0b, 0x42, 0x5a, 0xcd, 0xce, 0x5c, 0x6f, 0x46, 0x9e, 0x9d, 0xd9, 0xec, 0xcc,
+	0xb8, 0x58, 0x55, 0x25, 0x84, 0x80, 0x27, 0x1e, 0x90, 0x8a, 0xc4, 0x13, 0x52, 0x1f, 0x91, 0xe0,
+	0xa1, 0xa0, 0x48, 0x45, 0x42, 0x48, 0x7d, 0xa3, 0x42, 0x48, 0xb5, 0x0a, 0x0f, 0x14, 0xa4, 0x0a,
+	0x12, 0x24, 0xfe, 0x0d, 0x34, 0xf7, 0x9e, 0xd9, 0x9d, 0xd9, 0x9f, 0x33, 0xeb, 0x0d, 0xe2, 0xc5,
+	0xda, 0xb9, 0xf7, 0x7e, 0xe7, 0x7e, 0xdf, 0xb9, 0xe7, 0xde, 0x7b, 0xee, 0x31, 0xbc, 0xb8, 0xe7,
+	0xdb, 0x46, 0x4b, 0x33, 0x5d, 0xd3, 0xae, 0x2b, 0xd1, 0xdf, 0x07, 0xeb, 0xca, 0x63, 0x9f, 0xb5,
+	0x0e, 0xf3, 0xcd, 0x96, 0xe3, 0x39, 0x54, 0x8a, 0xf4, 0xe5, 0xa3, 0xbf, 0x0f, 0xd6, 0xa5, 0x33,
+	0x5a, 0xc3, 0xb4, 0x1d, 0x85, 0xff, 0x15, 0xc3, 0xa5, 0x97, 0x74, 0xc7, 0x6d, 0x38, 0xae, 0x52,
+	0xd3, 0x5c, 0x26, 0xec, 0x28, 0x07, 0xeb, 0x35, 0xe6, 0x69, 0xeb, 0x4a, 0x53, 0xab, 0x9b, 0xb6,
+	0xe6, 0x99, 0x8e, 0x8d, 0x63, 0x97, 0xa3, 0x63, 0xc3, 0x51, 0xba, 0x63, 0x86, 0xfd, 0x4b, 0xa2,
+	0xbf, 0xca, 0xbf, 0x14, 0xf1, 0x81, 0x5d, 0xca, 0x10, 0xf6, 0x9a, 0x65, 0x39, 0x6f, 0x31, 0xa3,
+	0x5a, 0x33, 0x0d, 0x83, 0xb5, 0x10, 0xb0, 0x3a, 0x0c, 0xe0, 0xeb, 0x11, 0x56, 0xca, 0xe8, 0x91,
+	0xd5, 0x16, 0x73, 0x7d, 0xcb, 0x43, 0xc0, 0xe7, 0x87, 0x00, 0x6a, 0xa6, 0x81, 0xa3, 0xf2, 0xc3,
+	0x46, 0xf9, 0x87, 0xac, 0x55, 0x3d, 0x60, 0xae, 0x17, 0x38, 0x76, 0x34, 0x0d, 0xdd, 0xb1, 0x2c,
+	0xa6, 0x7b, 0xcc, 0xa8, 0xee, 0x31, 0x16, 0xba, 0xa4, 0x30, 0x04, 0xb0, 0xa7, 0x99, 0x16, 0x33,
+	0xaa, 0x5e, 0x4b, 0xb3, 0x5d, 0x33, 0xa2, 0xf5, 0xca, 0x10, 0x4c, 0x53, 0x6b, 0x69, 0x0d, 0x37,
+	0x01, 0x7b, 0xe4, 0x5d, 0x7d, 0xec, 0x33, 0x9f, 0xe1, 0xf8, 0xc5, 0xba, 0x53, 0x77, 0xc4, 0xba,
+	0x05, 0xbf, 0xb0, 0xf5, 0x62, 0xdd, 0x71, 0xea, 0x16, 0x53, 0xb4, 0xa6, 0xa9, 0x68, 0xb6, 0xed,
+	0x78, 0x3c, 0x1a, 0xc2, 0x39, 0x96, 0xb0, 0x97, 0x7f, 0xd5, 0xfc, 0x3d, 0x45, 0xb3, 0x31, 0x08,
+	0xe5, 0x45, 0xa0, 0x0f, 0x83, 0x58, 0xda, 0xe1, 0x9c, 0x54, 0xf6, 0xd8, 0x67, 0xae, 0x27, 0x7f,
+	0x17, 0xce, 0xc6, 0x5a, 0xdd, 0xa6, 0x63, 0xbb, 0x8c, 0xde, 0x81, 0x59, 0xc1, 0x3d, 0x47, 0x2e,
+	0x93, 0xd5, 0x4c, 0x41, 0xce, 0x0f, 0x0e, 0xe1, 0xbc, 0xc0, 0x96, 0xe6, 0x3e, 0xfa, 0x6c, 0xe5,
+	0xc4, 0x2f, 0xff, 0xf3, 0xfe, 0x4b, 0x44, 0x45, 0xb0, 0x7c, 0x01, 0x96, 0xb8, 0xf5, 0xa2, 0x08,
+	0xa7, 0xdb, 0xcc, 0x76, 0x3a, 0x53, 0xff, 0x98, 0x80, 0xd4, 0xaf, 0x17, 0x29, 0x14, 0xe0, 0x5c,
+	0x18, 0x85, 0x4d, 0xed, 0x30, 0x70, 0x8e, 0xc1, 0x07, 0xe4, 0xc8, 0xe5, 0xe9, 0xd5, 0x39, 0xf5,
+	0x2c, 0x76, 0xee, 0xf0, 0x3e, 0x81, 0xa5, 0xd7, 0xe0, 0x7c, 0xcd, 0x72, 0xf4, 0x7d, 0x66, 0x54,
+	0x5d, 0x66, 0x59, 0x11, 0xd0, 0x14, 0x07, 0x2d, 0x62, 0xef, 0xae, 0xe8, 0x14, 0x28, 0xf9, 0x27,
+	0x04, 0xce, 0x87, 0x44, 0x8a, 0x22, 0x3a, 0x91, 0x23, 0x3d, 0x0f, 0xb3, 0xae, 0xa7, 0x79, 0xbe,
+	0xf0, 0xc3, 0x9c, 0x8a, 0x5f, 0x94, 0xc2, 0x8c, 0x77, 0xd8, 0x64, 0xb9, 0x29, 0xde, 0xca, 0x7f,
+	0xd3, 0xbb, 0x00, 0x9d, 0xed, 0x99, 0x9b, 0xe6, 0x7e, 0x7b, 0x31, 0x8f, 0x5b, 0x2e, 0xd8, 0x9f,
+	0x79, 0x71, 0x26, 0xe0, 0x2e, 0xcd, 0xef, 0x68, 0x75, 0x86, 0xf3, 0xa8, 0x11, 0xa4, 0xfc, 0x1e,
+	0x81, 0x17, 0x7a, 0xe8, 0xa0, 0x53, 0x6e, 0xc2, 0x29, 0xdc, 0x3f, 0xdc, 0x0d, 0x99, 0xc2, 0x62,
+	0x5e, 0xac, 0x78, 0x3e, 0x5c, 0xf1, 0x7c, 0xd1, 0x3e, 0x2c, 0x65, 0xff, 0xf4, 0x64, 0xed, 0x73,
+	0x88, 0xad, 0xa8, 0x21, 0x84, 0x96, 0x63, 0x0c, 0xa7, 0x38, 0xc3, 0x2b, 0x23, 0x19, 0x8a, 0xa9,
+	0x63, 0x14, 0x37, 0xd1, 0x61, 0x65, 0xe6, 0x75, 0x39, 0xec, 0x12, 0x40, 0xb8, 0xc1, 0x4d, 0x83,
+	0x3b, 0x6d, 0x46, 0x9d, 0xc3, 0x96, 0x8a, 0x21, 0xbf, 0x01, 0x2f, 0xf4, 0x00, 0xfb, 0x49, 0x23,
+	0x29, 0xa5, 0xc9, 0xaf, 0x62, 0xa4, 0xdd, 0x0a, 0xb7, 0xf5, 0x5d, 0xc6, 0xdc, 0x84, 0xa4, 0x3c,
+	0x90, 0xfa, 0x61, 0x91, 0xd7, 0xeb, 0x70, 0x3a, 0x7e, 0x56, 0x20, 0xbd, 0x2f, 0x0c, 0xdb, 0x12,
+	0x31, 0x53, 0xa5, 0x99, 0x60, 0x67, 0xa8, 0xf3, 0x7a, 0xb4, 0xb1, 0xcd, 0xb8, 0xe3, 0x07, 0xdf,
+	0xf2, 0x52, 0x32, 0xee, 0xc2, 0x76, 0x18, 0xc7, 0x0f, 0xd9, 0x24, 0x8c, 0x63, 0xa6, 0x42, 0xc6,
+	0x5a, 0xb4, 0x51, 0x76, 0xe0, 0x22, 0x9f, 0xb5, 0xc4, 0x2f, 0x85, 0x60, 0xd7, 0xea, 0x5a, 0xf2,
+	0xb5, 0xa7, 0xaf, 0xc0, 0xac, 0xb8, 0x4e, 0xc4, 0xae, 0x29, 0xe5, 0x3e, 0x79, 0xb2, 0xb6, 0x88,
+	0xc1, 0x57, 0x34, 0x8c, 0x16, 0x73, 0xdd, 0x5d, 0xaf, 0x65, 0xda, 0x75, 0x15, 0xc7, 0xc9, 0xdf,
+	0x27, 0x70, 0x69, 0xc0, 0x8c, 0x28, 0xb5, 0x0a, 0x67, 0xc4, 0xd8, 0xaa, 0xd6, 0xee, 0x44, 0xb5,
+	0x5f, 0x1c, 0xa6, 0xb6, 0xdb, 0x20, 0x0a, 0x5e, 0xa8, 0x75, 0xb5, 0xcb, 0xfb, 0x90, 0x13, 0x0c,
+	0x82, 0xeb, 0xe5, 0x75, 0x71, 0x4a, 0x3f, 0x37, 0xbd, 0x1f, 0x4f, 0xc1, 0x52, 0x9f, 0xd9, 0x50,
+	0xeb, 0x2e, 0xcc, 0xc7, 0x2e, 0x39, 0xd4, 0xb9, 0x3a, 0x54, 0x67, 0xc4, 0x10, 0x6a, 0xcc, 0xd6,
+	0x22, 0x6d, 0xf4, 0x0e, 0x64, 0xf0, 0xc0, 0x0c, 0x92, 0x06, 0x3c, 0x13, 0x96, 0x62, 0x67, 0x42,
+	0x78, 0x1a, 0xdc, 0x72, 0x4c, 0x3b, 0x7a, 0xc8, 0x83, 0x00, 0x06, 0xcd, 0xb4, 0x02, 0xf3, 0xbe,
+	0x1d, 0x35, 0x34, 0x9d, 0xc2, 0x50, 0x36, 0x84, 0x72, 0x53, 0x5f, 0x87, 0xd3, 0xba, 0xa5, 0x99,
+	0x0d, 0xad, 0x66, 0x31, 0x61, 0x6b, 0x26, 0x85, 0xad, 0xf9, 0x36, 0x36, 0xe8, 0x69, 0x87, 0xec,
+	0xae, 0xd9, 0xf0, 0x2d, 0xcd, 0x63, 0xf7, 0x35, 0x4f, 0x7f, 0xf4, 0x3c, 0x97, 0xf0, 0xdd, 0x69,
+	0xb8, 0x34, 0x60, 0xc6, 0xf6, 0xee, 0x9c, 0x6f, 0x04, 0x6d, 0xc1, 0xbd, 0xd6, 0x32, 0x75, 0x26,
+	0x6e, 0x96, 0xd2, 0x7a, 0xa0, 0xe1, 0xef, 0x9f, 0xad, 0x5c, 0x10, 0xe6, 0x5d, 0x63, 0x3f, 0x6f,
+	0x3a, 0x4a, 0x43, 0xf3, 0x1e, 0xe5, 0xb7, 0x59, 0x5d, 0xd3, 0x0f, 0x6f, 0x33, 0xfd, 0x93, 0x27,
+	0x6b, 0x80, 0xb3, 0xdf, 0x66, 0xba, 0x9a, 0x45, 0x3b, 0x3b, 0x81, 0x19, 0xaa, 0xc2, 0xe9, 0xd0,
+	0xae, 0xd6, 0x70, 0x7c, 0xdb, 0x43, 0xce, 0x2f, 0xa3, 0xe1, 0x73, 0xbd, 0x86, 0x2b, 0xb6, 0x17,
+	0x31, 0x59, 0xb1, 0x3d, 0x35, 0xa4, 0x56, 0xe4, 0x16, 0xe8, 0x0a, 0x64, 0x42, 0x9b, 0x16, 0x13,
+	0x8b, 0x3a, 0xad, 0x02, 0x36, 0x6d, 0x33, 0x9b, 0x7e, 0xbb, 0xdf, 0xfe, 0x9b, 0x49, 0xbf, 0xff,
+	0x7a, 0x77, 0x1e, 0xbd, 0x07, 0xd9, 0xc0, 0x3f, 0xc1, 0x1d, 0xde, 0x70, 0x0c, 0x96, 0x3b, 0x79,
+	0x99, 0xac, 0x9e, 0x2e, 0x5c, 0x19, 0x9a, 0x88, 0x88, 0xf1, 0xf7, 0x1d, 0x83, 0xa9, 0x99, 0x66,
+	0xe7, 0x43, 0xbe, 0x86, 0x59, 0x4e, 0xc9, 0x34, 0x4a, 0x8e, 0xb3, 0x9f, 0xf0, 0x94, 0xfd, 0x35,
+	0x81, 0xc5, 0x38, 0x0c, 0x97, 0xb0, 0x0c, 0xd9, 0x30, 0xbd, 0xe0, 0x01, 0x4a, 0x52, 0x04, 0x68,
+	0x06, 0x91, 0x18, 0xeb, 0xb3, 0x16, 0x3b, 0x60, 0x96, 0xc8, 0x4f, 0x46, 0xed, 0x65, 0xc1, 0x62,
+	0x3b, 0x00, 0xc4, 0x92, 0x2d, 0x61, 0x42, 0xfe, 0x0d, 0x81, 0x6c, 0x74, 0x0c, 0x2d, 0xc3, 0xc9,
+	0x63, 0x46, 0x98, 0xc0, 0xd3, 0x37, 0xe1, 0x8c, 0xee, 0xf3, 0x70, 0x36, 0x0f, 0xd8, 0x31, 0xa2,
+	0x6b, 0xa1, 0x63, 0x45, 0x04, 0x98, 0xfc, 0x23, 0x02, 0x17, 0xdb, 0xb9, 0x8e, 0x48, 0xe8, 0x44,
+	0x68, 0x24, 0xdc, 0xa0, 0x77, 0xfb, 0x64, 0x34, 0xe3, 0xe4, 0x5c, 0x1f, 0x86, 0x37, 0x4d, 0x2f,
+	0x8f, 0xc8, 0xa5, 0x1a, 0x7b, 0x14, 0x61, 0x02, 0x36, 0xfc, 0x52, 0x8d, 0x9a, 0x6a, 0x5f, 0xaa,
+	0xd1, 0xc6, 0xc9, 0xe5, 0x64, 0xdf, 0x82, 0x8b, 0xed, 0xd4, 0x6a, 0x0c, 0x4f, 0x9e, 0x8f, 0x1f,
+	0x75, 0xed, 0x03, 0xed, 0x2d, 0xb8, 0x34, 0xc0, 0xec, 0x10, 0xc7, 0x90, 0xe3, 0x3b, 0x46, 0xbe,
+	0x87, 0xef, 0x95, 0x32, 0xf3, 0x4a, 0xa6, 0x91, 0x50, 0xc5, 0x39, 0xae, 0x22, 0xe8, 0x9a, 0xe2,
+	0x5d, 0x27, 0x6b, 0xa6, 0x51, 0x31, 0xe4, 0x6f, 0xc0, 0xd9, 0x98, 0x2d, 0xa4, 0xbe, 0x09, 0xd3,
+	0x35, 0xb4, 0x92, 0x29, 0xac, 0x8c, 0xda, 0x7b, 0x82, 0x65, 0x80, 0x90, 0x7f, 0x4b, 0x90, 0x5c,
+	0xd1, 0xb2, 0x92, 0x93, 0x1b, 0xe0, 0xe2, 0x00, 0x66, 0xba, 0x55, 0x3c, 0x55, 0xf9, 0x21, 0x3b,
+	0xa7, 0xce, 0x99, 0xee, 0x7d, 0xd1, 0xd0, 0x15, 0xe3, 0x33, 0x63, 0xc7, 0xf8, 0xcf, 0x09, 0x9c,
+	0x8d, 0x91, 0xee, 0xf6, 0xc2, 0x74, 0x3a, 0x2f, 0x4c, 0x2e, 0x74, 0x7f, 0x46, 0xc2, 0x2c, 0xcb,
+	0x34, 0xdc, 0xd2, 0x61, 0x3c, 0x6e, 0x3b, 0x77, 0x30, 0x49, 0x76, 0x07, 0x4f, 0xec, 0x50, 0xf8,
+	0x05, 0x81, 0xa5, 0x3e, 0xb4, 0xfe, 0x6f, 0xdc, 0x66, 0xc2, 0x4a, 0xb8, 0x9e, 0x77, 0x79, 0x71,
+	0xe2, 0x9b, 0xed, 0xda, 0x44, 0xe8, 0xbc, 0xb8, 0x2b, 0xc8, 0xd8, 0xae, 0xf8, 0x33, 0x81, 0xcb,
+	0x83, 0xe7, 0xea, 0x24, 0xe3, 0x3d, 0x45, 0x12, 0xf4, 0xcf, 0xd0, 0x64, 0xa0, 0xdb, 0x60, 0x98,
+	0x8c, 0xef, 0x75, 0xb5, 0x4f, 0xce, 0x73, 0x3f, 0x24, 0x70, 0x21, 0x94, 0x83, 0x99, 0xf0, 0x43,
+	0x9f, 0xf9, 0xec, 0x7f, 0x7c, 0xeb, 0xfc, 0x3e, 0x72, 0xfb, 0xc5, 0x69, 0xa0, 0x47, 0x55, 0xc8,
+	0x1e, 0x44, 0xda, 0x73, 0x64, 0x74, 0x96, 0x10, 0xb5, 0x13, 0x66, 0xfc, 0x51, 0x1b, 0x13, 0x73,
+	0x62, 0xe1, 0x48, 0x82, 0x93, 0x9c, 0x3d, 0x7d, 0x8f, 0xc0, 0xac, 0x28, 0x02, 0xd1, 0xfc, 0x30,
+	0x6e, 0xbd, 0xf5, 0x27, 0x49, 0x49, 0x3c, 0x5e, 0x30, 0x90, 0x37, 0x7e, 0xf0, 0x97, 0x7f, 0xbf,
+	0x3b, 0xa5, 0xd0, 0x35, 0xc5, 0x63, 0xb6, 0xc1, 0x5a, 0x0d, 0xd3, 0xf6, 0x94, 0x91, 0x25, 0x38,
+	0xfa, 0x07, 0x02, 0xf3, 0xb1, 0x3a, 0x13, 0xdd, 0x18, 0x39, 0x73, 0xbf, 0xaa, 0x95, 0x74, 0x3d,
+	0x2d, 0x0c, 0x79, 0x7f, 0x99, 0xf3, 0xde, 0xa4, 0x1b, 0x09, 0x79, 0x87, 0x77, 0xaa, 0xa8, 0x5f,
+	0xd1, 0x5f, 0x11, 0xc8, 0x6c, 0x9b, 0x6e, 0x58, 0x35, 0xa1, 0x85, 0x24, 0x34, 0xe2, 0xb5, 0x19,
+	0xe9, 0x6a, 0x2a, 0x0c, 0xf2, 0xbe, 0xca, 0x79, 0xaf, 0xd1, 0x97, 0x93, 0xf0, 0x0e, 0x0b, 0x4d,
+	0x1f, 0x10, 0x80, 0x32, 0x4b, 0x41, 0xb6, 0xa7, 0x90, 0x24, 0x5d, 0x4d, 0x85, 0x41, 0xb2, 0x5f,
+	0xe5, 0x64, 0x5f, 0xa5, 0x37, 0x52, 0x90, 0x55, 0xde, 0xee, 0x6c, 0xf5, 0x77, 0xe8, 0x1f, 0x83,
+	0x38, 0x89, 0x56, 0x3d, 0x92, 0xc4, 0x49, 0x9f, 0x0a, 0x8e, 0x74, 0x3d, 0x2d, 0x0c, 0x25, 0x94,
+	0xb9, 0x84, 0x22, 0x7d, 0x6d, 0x5c, 0x09, 0x8a, 0xa8, 0xf9, 0xd0, 0x8f, 0x09, 0xcc, 0xc7, 0xca,
+	0x50, 0x09, 0x94, 0xf4, 0xab, 0x9e, 0x49, 0xd7, 0xd3, 0xc2, 0x50, 0xc9, 0x03, 0xae, 0xa4, 0x42,
+	0xcb, 0x63, 0x2b, 0x89, 0xd7, 0xdd, 0xe8, 0x5f, 0x83, 0x07, 0x4e, 0xb4, 0x78, 0x71, 0x6d, 0x24,
+	0xb3, 0x3e, 0x65, 0x1b, 0x69, 0x23, 0x25, 0x0a, 0xe5, 0xbc, 0xc1, 0xe5, 0x3c, 0xa4, 0x0f, 0xc6,
+	0x96, 0x13, 0xab, 0xde, 0x28, 0x6f, 0x8b, 0x6c, 0xe5, 0x1d, 0xfa, 0x29, 0x81, 0x85, 0xee, 0xf7,
+	0x30, 0xbd, 0x31, 0x9a, 0x64, 0xff, 0x2a, 0x9c, 0xb4, 0x35, 0x06, 0x12, 0x25, 0xee, 0x70, 0x89,
+	0xf7, 0xe8, 0xd7, 0x8e, 0x19, 0x7b, 0x1d, 0x6d, 0xff, 0x20, 0xb0, 0xd0, 0x5d, 0x09, 0x49, 0xa0,
+	0x6d, 0x40, 0xb9, 0x46, 0xda, 0x1a, 0x03, 0x89, 0xda, 0x54, 0xae, 0x6d, 0x9b, 0xde, 0x1b, 0x5b,
+	0x9b, 0x8b, 0xa6, 0xab, 0x8d, 0x50, 0xc8, 0x07, 0x04, 0x4e, 0xe1, 0x8b, 0x9b, 0x2a, 0x49, 0xdc,
+	0x1e, 0x29, 0x3e, 0x48, 0xaf, 0x24, 0x07, 0xa0, 0x84, 0x0a, 0x97, 0x70, 0x8b, 0x16, 0xc7, 0x8f,
+	0x40, 0xd3, 0xa8, 0xd6, 0x02, 0xb6, 0x9f, 0x12, 0x38, 0xc3, 0xaf, 0x93, 0xd8, 0x5b, 0xf4, 0x46,
+	0xa2, 0x0b, 0xa2, 0xcf, 0xe3, 0x52, 0xda, 0x1a, 0x03, 0x39, 0xb1, 0x63, 0x22, 0xfe, 0xfc, 0xa4,
+	0xff, 0x22, 0xb0, 0x50, 0x66, 0xa9, 0xa5, 0x0d, 0x78, 0x37, 0x4b, 0x5b, 0x63, 0x20, 0x51, 0xda,
+	0x9b, 0x5c, 0x9a, 0x4a, 0x77, 0x26, 0x24, 0xad, 0xb3, 0xaf, 0xde, 0x27, 0x70, 0x2a, 0x58, 0xbf,
+	0x92, 0x69, 0x24, 0x48, 0xb9, 0x62, 0xaf, 0x54, 0x49, 0x49, 0x3c, 0x1e, 0x65, 0xdc, 0xe6, 0x32,
+	0xbe, 0x42, 0x6f, 0x1e, 0x27, 0xee, 0xe8, 0xef, 0x08, 0xcc, 0x96, 0x59, 0x42, 0xc6, 0xb1, 0x47,
+	0xbf, 0xa4, 0x24, 0x1e, 0x8f, 0x8c, 0xef, 0x73, 0xc6, 0x65, 0x7a, 0xe7, 0x38, 0x8c, 0xb9, 0xb7,
+	0x83, 0x0f, 0xfa, 0x21, 0x81, 0x05, 0xf4, 0x76, 0xfb, 0x1d, 0x98, 0xe4, 0xf2, 0xe9, 0x7d, 0xcd,
+	0x4a, 0x1b, 0x29, 0x51, 0x28, 0xe8, 0x35, 0x2e, 0x68, 0x8b, 0x6e, 0x26, 0x11, 0xd4, 0x15, 0x2c,
+	0xdc, 0xfb, 0x47, 0x04, 0x16, 0x03, 0x09, 0xdd, 0x6f, 0x2d, 0xfa, 0xa5, 0x24, 0xd1, 0x30, 0xe0,
+	0x79, 0x29, 0xdd, 0x1c, 0x0f, 0x3c, 0x4e, 0x4a, 0xdc, 0xf3, 0xb2, 0xa4, 0x47, 0xb8, 0x2a, 0xd1,
+	0x17, 0x0f, 0xdd, 0x4c, 0xc2, 0xa8, 0xcf, 0x93, 0x4f, 0xba, 0x91, 0x1e, 0x38, 0xb1, 0x63, 0x19,
+	0x53, 0x02, 0xb7, 0xf4, 0xe0, 0xa3, 0xa7, 0xcb, 0xe4, 0xe8, 0xe9, 0x32, 0xf9, 0xe7, 0xd3, 0x65,
+	0xf2, 0xd3, 0x67, 0xcb, 0x27, 0x8e, 0x9e, 0x2d, 0x9f, 0xf8, 0xdb, 0xb3, 0xe5, 0x13, 0xdf, 0xd9,
+	0xa8, 0x9b, 0xde, 0x23, 0xbf, 0x96, 0xd7, 0x9d, 0xc6, 0xa0, 0x69, 0xbe, 0x17, 0xfb, 0x0a, 0xfe,
+	0x25, 0xed, 0xd6, 0x66, 0xf9, 0xff, 0x4e, 0xaf, 0xfe, 0x77, 0x00, 0x76, 0x36, 0x43, 0x73, 0xaa,
+	0x22, 0x00, 0x00,
 }
 
-func (m *QueryAllAllowedBidderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllowedDenoms queries the paying denoms allowed and the selling denoms
+	// blocked by the parameters of the module.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// Queries a list of Auction items.
+	ListAuction(ctx context.Context, in *QueryAllAuctionRequest, opts ...grpc.CallOption) (*QueryAllAuctionResponse, error)
+	GetAuction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error)
+	// Queries the final matching result of a closed auction.
+	AuctionResult(ctx context.Context, in *QueryAuctionResultRequest, opts ...grpc.CallOption) (*QueryAuctionResultResponse, error)
+	// Queries the fees collected for an auction.
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
+	// Queries the locked and unlocked selling coin of a bidder by the buyer
+	// vesting schedules of an auction.
+	BuyerVesting(ctx context.Context, in *QueryBuyerVestingRequest, opts ...grpc.CallOption) (*QueryBuyerVestingResponse, error)
+	// Queries the settlement of a bidder in a closed auction.
+	BidderAllocation(ctx context.Context, in *QueryBidderAllocationRequest, opts ...grpc.CallOption) (*QueryBidderAllocationResponse, error)
+	// Simulates the matching of a running batch auction with the bids placed so far.
+	SimulateMatching(ctx context.Context, in *QuerySimulateMatchingRequest, opts ...grpc.CallOption) (*QuerySimulateMatchingResponse, error)
+	// Queries the cumulative demand curve of a batch auction.
+	BidBook(ctx context.Context, in *QueryBidBookRequest, opts ...grpc.CallOption) (*QueryBidBookResponse, error)
+	// Queries a list of AllowedBidder items.
+	ListAllowedBidder(ctx context.Context, in *QueryAllAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllAllowedBidderResponse, error)
+	GetAllowedBidder(ctx context.Context, in *QueryGetAllowedBidderRequest, opts ...grpc.CallOption) (*QueryGetAllowedBidderResponse, error)
+	// Queries a list of Bid items.
+	ListBid(ctx context.Context, in *QueryAllBidRequest, opts ...grpc.CallOption) (*QueryAllBidResponse, error)
+	GetBid(ctx context.Context, in *QueryGetBidRequest, opts ...grpc.CallOption) (*QueryGetBidResponse, error)
+	// Queries a list of Bid items placed by the bidder.
+	ListBidsByBidder(ctx context.Context, in *QueryBidsByBidderRequest, opts ...grpc.CallOption) (*QueryBidsByBidderResponse, error)
+	// Queries a list of FailedTransition items.
+	ListFailedTransition(ctx context.Context, in *QueryAllFailedTransitionRequest, opts ...grpc.CallOption) (*QueryAllFailedTransitionResponse, error)
+	// Queries a list of VestingQueue items.
+	ListVestingQueue(ctx context.Context, in *QueryAllVestingQueueRequest, opts ...grpc.CallOption) (*QueryAllVestingQueueResponse, error)
 }
 
-func (m *QueryAllAllowedBidderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AllowedBidder) > 0 {
-		for _, e := range m.AllowedBidder {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/AllowedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ListAuction(ctx context.Context, in *QueryAllAuctionRequest, opts ...grpc.CallOption) (*QueryAllAuctionResponse, error) {
+	out := new(QueryAllAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetAllowedBidderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetAuction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error) {
+	out := new(QueryGetAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/GetAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	return out, nil
+}
+
+func (c *queryClient) AuctionResult(ctx context.Context, in *QueryAuctionResultRequest, opts ...grpc.CallOption) (*QueryAuctionResultResponse, error) {
+	out := new(QueryAuctionResultResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/AuctionResult", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/CollectedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetAllowedBidderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BuyerVesting(ctx context.Context, in *QueryBuyerVestingRequest, opts ...grpc.CallOption) (*QueryBuyerVestingResponse, error) {
+	out := new(QueryBuyerVestingResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/BuyerVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AllowedBidder.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BidderAllocation(ctx context.Context, in *QueryBidderAllocationRequest, opts ...grpc.CallOption) (*QueryBidderAllocationResponse, error) {
+	out := new(QueryBidderAllocationResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/BidderAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	return out, nil
+}
+
+func (c *queryClient) SimulateMatching(ctx context.Context, in *QuerySimulateMatchingRequest, opts ...grpc.CallOption) (*QuerySimulateMatchingResponse, error) {
+	out := new(QuerySimulateMatchingResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/SimulateMatching", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.BidId != 0 {
-		n += 1 + sovQuery(uint64(m.BidId))
+	return out, nil
+}
+
+func (c *queryClient) BidBook(ctx context.Context, in *QueryBidBookRequest, opts ...grpc.CallOption) (*QueryBidBookResponse, error) {
+	out := new(QueryBidBookResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/BidBook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListAllowedBidder(ctx context.Context, in *QueryAllAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllAllowedBidderResponse, error) {
+	out := new(QueryAllAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListAllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Bid.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetAllowedBidder(ctx context.Context, in *QueryGetAllowedBidderRequest, opts ...grpc.CallOption) (*QueryGetAllowedBidderResponse, error) {
+	out := new(QueryGetAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/GetAllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	return out, nil
+}
+
+func (c *queryClient) ListBid(ctx context.Context, in *QueryAllBidRequest, opts ...grpc.CallOption) (*QueryAllBidResponse, error) {
+	out := new(QueryAllBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListBid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetBid(ctx context.Context, in *QueryGetBidRequest, opts ...grpc.CallOption) (*QueryGetBidResponse, error) {
+	out := new(QueryGetBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/GetBid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.IsMatched)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ListBidsByBidder(ctx context.Context, in *QueryBidsByBidderRequest, opts ...grpc.CallOption) (*QueryBidsByBidderResponse, error) {
+	out := new(QueryBidsByBidderResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListBidsByBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ListFailedTransition(ctx context.Context, in *QueryAllFailedTransitionRequest, opts ...grpc.CallOption) (*QueryAllFailedTransitionResponse, error) {
+	out := new(QueryAllFailedTransitionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListFailedTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListVestingQueue(ctx context.Context, in *QueryAllVestingQueueRequest, opts ...grpc.CallOption) (*QueryAllVestingQueueResponse, error) {
+	out := new(QueryAllVestingQueueResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Query/ListVestingQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Bid) > 0 {
-		for _, e := range m.Bid {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllowedDenoms queries the paying denoms allowed and the selling denoms
+	// blocked by the parameters of the module.
+	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// Queries a list of Auction items.
+	ListAuction(context.Context, *QueryAllAuctionRequest) (*QueryAllAuctionResponse, error)
+	GetAuction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error)
+	// Queries the final matching result of a closed auction.
+	AuctionResult(context.Context, *QueryAuctionResultRequest) (*QueryAuctionResultResponse, error)
+	// Queries the fees collected for an auction.
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
+	// Queries the locked and unlocked selling coin of a bidder by the buyer
+	// vesting schedules of an auction.
+	BuyerVesting(context.Context, *QueryBuyerVestingRequest) (*QueryBuyerVestingResponse, error)
+	// Queries the settlement of a bidder in a closed auction.
+	BidderAllocation(context.Context, *QueryBidderAllocationRequest) (*QueryBidderAllocationResponse, error)
+	// Simulates the matching of a running batch auction with the bids placed so far.
+	SimulateMatching(context.Context, *QuerySimulateMatchingRequest) (*QuerySimulateMatchingResponse, error)
+	// Queries the cumulative demand curve of a batch auction.
+	BidBook(context.Context, *QueryBidBookRequest) (*QueryBidBookResponse, error)
+	// Queries a list of AllowedBidder items.
+	ListAllowedBidder(context.Context, *QueryAllAllowedBidderRequest) (*QueryAllAllowedBidderResponse, error)
+	GetAllowedBidder(context.Context, *QueryGetAllowedBidderRequest) (*QueryGetAllowedBidderResponse, error)
+	// Queries a list of Bid items.
+	ListBid(context.Context, *QueryAllBidRequest) (*QueryAllBidResponse, error)
+	GetBid(context.Context, *QueryGetBidRequest) (*QueryGetBidResponse, error)
+	// Queries a list of Bid items placed by the bidder.
+	ListBidsByBidder(context.Context, *QueryBidsByBidderRequest) (*QueryBidsByBidderResponse, error)
+	// Queries a list of FailedTransition items.
+	ListFailedTransition(context.Context, *QueryAllFailedTransitionRequest) (*QueryAllFailedTransitionResponse, error)
+	// Queries a list of VestingQueue items.
+	ListVestingQueue(context.Context, *QueryAllVestingQueueRequest) (*QueryAllVestingQueueResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AllowedDenoms(ctx context.Context, req *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) ListAuction(ctx context.Context, req *QueryAllAuctionRequest) (*QueryAllAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuction not implemented")
+}
+func (*UnimplementedQueryServer) GetAuction(ctx context.Context, req *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuction not implemented")
+}
+func (*UnimplementedQueryServer) AuctionResult(ctx context.Context, req *QueryAuctionResultRequest) (*QueryAuctionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionResult not implemented")
+}
+func (*UnimplementedQueryServer) CollectedFees(ctx context.Context, req *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
+func (*UnimplementedQueryServer) BuyerVesting(ctx context.Context, req *QueryBuyerVestingRequest) (*QueryBuyerVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyerVesting not implemented")
+}
+func (*UnimplementedQueryServer) BidderAllocation(ctx context.Context, req *QueryBidderAllocationRequest) (*QueryBidderAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAllocation not implemented")
+}
+func (*UnimplementedQueryServer) SimulateMatching(ctx context.Context, req *QuerySimulateMatchingRequest) (*QuerySimulateMatchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMatching not implemented")
+}
+func (*UnimplementedQueryServer) BidBook(ctx context.Context, req *QueryBidBookRequest) (*QueryBidBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidBook not implemented")
+}
+func (*UnimplementedQueryServer) ListAllowedBidder(ctx context.Context, req *QueryAllAllowedBidderRequest) (*QueryAllAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedBidder not implemented")
+}
+func (*UnimplementedQueryServer) GetAllowedBidder(ctx context.Context, req *QueryGetAllowedBidderRequest) (*QueryGetAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedBidder not implemented")
+}
+func (*UnimplementedQueryServer) ListBid(ctx context.Context, req *QueryAllBidRequest) (*QueryAllBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBid not implemented")
+}
+func (*UnimplementedQueryServer) GetBid(ctx context.Context, req *QueryGetBidRequest) (*QueryGetBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBid not implemented")
+}
+func (*UnimplementedQueryServer) ListBidsByBidder(ctx context.Context, req *QueryBidsByBidderRequest) (*QueryBidsByBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidsByBidder not implemented")
+}
+func (*UnimplementedQueryServer) ListFailedTransition(ctx context.Context, req *QueryAllFailedTransitionRequest) (*QueryAllFailedTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedTransition not implemented")
+}
+func (*UnimplementedQueryServer) ListVestingQueue(ctx context.Context, req *QueryAllVestingQueueRequest) (*QueryAllVestingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVestingQueue not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/AllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/ListAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAuction(ctx, req.(*QueryAllAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/GetAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuction(ctx, req.(*QueryGetAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/AuctionResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionResult(ctx, req.(*QueryAuctionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/CollectedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyerVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyerVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyerVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/BuyerVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyerVesting(ctx, req.(*QueryBuyerVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/BidderAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAllocation(ctx, req.(*QueryBidderAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateMatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateMatchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMatching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/SimulateMatching",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMatching(ctx, req.(*QuerySimulateMatchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/BidBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidBook(ctx, req.(*QueryBidBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAllowedBidderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/ListAllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAllowedBidder(ctx, req.(*QueryAllAllowedBidderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowedBidderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/GetAllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllowedBidder(ctx, req.(*QueryGetAllowedBidderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/ListBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBid(ctx, req.(*QueryAllBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/GetBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBid(ctx, req.(*QueryGetBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListBidsByBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidsByBidderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBidsByBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/ListBidsByBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBidsByBidder(ctx, req.(*QueryBidsByBidderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFailedTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFailedTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFailedTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/ListFailedTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFailedTransition(ctx, req.(*QueryAllFailedTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVestingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVestingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVestingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Query/ListVestingQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVestingQueue(ctx, req.(*QueryAllVestingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fundraising.fundraising.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "ListAuction",
+			Handler:    _Query_ListAuction_Handler,
+		},
+		{
+			MethodName: "GetAuction",
+			Handler:    _Query_GetAuction_Handler,
+		},
+		{
+			MethodName: "AuctionResult",
+			Handler:    _Query_AuctionResult_Handler,
+		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
+		{
+			MethodName: "BuyerVesting",
+			Handler:    _Query_BuyerVesting_Handler,
+		},
+		{
+			MethodName: "BidderAllocation",
+			Handler:    _Query_BidderAllocation_Handler,
+		},
+		{
+			MethodName: "SimulateMatching",
+			Handler:    _Query_SimulateMatching_Handler,
+		},
+		{
+			MethodName: "BidBook",
+			Handler:    _Query_BidBook_Handler,
+		},
+		{
+			MethodName: "ListAllowedBidder",
+			Handler:    _Query_ListAllowedBidder_Handler,
+		},
+		{
+			MethodName: "GetAllowedBidder",
+			Handler:    _Query_GetAllowedBidder_Handler,
+		},
+		{
+			MethodName: "ListBid",
+			Handler:    _Query_ListBid_Handler,
+		},
+		{
+			MethodName: "GetBid",
+			Handler:    _Query_GetBid_Handler,
+		},
+		{
+			MethodName: "ListBidsByBidder",
+			Handler:    _Query_ListBidsByBidder_Handler,
+		},
+		{
+			MethodName: "ListFailedTransition",
+			Handler:    _Query_ListFailedTransition_Handler,
+		},
+		{
+			MethodName: "ListVestingQueue",
+			Handler:    _Query_ListVestingQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fundraising/fundraising/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedSellingDenoms) > 0 {
+		for iNdEx := len(m.BlockedSellingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedSellingDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedSellingDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockedSellingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedPayingDenoms) > 0 {
+		for iNdEx := len(m.AllowedPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPayingDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedPayingDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedPayingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auction) > 0 {
+		for iNdEx := len(m.Auction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollectedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuctionResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAllocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAllocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAllocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BidderAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyerVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyerVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyerVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyerVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyerVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyerVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimableCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.UnlockedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LockedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BuyerVesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateMatchingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateMatchingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateMatchingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateMatchingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateMatchingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateMatchingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PricingMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PricingMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BidderAllocation != nil {
+		{
+			size, err := m.BidderAllocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MatchedLen != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchedLen))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MatchedAmount.Size()
+		i -= size
+		if _, err := m.MatchedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MatchedPrice.Size()
+		i -= size
+		if _, err := m.MatchedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for iNdEx := len(m.Levels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Levels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BidBookLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidBookLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidBookLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeAmount.Size()
+		i -= size
+		if _, err := m.CumulativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowedBidderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowedBidderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowedBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedBidder) > 0 {
+		for iNdEx := len(m.AllowedBidder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedBidder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowedBidderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowedBidderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowedBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IsMatched) > 0 {
+		i -= len(m.IsMatched)
+		copy(dAtA[i:], m.IsMatched)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsMatched)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bid) > 0 {
+		for iNdEx := len(m.Bid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidsByBidderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidsByBidderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidsByBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidsByBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidsByBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidsByBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bid) > 0 {
+		for iNdEx := len(m.Bid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFailedTransitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFailedTransitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFailedTransitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFailedTransitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFailedTransitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFailedTransitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedTransition) > 0 {
+		for iNdEx := len(m.FailedTransition) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedTransition[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingQueue) > 0 {
+		for iNdEx := len(m.VestingQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedPayingDenoms) > 0 {
+		for _, s := range m.AllowedPayingDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BlockedSellingDenoms) > 0 {
+		for _, s := range m.BlockedSellingDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auction) > 0 {
+		for _, e := range m.Auction {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryGetAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollectedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryCollectedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryAuctionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuctionResult.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBidderAllocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BidderAllocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBuyerVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyerVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BuyerVesting.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnlockedCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClaimableCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateMatchingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateMatchingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MatchedPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MatchedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MatchedLen != 0 {
+		n += 1 + sovQuery(uint64(m.MatchedLen))
+	}
+	if m.BidderAllocation != nil {
+		l = m.BidderAllocation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PricingMode != 0 {
+		n += 1 + sovQuery(uint64(m.PricingMode))
+	}
+	return n
+}
+
+func (m *QueryBidBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryBidBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Levels) > 0 {
+		for _, e := range m.Levels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BidBookLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAllowedBidderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedBidder) > 0 {
+		for _, e := range m.AllowedBidder {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowedBidderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowedBidder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovQuery(uint64(m.BidId))
+	}
+	return n
+}
+
+func (m *QueryGetBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IsMatched)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bid) > 0 {
+		for _, e := range m.Bid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidsByBidderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidsByBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bid) > 0 {
+		for _, e := range m.Bid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFailedTransitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFailedTransitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedTransition) > 0 {
+		for _, e := range m.FailedTransition {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingQueue) > 0 {
+		for _, e := range m.VestingQueue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPayingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPayingDenoms = append(m.AllowedPayingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedSellingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedSellingDenoms = append(m.BlockedSellingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auction = append(m.Auction, &types.Any{})
+			if err := m.Auction[len(m.Auction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &types.Any{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderAllocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderAllocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderAllocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidderAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyerVestingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyerVestingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyerVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyerVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyerVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyerVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateMatchingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateMatchingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateMatchingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateMatchingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateMatchingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateMatchingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedLen", wireType)
+			}
+			m.MatchedLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchedLen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidderAllocation == nil {
+				m.BidderAllocation = &BidderAllocation{}
+			}
+			if err := m.BidderAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingMode", wireType)
+			}
+			m.PricingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingMode |= PricingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllVestingQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryAllVestingQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.VestingQueue) > 0 {
-		for _, e := range m.VestingQueue {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidBookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2215,12 +6430,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidBookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2242,7 +6476,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidBookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2265,15 +6499,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidBookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2300,7 +6534,41 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Levels = append(m.Levels, BidBookLevel{})
+			if err := m.Levels[len(m.Levels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2325,7 +6593,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *BidBookLevel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2348,15 +6616,49 @@ func (m *QueryAllAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidBookLevel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidBookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2381,16 +6683,68 @@ func (m *QueryAllAuctionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAllowedBidderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAllowedBidderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2400,25 +6754,12 @@ func (m *QueryAllAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2475,7 +6816,7 @@ func (m *QueryAllAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,15 +6839,15 @@ func (m *QueryAllAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowedBidderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2533,8 +6874,8 @@ func (m *QueryAllAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auction = append(m.Auction, &types.Any{})
-			if err := m.Auction[len(m.Auction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowedBidder = append(m.AllowedBidder, AllowedBidder{})
+			if err := m.AllowedBidder[len(m.AllowedBidder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2595,7 +6936,7 @@ func (m *QueryAllAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2618,10 +6959,10 @@ func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowedBidderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2643,6 +6984,38 @@ func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2664,7 +7037,7 @@ func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2687,15 +7060,15 @@ func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowedBidderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2722,10 +7095,7 @@ func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auction == nil {
-				m.Auction = &types.Any{}
-			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedBidder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2750,7 +7120,7 @@ func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAllowedBidderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2773,10 +7143,10 @@ func (m *QueryAllAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAllowedBidderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2799,10 +7169,10 @@ func (m *QueryAllAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
 			}
-			var msglen int
+			m.BidId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2812,28 +7182,11 @@ func (m *QueryAllAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BidId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2855,7 +7208,7 @@ func (m *QueryAllAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAllowedBidderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2878,49 +7231,15 @@ func (m *QueryAllAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAllowedBidderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllowedBidder = append(m.AllowedBidder, AllowedBidder{})
-			if err := m.AllowedBidder[len(m.AllowedBidder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2947,10 +7266,7 @@ func (m *QueryAllAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2975,7 +7291,7 @@ func (m *QueryAllAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllowedBidderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,10 +7314,10 @@ func (m *QueryGetAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllowedBidderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3023,11 +7339,75 @@ func (m *QueryGetAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsMatched = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3037,23 +7417,27 @@ func (m *QueryGetAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3076,7 +7460,7 @@ func (m *QueryGetAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllowedBidderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3099,15 +7483,15 @@ func (m *QueryGetAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllowedBidderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3134,7 +7518,44 @@ func (m *QueryGetAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllowedBidder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bid = append(m.Bid, Bid{})
+			if err := m.Bid[len(m.Bid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3159,7 +7580,7 @@ func (m *QueryGetAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBidRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsByBidderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3182,17 +7603,17 @@ func (m *QueryGetBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsByBidderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsByBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			m.AuctionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3202,16 +7623,29 @@ func (m *QueryGetBidRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.BidId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3221,11 +7655,28 @@ func (m *QueryGetBidRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BidId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3247,7 +7698,7 @@ func (m *QueryGetBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBidResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsByBidderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3270,10 +7721,10 @@ func (m *QueryGetBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsByBidderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsByBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3305,7 +7756,44 @@ func (m *QueryGetBidResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bid = append(m.Bid, Bid{})
+			if err := m.Bid[len(m.Bid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3330,7 +7818,7 @@ func (m *QueryGetBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBidRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFailedTransitionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3353,96 +7841,13 @@ func (m *QueryAllBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFailedTransitionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFailedTransitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IsMatched = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3499,7 +7904,7 @@ func (m *QueryAllBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBidResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFailedTransitionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3522,15 +7927,15 @@ func (m *QueryAllBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFailedTransitionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFailedTransitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3557,8 +7962,8 @@ func (m *QueryAllBidResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bid = append(m.Bid, Bid{})
-			if err := m.Bid[len(m.Bid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedTransition = append(m.FailedTransition, FailedTransition{})
+			if err := m.FailedTransition[len(m.FailedTransition)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -18,6 +19,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -148,6 +150,32 @@ type MsgCreateFixedPriceAuction struct {
 	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// end_time specifies the end time of the plan
 	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// bid_fee specifies the fee that a bidder pays to the auctioneer for each
+	// bid placed; it is optional
+	BidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=bid_fee,json=bidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_fee"`
+	// soft_cap specifies the minimum total paying amount that the auction must
+	// raise; the auction fails and all the coins are refunded otherwise
+	SoftCap cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=soft_cap,json=softCap,proto3,customtype=cosmossdk.io/math.Int" json:"soft_cap"`
+	// buyer_vesting_schedules specifies the vesting schedules of the selling
+	// coin allocated to the bidders; it is optional
+	BuyerVestingSchedules []VestingSchedule `protobuf:"bytes,10,rep,name=buyer_vesting_schedules,json=buyerVestingSchedules,proto3" json:"buyer_vesting_schedules"`
+	// linear_vesting specifies the continuous vesting of the paying coin; it is
+	// optional and can't be set together with vesting_schedules
+	LinearVesting *LinearVesting `protobuf:"bytes,11,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+	// auto_release specifies whether the vested paying coin is released to the
+	// auctioneer by the begin blocker instead of being claimed
+	AutoRelease bool `protobuf:"varint,12,opt,name=auto_release,json=autoRelease,proto3" json:"auto_release,omitempty"`
+	// beneficiary specifies the bech32-encoded address that receives the paying
+	// coin and the unsold selling coin; the auctioneer receives them when it is
+	// empty
+	Beneficiary string `protobuf:"bytes,13,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// additional_paying_denoms specifies the denoms that bidders can pay with
+	// besides the paying coin denom; a zero ratio is filled in by the price
+	// source of the chain when the auction is created
+	AdditionalPayingDenoms []PayingDenom `protobuf:"bytes,14,rep,name=additional_paying_denoms,json=additionalPayingDenoms,proto3" json:"additional_paying_denoms"`
+	// sale_mode specifies how the selling coin is allocated to the bidders; the
+	// bids are filled in the order they are placed when it is unspecified
+	SaleMode SaleMode `protobuf:"varint,15,opt,name=sale_mode,json=saleMode,proto3,enum=fundraising.fundraising.v1.SaleMode" json:"sale_mode,omitempty"`
 }
 
 func (m *MsgCreateFixedPriceAuction) Reset()         { *m = MsgCreateFixedPriceAuction{} }
@@ -225,6 +253,55 @@ func (m *MsgCreateFixedPriceAuction) GetEndTime() time.Time {
 	return time.Time{}
 }
 
+func (m *MsgCreateFixedPriceAuction) GetBidFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BidFee
+	}
+	return nil
+}
+
+func (m *MsgCreateFixedPriceAuction) GetBuyerVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.BuyerVestingSchedules
+	}
+	return nil
+}
+
+func (m *MsgCreateFixedPriceAuction) GetLinearVesting() *LinearVesting {
+	if m != nil {
+		return m.LinearVesting
+	}
+	return nil
+}
+
+func (m *MsgCreateFixedPriceAuction) GetAutoRelease() bool {
+	if m != nil {
+		return m.AutoRelease
+	}
+	return false
+}
+
+func (m *MsgCreateFixedPriceAuction) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *MsgCreateFixedPriceAuction) GetAdditionalPayingDenoms() []PayingDenom {
+	if m != nil {
+		return m.AdditionalPayingDenoms
+	}
+	return nil
+}
+
+func (m *MsgCreateFixedPriceAuction) GetSaleMode() SaleMode {
+	if m != nil {
+		return m.SaleMode
+	}
+	return SaleModeFirstCome
+}
+
 // MsgCreateFixedPriceAuctionResponse defines the
 // Msg/MsgCreateFixedPriceAuctionResponse response type.
 type MsgCreateFixedPriceAuctionResponse struct {
@@ -292,6 +369,42 @@ type MsgCreateBatchAuction struct {
 	StartTime time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// end_time specifies the end time of the plan
 	EndTime time.Time `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// bid_cancel_cutoff specifies the period before the end time in which
+	// bidders are not allowed to cancel their bids
+	BidCancelCutoff time.Duration `protobuf:"bytes,11,opt,name=bid_cancel_cutoff,json=bidCancelCutoff,proto3,stdduration" json:"bid_cancel_cutoff"`
+	// bid_fee specifies the fee that a bidder pays to the auctioneer for each
+	// bid placed; it is optional
+	BidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=bid_fee,json=bidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_fee"`
+	// soft_cap specifies the minimum total paying amount that the auction must
+	// raise; the auction fails and all the coins are refunded otherwise
+	SoftCap cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=soft_cap,json=softCap,proto3,customtype=cosmossdk.io/math.Int" json:"soft_cap"`
+	// buyer_vesting_schedules specifies the vesting schedules of the selling
+	// coin allocated to the bidders; it is optional
+	BuyerVestingSchedules []VestingSchedule `protobuf:"bytes,14,rep,name=buyer_vesting_schedules,json=buyerVestingSchedules,proto3" json:"buyer_vesting_schedules"`
+	// linear_vesting specifies the continuous vesting of the paying coin; it is
+	// optional and can't be set together with vesting_schedules
+	LinearVesting *LinearVesting `protobuf:"bytes,15,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+	// auto_release specifies whether the vested paying coin is released to the
+	// auctioneer by the begin blocker instead of being claimed
+	AutoRelease bool `protobuf:"varint,16,opt,name=auto_release,json=autoRelease,proto3" json:"auto_release,omitempty"`
+	// beneficiary specifies the bech32-encoded address that receives the paying
+	// coin and the unsold selling coin; the auctioneer receives them when it is
+	// empty
+	Beneficiary string `protobuf:"bytes,17,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// marginal_fill_mode specifies how the bids at the matched price are filled
+	// when their demand exceeds the remaining selling coin; they aren't filled
+	// partially when it is unspecified
+	MarginalFillMode MarginalFillMode `protobuf:"varint,18,opt,name=marginal_fill_mode,json=marginalFillMode,proto3,enum=fundraising.fundraising.v1.MarginalFillMode" json:"marginal_fill_mode,omitempty"`
+	// pricing_mode specifies the price that the matched bids pay; they pay the
+	// matched price when it is unspecified
+	PricingMode PricingMode `protobuf:"varint,19,opt,name=pricing_mode,json=pricingMode,proto3,enum=fundraising.fundraising.v1.PricingMode" json:"pricing_mode,omitempty"`
+	// sealed_bid_config makes the auction accept only sealed bids; the bids are
+	// public if it is not set
+	SealedBidConfig *SealedBidConfig `protobuf:"bytes,20,opt,name=sealed_bid_config,json=sealedBidConfig,proto3" json:"sealed_bid_config,omitempty"`
+	// additional_paying_denoms specifies the denoms that bidders can pay with
+	// besides the paying coin denom; a zero ratio is filled in by the price
+	// source of the chain when the auction is created
+	AdditionalPayingDenoms []PayingDenom `protobuf:"bytes,21,rep,name=additional_paying_denoms,json=additionalPayingDenoms,proto3" json:"additional_paying_denoms"`
 }
 
 func (m *MsgCreateBatchAuction) Reset()         { *m = MsgCreateBatchAuction{} }
@@ -376,6 +489,76 @@ func (m *MsgCreateBatchAuction) GetEndTime() time.Time {
 	return time.Time{}
 }
 
+func (m *MsgCreateBatchAuction) GetBidCancelCutoff() time.Duration {
+	if m != nil {
+		return m.BidCancelCutoff
+	}
+	return 0
+}
+
+func (m *MsgCreateBatchAuction) GetBidFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BidFee
+	}
+	return nil
+}
+
+func (m *MsgCreateBatchAuction) GetBuyerVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.BuyerVestingSchedules
+	}
+	return nil
+}
+
+func (m *MsgCreateBatchAuction) GetLinearVesting() *LinearVesting {
+	if m != nil {
+		return m.LinearVesting
+	}
+	return nil
+}
+
+func (m *MsgCreateBatchAuction) GetAutoRelease() bool {
+	if m != nil {
+		return m.AutoRelease
+	}
+	return false
+}
+
+func (m *MsgCreateBatchAuction) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *MsgCreateBatchAuction) GetMarginalFillMode() MarginalFillMode {
+	if m != nil {
+		return m.MarginalFillMode
+	}
+	return MarginalFillModeNone
+}
+
+func (m *MsgCreateBatchAuction) GetPricingMode() PricingMode {
+	if m != nil {
+		return m.PricingMode
+	}
+	return PricingModeUniform
+}
+
+func (m *MsgCreateBatchAuction) GetSealedBidConfig() *SealedBidConfig {
+	if m != nil {
+		return m.SealedBidConfig
+	}
+	return nil
+}
+
+func (m *MsgCreateBatchAuction) GetAdditionalPayingDenoms() []PayingDenom {
+	if m != nil {
+		return m.AdditionalPayingDenoms
+	}
+	return nil
+}
+
 // MsgCreateBatchAuctionResponse defines the
 // Msg/MsgCreateBatchAuctionResponse response type.
 type MsgCreateBatchAuctionResponse struct {
@@ -414,6 +597,215 @@ func (m *MsgCreateBatchAuctionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateBatchAuctionResponse proto.InternalMessageInfo
 
+// MsgCreateDutchAuction defines a SDK message for creating a dutch auction.
+type MsgCreateDutchAuction struct {
+	// auctioneer specifies the bech32-encoded address that creates the auction
+	Auctioneer string `protobuf:"bytes,1,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// start_price specifies the starting price of the auction
+	StartPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=start_price,json=startPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_price"`
+	// floor_price specifies the lowest price that the auction price decays to
+	FloorPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=floor_price,json=floorPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"floor_price"`
+	// selling_coin specifies the selling coin for the auction
+	SellingCoin types.Coin `protobuf:"bytes,4,opt,name=selling_coin,json=sellingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"selling_coin"`
+	// paying_coin_denom specifies the paying coin denom that bidders use to bid
+	// for
+	PayingCoinDenom string `protobuf:"bytes,5,opt,name=paying_coin_denom,json=payingCoinDenom,proto3" json:"paying_coin_denom,omitempty"`
+	// vesting_schedules specifies the vesting schedules for the auction
+	VestingSchedules []VestingSchedule `protobuf:"bytes,6,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
+	// decay_interval specifies the interval between price drops; zero means
+	// the price decays linearly
+	DecayInterval time.Duration `protobuf:"bytes,7,opt,name=decay_interval,json=decayInterval,proto3,stdduration" json:"decay_interval"`
+	// start_time specifies the start time of the plan
+	StartTime time.Time `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time specifies the end time of the plan
+	EndTime time.Time `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// bid_fee specifies the fee that a bidder pays to the auctioneer for each
+	// bid placed; it is optional
+	BidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=bid_fee,json=bidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_fee"`
+	// soft_cap specifies the minimum total paying amount that the auction must
+	// raise; the auction fails and all the coins are refunded otherwise
+	SoftCap cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=soft_cap,json=softCap,proto3,customtype=cosmossdk.io/math.Int" json:"soft_cap"`
+	// buyer_vesting_schedules specifies the vesting schedules of the selling
+	// coin allocated to the bidders; it is optional
+	BuyerVestingSchedules []VestingSchedule `protobuf:"bytes,12,rep,name=buyer_vesting_schedules,json=buyerVestingSchedules,proto3" json:"buyer_vesting_schedules"`
+	// linear_vesting specifies the continuous vesting of the paying coin; it is
+	// optional and can't be set together with vesting_schedules
+	LinearVesting *LinearVesting `protobuf:"bytes,13,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+	// auto_release specifies whether the vested paying coin is released to the
+	// auctioneer by the begin blocker instead of being claimed
+	AutoRelease bool `protobuf:"varint,14,opt,name=auto_release,json=autoRelease,proto3" json:"auto_release,omitempty"`
+	// beneficiary specifies the bech32-encoded address that receives the paying
+	// coin and the unsold selling coin; the auctioneer receives them when it is
+	// empty
+	Beneficiary string `protobuf:"bytes,15,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// additional_paying_denoms specifies the denoms that bidders can pay with
+	// besides the paying coin denom; a zero ratio is filled in by the price
+	// source of the chain when the auction is created
+	AdditionalPayingDenoms []PayingDenom `protobuf:"bytes,16,rep,name=additional_paying_denoms,json=additionalPayingDenoms,proto3" json:"additional_paying_denoms"`
+}
+
+func (m *MsgCreateDutchAuction) Reset()         { *m = MsgCreateDutchAuction{} }
+func (m *MsgCreateDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDutchAuction) ProtoMessage()    {}
+func (*MsgCreateDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{6}
+}
+func (m *MsgCreateDutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDutchAuction.Merge(m, src)
+}
+func (m *MsgCreateDutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDutchAuction proto.InternalMessageInfo
+
+func (m *MsgCreateDutchAuction) GetAuctioneer() string {
+	if m != nil {
+		return m.Auctioneer
+	}
+	return ""
+}
+
+func (m *MsgCreateDutchAuction) GetSellingCoin() types.Coin {
+	if m != nil {
+		return m.SellingCoin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCreateDutchAuction) GetPayingCoinDenom() string {
+	if m != nil {
+		return m.PayingCoinDenom
+	}
+	return ""
+}
+
+func (m *MsgCreateDutchAuction) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+func (m *MsgCreateDutchAuction) GetDecayInterval() time.Duration {
+	if m != nil {
+		return m.DecayInterval
+	}
+	return 0
+}
+
+func (m *MsgCreateDutchAuction) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateDutchAuction) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateDutchAuction) GetBidFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BidFee
+	}
+	return nil
+}
+
+func (m *MsgCreateDutchAuction) GetBuyerVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.BuyerVestingSchedules
+	}
+	return nil
+}
+
+func (m *MsgCreateDutchAuction) GetLinearVesting() *LinearVesting {
+	if m != nil {
+		return m.LinearVesting
+	}
+	return nil
+}
+
+func (m *MsgCreateDutchAuction) GetAutoRelease() bool {
+	if m != nil {
+		return m.AutoRelease
+	}
+	return false
+}
+
+func (m *MsgCreateDutchAuction) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *MsgCreateDutchAuction) GetAdditionalPayingDenoms() []PayingDenom {
+	if m != nil {
+		return m.AdditionalPayingDenoms
+	}
+	return nil
+}
+
+// MsgCreateDutchAuctionResponse defines the
+// Msg/MsgCreateDutchAuctionResponse response type.
+type MsgCreateDutchAuctionResponse struct {
+}
+
+func (m *MsgCreateDutchAuctionResponse) Reset()         { *m = MsgCreateDutchAuctionResponse{} }
+func (m *MsgCreateDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDutchAuctionResponse) ProtoMessage()    {}
+func (*MsgCreateDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{7}
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDutchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDutchAuctionResponse.Merge(m, src)
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDutchAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDutchAuctionResponse proto.InternalMessageInfo
+
 // MsgCancelAuction defines a SDK message for cancelling the auction.
 // Cancelling is only allowed when the auction hasn't started yet.
 type MsgCancelAuction struct {
@@ -428,7 +820,7 @@ func (m *MsgCancelAuction) Reset()         { *m = MsgCancelAuction{} }
 func (m *MsgCancelAuction) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelAuction) ProtoMessage()    {}
 func (*MsgCancelAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{6}
+	return fileDescriptor_bd48063046df62e0, []int{8}
 }
 func (m *MsgCancelAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +872,7 @@ func (m *MsgCancelAuctionResponse) Reset()         { *m = MsgCancelAuctionRespon
 func (m *MsgCancelAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelAuctionResponse) ProtoMessage()    {}
 func (*MsgCancelAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{7}
+	return fileDescriptor_bd48063046df62e0, []int{9}
 }
 func (m *MsgCancelAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,36 +901,29 @@ func (m *MsgCancelAuctionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelAuctionResponse proto.InternalMessageInfo
 
-// MsgPlaceBid defines a SDK message for placing a bid for the auction.
-type MsgPlaceBid struct {
+// MsgPauseAuction defines a SDK message for pausing the auction.
+// Pausing is only allowed when the auction is started and
+// bids are not accepted while the auction is paused.
+type MsgPauseAuction struct {
+	// sender specifies the bech32-encoded address of the auctioneer or the
+	// authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// auction_id specifies the auction id
-	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	// bidder specifies the bech32-encoded address that bids for the auction
-	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
-	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
-	// is how-many-coins
-	BidType BidType `protobuf:"varint,3,opt,name=bid_type,json=bidType,proto3,enum=fundraising.fundraising.v1.BidType" json:"bid_type,omitempty"`
-	// price specifies the bid price.
-	// The bid price must be the start price for fixed price auction whereas
-	// the bide price can be any value that the bidder places.
-	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
-	// coin specifies the paying amount of coin or the selling amount that the
-	// bidder bids
-	Coin types.Coin `protobuf:"bytes,5,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
-func (m *MsgPlaceBid) String() string { return proto.CompactTextString(m) }
-func (*MsgPlaceBid) ProtoMessage()    {}
-func (*MsgPlaceBid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{8}
+func (m *MsgPauseAuction) Reset()         { *m = MsgPauseAuction{} }
+func (m *MsgPauseAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseAuction) ProtoMessage()    {}
+func (*MsgPauseAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{10}
 }
-func (m *MsgPlaceBid) XXX_Unmarshal(b []byte) error {
+func (m *MsgPauseAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgPlaceBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPauseAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgPlaceBid.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPauseAuction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -548,62 +933,49 @@ func (m *MsgPlaceBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgPlaceBid) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgPlaceBid.Merge(m, src)
+func (m *MsgPauseAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseAuction.Merge(m, src)
 }
-func (m *MsgPlaceBid) XXX_Size() int {
+func (m *MsgPauseAuction) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgPlaceBid) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgPlaceBid.DiscardUnknown(m)
+func (m *MsgPauseAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseAuction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgPlaceBid proto.InternalMessageInfo
+var xxx_messageInfo_MsgPauseAuction proto.InternalMessageInfo
 
-func (m *MsgPlaceBid) GetAuctionId() uint64 {
+func (m *MsgPauseAuction) GetSender() string {
 	if m != nil {
-		return m.AuctionId
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgPlaceBid) GetBidder() string {
+func (m *MsgPauseAuction) GetAuctionId() uint64 {
 	if m != nil {
-		return m.Bidder
+		return m.AuctionId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgPlaceBid) GetBidType() BidType {
-	if m != nil {
-		return m.BidType
-	}
-	return BidTypeNil
+// MsgPauseAuctionResponse defines the Msg/MsgPauseAuctionResponse
+// response type.
+type MsgPauseAuctionResponse struct {
 }
 
-func (m *MsgPlaceBid) GetCoin() types.Coin {
-	if m != nil {
-		return m.Coin
-	}
-	return types.Coin{}
-}
-
-// MsgPlaceBidResponse defines the Msg/MsgPlaceBidResponse response type.
-type MsgPlaceBidResponse struct {
-}
-
-func (m *MsgPlaceBidResponse) Reset()         { *m = MsgPlaceBidResponse{} }
-func (m *MsgPlaceBidResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgPlaceBidResponse) ProtoMessage()    {}
-func (*MsgPlaceBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{9}
+func (m *MsgPauseAuctionResponse) Reset()         { *m = MsgPauseAuctionResponse{} }
+func (m *MsgPauseAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseAuctionResponse) ProtoMessage()    {}
+func (*MsgPauseAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{11}
 }
-func (m *MsgPlaceBidResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgPauseAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgPlaceBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPauseAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgPlaceBidResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPauseAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -613,48 +985,40 @@ func (m *MsgPlaceBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgPlaceBidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgPlaceBidResponse.Merge(m, src)
+func (m *MsgPauseAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseAuctionResponse.Merge(m, src)
 }
-func (m *MsgPlaceBidResponse) XXX_Size() int {
+func (m *MsgPauseAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgPlaceBidResponse.DiscardUnknown(m)
+func (m *MsgPauseAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgPauseAuctionResponse proto.InternalMessageInfo
 
-// MsgModifyBid defines a SDK message for modifying an existing bid for the
-// auction.
-type MsgModifyBid struct {
+// MsgResumeAuction defines a SDK message for resuming the paused auction.
+// The end time of the auction is shifted by the paused duration.
+type MsgResumeAuction struct {
+	// sender specifies the bech32-encoded address of the auctioneer or the
+	// authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// auction_id specifies the auction id
-	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	// bidder specifies the bech32-encoded address that bids for the auction
-	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
-	// bid_id specifies the bid id
-	BidId uint64 `protobuf:"varint,3,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
-	// price specifies the bid price.
-	// the bide price must be above or equal to the original value that the bidder
-	// placed.
-	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
-	// coin specifies the paying amount of coin or the selling amount that the
-	// bidder bids
-	Coin types.Coin `protobuf:"bytes,5,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *MsgModifyBid) Reset()         { *m = MsgModifyBid{} }
-func (m *MsgModifyBid) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyBid) ProtoMessage()    {}
-func (*MsgModifyBid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{10}
+func (m *MsgResumeAuction) Reset()         { *m = MsgResumeAuction{} }
+func (m *MsgResumeAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeAuction) ProtoMessage()    {}
+func (*MsgResumeAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{12}
 }
-func (m *MsgModifyBid) XXX_Unmarshal(b []byte) error {
+func (m *MsgResumeAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgResumeAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyBid.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgResumeAuction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -664,62 +1028,49 @@ func (m *MsgModifyBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyBid) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyBid.Merge(m, src)
+func (m *MsgResumeAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeAuction.Merge(m, src)
 }
-func (m *MsgModifyBid) XXX_Size() int {
+func (m *MsgResumeAuction) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyBid) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyBid.DiscardUnknown(m)
+func (m *MsgResumeAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeAuction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyBid proto.InternalMessageInfo
-
-func (m *MsgModifyBid) GetAuctionId() uint64 {
-	if m != nil {
-		return m.AuctionId
-	}
-	return 0
-}
+var xxx_messageInfo_MsgResumeAuction proto.InternalMessageInfo
 
-func (m *MsgModifyBid) GetBidder() string {
+func (m *MsgResumeAuction) GetSender() string {
 	if m != nil {
-		return m.Bidder
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgModifyBid) GetBidId() uint64 {
+func (m *MsgResumeAuction) GetAuctionId() uint64 {
 	if m != nil {
-		return m.BidId
+		return m.AuctionId
 	}
 	return 0
 }
 
-func (m *MsgModifyBid) GetCoin() types.Coin {
-	if m != nil {
-		return m.Coin
-	}
-	return types.Coin{}
-}
-
-// MsgModifyBidResponse defines the Msg/MsgModifyBidResponse response type.
-type MsgModifyBidResponse struct {
+// MsgResumeAuctionResponse defines the Msg/MsgResumeAuctionResponse
+// response type.
+type MsgResumeAuctionResponse struct {
 }
 
-func (m *MsgModifyBidResponse) Reset()         { *m = MsgModifyBidResponse{} }
-func (m *MsgModifyBidResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyBidResponse) ProtoMessage()    {}
-func (*MsgModifyBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{11}
+func (m *MsgResumeAuctionResponse) Reset()         { *m = MsgResumeAuctionResponse{} }
+func (m *MsgResumeAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeAuctionResponse) ProtoMessage()    {}
+func (*MsgResumeAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{13}
 }
-func (m *MsgModifyBidResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgResumeAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgResumeAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyBidResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgResumeAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -729,40 +1080,40 @@ func (m *MsgModifyBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyBidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyBidResponse.Merge(m, src)
+func (m *MsgResumeAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeAuctionResponse.Merge(m, src)
 }
-func (m *MsgModifyBidResponse) XXX_Size() int {
+func (m *MsgResumeAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyBidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyBidResponse.DiscardUnknown(m)
+func (m *MsgResumeAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyBidResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgResumeAuctionResponse proto.InternalMessageInfo
 
-// MsgAddAllowedBidder defines a SDK message for adding an allowed bidder to the
-// auction.
-type MsgAddAllowedBidder struct {
+// MsgCloseAuctionEarly defines a SDK message for closing the started or
+// paused auction at the current block.
+type MsgCloseAuctionEarly struct {
+	// sender specifies the bech32-encoded address of the auctioneer or the
+	// authority
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// auction_id specifies the auction id
-	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	// allowed_bidder specifies the bidder who is allowed to bid and their maximum
-	// bid amount
-	AllowedBidder AllowedBidder `protobuf:"bytes,2,opt,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *MsgAddAllowedBidder) Reset()         { *m = MsgAddAllowedBidder{} }
-func (m *MsgAddAllowedBidder) String() string { return proto.CompactTextString(m) }
-func (*MsgAddAllowedBidder) ProtoMessage()    {}
-func (*MsgAddAllowedBidder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{12}
+func (m *MsgCloseAuctionEarly) Reset()         { *m = MsgCloseAuctionEarly{} }
+func (m *MsgCloseAuctionEarly) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseAuctionEarly) ProtoMessage()    {}
+func (*MsgCloseAuctionEarly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{14}
 }
-func (m *MsgAddAllowedBidder) XXX_Unmarshal(b []byte) error {
+func (m *MsgCloseAuctionEarly) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCloseAuctionEarly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddAllowedBidder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCloseAuctionEarly.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -772,48 +1123,49 @@ func (m *MsgAddAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddAllowedBidder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddAllowedBidder.Merge(m, src)
+func (m *MsgCloseAuctionEarly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseAuctionEarly.Merge(m, src)
 }
-func (m *MsgAddAllowedBidder) XXX_Size() int {
+func (m *MsgCloseAuctionEarly) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddAllowedBidder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddAllowedBidder.DiscardUnknown(m)
+func (m *MsgCloseAuctionEarly) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseAuctionEarly.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddAllowedBidder proto.InternalMessageInfo
+var xxx_messageInfo_MsgCloseAuctionEarly proto.InternalMessageInfo
 
-func (m *MsgAddAllowedBidder) GetAuctionId() uint64 {
+func (m *MsgCloseAuctionEarly) GetSender() string {
 	if m != nil {
-		return m.AuctionId
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgAddAllowedBidder) GetAllowedBidder() AllowedBidder {
+func (m *MsgCloseAuctionEarly) GetAuctionId() uint64 {
 	if m != nil {
-		return m.AllowedBidder
+		return m.AuctionId
 	}
-	return AllowedBidder{}
+	return 0
 }
 
-// MsgAddAllowedBidderResponse defines the Msg/MsgAddAllowedBidderResponse response type.
-type MsgAddAllowedBidderResponse struct {
+// MsgCloseAuctionEarlyResponse defines the Msg/MsgCloseAuctionEarlyResponse
+// response type.
+type MsgCloseAuctionEarlyResponse struct {
 }
 
-func (m *MsgAddAllowedBidderResponse) Reset()         { *m = MsgAddAllowedBidderResponse{} }
-func (m *MsgAddAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddAllowedBidderResponse) ProtoMessage()    {}
-func (*MsgAddAllowedBidderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd48063046df62e0, []int{13}
+func (m *MsgCloseAuctionEarlyResponse) Reset()         { *m = MsgCloseAuctionEarlyResponse{} }
+func (m *MsgCloseAuctionEarlyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseAuctionEarlyResponse) ProtoMessage()    {}
+func (*MsgCloseAuctionEarlyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{15}
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCloseAuctionEarlyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCloseAuctionEarlyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddAllowedBidderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCloseAuctionEarlyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -823,1264 +1175,7794 @@ func (m *MsgAddAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddAllowedBidderResponse.Merge(m, src)
+func (m *MsgCloseAuctionEarlyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseAuctionEarlyResponse.Merge(m, src)
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Size() int {
+func (m *MsgCloseAuctionEarlyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddAllowedBidderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddAllowedBidderResponse.DiscardUnknown(m)
+func (m *MsgCloseAuctionEarlyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseAuctionEarlyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddAllowedBidderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCloseAuctionEarlyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "fundraising.fundraising.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "fundraising.fundraising.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateFixedPriceAuction)(nil), "fundraising.fundraising.v1.MsgCreateFixedPriceAuction")
-	proto.RegisterType((*MsgCreateFixedPriceAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCreateFixedPriceAuctionResponse")
-	proto.RegisterType((*MsgCreateBatchAuction)(nil), "fundraising.fundraising.v1.MsgCreateBatchAuction")
-	proto.RegisterType((*MsgCreateBatchAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCreateBatchAuctionResponse")
-	proto.RegisterType((*MsgCancelAuction)(nil), "fundraising.fundraising.v1.MsgCancelAuction")
-	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCancelAuctionResponse")
-	proto.RegisterType((*MsgPlaceBid)(nil), "fundraising.fundraising.v1.MsgPlaceBid")
-	proto.RegisterType((*MsgPlaceBidResponse)(nil), "fundraising.fundraising.v1.MsgPlaceBidResponse")
-	proto.RegisterType((*MsgModifyBid)(nil), "fundraising.fundraising.v1.MsgModifyBid")
-	proto.RegisterType((*MsgModifyBidResponse)(nil), "fundraising.fundraising.v1.MsgModifyBidResponse")
-	proto.RegisterType((*MsgAddAllowedBidder)(nil), "fundraising.fundraising.v1.MsgAddAllowedBidder")
-	proto.RegisterType((*MsgAddAllowedBidderResponse)(nil), "fundraising.fundraising.v1.MsgAddAllowedBidderResponse")
+// MsgForceCancelAuction defines a SDK message for cancelling the auction that
+// is not finished yet by the authority.
+type MsgForceCancelAuction struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("fundraising/fundraising/v1/tx.proto", fileDescriptor_bd48063046df62e0)
+func (m *MsgForceCancelAuction) Reset()         { *m = MsgForceCancelAuction{} }
+func (m *MsgForceCancelAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgForceCancelAuction) ProtoMessage()    {}
+func (*MsgForceCancelAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{16}
 }
-
-var fileDescriptor_bd48063046df62e0 = []byte{
-	// 1160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x4f, 0x1b, 0xc7,
-	0x1b, 0x66, 0xc1, 0x36, 0x78, 0x0c, 0x01, 0x16, 0x08, 0x66, 0x23, 0x6c, 0xe4, 0x44, 0x82, 0xf0,
-	0x0b, 0xbb, 0x3f, 0x93, 0x36, 0x55, 0x51, 0x95, 0x8a, 0x05, 0x5a, 0x45, 0xaa, 0x55, 0xb4, 0xf9,
-	0x38, 0xf4, 0x50, 0x6b, 0xbc, 0x33, 0x2c, 0xa3, 0x78, 0x77, 0xad, 0x9d, 0x35, 0xb5, 0xa5, 0x1e,
-	0xaa, 0xf4, 0xd6, 0x53, 0x7a, 0xad, 0x7a, 0xe9, 0x2d, 0xea, 0x89, 0x43, 0x2e, 0xfd, 0x0f, 0x72,
-	0xaa, 0x50, 0x4e, 0x6d, 0x0f, 0x49, 0x05, 0x07, 0xfe, 0x8d, 0x6a, 0x3e, 0xbc, 0xac, 0x1d, 0x6c,
-	0x63, 0x12, 0xb5, 0x55, 0x2f, 0xb0, 0xfb, 0xbe, 0xcf, 0xfb, 0x31, 0xcf, 0x3c, 0xef, 0xec, 0x18,
-	0x5c, 0xdf, 0xab, 0x7b, 0x28, 0x80, 0x84, 0x12, 0xcf, 0x31, 0xe2, 0xcf, 0x07, 0x45, 0x23, 0x6c,
-	0xe8, 0xb5, 0xc0, 0x0f, 0x7d, 0x55, 0x8b, 0x39, 0xf4, 0xf8, 0xf3, 0x41, 0x51, 0x9b, 0x86, 0x2e,
-	0xf1, 0x7c, 0x83, 0xff, 0x15, 0x70, 0x2d, 0x67, 0xfb, 0xd4, 0xf5, 0xa9, 0x51, 0x81, 0x14, 0x1b,
-	0x07, 0xc5, 0x0a, 0x0e, 0x61, 0xd1, 0xb0, 0x7d, 0xe2, 0x49, 0xff, 0xbc, 0xf4, 0xbb, 0x94, 0x97,
-	0x71, 0xa9, 0x23, 0x1d, 0x0b, 0xc2, 0x51, 0xe6, 0x6f, 0x86, 0x78, 0x91, 0x2e, 0xa3, 0x47, 0x9f,
-	0xb0, 0x5a, 0xf5, 0xbf, 0xc2, 0xa8, 0x5c, 0x21, 0x08, 0xe1, 0x40, 0x06, 0xac, 0xf4, 0x0a, 0xa8,
-	0xdb, 0x21, 0xf1, 0x5b, 0xed, 0xdc, 0xe8, 0x81, 0xac, 0x10, 0x24, 0x51, 0xcb, 0x3d, 0x50, 0x35,
-	0x18, 0x40, 0xb7, 0xd5, 0xe9, 0xac, 0xe3, 0x3b, 0xbe, 0x58, 0x01, 0x7b, 0x92, 0xd6, 0xbc, 0xe3,
-	0xfb, 0x4e, 0x15, 0x1b, 0xfc, 0xad, 0x52, 0xdf, 0x33, 0x42, 0xe2, 0x62, 0x1a, 0x42, 0xb7, 0x26,
-	0x00, 0x85, 0x5f, 0x15, 0x30, 0x59, 0xa2, 0xce, 0xc3, 0x1a, 0x82, 0x21, 0xde, 0xe5, 0x09, 0xd5,
-	0x3b, 0x20, 0x0d, 0xeb, 0xe1, 0xbe, 0x1f, 0x90, 0xb0, 0x99, 0x55, 0x96, 0x94, 0x95, 0xb4, 0x99,
-	0x7d, 0xf9, 0x7c, 0x6d, 0x56, 0x32, 0xb3, 0x89, 0x50, 0x80, 0x29, 0xbd, 0x1f, 0x06, 0xc4, 0x73,
-	0xac, 0x33, 0xa8, 0xba, 0x03, 0x52, 0xa2, 0xa5, 0xec, 0xf0, 0x92, 0xb2, 0x92, 0x59, 0x2f, 0xe8,
-	0xdd, 0x37, 0x50, 0x17, 0xb5, 0xcc, 0xf4, 0x8b, 0x57, 0xf9, 0xa1, 0x67, 0xa7, 0x87, 0xab, 0x8a,
-	0x25, 0x83, 0x37, 0x3e, 0x7a, 0x72, 0x7a, 0xb8, 0x7a, 0x96, 0xf6, 0xbb, 0xd3, 0xc3, 0xd5, 0x9b,
-	0xf1, 0x95, 0x37, 0xda, 0x78, 0xe8, 0x68, 0xbe, 0xb0, 0x00, 0xe6, 0x3b, 0x4c, 0x16, 0xa6, 0x35,
-	0xdf, 0xa3, 0xb8, 0xf0, 0x4b, 0x02, 0x68, 0x25, 0xea, 0x6c, 0x05, 0x18, 0x86, 0xf8, 0x13, 0xd2,
-	0xc0, 0x68, 0x37, 0x20, 0x36, 0xde, 0x14, 0xdb, 0xa2, 0xe6, 0x00, 0x90, 0x3b, 0x84, 0x71, 0x20,
-	0xd6, 0x6d, 0xc5, 0x2c, 0xaa, 0x05, 0x32, 0x34, 0x84, 0x41, 0x58, 0xae, 0xb1, 0x28, 0xbe, 0xc6,
-	0xb4, 0x59, 0x64, 0xfd, 0xff, 0xf1, 0x2a, 0x7f, 0x4d, 0x90, 0x43, 0xd1, 0x63, 0x9d, 0xf8, 0x86,
-	0x0b, 0xc3, 0x7d, 0xfd, 0x33, 0xec, 0x40, 0xbb, 0xb9, 0x8d, 0xed, 0x97, 0xcf, 0xd7, 0x80, 0xe4,
-	0x6e, 0x1b, 0xdb, 0x16, 0xe0, 0x59, 0x78, 0x69, 0xf5, 0x5b, 0x05, 0x8c, 0x53, 0x5c, 0xad, 0x12,
-	0xcf, 0x29, 0x33, 0xa9, 0x66, 0x47, 0x38, 0x73, 0x0b, 0xba, 0xc4, 0x33, 0x2d, 0xeb, 0x52, 0xcb,
-	0xfa, 0x96, 0x4f, 0x3c, 0x73, 0x87, 0x15, 0xfc, 0xf9, 0x75, 0x7e, 0xd9, 0x21, 0xe1, 0x7e, 0xbd,
-	0xa2, 0xdb, 0xbe, 0x2b, 0x25, 0x2b, 0xff, 0xad, 0x51, 0xf4, 0xd8, 0x08, 0x9b, 0x35, 0x4c, 0x79,
-	0xc0, 0x0f, 0xa7, 0x87, 0xab, 0xe3, 0x55, 0xde, 0x0a, 0xaf, 0x40, 0x05, 0xd9, 0x19, 0x59, 0x95,
-	0x41, 0xd4, 0x55, 0x30, 0x5d, 0x83, 0xcd, 0x56, 0x0f, 0x65, 0x84, 0x3d, 0xdf, 0xcd, 0x26, 0x38,
-	0x01, 0x93, 0xc2, 0xc1, 0x60, 0xdb, 0xcc, 0xac, 0x7e, 0x09, 0xa6, 0x0f, 0x30, 0x0d, 0x19, 0x98,
-	0xda, 0xfb, 0x18, 0xd5, 0xab, 0x98, 0x66, 0x93, 0x4b, 0x23, 0x2b, 0x99, 0xf5, 0xff, 0xf5, 0xda,
-	0xef, 0x47, 0x22, 0xe8, 0xbe, 0x8c, 0x31, 0x13, 0x6c, 0x1d, 0xd6, 0xd4, 0x41, 0xbb, 0x99, 0xaa,
-	0x5b, 0x40, 0xf0, 0x53, 0x66, 0x4a, 0xcd, 0xa6, 0x38, 0x1d, 0x9a, 0x2e, 0x64, 0xac, 0xb7, 0x64,
-	0xac, 0x3f, 0x68, 0xc9, 0xd8, 0x1c, 0x63, 0x79, 0x9e, 0xbe, 0xce, 0x2b, 0x56, 0x9a, 0xc7, 0x31,
-	0x8f, 0xfa, 0x31, 0x18, 0xc3, 0x1e, 0x12, 0x29, 0x46, 0x07, 0x48, 0x31, 0x8a, 0x3d, 0xc4, 0xec,
-	0x1b, 0x93, 0x4c, 0x83, 0xb1, 0xcd, 0x2f, 0xdc, 0x00, 0x85, 0xee, 0xd2, 0x89, 0x14, 0xf6, 0x63,
-	0x0a, 0xcc, 0x45, 0x30, 0x13, 0x86, 0xf6, 0xfe, 0x3f, 0x29, 0xae, 0x87, 0x60, 0xc2, 0x25, 0x1e,
-	0x3b, 0x9f, 0x64, 0xd6, 0x91, 0xcb, 0x66, 0xcd, 0xb8, 0xc4, 0x33, 0x09, 0xea, 0xa2, 0xd9, 0xc4,
-	0xbf, 0x46, 0xb3, 0xc9, 0x01, 0x34, 0x9b, 0x7a, 0x77, 0x9a, 0xbd, 0x05, 0x54, 0x17, 0x36, 0xca,
-	0xb8, 0x11, 0x62, 0x0f, 0x61, 0x54, 0x0e, 0xfc, 0xba, 0x87, 0xb8, 0xf0, 0x26, 0xac, 0x29, 0x17,
-	0x36, 0x76, 0xa4, 0xc3, 0x62, 0x76, 0x15, 0x82, 0x99, 0x76, 0x64, 0x39, 0x80, 0x21, 0xce, 0x8e,
-	0x5d, 0x76, 0x73, 0xa6, 0x71, 0x3c, 0xbd, 0x05, 0x43, 0xdc, 0x31, 0x44, 0xe9, 0xb7, 0x1f, 0x22,
-	0xf0, 0x4e, 0x86, 0x28, 0x0f, 0x16, 0xcf, 0x9d, 0x8e, 0x68, 0x7e, 0x2a, 0x60, 0x8a, 0x01, 0xa0,
-	0x67, 0xe3, 0xea, 0x45, 0x27, 0x67, 0x31, 0xf2, 0x97, 0x09, 0xe2, 0x83, 0x93, 0x60, 0x1f, 0x25,
-	0x6e, 0xb9, 0x87, 0xde, 0x6c, 0x42, 0x03, 0xd9, 0xce, 0x1a, 0x51, 0xfd, 0xa3, 0x61, 0x90, 0x29,
-	0x51, 0x67, 0xb7, 0x0a, 0x6d, 0x6c, 0x12, 0xd4, 0x91, 0x5b, 0xe9, 0xc8, 0xad, 0x5e, 0x05, 0x29,
-	0xf1, 0xf1, 0x17, 0xf3, 0x6a, 0xc9, 0x37, 0xf5, 0x2e, 0x18, 0x63, 0x43, 0xc7, 0x04, 0xcd, 0x67,
-	0xee, 0xca, 0xfa, 0xf5, 0x5e, 0x32, 0x33, 0x09, 0x7a, 0xd0, 0xac, 0x61, 0x6b, 0xb4, 0x22, 0x1e,
-	0xd4, 0x4f, 0x41, 0x52, 0x0c, 0x6c, 0xe2, 0xb2, 0x9a, 0x10, 0xf1, 0x6a, 0x08, 0x12, 0x7c, 0x42,
-	0x93, 0x7f, 0xd3, 0x84, 0xf2, 0x6a, 0x1b, 0x19, 0x46, 0xb9, 0xe4, 0xa2, 0x30, 0x07, 0x66, 0x62,
-	0x8c, 0x46, 0x4c, 0x3f, 0x1b, 0x06, 0xe3, 0x25, 0xea, 0x94, 0x7c, 0x44, 0xf6, 0x9a, 0x6f, 0x41,
-	0xf5, 0x1c, 0xb7, 0xb3, 0x90, 0x11, 0x1e, 0x92, 0xac, 0x10, 0x74, 0x0f, 0xfd, 0xa7, 0x18, 0xbc,
-	0x0a, 0x66, 0xe3, 0x4c, 0x45, 0x14, 0xfe, 0xa4, 0x70, 0x6a, 0x37, 0x11, 0xda, 0x14, 0x37, 0x51,
-	0x53, 0x50, 0xd2, 0x87, 0xc9, 0x47, 0xe0, 0x4a, 0xfb, 0xcd, 0x55, 0xde, 0xd6, 0x6e, 0xf6, 0x92,
-	0x68, 0x5b, 0x05, 0x79, 0x0e, 0x4e, 0xc0, 0xb8, 0x71, 0x63, 0x86, 0xf5, 0xdc, 0x91, 0xba, 0xb0,
-	0x08, 0xae, 0x9d, 0xd3, 0x62, 0x6b, 0x09, 0xeb, 0xbf, 0xa7, 0xc0, 0x48, 0x89, 0x3a, 0x6a, 0x0d,
-	0x8c, 0xb7, 0xdd, 0x40, 0x7b, 0x9e, 0xca, 0x1d, 0xd7, 0x3b, 0xed, 0xf6, 0x00, 0xe0, 0x56, 0x65,
-	0xf5, 0x7b, 0x05, 0xcc, 0x77, 0xbb, 0x08, 0xde, 0xe9, 0x93, 0xb0, 0x4b, 0x9c, 0x76, 0xf7, 0x72,
-	0x71, 0x51, 0x4f, 0x4f, 0x14, 0xa0, 0x9e, 0x73, 0x75, 0x28, 0x5e, 0x28, 0x6d, 0x3c, 0x44, 0xfb,
-	0x70, 0xe0, 0x90, 0xa8, 0x09, 0x0a, 0x26, 0xda, 0xcf, 0xdf, 0x5b, 0xfd, 0x72, 0xc5, 0xd1, 0xda,
-	0x7b, 0x83, 0xa0, 0xa3, 0xa2, 0x08, 0x8c, 0x45, 0x67, 0xee, 0x72, 0x9f, 0x0c, 0x2d, 0xa0, 0x66,
-	0x5c, 0x10, 0x18, 0x55, 0x71, 0x40, 0xfa, 0xec, 0xbc, 0x59, 0xe9, 0x13, 0x1d, 0x21, 0xb5, 0xff,
-	0x5f, 0x14, 0x19, 0x15, 0xfa, 0x1a, 0x4c, 0xbd, 0x31, 0x95, 0xfd, 0xba, 0xed, 0x0c, 0xd0, 0x3e,
-	0x18, 0x30, 0xa0, 0x55, 0x5d, 0x4b, 0x7e, 0xc3, 0x4e, 0x12, 0xf3, 0xf3, 0x17, 0xc7, 0x39, 0xe5,
-	0xe8, 0x38, 0xa7, 0xfc, 0x79, 0x9c, 0x53, 0x9e, 0x9e, 0xe4, 0x86, 0x8e, 0x4e, 0x72, 0x43, 0xbf,
-	0x9d, 0xe4, 0x86, 0xbe, 0x78, 0x3f, 0x76, 0x44, 0xf1, 0x9b, 0x43, 0xe0, 0x12, 0x2f, 0x34, 0xba,
-	0xff, 0xc6, 0xe2, 0xa7, 0x56, 0x25, 0xc5, 0xbf, 0xfa, 0xb7, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x3f, 0x65, 0x01, 0xec, 0xbc, 0x0f, 0x00, 0x00,
+func (m *MsgForceCancelAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateFixedPriceAuction submits a create fixed price auction message.
-	CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error)
-	// CreateBatchAuction submits a create batch auction message.
-	CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error)
-	// CancelAuction defines a method to cancel the auction message.
-	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
-	// PlaceBid defines a method to place a bid message.
-	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
-	// ModifyBid defines a method to modify the bid message.
-	ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error)
-	// AddAllowedBidder defines a method sto add a single allowed bidder message.
-	// This is for the testing purpose and it must not be used in mainnet.
-	AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error)
+func (m *MsgForceCancelAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceCancelAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgForceCancelAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceCancelAuction.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgForceCancelAuction) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgForceCancelAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceCancelAuction.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error) {
-	out := new(MsgCreateFixedPriceAuctionResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CreateFixedPriceAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgForceCancelAuction proto.InternalMessageInfo
 
-func (c *msgClient) CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error) {
-	out := new(MsgCreateBatchAuctionResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CreateBatchAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgForceCancelAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
-	out := new(MsgCancelAuctionResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CancelAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgForceCancelAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
-	out := new(MsgPlaceBidResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/PlaceBid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgForceCancelAuctionResponse defines the Msg/MsgForceCancelAuctionResponse
+// response type.
+type MsgForceCancelAuctionResponse struct {
 }
 
-func (c *msgClient) ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error) {
-	out := new(MsgModifyBidResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/ModifyBid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgForceCancelAuctionResponse) Reset()         { *m = MsgForceCancelAuctionResponse{} }
+func (m *MsgForceCancelAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceCancelAuctionResponse) ProtoMessage()    {}
+func (*MsgForceCancelAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{17}
 }
-
-func (c *msgClient) AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error) {
-	out := new(MsgAddAllowedBidderResponse)
-	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/AddAllowedBidder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgForceCancelAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceCancelAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceCancelAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateFixedPriceAuction submits a create fixed price auction message.
-	CreateFixedPriceAuction(context.Context, *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error)
-	// CreateBatchAuction submits a create batch auction message.
-	CreateBatchAuction(context.Context, *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error)
-	// CancelAuction defines a method to cancel the auction message.
-	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
-	// PlaceBid defines a method to place a bid message.
-	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
-	// ModifyBid defines a method to modify the bid message.
-	ModifyBid(context.Context, *MsgModifyBid) (*MsgModifyBidResponse, error)
-	// AddAllowedBidder defines a method sto add a single allowed bidder message.
-	// This is for the testing purpose and it must not be used in mainnet.
-	AddAllowedBidder(context.Context, *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error)
+func (m *MsgForceCancelAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceCancelAuctionResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgForceCancelAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceCancelAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceCancelAuctionResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgForceCancelAuctionResponse proto.InternalMessageInfo
+
+// MsgPlaceBid defines a SDK message for placing a bid for the auction.
+type MsgPlaceBid struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that bids for the auction
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
+	// is how-many-coins, 4 is dutch
+	BidType BidType `protobuf:"varint,3,opt,name=bid_type,json=bidType,proto3,enum=fundraising.fundraising.v1.BidType" json:"bid_type,omitempty"`
+	// price specifies the bid price.
+	// The bid price must be the start price for fixed price auction whereas
+	// the bide price can be any value that the bidder places.
+	// For dutch auction, it is the maximum price that the bidder is willing to
+	// pay and the bid is filled at the current auction price.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// coin specifies the paying amount of coin or the selling amount that the
+	// bidder bids
+	Coin types.Coin `protobuf:"bytes,5,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
+	// paying_denom specifies the additional paying denom that the bid reserves
+	// when its coin is of the selling coin; the paying coin denom of the auction
+	// is reserved when it is empty
+	PayingDenom string `protobuf:"bytes,6,opt,name=paying_denom,json=payingDenom,proto3" json:"paying_denom,omitempty"`
 }
-func (*UnimplementedMsgServer) CreateFixedPriceAuction(ctx context.Context, req *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedPriceAuction not implemented")
+
+func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
+func (m *MsgPlaceBid) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBid) ProtoMessage()    {}
+func (*MsgPlaceBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{18}
 }
-func (*UnimplementedMsgServer) CreateBatchAuction(ctx context.Context, req *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBatchAuction not implemented")
+func (m *MsgPlaceBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+func (m *MsgPlaceBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+func (m *MsgPlaceBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBid.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ModifyBid(ctx context.Context, req *MsgModifyBid) (*MsgModifyBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyBid not implemented")
+func (m *MsgPlaceBid) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddAllowedBidder(ctx context.Context, req *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBidder not implemented")
+func (m *MsgPlaceBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBid.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgPlaceBid proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgPlaceBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateFixedPriceAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFixedPriceAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFixedPriceAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/CreateFixedPriceAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFixedPriceAuction(ctx, req.(*MsgCreateFixedPriceAuction))
+func (m *MsgPlaceBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateBatchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBatchAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBatchAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/CreateBatchAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBatchAuction(ctx, req.(*MsgCreateBatchAuction))
+func (m *MsgPlaceBid) GetBidType() BidType {
+	if m != nil {
+		return m.BidType
 	}
-	return interceptor(ctx, in, info, handler)
+	return BidTypeNil
 }
 
-func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/CancelAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+func (m *MsgPlaceBid) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/PlaceBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+func (m *MsgPlaceBid) GetPayingDenom() string {
+	if m != nil {
+		return m.PayingDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ModifyBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/ModifyBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyBid(ctx, req.(*MsgModifyBid))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgPlaceBidResponse defines the Msg/MsgPlaceBidResponse response type.
+type MsgPlaceBidResponse struct {
 }
 
-func _Msg_AddAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAllowedBidder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAllowedBidder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fundraising.fundraising.v1.Msg/AddAllowedBidder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAllowedBidder(ctx, req.(*MsgAddAllowedBidder))
+func (m *MsgPlaceBidResponse) Reset()         { *m = MsgPlaceBidResponse{} }
+func (m *MsgPlaceBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBidResponse) ProtoMessage()    {}
+func (*MsgPlaceBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{19}
+}
+func (m *MsgPlaceBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPlaceBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBidResponse.Merge(m, src)
+}
+func (m *MsgPlaceBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBidResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fundraising.fundraising.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateFixedPriceAuction",
-			Handler:    _Msg_CreateFixedPriceAuction_Handler,
-		},
-		{
-			MethodName: "CreateBatchAuction",
-			Handler:    _Msg_CreateBatchAuction_Handler,
-		},
-		{
-			MethodName: "CancelAuction",
-			Handler:    _Msg_CancelAuction_Handler,
-		},
-		{
-			MethodName: "PlaceBid",
-			Handler:    _Msg_PlaceBid_Handler,
-		},
-		{
-			MethodName: "ModifyBid",
-			Handler:    _Msg_ModifyBid_Handler,
-		},
-		{
-			MethodName: "AddAllowedBidder",
-			Handler:    _Msg_AddAllowedBidder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fundraising/fundraising/v1/tx.proto",
-}
+var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgModifyBid defines a SDK message for modifying an existing bid for the
+// auction.
+type MsgModifyBid struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that bids for the auction
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_id specifies the bid id
+	BidId uint64 `protobuf:"varint,3,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
+	// price specifies the bid price.
+	// the bide price must be above or equal to the original value that the bidder
+	// placed.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// coin specifies the paying amount of coin or the selling amount that the
+	// bidder bids
+	Coin types.Coin `protobuf:"bytes,5,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgModifyBid) Reset()         { *m = MsgModifyBid{} }
+func (m *MsgModifyBid) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyBid) ProtoMessage()    {}
+func (*MsgModifyBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{20}
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgModifyBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgModifyBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyBid.Merge(m, src)
+}
+func (m *MsgModifyBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyBid.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgModifyBid proto.InternalMessageInfo
+
+func (m *MsgModifyBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgModifyBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgModifyBid) GetBidId() uint64 {
+	if m != nil {
+		return m.BidId
+	}
+	return 0
 }
 
-func (m *MsgCreateFixedPriceAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModifyBid) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgCreateFixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgModifyBidResponse defines the Msg/MsgModifyBidResponse response type.
+type MsgModifyBidResponse struct {
 }
 
-func (m *MsgCreateFixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x3a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x32
-	if len(m.VestingSchedules) > 0 {
-		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PayingCoinDenom) > 0 {
-		i -= len(m.PayingCoinDenom)
-		copy(dAtA[i:], m.PayingCoinDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgModifyBidResponse) Reset()         { *m = MsgModifyBidResponse{} }
+func (m *MsgModifyBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyBidResponse) ProtoMessage()    {}
+func (*MsgModifyBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{21}
+}
+func (m *MsgModifyBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.StartPrice.Size()
-		i -= size
-		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateFixedPriceAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgModifyBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyBidResponse.Merge(m, src)
 }
-
-func (m *MsgCreateFixedPriceAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgModifyBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyBidResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFixedPriceAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MsgModifyBidResponse proto.InternalMessageInfo
 
-func (m *MsgCreateBatchAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgCancelBid defines a SDK message for cancelling an existing bid for the
+// batch auction. The reserved paying coin is refunded to the bidder.
+type MsgCancelBid struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that bids for the auction
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_id specifies the bid id
+	BidId uint64 `protobuf:"varint,3,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
 }
 
-func (m *MsgCreateBatchAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelBid) Reset()         { *m = MsgCancelBid{} }
+func (m *MsgCancelBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBid) ProtoMessage()    {}
+func (*MsgCancelBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{22}
 }
-
-func (m *MsgCreateBatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x52
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintTx(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.ExtendedRoundRate.Size()
-		i -= size
-		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.MaxExtendedRound != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxExtendedRound))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.VestingSchedules) > 0 {
-		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.PayingCoinDenom) > 0 {
-		i -= len(m.PayingCoinDenom)
-		copy(dAtA[i:], m.PayingCoinDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.MinBidPrice.Size()
-		i -= size
-		if _, err := m.MinBidPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.StartPrice.Size()
-		i -= size
-		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBid.Merge(m, src)
+}
+func (m *MsgCancelBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBid proto.InternalMessageInfo
+
+func (m *MsgCancelBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateBatchAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateBatchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelBid) GetBidId() uint64 {
+	if m != nil {
+		return m.BidId
+	}
+	return 0
 }
 
-func (m *MsgCreateBatchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelBidResponse defines the Msg/MsgCancelBidResponse response type.
+type MsgCancelBidResponse struct {
 }
 
-func (m *MsgCancelAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelBidResponse) Reset()         { *m = MsgCancelBidResponse{} }
+func (m *MsgCancelBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBidResponse) ProtoMessage()    {}
+func (*MsgCancelBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{23}
+}
+func (m *MsgCancelBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBidResponse.Merge(m, src)
+}
+func (m *MsgCancelBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBidResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelBidResponse proto.InternalMessageInfo
+
+// MsgClaimBuyerVesting defines a SDK message for claiming the unlocked selling
+// coin allocated to the bidder by the buyer vesting schedules of the auction.
+type MsgClaimBuyerVesting struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that bids for the auction
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *MsgCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgClaimBuyerVesting) Reset()         { *m = MsgClaimBuyerVesting{} }
+func (m *MsgClaimBuyerVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBuyerVesting) ProtoMessage()    {}
+func (*MsgClaimBuyerVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{24}
+}
+func (m *MsgClaimBuyerVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBuyerVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBuyerVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimBuyerVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBuyerVesting.Merge(m, src)
+}
+func (m *MsgClaimBuyerVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBuyerVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBuyerVesting.DiscardUnknown(m)
 }
 
-func (m *MsgCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimBuyerVesting proto.InternalMessageInfo
+
+func (m *MsgClaimBuyerVesting) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimBuyerVesting) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-func (m *MsgCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgClaimBuyerVestingResponse defines the Msg/MsgClaimBuyerVestingResponse
+// response type.
+type MsgClaimBuyerVestingResponse struct {
 }
 
-func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimBuyerVestingResponse) Reset()         { *m = MsgClaimBuyerVestingResponse{} }
+func (m *MsgClaimBuyerVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBuyerVestingResponse) ProtoMessage()    {}
+func (*MsgClaimBuyerVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{25}
+}
+func (m *MsgClaimBuyerVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBuyerVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBuyerVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimBuyerVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBuyerVestingResponse.Merge(m, src)
+}
+func (m *MsgClaimBuyerVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBuyerVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBuyerVestingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgClaimBuyerVestingResponse proto.InternalMessageInfo
+
+// MsgClaimVestedProceeds defines a SDK message for claiming the paying coin of
+// all the matured vesting queues of the auction.
+type MsgClaimVestedProceeds struct {
+	// auctioneer specifies the bech32-encoded address that is in charge of the
+	// auction
+	Auctioneer string `protobuf:"bytes,1,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// beneficiary specifies the bech32-encoded address that receives the
+	// claimed paying coin; the beneficiary of the auction receives it when it
+	// is empty
+	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (m *MsgClaimVestedProceeds) Reset()         { *m = MsgClaimVestedProceeds{} }
+func (m *MsgClaimVestedProceeds) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedProceeds) ProtoMessage()    {}
+func (*MsgClaimVestedProceeds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{26}
+}
+func (m *MsgClaimVestedProceeds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedProceeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedProceeds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.BidType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BidType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimVestedProceeds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedProceeds.Merge(m, src)
+}
+func (m *MsgClaimVestedProceeds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedProceeds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedProceeds.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimVestedProceeds proto.InternalMessageInfo
+
+func (m *MsgClaimVestedProceeds) GetAuctioneer() string {
+	if m != nil {
+		return m.Auctioneer
+	}
+	return ""
 }
 
-func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimVestedProceeds) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
 }
 
-func (m *MsgModifyBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimVestedProceeds) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgModifyBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClaimVestedProceedsResponse defines the Msg/MsgClaimVestedProceedsResponse
+// response type.
+type MsgClaimVestedProceedsResponse struct {
 }
 
-func (m *MsgModifyBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimVestedProceedsResponse) Reset()         { *m = MsgClaimVestedProceedsResponse{} }
+func (m *MsgClaimVestedProceedsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedProceedsResponse) ProtoMessage()    {}
+func (*MsgClaimVestedProceedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{27}
+}
+func (m *MsgClaimVestedProceedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedProceedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedProceedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgClaimVestedProceedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedProceedsResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedProceedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedProceedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedProceedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVestedProceedsResponse proto.InternalMessageInfo
+
+// MsgTransferAuctionOwnership defines a SDK message for proposing a new owner
+// of the auction. The ownership is transferred once the new owner accepts it.
+type MsgTransferAuctionOwnership struct {
+	// auctioneer specifies the bech32-encoded address that is in charge of the
+	// auction
+	Auctioneer string `protobuf:"bytes,1,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// new_owner specifies the bech32-encoded address that is proposed to be the
+	// auctioneer
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// new_beneficiary specifies the bech32-encoded address that receives the
+	// paying coin and the unsold selling coin after the transfer; the new owner
+	// receives them when it is empty
+	NewBeneficiary string `protobuf:"bytes,4,opt,name=new_beneficiary,json=newBeneficiary,proto3" json:"new_beneficiary,omitempty"`
+}
+
+func (m *MsgTransferAuctionOwnership) Reset()         { *m = MsgTransferAuctionOwnership{} }
+func (m *MsgTransferAuctionOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAuctionOwnership) ProtoMessage()    {}
+func (*MsgTransferAuctionOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{28}
+}
+func (m *MsgTransferAuctionOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAuctionOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAuctionOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.BidId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferAuctionOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAuctionOwnership.Merge(m, src)
+}
+func (m *MsgTransferAuctionOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAuctionOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAuctionOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgModifyBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferAuctionOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferAuctionOwnership) GetAuctioneer() string {
+	if m != nil {
+		return m.Auctioneer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgModifyBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferAuctionOwnership) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
 }
 
-func (m *MsgModifyBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferAuctionOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-func (m *MsgAddAllowedBidder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferAuctionOwnership) GetNewBeneficiary() string {
+	if m != nil {
+		return m.NewBeneficiary
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddAllowedBidder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferAuctionOwnershipResponse defines the
+// Msg/MsgTransferAuctionOwnershipResponse response type.
+type MsgTransferAuctionOwnershipResponse struct {
 }
 
-func (m *MsgAddAllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTransferAuctionOwnershipResponse) Reset()         { *m = MsgTransferAuctionOwnershipResponse{} }
+func (m *MsgTransferAuctionOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAuctionOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferAuctionOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{29}
+}
+func (m *MsgTransferAuctionOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAuctionOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAuctionOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTransferAuctionOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAuctionOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferAuctionOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAuctionOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAuctionOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferAuctionOwnershipResponse proto.InternalMessageInfo
+
+// MsgAcceptAuctionOwnership defines a SDK message for accepting the ownership
+// of the auction proposed by the auctioneer.
+type MsgAcceptAuctionOwnership struct {
+	// new_owner specifies the bech32-encoded address of the pending owner
+	NewOwner string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *MsgAddAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAcceptAuctionOwnership) Reset()         { *m = MsgAcceptAuctionOwnership{} }
+func (m *MsgAcceptAuctionOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAuctionOwnership) ProtoMessage()    {}
+func (*MsgAcceptAuctionOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{30}
+}
+func (m *MsgAcceptAuctionOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAuctionOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAuctionOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAuctionOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAuctionOwnership.Merge(m, src)
+}
+func (m *MsgAcceptAuctionOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAuctionOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAuctionOwnership.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgAcceptAuctionOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptAuctionOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgAcceptAuctionOwnership) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgAcceptAuctionOwnershipResponse defines the
+// Msg/MsgAcceptAuctionOwnershipResponse response type.
+type MsgAcceptAuctionOwnershipResponse struct {
+}
+
+func (m *MsgAcceptAuctionOwnershipResponse) Reset()         { *m = MsgAcceptAuctionOwnershipResponse{} }
+func (m *MsgAcceptAuctionOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAuctionOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptAuctionOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{31}
+}
+func (m *MsgAcceptAuctionOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAuctionOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAuctionOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgAcceptAuctionOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAuctionOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptAuctionOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAuctionOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAuctionOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgAcceptAuctionOwnershipResponse proto.InternalMessageInfo
+
+// MsgCommitBid defines a SDK message for committing a sealed bid for a
+// sealed-bid batch auction.
+type MsgCommitBid struct {
+	// bidder specifies the bech32-encoded address that commits the bid
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// hash specifies the SHA-256 hash of the sealed bid and its salt
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// collateral specifies the paying coin to deposit with the commitment
+	Collateral types.Coin `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral"`
+}
+
+func (m *MsgCommitBid) Reset()         { *m = MsgCommitBid{} }
+func (m *MsgCommitBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBid) ProtoMessage()    {}
+func (*MsgCommitBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{32}
+}
+func (m *MsgCommitBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgCommitBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBid.Merge(m, src)
+}
+func (m *MsgCommitBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBid.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFixedPriceAuction) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCommitBid proto.InternalMessageInfo
+
+func (m *MsgCommitBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	var l int
-	_ = l
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCommitBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	l = m.StartPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SellingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.PayingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgCommitBid) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	if len(m.VestingSchedules) > 0 {
-		for _, e := range m.VestingSchedules {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgCommitBid) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+// MsgCommitBidResponse defines the Msg/MsgCommitBidResponse response type.
+type MsgCommitBidResponse struct {
+	// commitment_id specifies the id of the commitment
+	CommitmentId uint64 `protobuf:"varint,1,opt,name=commitment_id,json=commitmentId,proto3" json:"commitment_id,omitempty"`
+}
+
+func (m *MsgCommitBidResponse) Reset()         { *m = MsgCommitBidResponse{} }
+func (m *MsgCommitBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBidResponse) ProtoMessage()    {}
+func (*MsgCommitBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{33}
+}
+func (m *MsgCommitBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBidResponse.Merge(m, src)
+}
+func (m *MsgCommitBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitBidResponse proto.InternalMessageInfo
+
+func (m *MsgCommitBidResponse) GetCommitmentId() uint64 {
+	if m != nil {
+		return m.CommitmentId
+	}
+	return 0
+}
+
+// MsgRevealBid defines a SDK message for revealing a committed bid during the
+// reveal phase of a sealed-bid batch auction.
+type MsgRevealBid struct {
+	// bidder specifies the bech32-encoded address that committed the bid
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// commitment_id specifies the id of the commitment to reveal
+	CommitmentId uint64 `protobuf:"varint,3,opt,name=commitment_id,json=commitmentId,proto3" json:"commitment_id,omitempty"`
+	// bid_type specifies the bid type; type 2 is how-much-worth, 3 is
+	// how-many-coins
+	BidType BidType `protobuf:"varint,4,opt,name=bid_type,json=bidType,proto3,enum=fundraising.fundraising.v1.BidType" json:"bid_type,omitempty"`
+	// price specifies the bid price
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// coin specifies the paying amount of coin or the selling amount that the
+	// bidder bids
+	Coin types.Coin `protobuf:"bytes,6,opt,name=coin,proto3" json:"coin"`
+	// salt specifies the secret mixed into the hash of the commitment
+	Salt string `protobuf:"bytes,7,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealBid) Reset()         { *m = MsgRevealBid{} }
+func (m *MsgRevealBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBid) ProtoMessage()    {}
+func (*MsgRevealBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{34}
+}
+func (m *MsgRevealBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBid.Merge(m, src)
+}
+func (m *MsgRevealBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBid proto.InternalMessageInfo
+
+func (m *MsgRevealBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *MsgRevealBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *MsgRevealBid) GetCommitmentId() uint64 {
+	if m != nil {
+		return m.CommitmentId
+	}
+	return 0
+}
+
+func (m *MsgRevealBid) GetBidType() BidType {
+	if m != nil {
+		return m.BidType
+	}
+	return BidTypeNil
+}
+
+func (m *MsgRevealBid) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRevealBid) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+// MsgRevealBidResponse defines the Msg/MsgRevealBidResponse response type.
+type MsgRevealBidResponse struct {
+	// bid_id specifies the id of the bid placed by the reveal
+	BidId uint64 `protobuf:"varint,1,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
+}
+
+func (m *MsgRevealBidResponse) Reset()         { *m = MsgRevealBidResponse{} }
+func (m *MsgRevealBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidResponse) ProtoMessage()    {}
+func (*MsgRevealBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{35}
+}
+func (m *MsgRevealBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidResponse.Merge(m, src)
+}
+func (m *MsgRevealBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBidResponse proto.InternalMessageInfo
+
+func (m *MsgRevealBidResponse) GetBidId() uint64 {
+	if m != nil {
+		return m.BidId
+	}
+	return 0
+}
+
+// MsgAddAllowedBidder defines a SDK message for adding an allowed bidder to the
+// auction.
+type MsgAddAllowedBidder struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// allowed_bidder specifies the bidder who is allowed to bid and their maximum
+	// bid amount
+	AllowedBidder AllowedBidder `protobuf:"bytes,2,opt,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
+}
+
+func (m *MsgAddAllowedBidder) Reset()         { *m = MsgAddAllowedBidder{} }
+func (m *MsgAddAllowedBidder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedBidder) ProtoMessage()    {}
+func (*MsgAddAllowedBidder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{36}
+}
+func (m *MsgAddAllowedBidder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedBidder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAllowedBidder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedBidder.Merge(m, src)
+}
+func (m *MsgAddAllowedBidder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedBidder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedBidder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAllowedBidder proto.InternalMessageInfo
+
+func (m *MsgAddAllowedBidder) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *MsgAddAllowedBidder) GetAllowedBidder() AllowedBidder {
+	if m != nil {
+		return m.AllowedBidder
+	}
+	return AllowedBidder{}
+}
+
+// MsgAddAllowedBidderResponse defines the Msg/MsgAddAllowedBidderResponse response type.
+type MsgAddAllowedBidderResponse struct {
+}
+
+func (m *MsgAddAllowedBidderResponse) Reset()         { *m = MsgAddAllowedBidderResponse{} }
+func (m *MsgAddAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedBidderResponse) ProtoMessage()    {}
+func (*MsgAddAllowedBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd48063046df62e0, []int{37}
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedBidderResponse.Merge(m, src)
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedBidderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAllowedBidderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "fundraising.fundraising.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "fundraising.fundraising.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateFixedPriceAuction)(nil), "fundraising.fundraising.v1.MsgCreateFixedPriceAuction")
+	proto.RegisterType((*MsgCreateFixedPriceAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCreateFixedPriceAuctionResponse")
+	proto.RegisterType((*MsgCreateBatchAuction)(nil), "fundraising.fundraising.v1.MsgCreateBatchAuction")
+	proto.RegisterType((*MsgCreateBatchAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCreateBatchAuctionResponse")
+	proto.RegisterType((*MsgCreateDutchAuction)(nil), "fundraising.fundraising.v1.MsgCreateDutchAuction")
+	proto.RegisterType((*MsgCreateDutchAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCreateDutchAuctionResponse")
+	proto.RegisterType((*MsgCancelAuction)(nil), "fundraising.fundraising.v1.MsgCancelAuction")
+	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "fundraising.fundraising.v1.MsgCancelAuctionResponse")
+	proto.RegisterType((*MsgPauseAuction)(nil), "fundraising.fundraising.v1.MsgPauseAuction")
+	proto.RegisterType((*MsgPauseAuctionResponse)(nil), "fundraising.fundraising.v1.MsgPauseAuctionResponse")
+	proto.RegisterType((*MsgResumeAuction)(nil), "fundraising.fundraising.v1.MsgResumeAuction")
+	proto.RegisterType((*MsgResumeAuctionResponse)(nil), "fundraising.fundraising.v1.MsgResumeAuctionResponse")
+	proto.RegisterType((*MsgCloseAuctionEarly)(nil), "fundraising.fundraising.v1.MsgCloseAuctionEarly")
+	proto.RegisterType((*MsgCloseAuctionEarlyResponse)(nil), "fundraising.fundraising.v1.MsgCloseAuctionEarlyResponse")
+	proto.RegisterType((*MsgForceCancelAuction)(nil), "fundraising.fundraising.v1.MsgForceCancelAuction")
+	proto.RegisterType((*MsgForceCancelAuctionResponse)(nil), "fundraising.fundraising.v1.MsgForceCancelAuctionResponse")
+	proto.RegisterType((*MsgPlaceBid)(nil), "fundraising.fundraising.v1.MsgPlaceBid")
+	proto.RegisterType((*MsgPlaceBidResponse)(nil), "fundraising.fundraising.v1.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgModifyBid)(nil), "fundraising.fundraising.v1.MsgModifyBid")
+	proto.RegisterType((*MsgModifyBidResponse)(nil), "fundraising.fundraising.v1.MsgModifyBidResponse")
+	proto.RegisterType((*MsgCancelBid)(nil), "fundraising.fundraising.v1.MsgCancelBid")
+	proto.RegisterType((*MsgCancelBidResponse)(nil), "fundraising.fundraising.v1.MsgCancelBidResponse")
+	proto.RegisterType((*MsgClaimBuyerVesting)(nil), "fundraising.fundraising.v1.MsgClaimBuyerVesting")
+	proto.RegisterType((*MsgClaimBuyerVestingResponse)(nil), "fundraising.fundraising.v1.MsgClaimBuyerVestingResponse")
+	proto.RegisterType((*MsgClaimVestedProceeds)(nil), "fundraising.fundraising.v1.MsgClaimVestedProceeds")
+	proto.RegisterType((*MsgClaimVestedProceedsResponse)(nil), "fundraising.fundraising.v1.MsgClaimVestedProceedsResponse")
+	proto.RegisterType((*MsgTransferAuctionOwnership)(nil), "fundraising.fundraising.v1.MsgTransferAuctionOwnership")
+	proto.RegisterType((*MsgTransferAuctionOwnershipResponse)(nil), "fundraising.fundraising.v1.MsgTransferAuctionOwnershipResponse")
+	proto.RegisterType((*MsgAcceptAuctionOwnership)(nil), "fundraising.fundraising.v1.MsgAcceptAuctionOwnership")
+	proto.RegisterType((*MsgAcceptAuctionOwnershipResponse)(nil), "fundraising.fundraising.v1.MsgAcceptAuctionOwnershipResponse")
+	proto.RegisterType((*MsgCommitBid)(nil), "fundraising.fundraising.v1.MsgCommitBid")
+	proto.RegisterType((*MsgCommitBidResponse)(nil), "fundraising.fundraising.v1.MsgCommitBidResponse")
+	proto.RegisterType((*MsgRevealBid)(nil), "fundraising.fundraising.v1.MsgRevealBid")
+	proto.RegisterType((*MsgRevealBidResponse)(nil), "fundraising.fundraising.v1.MsgRevealBidResponse")
+	proto.RegisterType((*MsgAddAllowedBidder)(nil), "fundraising.fundraising.v1.MsgAddAllowedBidder")
+	proto.RegisterType((*MsgAddAllowedBidderResponse)(nil), "fundraising.fundraising.v1.MsgAddAllowedBidderResponse")
+}
+
+func init() {
+	proto.RegisterFile("fundraising/fundraising/v1/tx.proto", fileDescriptor_bd48063046df62e0)
+}
+
+var fileDescriptor_bd48063046df62e0 = []byte{
+	// 2250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x5a, 0x9f, 0x7c, 0x24, 0xf5, 0xb1, 0x96, 0xec, 0xf5, 0xba, 0x91, 0x14, 0xda, 0x85,
+	0x15, 0xc7, 0x26, 0x2d, 0x39, 0x1f, 0x8e, 0xdb, 0x26, 0x10, 0x25, 0xab, 0x50, 0x10, 0xc1, 0xc6,
+	0xda, 0x71, 0x01, 0x1f, 0xba, 0x18, 0xee, 0x0c, 0x57, 0x83, 0xec, 0x07, 0xb1, 0xb3, 0x94, 0xa5,
+	0xa2, 0x40, 0x8b, 0x04, 0xbd, 0xe4, 0x50, 0xa4, 0x40, 0x0f, 0x45, 0x81, 0x1e, 0x7a, 0x0b, 0xda,
+	0x1e, 0x8c, 0x36, 0x40, 0xff, 0x85, 0x9c, 0x82, 0x20, 0xa7, 0xa2, 0x87, 0xa4, 0xb0, 0x0f, 0xfe,
+	0x03, 0xfa, 0x0f, 0x14, 0x33, 0x3b, 0x5c, 0xee, 0x92, 0x14, 0x97, 0x14, 0x2d, 0xbb, 0x05, 0x7a,
+	0x91, 0x96, 0x33, 0xef, 0xf7, 0xde, 0x6f, 0xde, 0xbc, 0x7d, 0xef, 0xcd, 0x2c, 0x5c, 0xac, 0x37,
+	0x3d, 0x1c, 0x20, 0xca, 0xa8, 0x67, 0x57, 0x92, 0xcf, 0xfb, 0x6b, 0x95, 0xf0, 0xa0, 0xdc, 0x08,
+	0xfc, 0xd0, 0x57, 0xf5, 0xc4, 0x44, 0x39, 0xf9, 0xbc, 0xbf, 0xa6, 0xcf, 0x23, 0x97, 0x7a, 0x7e,
+	0x45, 0xfc, 0x8d, 0xc4, 0xf5, 0x25, 0xcb, 0x67, 0xae, 0xcf, 0x2a, 0x35, 0xc4, 0x48, 0x65, 0x7f,
+	0xad, 0x46, 0x42, 0xb4, 0x56, 0xb1, 0x7c, 0xea, 0xc9, 0xf9, 0x73, 0x72, 0xde, 0x65, 0xc2, 0x8c,
+	0xcb, 0x6c, 0x39, 0x71, 0x3e, 0x9a, 0x30, 0xc5, 0xaf, 0x4a, 0xf4, 0x43, 0x4e, 0x55, 0xfa, 0xf0,
+	0x44, 0x8e, 0xe3, 0x3f, 0x22, 0xd8, 0xac, 0x51, 0x8c, 0x49, 0x20, 0x01, 0xab, 0xfd, 0x00, 0x4d,
+	0x2b, 0xa4, 0x7e, 0x8b, 0xce, 0xa5, 0x3e, 0x92, 0x35, 0x8a, 0xa5, 0xd4, 0xe5, 0x3e, 0x52, 0x0d,
+	0x14, 0x20, 0xb7, 0xc5, 0x74, 0xc1, 0xf6, 0x6d, 0x3f, 0x5a, 0x01, 0x7f, 0x6a, 0xf9, 0xc4, 0xf6,
+	0x7d, 0xdb, 0x21, 0x15, 0xf1, 0xab, 0xd6, 0xac, 0x57, 0x70, 0x33, 0x40, 0x09, 0x12, 0xcb, 0x9d,
+	0xf3, 0x21, 0x75, 0x09, 0x0b, 0x91, 0xdb, 0x88, 0x04, 0x4a, 0x5f, 0x29, 0x30, 0xbb, 0xcb, 0xec,
+	0x0f, 0x1b, 0x18, 0x85, 0xe4, 0xae, 0x30, 0xa8, 0xbe, 0x05, 0x39, 0xd4, 0x0c, 0xf7, 0xfc, 0x80,
+	0x86, 0x87, 0x9a, 0xb2, 0xa2, 0xac, 0xe6, 0xaa, 0xda, 0x37, 0x5f, 0x5c, 0x5b, 0x90, 0x9e, 0xdb,
+	0xc0, 0x38, 0x20, 0x8c, 0xdd, 0x0b, 0x03, 0xea, 0xd9, 0x46, 0x5b, 0x54, 0xbd, 0x0d, 0x93, 0x11,
+	0x65, 0xed, 0xf4, 0x8a, 0xb2, 0x9a, 0x5f, 0x2f, 0x95, 0x8f, 0xde, 0xe0, 0x72, 0x64, 0xab, 0x9a,
+	0xfb, 0xf2, 0xdb, 0xe5, 0x53, 0x9f, 0x3f, 0x7b, 0x7c, 0x45, 0x31, 0x24, 0xf8, 0xd6, 0x0f, 0x3f,
+	0x7e, 0xf6, 0xf8, 0x4a, 0x5b, 0xed, 0xa7, 0xcf, 0x1e, 0x5f, 0x79, 0x2d, 0xe9, 0x99, 0x83, 0x94,
+	0x9f, 0x3a, 0xc8, 0x97, 0xce, 0xc3, 0xb9, 0x8e, 0x21, 0x83, 0xb0, 0x86, 0xef, 0x31, 0x52, 0xfa,
+	0x73, 0x0e, 0xf4, 0x5d, 0x66, 0x6f, 0x06, 0x04, 0x85, 0x64, 0x9b, 0x1e, 0x10, 0x7c, 0x37, 0xa0,
+	0x16, 0xd9, 0x88, 0xb6, 0x4d, 0x5d, 0x02, 0x90, 0x3b, 0x48, 0x48, 0x10, 0xad, 0xdb, 0x48, 0x8c,
+	0xa8, 0x06, 0xe4, 0x59, 0x88, 0x82, 0xd0, 0x6c, 0x70, 0x94, 0x58, 0x63, 0xae, 0xba, 0xc6, 0xf9,
+	0xff, 0xf3, 0xdb, 0xe5, 0x0b, 0x91, 0x73, 0x18, 0xfe, 0xa8, 0x4c, 0xfd, 0x8a, 0x8b, 0xc2, 0xbd,
+	0xf2, 0x07, 0xc4, 0x46, 0xd6, 0xe1, 0x16, 0xb1, 0xbe, 0xf9, 0xe2, 0x1a, 0x48, 0xdf, 0x6d, 0x11,
+	0xcb, 0x00, 0xa1, 0x45, 0x98, 0x56, 0x3f, 0x51, 0xa0, 0xc0, 0x88, 0xe3, 0x50, 0xcf, 0x36, 0x79,
+	0x28, 0x6b, 0x63, 0xc2, 0x73, 0xe7, 0xcb, 0x52, 0x9e, 0xc7, 0x7a, 0x59, 0xc6, 0x7a, 0x79, 0xd3,
+	0xa7, 0x5e, 0xf5, 0x36, 0x37, 0xf8, 0xa7, 0xef, 0x96, 0x2f, 0xdb, 0x34, 0xdc, 0x6b, 0xd6, 0xca,
+	0x96, 0xef, 0xca, 0x90, 0x96, 0xff, 0xae, 0x31, 0xfc, 0x51, 0x25, 0x3c, 0x6c, 0x10, 0x26, 0x00,
+	0xbf, 0x7f, 0xf6, 0xf8, 0x4a, 0xc1, 0x11, 0x54, 0x84, 0x05, 0x16, 0x39, 0x3b, 0x2f, 0xad, 0x72,
+	0x11, 0xf5, 0x0a, 0xcc, 0x37, 0xd0, 0x61, 0x8b, 0x83, 0x89, 0x89, 0xe7, 0xbb, 0xda, 0xb8, 0x70,
+	0xc0, 0x6c, 0x34, 0xc1, 0xc5, 0xb6, 0xf8, 0xb0, 0xfa, 0x53, 0x98, 0xdf, 0x27, 0x2c, 0xe4, 0xc2,
+	0xcc, 0xda, 0x23, 0xb8, 0xe9, 0x10, 0xa6, 0x4d, 0xac, 0x8c, 0xad, 0xe6, 0xd7, 0x5f, 0xef, 0xb7,
+	0xdf, 0x0f, 0x22, 0xd0, 0x3d, 0x89, 0xa9, 0x8e, 0xf3, 0x75, 0x18, 0x73, 0xfb, 0xe9, 0x61, 0xa6,
+	0x6e, 0x42, 0xe4, 0x1f, 0x93, 0x47, 0xaa, 0x36, 0x29, 0xdc, 0xa1, 0x97, 0xa3, 0x30, 0x2e, 0xb7,
+	0xc2, 0xb8, 0x7c, 0xbf, 0x15, 0xc6, 0xd5, 0x69, 0xae, 0xe7, 0xb3, 0xef, 0x96, 0x15, 0x23, 0x27,
+	0x70, 0x7c, 0x46, 0x7d, 0x0f, 0xa6, 0x89, 0x87, 0x23, 0x15, 0x53, 0x43, 0xa8, 0x98, 0x22, 0x1e,
+	0x16, 0x0a, 0x7e, 0x06, 0x53, 0x35, 0x8a, 0xcd, 0x3a, 0x21, 0xda, 0xf4, 0xca, 0x58, 0xff, 0x1d,
+	0xd9, 0x96, 0x3b, 0xb2, 0x3a, 0xe0, 0x8e, 0xb0, 0x23, 0xb6, 0x64, 0xb2, 0x46, 0xf1, 0x36, 0x21,
+	0xea, 0x36, 0x4c, 0x33, 0xbf, 0x1e, 0x9a, 0x16, 0x6a, 0x68, 0x39, 0x11, 0x64, 0xaf, 0xcb, 0x20,
+	0x5b, 0xec, 0x0e, 0xb2, 0x1d, 0x2f, 0x4c, 0x84, 0xd7, 0x8e, 0x17, 0x1a, 0x53, 0x1c, 0xbc, 0x89,
+	0x1a, 0x2a, 0x85, 0x73, 0xb5, 0xe6, 0x21, 0x09, 0xcc, 0xee, 0xfd, 0x82, 0xe3, 0xee, 0xd7, 0xa2,
+	0xd0, 0xf8, 0xa0, 0x73, 0xd3, 0xee, 0xc2, 0x8c, 0x43, 0x3d, 0x82, 0x62, 0x5b, 0x5a, 0x5e, 0x78,
+	0xfd, 0xb5, 0x7e, 0x16, 0x3e, 0x10, 0x08, 0xa9, 0xcb, 0x28, 0x3a, 0xc9, 0x9f, 0xea, 0xab, 0x50,
+	0x40, 0xcd, 0xd0, 0x37, 0x03, 0xe2, 0x10, 0xc4, 0x88, 0x56, 0x58, 0x51, 0x56, 0xa7, 0x8d, 0x3c,
+	0x1f, 0x33, 0xa2, 0x21, 0x75, 0x05, 0xf2, 0x35, 0xe2, 0x91, 0x3a, 0xb5, 0x28, 0x0a, 0x0e, 0xb5,
+	0xa2, 0x88, 0xd7, 0xe4, 0x90, 0x6a, 0x83, 0x86, 0x30, 0xa6, 0xfc, 0x05, 0x46, 0x8e, 0x29, 0x43,
+	0x5c, 0x44, 0x37, 0xd3, 0x66, 0x84, 0x0b, 0x2e, 0xf7, 0x4f, 0x51, 0x1c, 0x20, 0xc2, 0x5e, 0x2e,
+	0xff, 0x6c, 0x5b, 0x5d, 0x62, 0x92, 0xa9, 0x1b, 0x90, 0x63, 0xc8, 0x21, 0xa6, 0xeb, 0x63, 0xa2,
+	0xcd, 0xae, 0x28, 0xab, 0x33, 0xeb, 0x97, 0xfa, 0x69, 0xbe, 0x87, 0x1c, 0xb2, 0xeb, 0x63, 0x62,
+	0x4c, 0x33, 0xf9, 0x74, 0x6b, 0x96, 0x67, 0xbd, 0x44, 0xba, 0x29, 0x5d, 0x82, 0xd2, 0xd1, 0xc9,
+	0x2a, 0xce, 0x69, 0x7f, 0x28, 0xc2, 0x62, 0x2c, 0x56, 0x45, 0xa1, 0xb5, 0xf7, 0x32, 0xd3, 0xd9,
+	0x87, 0x50, 0x74, 0xa9, 0xc7, 0x2b, 0xa6, 0xd4, 0x3a, 0x76, 0x5c, 0xad, 0x79, 0x97, 0x7a, 0x55,
+	0x8a, 0x8f, 0xc8, 0x92, 0xe3, 0xff, 0x35, 0x59, 0x72, 0x62, 0x88, 0x2c, 0x39, 0xf9, 0xfc, 0xb2,
+	0xe4, 0x55, 0x50, 0x5d, 0x74, 0x60, 0x92, 0x83, 0x90, 0x78, 0x98, 0x60, 0x33, 0xf0, 0x9b, 0x1e,
+	0x16, 0xa9, 0xae, 0x68, 0xcc, 0xb9, 0xe8, 0xe0, 0xb6, 0x9c, 0x30, 0xf8, 0xb8, 0x8a, 0xe0, 0x4c,
+	0x5a, 0xd2, 0x0c, 0x50, 0xc8, 0x33, 0xdb, 0x31, 0x37, 0x67, 0x9e, 0x24, 0xd5, 0x1b, 0x28, 0x24,
+	0x1d, 0x69, 0x3b, 0x37, 0x7a, 0xda, 0x86, 0xe3, 0xa4, 0xed, 0x3b, 0x30, 0xcf, 0x63, 0xcf, 0x42,
+	0x9e, 0x45, 0x1c, 0xd3, 0x6a, 0x86, 0x7e, 0xbd, 0x2e, 0x53, 0xd1, 0xf9, 0x2e, 0x4d, 0x5b, 0xb2,
+	0x55, 0x8a, 0x14, 0xfd, 0x8e, 0x2b, 0x9a, 0xad, 0x51, 0xbc, 0x29, 0xc0, 0x9b, 0x02, 0x9b, 0xac,
+	0x03, 0x85, 0x97, 0x59, 0x07, 0x8a, 0x27, 0x53, 0x07, 0x66, 0x4e, 0xbc, 0x0e, 0xcc, 0x3e, 0xe7,
+	0x3a, 0x30, 0x97, 0x59, 0x07, 0xe6, 0xbb, 0xeb, 0xc0, 0x43, 0xfe, 0xb6, 0x04, 0x36, 0xe5, 0x55,
+	0xa0, 0x4e, 0x1d, 0x27, 0xca, 0xd3, 0xaa, 0xc8, 0xd3, 0x57, 0xfb, 0x51, 0xdb, 0x95, 0xa8, 0x6d,
+	0xea, 0x38, 0x22, 0x5f, 0xcf, 0xb9, 0x1d, 0x23, 0xea, 0xfb, 0x50, 0xe0, 0xa9, 0x8e, 0xfb, 0x55,
+	0x68, 0x3d, 0x23, 0xb4, 0xf6, 0xaf, 0x2b, 0x91, 0xbc, 0x50, 0x98, 0x6f, 0xb4, 0x7f, 0xa8, 0x3f,
+	0x81, 0x79, 0x46, 0x90, 0x13, 0x9d, 0x39, 0x4c, 0xcb, 0xf7, 0xea, 0xd4, 0xd6, 0x16, 0x56, 0x94,
+	0xac, 0x3d, 0xba, 0x27, 0x40, 0x55, 0x8a, 0x37, 0x05, 0xc4, 0x98, 0x65, 0xe9, 0x81, 0xbe, 0x85,
+	0x70, 0xf1, 0x39, 0x16, 0xc2, 0xee, 0x2a, 0xb6, 0x0c, 0xaf, 0xf4, 0x2c, 0x4f, 0x71, 0x01, 0xfb,
+	0x04, 0x12, 0x05, 0x6c, 0xab, 0xf9, 0x92, 0x0b, 0x98, 0x01, 0xf9, 0xba, 0xe3, 0xfb, 0xc1, 0xa8,
+	0xe5, 0x0b, 0x84, 0x96, 0xff, 0x57, 0xaf, 0xde, 0xd5, 0xeb, 0x7d, 0x98, 0xc1, 0xc4, 0x42, 0x87,
+	0x26, 0xf5, 0x42, 0x12, 0xec, 0x23, 0x47, 0x9b, 0x1a, 0x3c, 0x47, 0x17, 0x05, 0x74, 0x47, 0x22,
+	0x3b, 0x0a, 0xcf, 0xf4, 0xe8, 0x85, 0x27, 0x37, 0xe2, 0x79, 0x01, 0x5e, 0x66, 0x9d, 0xc8, 0x9f,
+	0x4c, 0x9d, 0x28, 0x9c, 0x78, 0x9d, 0x28, 0x3e, 0xe7, 0x3a, 0x31, 0x93, 0x59, 0x27, 0x66, 0x87,
+	0x3b, 0x2f, 0xcc, 0xbd, 0xb0, 0x34, 0xb9, 0xd5, 0xec, 0x91, 0x26, 0x6b, 0x30, 0xc7, 0x05, 0x44,
+	0x6f, 0x32, 0x68, 0x82, 0x7c, 0x25, 0x9e, 0x37, 0x29, 0x16, 0xf9, 0x71, 0xdc, 0xc8, 0xc9, 0x91,
+	0x1d, 0xdc, 0x4d, 0x42, 0x07, 0xad, 0xd3, 0x46, 0x6c, 0xdf, 0x17, 0xd7, 0x44, 0x77, 0x51, 0x93,
+	0xc5, 0xf7, 0x25, 0xd7, 0x61, 0x92, 0x11, 0x0f, 0x93, 0x20, 0xf3, 0x8e, 0x48, 0xca, 0x65, 0x11,
+	0xca, 0x73, 0x42, 0x52, 0x56, 0xde, 0xe3, 0x24, 0x0d, 0xc6, 0x5c, 0x1a, 0xc2, 0x17, 0x06, 0x61,
+	0x4d, 0xf7, 0x05, 0x91, 0x89, 0x3c, 0x93, 0xb2, 0x18, 0xb3, 0x09, 0x61, 0x81, 0x7b, 0xcd, 0xf1,
+	0x63, 0xa2, 0xb7, 0x51, 0xe0, 0x1c, 0x9e, 0x30, 0xa3, 0x25, 0xf8, 0x5e, 0x2f, 0xab, 0x31, 0xab,
+	0xbf, 0x2a, 0xa2, 0xac, 0x6e, 0xfb, 0x81, 0x45, 0xd2, 0x51, 0x73, 0xdc, 0xdb, 0xbd, 0x0c, 0x76,
+	0xd5, 0xee, 0x5b, 0xbb, 0x4a, 0xdf, 0x5b, 0xbb, 0x6e, 0x6a, 0xf2, 0x2d, 0xe8, 0x9e, 0x88, 0x57,
+	0xf5, 0xef, 0xd3, 0x90, 0xe7, 0x51, 0xe1, 0x20, 0x8b, 0x54, 0x29, 0xee, 0xe0, 0xa4, 0x74, 0x70,
+	0x52, 0xcf, 0xc2, 0x64, 0x74, 0x79, 0x1b, 0x35, 0x07, 0x86, 0xfc, 0xa5, 0xbe, 0x0b, 0xd3, 0x3c,
+	0x5b, 0xf3, 0x14, 0x2b, 0x4a, 0xfc, 0xcc, 0xfa, 0xc5, 0x7e, 0xef, 0x75, 0x95, 0xe2, 0xfb, 0x87,
+	0x0d, 0x62, 0x4c, 0xd5, 0xa2, 0x07, 0xf5, 0xc7, 0x30, 0x11, 0xf5, 0x07, 0xe3, 0xc7, 0xed, 0x0f,
+	0x22, 0xbc, 0x1a, 0xc2, 0xb8, 0xe8, 0x08, 0x26, 0x5e, 0x50, 0x47, 0x20, 0xac, 0xf1, 0x5c, 0x99,
+	0xcc, 0x6d, 0xe2, 0x92, 0x2d, 0x67, 0xe4, 0x1b, 0xed, 0x0c, 0x25, 0x63, 0x2d, 0x72, 0x57, 0x69,
+	0x11, 0xce, 0x24, 0x9c, 0x1e, 0x6f, 0xc6, 0xe7, 0xa7, 0xa1, 0xb0, 0xcb, 0x78, 0xe7, 0x4a, 0xeb,
+	0x87, 0x23, 0xec, 0xc6, 0xa2, 0x18, 0xe7, 0x90, 0x31, 0x01, 0x99, 0xa8, 0x51, 0xbc, 0x83, 0xff,
+	0xc7, 0x9d, 0x9c, 0xf6, 0xe0, 0x59, 0x58, 0x48, 0x7a, 0x2a, 0x76, 0x21, 0x15, 0x1e, 0x8c, 0x62,
+	0xfd, 0xb9, 0x7b, 0xb0, 0x17, 0x85, 0xd8, 0x54, 0x4c, 0xe1, 0x61, 0x34, 0xee, 0x20, 0xea, 0x56,
+	0x13, 0xb5, 0xfa, 0x98, 0x54, 0xd2, 0x36, 0x5b, 0x49, 0xaa, 0x43, 0x77, 0x6c, 0xfb, 0x53, 0x05,
+	0xce, 0xb6, 0x04, 0xf8, 0x1c, 0xbf, 0xe3, 0xf2, 0x2d, 0x42, 0x30, 0x1b, 0xb1, 0xb6, 0x75, 0xd6,
+	0xfa, 0xb1, 0xae, 0x5a, 0xdf, 0x5d, 0xfd, 0x56, 0x60, 0xa9, 0x37, 0x97, 0x98, 0xee, 0xdf, 0x14,
+	0xb8, 0xb0, 0xcb, 0xec, 0xfb, 0x01, 0xf2, 0x58, 0x9d, 0x04, 0x32, 0x39, 0xdd, 0x79, 0xe4, 0x91,
+	0x80, 0xed, 0xd1, 0xc6, 0xa8, 0x9c, 0x2f, 0x40, 0xce, 0x23, 0x8f, 0x4c, 0x9f, 0xeb, 0x93, 0x8c,
+	0xa7, 0x3d, 0xf2, 0x48, 0xe8, 0x57, 0x2f, 0xc3, 0x2c, 0x9f, 0x4c, 0x2e, 0x2a, 0xba, 0xa0, 0x9f,
+	0xf1, 0xc8, 0xa3, 0x6a, 0xbf, 0x75, 0x7d, 0x1f, 0x2e, 0xf6, 0x21, 0x1d, 0x2f, 0xce, 0x86, 0xf3,
+	0xbb, 0xcc, 0xde, 0xb0, 0x2c, 0xd2, 0x08, 0xbb, 0x56, 0x96, 0xa2, 0xa6, 0x74, 0x50, 0xcb, 0x28,
+	0x0c, 0x33, 0xa2, 0x30, 0xc4, 0xf0, 0xd2, 0x45, 0x78, 0xf5, 0x48, 0x43, 0x31, 0x9b, 0xbf, 0x28,
+	0xd1, 0x9b, 0xe1, 0xbb, 0x2e, 0x0d, 0xab, 0x34, 0x19, 0x6f, 0x4a, 0x2a, 0xf4, 0x33, 0x7c, 0xaa,
+	0xc2, 0xf8, 0x1e, 0x62, 0x7b, 0xc2, 0x9d, 0x05, 0x43, 0x3c, 0xab, 0x5b, 0x00, 0x96, 0xef, 0x38,
+	0x28, 0x24, 0x01, 0x72, 0xb2, 0x0f, 0x63, 0x89, 0x2f, 0x54, 0x09, 0x5c, 0x3a, 0xd0, 0x7f, 0x00,
+	0x0b, 0x49, 0xb6, 0xad, 0x65, 0xa8, 0x17, 0xa1, 0x68, 0x89, 0x41, 0x97, 0x78, 0x61, 0xfb, 0x3d,
+	0x2a, 0xb4, 0x07, 0x77, 0x70, 0xe9, 0xab, 0x28, 0x8f, 0x1a, 0x64, 0x9f, 0x20, 0x67, 0x84, 0xb5,
+	0x76, 0x19, 0x1b, 0xeb, 0x36, 0x96, 0x2a, 0x7d, 0xe3, 0xa3, 0x94, 0xbe, 0x89, 0x11, 0xb3, 0xf2,
+	0x4d, 0x99, 0x95, 0x27, 0x87, 0xf0, 0x7f, 0x54, 0xbe, 0x54, 0x18, 0x67, 0xc8, 0x09, 0xc5, 0x99,
+	0x31, 0x67, 0x88, 0xe7, 0xf4, 0x6e, 0x5c, 0x83, 0x85, 0xa4, 0x3f, 0xe3, 0xdd, 0x68, 0xa7, 0x49,
+	0x25, 0x91, 0x26, 0x4b, 0x7f, 0x54, 0x44, 0x7d, 0xdb, 0xc0, 0x78, 0x23, 0xfa, 0xe2, 0x5b, 0xed,
+	0xe5, 0xee, 0xae, 0x0c, 0xf8, 0x00, 0x66, 0xd2, 0x5f, 0x88, 0xb5, 0xd3, 0xd9, 0x67, 0x98, 0x94,
+	0x05, 0x79, 0x48, 0x28, 0xa2, 0xe4, 0xe0, 0xad, 0x33, 0x7c, 0x29, 0x1d, 0xaa, 0x4b, 0xaf, 0xc0,
+	0x85, 0x1e, 0x14, 0x5b, 0x2b, 0x5b, 0xff, 0xbb, 0x0a, 0x63, 0xbb, 0xcc, 0x56, 0x1b, 0x50, 0x48,
+	0x7d, 0xc9, 0xed, 0x7b, 0x62, 0xeb, 0xf8, 0x4c, 0xaa, 0xdf, 0x18, 0x42, 0x38, 0xf6, 0xe9, 0x6f,
+	0x14, 0x38, 0x77, 0xd4, 0x07, 0xd5, 0xb7, 0x32, 0x14, 0x1e, 0x81, 0xd3, 0xdf, 0x3d, 0x1e, 0x2e,
+	0xe6, 0xf4, 0xb1, 0x02, 0x6a, 0x8f, 0x0f, 0x22, 0x6b, 0x03, 0xa9, 0x4d, 0x42, 0xf4, 0x77, 0x86,
+	0x86, 0xf4, 0x20, 0xb1, 0xd5, 0x1c, 0x9a, 0xc4, 0x56, 0x73, 0x68, 0x12, 0xbd, 0x4e, 0x8d, 0x2a,
+	0x83, 0x62, 0xba, 0xf9, 0xbf, 0x9a, 0xa5, 0x2b, 0x29, 0xad, 0xbf, 0x31, 0x8c, 0x74, 0x6c, 0xb4,
+	0x01, 0x85, 0xd4, 0x39, 0x31, 0x2b, 0x08, 0x93, 0xc2, 0xfa, 0x8d, 0x21, 0x84, 0x93, 0xcb, 0x4c,
+	0x9f, 0x06, 0xb3, 0x96, 0x99, 0x92, 0xd6, 0xdf, 0x18, 0x46, 0x3a, 0x36, 0xfa, 0x0b, 0x98, 0xef,
+	0x71, 0xe8, 0xcb, 0xf2, 0x58, 0x27, 0x42, 0xbf, 0x39, 0x2c, 0x22, 0x15, 0x61, 0x3d, 0xce, 0x77,
+	0x59, 0x11, 0xd6, 0x0d, 0xd1, 0xdf, 0x19, 0x1a, 0x12, 0x93, 0xc0, 0x30, 0x1d, 0x9f, 0xc6, 0x2e,
+	0x67, 0xed, 0x9d, 0x14, 0xd4, 0x2b, 0x03, 0x0a, 0xc6, 0x56, 0x6c, 0xc8, 0xb5, 0x8f, 0x19, 0xab,
+	0x19, 0xe8, 0x58, 0x52, 0xbf, 0x3e, 0xa8, 0x64, 0xd2, 0x50, 0xbb, 0x1b, 0x5f, 0x1d, 0x28, 0xfc,
+	0x07, 0x31, 0xd4, 0xd5, 0x76, 0x47, 0xd1, 0xd3, 0xd9, 0x73, 0x67, 0x47, 0x4f, 0x07, 0x42, 0xbf,
+	0x39, 0x2c, 0x22, 0x26, 0xf0, 0x2b, 0x05, 0xce, 0xf4, 0x6a, 0xbc, 0xd7, 0x07, 0xd1, 0x98, 0xc6,
+	0xe8, 0xb7, 0x86, 0xc7, 0xc4, 0x3c, 0x7e, 0xab, 0x80, 0x76, 0x64, 0x47, 0xfd, 0x76, 0x86, 0xe2,
+	0xa3, 0x80, 0xfa, 0x7b, 0xc7, 0x04, 0xc6, 0xb4, 0x7e, 0xad, 0xc0, 0xd9, 0x23, 0x9a, 0xe1, 0x37,
+	0x33, 0x74, 0xf7, 0x86, 0xe9, 0x3f, 0x3a, 0x16, 0x2c, 0x15, 0x99, 0x71, 0x37, 0x9c, 0x19, 0x99,
+	0x2d, 0x49, 0xfd, 0xfa, 0xa0, 0x92, 0x49, 0x43, 0xed, 0x56, 0x74, 0x35, 0x33, 0x35, 0x4a, 0x49,
+	0xfd, 0xfa, 0xa0, 0x92, 0xb1, 0xa1, 0x9f, 0xc3, 0x5c, 0x57, 0xcf, 0x95, 0x95, 0x19, 0x3a, 0x01,
+	0xfa, 0xdb, 0x43, 0x02, 0x5a, 0xd6, 0xf5, 0x89, 0x5f, 0xf2, 0x96, 0xb2, 0x7a, 0xe7, 0xcb, 0x27,
+	0x4b, 0xca, 0xd7, 0x4f, 0x96, 0x94, 0x7f, 0x3d, 0x59, 0x52, 0x3e, 0x7b, 0xba, 0x74, 0xea, 0xeb,
+	0xa7, 0x4b, 0xa7, 0xfe, 0xf1, 0x74, 0xe9, 0xd4, 0xc3, 0x37, 0x13, 0xb7, 0x00, 0xe2, 0x6b, 0x77,
+	0xe0, 0x52, 0x2f, 0xec, 0x73, 0xa7, 0x25, 0x2e, 0x06, 0x6a, 0x93, 0xe2, 0x83, 0xc1, 0x8d, 0xff,
+	0x0c, 0x00, 0xd7, 0xba, 0x63, 0x89, 0x02, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateFixedPriceAuction submits a create fixed price auction message.
+	CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error)
+	// CreateBatchAuction submits a create batch auction message.
+	CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error)
+	// CreateDutchAuction submits a create dutch auction message.
+	CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error)
+	// CancelAuction defines a method to cancel the auction message.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a method to pause the started auction.
+	PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a method to resume the paused auction.
+	ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error)
+	// CloseAuctionEarly defines a method to close the auction before its end time.
+	CloseAuctionEarly(ctx context.Context, in *MsgCloseAuctionEarly, opts ...grpc.CallOption) (*MsgCloseAuctionEarlyResponse, error)
+	// ForceCancelAuction defines a governance operation to cancel the auction
+	// that is not finished yet and refund the reserved coins.
+	ForceCancelAuction(ctx context.Context, in *MsgForceCancelAuction, opts ...grpc.CallOption) (*MsgForceCancelAuctionResponse, error)
+	// PlaceBid defines a method to place a bid message.
+	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// ModifyBid defines a method to modify the bid message.
+	ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error)
+	// CancelBid defines a method to cancel the bid message.
+	CancelBid(ctx context.Context, in *MsgCancelBid, opts ...grpc.CallOption) (*MsgCancelBidResponse, error)
+	// ClaimBuyerVesting defines a method to claim the unlocked selling coin
+	// allocated to a bidder.
+	ClaimBuyerVesting(ctx context.Context, in *MsgClaimBuyerVesting, opts ...grpc.CallOption) (*MsgClaimBuyerVestingResponse, error)
+	// ClaimVestedProceeds defines a method to claim the paying coin of the
+	// matured vesting queues of an auction.
+	ClaimVestedProceeds(ctx context.Context, in *MsgClaimVestedProceeds, opts ...grpc.CallOption) (*MsgClaimVestedProceedsResponse, error)
+	// TransferAuctionOwnership defines a method to propose a new owner and
+	// beneficiary of an auction.
+	TransferAuctionOwnership(ctx context.Context, in *MsgTransferAuctionOwnership, opts ...grpc.CallOption) (*MsgTransferAuctionOwnershipResponse, error)
+	// AcceptAuctionOwnership defines a method to accept the proposed ownership
+	// of an auction.
+	AcceptAuctionOwnership(ctx context.Context, in *MsgAcceptAuctionOwnership, opts ...grpc.CallOption) (*MsgAcceptAuctionOwnershipResponse, error)
+	// CommitBid defines a method to commit a sealed bid for a sealed-bid batch
+	// auction.
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method to reveal a committed bid during the reveal
+	// phase of a sealed-bid batch auction.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	// AddAllowedBidder defines a method sto add a single allowed bidder message.
+	// This is for the testing purpose and it must not be used in mainnet.
+	AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error) {
+	out := new(MsgCreateFixedPriceAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CreateFixedPriceAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error) {
+	out := new(MsgCreateBatchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CreateBatchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error) {
+	out := new(MsgCreateDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CreateDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error) {
+	out := new(MsgPauseAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/PauseAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error) {
+	out := new(MsgResumeAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/ResumeAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseAuctionEarly(ctx context.Context, in *MsgCloseAuctionEarly, opts ...grpc.CallOption) (*MsgCloseAuctionEarlyResponse, error) {
+	out := new(MsgCloseAuctionEarlyResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CloseAuctionEarly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceCancelAuction(ctx context.Context, in *MsgForceCancelAuction, opts ...grpc.CallOption) (*MsgForceCancelAuctionResponse, error) {
+	out := new(MsgForceCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/ForceCancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
+	out := new(MsgPlaceBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error) {
+	out := new(MsgModifyBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/ModifyBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBid(ctx context.Context, in *MsgCancelBid, opts ...grpc.CallOption) (*MsgCancelBidResponse, error) {
+	out := new(MsgCancelBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CancelBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimBuyerVesting(ctx context.Context, in *MsgClaimBuyerVesting, opts ...grpc.CallOption) (*MsgClaimBuyerVestingResponse, error) {
+	out := new(MsgClaimBuyerVestingResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/ClaimBuyerVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVestedProceeds(ctx context.Context, in *MsgClaimVestedProceeds, opts ...grpc.CallOption) (*MsgClaimVestedProceedsResponse, error) {
+	out := new(MsgClaimVestedProceedsResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/ClaimVestedProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferAuctionOwnership(ctx context.Context, in *MsgTransferAuctionOwnership, opts ...grpc.CallOption) (*MsgTransferAuctionOwnershipResponse, error) {
+	out := new(MsgTransferAuctionOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/TransferAuctionOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAuctionOwnership(ctx context.Context, in *MsgAcceptAuctionOwnership, opts ...grpc.CallOption) (*MsgAcceptAuctionOwnershipResponse, error) {
+	out := new(MsgAcceptAuctionOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/AcceptAuctionOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/CommitBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/RevealBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error) {
+	out := new(MsgAddAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/fundraising.fundraising.v1.Msg/AddAllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateFixedPriceAuction submits a create fixed price auction message.
+	CreateFixedPriceAuction(context.Context, *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error)
+	// CreateBatchAuction submits a create batch auction message.
+	CreateBatchAuction(context.Context, *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error)
+	// CreateDutchAuction submits a create dutch auction message.
+	CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error)
+	// CancelAuction defines a method to cancel the auction message.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a method to pause the started auction.
+	PauseAuction(context.Context, *MsgPauseAuction) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a method to resume the paused auction.
+	ResumeAuction(context.Context, *MsgResumeAuction) (*MsgResumeAuctionResponse, error)
+	// CloseAuctionEarly defines a method to close the auction before its end time.
+	CloseAuctionEarly(context.Context, *MsgCloseAuctionEarly) (*MsgCloseAuctionEarlyResponse, error)
+	// ForceCancelAuction defines a governance operation to cancel the auction
+	// that is not finished yet and refund the reserved coins.
+	ForceCancelAuction(context.Context, *MsgForceCancelAuction) (*MsgForceCancelAuctionResponse, error)
+	// PlaceBid defines a method to place a bid message.
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// ModifyBid defines a method to modify the bid message.
+	ModifyBid(context.Context, *MsgModifyBid) (*MsgModifyBidResponse, error)
+	// CancelBid defines a method to cancel the bid message.
+	CancelBid(context.Context, *MsgCancelBid) (*MsgCancelBidResponse, error)
+	// ClaimBuyerVesting defines a method to claim the unlocked selling coin
+	// allocated to a bidder.
+	ClaimBuyerVesting(context.Context, *MsgClaimBuyerVesting) (*MsgClaimBuyerVestingResponse, error)
+	// ClaimVestedProceeds defines a method to claim the paying coin of the
+	// matured vesting queues of an auction.
+	ClaimVestedProceeds(context.Context, *MsgClaimVestedProceeds) (*MsgClaimVestedProceedsResponse, error)
+	// TransferAuctionOwnership defines a method to propose a new owner and
+	// beneficiary of an auction.
+	TransferAuctionOwnership(context.Context, *MsgTransferAuctionOwnership) (*MsgTransferAuctionOwnershipResponse, error)
+	// AcceptAuctionOwnership defines a method to accept the proposed ownership
+	// of an auction.
+	AcceptAuctionOwnership(context.Context, *MsgAcceptAuctionOwnership) (*MsgAcceptAuctionOwnershipResponse, error)
+	// CommitBid defines a method to commit a sealed bid for a sealed-bid batch
+	// auction.
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method to reveal a committed bid during the reveal
+	// phase of a sealed-bid batch auction.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	// AddAllowedBidder defines a method sto add a single allowed bidder message.
+	// This is for the testing purpose and it must not be used in mainnet.
+	AddAllowedBidder(context.Context, *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateFixedPriceAuction(ctx context.Context, req *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedPriceAuction not implemented")
+}
+func (*UnimplementedMsgServer) CreateBatchAuction(ctx context.Context, req *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatchAuction not implemented")
+}
+func (*UnimplementedMsgServer) CreateDutchAuction(ctx context.Context, req *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDutchAuction not implemented")
+}
+func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (*UnimplementedMsgServer) PauseAuction(ctx context.Context, req *MsgPauseAuction) (*MsgPauseAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAuction not implemented")
+}
+func (*UnimplementedMsgServer) ResumeAuction(ctx context.Context, req *MsgResumeAuction) (*MsgResumeAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAuction not implemented")
+}
+func (*UnimplementedMsgServer) CloseAuctionEarly(ctx context.Context, req *MsgCloseAuctionEarly) (*MsgCloseAuctionEarlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAuctionEarly not implemented")
+}
+func (*UnimplementedMsgServer) ForceCancelAuction(ctx context.Context, req *MsgForceCancelAuction) (*MsgForceCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCancelAuction not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (*UnimplementedMsgServer) ModifyBid(ctx context.Context, req *MsgModifyBid) (*MsgModifyBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBid not implemented")
+}
+func (*UnimplementedMsgServer) CancelBid(ctx context.Context, req *MsgCancelBid) (*MsgCancelBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBid not implemented")
+}
+func (*UnimplementedMsgServer) ClaimBuyerVesting(ctx context.Context, req *MsgClaimBuyerVesting) (*MsgClaimBuyerVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBuyerVesting not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVestedProceeds(ctx context.Context, req *MsgClaimVestedProceeds) (*MsgClaimVestedProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVestedProceeds not implemented")
+}
+func (*UnimplementedMsgServer) TransferAuctionOwnership(ctx context.Context, req *MsgTransferAuctionOwnership) (*MsgTransferAuctionOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAuctionOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAuctionOwnership(ctx context.Context, req *MsgAcceptAuctionOwnership) (*MsgAcceptAuctionOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAuctionOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CommitBid(ctx context.Context, req *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (*UnimplementedMsgServer) RevealBid(ctx context.Context, req *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowedBidder(ctx context.Context, req *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBidder not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFixedPriceAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFixedPriceAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFixedPriceAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CreateFixedPriceAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFixedPriceAuction(ctx, req.(*MsgCreateFixedPriceAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBatchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBatchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBatchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CreateBatchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBatchAuction(ctx, req.(*MsgCreateBatchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CreateDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDutchAuction(ctx, req.(*MsgCreateDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/PauseAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseAuction(ctx, req.(*MsgPauseAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/ResumeAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeAuction(ctx, req.(*MsgResumeAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseAuctionEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseAuctionEarly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseAuctionEarly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CloseAuctionEarly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseAuctionEarly(ctx, req.(*MsgCloseAuctionEarly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceCancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceCancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/ForceCancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceCancelAuction(ctx, req.(*MsgForceCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/PlaceBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/ModifyBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyBid(ctx, req.(*MsgModifyBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CancelBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBid(ctx, req.(*MsgCancelBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimBuyerVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimBuyerVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimBuyerVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/ClaimBuyerVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimBuyerVesting(ctx, req.(*MsgClaimBuyerVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVestedProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVestedProceeds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVestedProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/ClaimVestedProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVestedProceeds(ctx, req.(*MsgClaimVestedProceeds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAuctionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAuctionOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAuctionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/TransferAuctionOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAuctionOwnership(ctx, req.(*MsgTransferAuctionOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAuctionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAuctionOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAuctionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/AcceptAuctionOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAuctionOwnership(ctx, req.(*MsgAcceptAuctionOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/CommitBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedBidder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fundraising.fundraising.v1.Msg/AddAllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedBidder(ctx, req.(*MsgAddAllowedBidder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fundraising.fundraising.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateFixedPriceAuction",
+			Handler:    _Msg_CreateFixedPriceAuction_Handler,
+		},
+		{
+			MethodName: "CreateBatchAuction",
+			Handler:    _Msg_CreateBatchAuction_Handler,
+		},
+		{
+			MethodName: "CreateDutchAuction",
+			Handler:    _Msg_CreateDutchAuction_Handler,
+		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
+		{
+			MethodName: "PauseAuction",
+			Handler:    _Msg_PauseAuction_Handler,
+		},
+		{
+			MethodName: "ResumeAuction",
+			Handler:    _Msg_ResumeAuction_Handler,
+		},
+		{
+			MethodName: "CloseAuctionEarly",
+			Handler:    _Msg_CloseAuctionEarly_Handler,
+		},
+		{
+			MethodName: "ForceCancelAuction",
+			Handler:    _Msg_ForceCancelAuction_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _Msg_PlaceBid_Handler,
+		},
+		{
+			MethodName: "ModifyBid",
+			Handler:    _Msg_ModifyBid_Handler,
+		},
+		{
+			MethodName: "CancelBid",
+			Handler:    _Msg_CancelBid_Handler,
+		},
+		{
+			MethodName: "ClaimBuyerVesting",
+			Handler:    _Msg_ClaimBuyerVesting_Handler,
+		},
+		{
+			MethodName: "ClaimVestedProceeds",
+			Handler:    _Msg_ClaimVestedProceeds_Handler,
+		},
+		{
+			MethodName: "TransferAuctionOwnership",
+			Handler:    _Msg_TransferAuctionOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptAuctionOwnership",
+			Handler:    _Msg_AcceptAuctionOwnership_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "AddAllowedBidder",
+			Handler:    _Msg_AddAllowedBidder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fundraising/fundraising/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFixedPriceAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SaleMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SaleMode))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for iNdEx := len(m.AdditionalPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalPayingDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AutoRelease {
+		i--
+		if m.AutoRelease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LinearVesting != nil {
+		{
+			size, err := m.LinearVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BuyerVestingSchedules) > 0 {
+		for iNdEx := len(m.BuyerVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.SoftCap.Size()
+		i -= size
+		if _, err := m.SoftCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.BidFee) > 0 {
+		for iNdEx := len(m.BidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for iNdEx := len(m.AdditionalPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalPayingDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.SealedBidConfig != nil {
+		{
+			size, err := m.SealedBidConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.PricingMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PricingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MarginalFillMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarginalFillMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.AutoRelease {
+		i--
+		if m.AutoRelease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.LinearVesting != nil {
+		{
+			size, err := m.LinearVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.BuyerVestingSchedules) > 0 {
+		for iNdEx := len(m.BuyerVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.SoftCap.Size()
+		i -= size
+		if _, err := m.SoftCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.BidFee) > 0 {
+		for iNdEx := len(m.BidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BidCancelCutoff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BidCancelCutoff):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x5a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x52
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ExtendedRoundRate.Size()
+		i -= size
+		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxExtendedRound != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxExtendedRound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBidPrice.Size()
+		i -= size
+		if _, err := m.MinBidPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBatchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBatchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBatchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for iNdEx := len(m.AdditionalPayingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalPayingDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.AutoRelease {
+		i--
+		if m.AutoRelease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LinearVesting != nil {
+		{
+			size, err := m.LinearVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BuyerVestingSchedules) > 0 {
+		for iNdEx := len(m.BuyerVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.SoftCap.Size()
+		i -= size
+		if _, err := m.SoftCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.BidFee) > 0 {
+		for iNdEx := len(m.BidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTx(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x4a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x42
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DecayInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DecayInterval):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTx(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FloorPrice.Size()
+		i -= size
+		if _, err := m.FloorPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseAuctionEarly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseAuctionEarly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseAuctionEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseAuctionEarlyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseAuctionEarlyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseAuctionEarlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceCancelAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceCancelAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayingDenom) > 0 {
+		i -= len(m.PayingDenom)
+		copy(dAtA[i:], m.PayingDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BidType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBuyerVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBuyerVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBuyerVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBuyerVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBuyerVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBuyerVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedProceeds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedProceeds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedProceeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAuctionOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAuctionOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAuctionOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewBeneficiary) > 0 {
+		i -= len(m.NewBeneficiary)
+		copy(dAtA[i:], m.NewBeneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBeneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAuctionOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAuctionOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAuctionOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAuctionOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAuctionOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAuctionOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAuctionOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAuctionOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAuctionOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitmentId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BidType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitmentId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedBidder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedBidder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFixedPriceAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BidFee) > 0 {
+		for _, e := range m.BidFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SoftCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BuyerVestingSchedules) > 0 {
+		for _, e := range m.BuyerVestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LinearVesting != nil {
+		l = m.LinearVesting.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoRelease {
+		n += 2
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for _, e := range m.AdditionalPayingDenoms {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SaleMode != 0 {
+		n += 1 + sovTx(uint64(m.SaleMode))
+	}
+	return n
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinBidPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxExtendedRound != 0 {
+		n += 1 + sovTx(uint64(m.MaxExtendedRound))
+	}
+	l = m.ExtendedRoundRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BidCancelCutoff)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BidFee) > 0 {
+		for _, e := range m.BidFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SoftCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BuyerVestingSchedules) > 0 {
+		for _, e := range m.BuyerVestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LinearVesting != nil {
+		l = m.LinearVesting.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoRelease {
+		n += 3
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.MarginalFillMode != 0 {
+		n += 2 + sovTx(uint64(m.MarginalFillMode))
+	}
+	if m.PricingMode != 0 {
+		n += 2 + sovTx(uint64(m.PricingMode))
+	}
+	if m.SealedBidConfig != nil {
+		l = m.SealedBidConfig.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for _, e := range m.AdditionalPayingDenoms {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateBatchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DecayInterval)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BidFee) > 0 {
+		for _, e := range m.BidFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SoftCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BuyerVestingSchedules) > 0 {
+		for _, e := range m.BuyerVestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LinearVesting != nil {
+		l = m.LinearVesting.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoRelease {
+		n += 2
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AdditionalPayingDenoms) > 0 {
+		for _, e := range m.AdditionalPayingDenoms {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgCancelAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgPauseAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgResumeAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseAuctionEarly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgCloseAuctionEarlyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceCancelAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgForceCancelAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidType != 0 {
+		n += 1 + sovTx(uint64(m.BidType))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovTx(uint64(m.BidId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovTx(uint64(m.BidId))
+	}
+	return n
+}
+
+func (m *MsgCancelBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimBuyerVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimBuyerVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVestedProceeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVestedProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferAuctionOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAuctionOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAuctionOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgAcceptAuctionOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCommitBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitmentId != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentId))
+	}
+	return n
+}
+
+func (m *MsgRevealBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	if m.CommitmentId != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentId))
+	}
+	if m.BidType != 0 {
+		n += 1 + sovTx(uint64(m.BidType))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		n += 1 + sovTx(uint64(m.BidId))
+	}
+	return n
+}
+
+func (m *MsgAddAllowedBidder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = m.AllowedBidder.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidFee = append(m.BidFee, types.Coin{})
+			if err := m.BidFee[len(m.BidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestingSchedules = append(m.BuyerVestingSchedules, VestingSchedule{})
+			if err := m.BuyerVestingSchedules[len(m.BuyerVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVesting == nil {
+				m.LinearVesting = &LinearVesting{}
+			}
+			if err := m.LinearVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRelease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRelease = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPayingDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalPayingDenoms = append(m.AdditionalPayingDenoms, PayingDenom{})
+			if err := m.AdditionalPayingDenoms[len(m.AdditionalPayingDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleMode", wireType)
+			}
+			m.SaleMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaleMode |= SaleMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBatchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			}
+			m.MaxExtendedRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCancelCutoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BidCancelCutoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidFee = append(m.BidFee, types.Coin{})
+			if err := m.BidFee[len(m.BidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestingSchedules = append(m.BuyerVestingSchedules, VestingSchedule{})
+			if err := m.BuyerVestingSchedules[len(m.BuyerVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVesting == nil {
+				m.LinearVesting = &LinearVesting{}
+			}
+			if err := m.LinearVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRelease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRelease = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginalFillMode", wireType)
+			}
+			m.MarginalFillMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginalFillMode |= MarginalFillMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingMode", wireType)
+			}
+			m.PricingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingMode |= PricingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SealedBidConfig == nil {
+				m.SealedBidConfig = &SealedBidConfig{}
+			}
+			if err := m.SealedBidConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPayingDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalPayingDenoms = append(m.AdditionalPayingDenoms, PayingDenom{})
+			if err := m.AdditionalPayingDenoms[len(m.AdditionalPayingDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DecayInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidFee = append(m.BidFee, types.Coin{})
+			if err := m.BidFee[len(m.BidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestingSchedules = append(m.BuyerVestingSchedules, VestingSchedule{})
+			if err := m.BuyerVestingSchedules[len(m.BuyerVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVesting == nil {
+				m.LinearVesting = &LinearVesting{}
+			}
+			if err := m.LinearVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRelease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRelease = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPayingDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalPayingDenoms = append(m.AdditionalPayingDenoms, PayingDenom{})
+			if err := m.AdditionalPayingDenoms[len(m.AdditionalPayingDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseAuctionEarly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseAuctionEarly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseAuctionEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseAuctionEarlyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseAuctionEarlyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseAuctionEarlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceCancelAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceCancelAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceCancelAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidType", wireType)
+			}
+			m.BidType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidType |= BidType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgCreateFixedPriceAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgCreateBatchAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.StartPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinBidPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SellingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.PayingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.VestingSchedules) > 0 {
-		for _, e := range m.VestingSchedules {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.MaxExtendedRound != 0 {
-		n += 1 + sovTx(uint64(m.MaxExtendedRound))
-	}
-	l = m.ExtendedRoundRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgCreateBatchAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCancelAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	return n
-}
-
-func (m *MsgCancelAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgPlaceBid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BidType != 0 {
-		n += 1 + sovTx(uint64(m.BidType))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgPlaceBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgModifyBid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BidId != 0 {
-		n += 1 + sovTx(uint64(m.BidId))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgModifyBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgAddAllowedBidder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = m.AllowedBidder.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgAddAllowedBidderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,15 +8985,34 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2139,11 +9040,64 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			}
+			m.BidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2170,7 +9124,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2195,7 +9149,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,10 +9172,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2245,7 +9199,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2268,17 +9222,17 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var stringLen uint64
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2288,27 +9242,14 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Auctioneer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2336,15 +9277,13 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
 			}
-			var msglen int
+			m.BidId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2354,96 +9293,116 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BidId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimBuyerVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
-			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimBuyerVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimBuyerVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var msglen int
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2453,30 +9412,16 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2486,24 +9431,23 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2526,7 +9470,7 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimBuyerVestingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2549,10 +9493,10 @@ func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimBuyerVestingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimBuyerVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2576,7 +9520,7 @@ func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVestedProceeds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2599,10 +9543,10 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBatchAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVestedProceeds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVestedProceeds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2638,10 +9582,10 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			m.Auctioneer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var stringLen uint64
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2651,29 +9595,14 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2701,131 +9630,111 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVestedProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
-			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAuctionOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxExtendedRound = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxExtendedRound |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAuctionOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAuctionOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2853,15 +9762,32 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2871,30 +9797,29 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBeneficiary", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2904,24 +9829,23 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewBeneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2944,7 +9868,7 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferAuctionOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2967,10 +9891,10 @@ func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferAuctionOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferAuctionOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2994,7 +9918,7 @@ func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAuctionOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3017,15 +9941,15 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAuctionOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAuctionOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3053,7 +9977,7 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3095,7 +10019,7 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAuctionOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,10 +10042,10 @@ func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAuctionOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAuctionOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3145,7 +10069,7 @@ func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3168,32 +10092,13 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
@@ -3225,11 +10130,11 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			}
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			m.BidType = 0
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3239,16 +10144,16 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BidType |= BidType(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3258,29 +10163,29 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3307,7 +10212,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3332,7 +10237,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3355,12 +10260,31 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentId", wireType)
+			}
+			m.CommitmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3382,7 +10306,7 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3405,32 +10329,13 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
@@ -3462,11 +10367,30 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 			}
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentId", wireType)
 			}
-			m.BidId = 0
+			m.CommitmentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3476,12 +10400,31 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BidId |= uint64(b&0x7F) << shift
+				m.CommitmentId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidType", wireType)
+			}
+			m.BidType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidType |= BidType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
@@ -3515,7 +10458,7 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
@@ -3548,6 +10491,38 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3569,7 +10544,7 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3592,12 +10567,31 @@ func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			}
+			m.BidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
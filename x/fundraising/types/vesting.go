@@ -8,6 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// LinearVestingReleaseInterval is the interval at which the paying coin vested by the linear vesting
+// is released automatically once the first release time has passed.
+const LinearVestingReleaseInterval = time.Hour
+
 // ValidateVestingSchedules validates the vesting schedules.
 // Each weight of the vesting schedule must be positive and total weight must be equal to 1.
 // If a number of schedule equals to zero, the auctioneer doesn't want any vesting schedule.
@@ -29,6 +33,10 @@ func ValidateVestingSchedules(schedules []VestingSchedule, endTime time.Time) er
 			return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "release time must be set after the end time")
 		}
 
+		if s.ReleaseTime.Equal(prevReleaseTime) {
+			return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "duplicate release time %s", s.ReleaseTime.Format(time.RFC3339))
+		}
+
 		if !s.ReleaseTime.After(prevReleaseTime) {
 			return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "release time must be chronological")
 		}
@@ -48,10 +56,72 @@ func ValidateVestingSchedules(schedules []VestingSchedule, endTime time.Time) er
 	return nil
 }
 
+// ValidateLinearVesting validates the linear vesting.
+// The vesting must start at or after the end time of the auction and end after it starts.
+// The cliff time is optional and must be within the vesting period. A nil linear vesting is valid.
+func ValidateLinearVesting(lv *LinearVesting, endTime time.Time) error {
+	if lv == nil {
+		return nil
+	}
+
+	if lv.StartTime.Before(endTime) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting start time must not be before the end time")
+	}
+
+	if !lv.EndTime.After(lv.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting end time must be set after the start time")
+	}
+
+	if lv.CliffTime != nil && (lv.CliffTime.Before(lv.StartTime) || lv.CliffTime.After(lv.EndTime)) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting cliff time must be within the vesting period")
+	}
+
+	return nil
+}
+
+// FirstReleaseTime returns the time from which the vested paying coin can be released,
+// which is the cliff time if it is set or the start time otherwise.
+func (lv LinearVesting) FirstReleaseTime() time.Time {
+	if lv.CliffTime != nil {
+		return *lv.CliffTime
+	}
+	return lv.StartTime
+}
+
+// NextReleaseTime returns the time of the next release of the linear vesting after the given time t.
+// The vested paying coin is released at the first release time and at every LinearVestingReleaseInterval
+// from then on until the end time.
+func (lv LinearVesting) NextReleaseTime(t time.Time) time.Time {
+	firstReleaseTime := lv.FirstReleaseTime()
+	if t.Before(firstReleaseTime) {
+		return firstReleaseTime
+	}
+	intervals := t.Sub(firstReleaseTime)/LinearVestingReleaseInterval + 1
+	if next := firstReleaseTime.Add(intervals * LinearVestingReleaseInterval); next.Before(lv.EndTime) {
+		return next
+	}
+	return lv.EndTime
+}
+
+// VestedAmount returns the amount of the total amount that is vested at the given time t.
+// Nothing is vested before the first release time and the vested amount is truncated.
+func (lv LinearVesting) VestedAmount(total math.Int, t time.Time) math.Int {
+	switch {
+	case t.Before(lv.FirstReleaseTime()):
+		return math.ZeroInt()
+	case !t.Before(lv.EndTime):
+		return total
+	}
+	elapsed := math.NewInt(int64(t.Sub(lv.StartTime)))
+	duration := math.NewInt(int64(lv.EndTime.Sub(lv.StartTime)))
+	return total.Mul(elapsed).Quo(duration)
+}
+
 // NewVestingQueue returns a new VestingQueue.
-func NewVestingQueue(auctionId uint64, auctioneer sdk.AccAddress, payingCoin sdk.Coin, releaseTime time.Time, released bool) VestingQueue {
+func NewVestingQueue(auctionId, sequence uint64, auctioneer sdk.AccAddress, payingCoin sdk.Coin, releaseTime time.Time, released bool) VestingQueue {
 	return VestingQueue{
 		AuctionId:   auctionId,
+		Sequence:    sequence,
 		Auctioneer:  auctioneer.String(),
 		PayingCoin:  payingCoin,
 		ReleaseTime: releaseTime,
@@ -65,7 +135,34 @@ func (vq VestingQueue) ShouldRelease(t time.Time) bool {
 	return !vq.GetReleaseTime().After(t) && !vq.Released
 }
 
+// RemainingPayingCoin returns the paying coin of the vesting queue that is not released yet.
+func (vq VestingQueue) RemainingPayingCoin() sdk.Coin {
+	if vq.Released {
+		return sdk.NewCoin(vq.PayingCoin.Denom, math.ZeroInt())
+	}
+	if vq.ReleasedCoin == nil {
+		return vq.PayingCoin
+	}
+	return vq.PayingCoin.SubAmount(vq.ReleasedCoin.Amount)
+}
+
 // SetReleased sets released status of the vesting queue.
 func (vq *VestingQueue) SetReleased(status bool) {
 	vq.Released = status
 }
+
+// UnlockedCoin returns the allocated selling coin unlocked by the buyer vesting schedules at the given time t.
+// The amount of each schedule is truncated and the last schedule unlocks all the rest of the allocated coin.
+func (v BuyerVesting) UnlockedCoin(schedules []VestingSchedule, t time.Time) sdk.Coin {
+	unlockedAmt := math.ZeroInt()
+	for i, schedule := range schedules {
+		if schedule.ReleaseTime.After(t) {
+			break
+		}
+		if i == len(schedules)-1 {
+			return v.AllocatedCoin
+		}
+		unlockedAmt = unlockedAmt.Add(math.LegacyNewDecFromInt(v.AllocatedCoin.Amount).MulTruncate(schedule.Weight).TruncateInt())
+	}
+	return sdk.NewCoin(v.AllocatedCoin.Denom, unlockedAmt)
+}
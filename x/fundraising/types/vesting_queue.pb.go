@@ -42,6 +42,12 @@ type VestingQueue struct {
 	ReleaseTime time.Time `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
 	// released specifies the status of distribution
 	Released bool `protobuf:"varint,5,opt,name=released,proto3" json:"released,omitempty"`
+	// released_coin specifies the paying coin released so far by the linear
+	// vesting of the auction; it is nil for the vesting schedules
+	ReleasedCoin *types.Coin `protobuf:"bytes,6,opt,name=released_coin,json=releasedCoin,proto3" json:"released_coin,omitempty"`
+	// sequence specifies the index of the vesting queue in the auction, in the
+	// order of the release time
+	Sequence uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *VestingQueue) Reset()         { *m = VestingQueue{} }
@@ -112,6 +118,20 @@ func (m *VestingQueue) GetReleased() bool {
 	return false
 }
 
+func (m *VestingQueue) GetReleasedCoin() *types.Coin {
+	if m != nil {
+		return m.ReleasedCoin
+	}
+	return nil
+}
+
+func (m *VestingQueue) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*VestingQueue)(nil), "fundraising.fundraising.v1.VestingQueue")
 }
@@ -121,33 +141,35 @@ func init() {
 }
 
 var fileDescriptor_d67ffad82b4f4ad3 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
-	0x1c, 0x3d, 0x97, 0x82, 0xae, 0xbe, 0x5b, 0x88, 0x18, 0xd2, 0x48, 0xf8, 0x4e, 0x2c, 0x9c, 0x2a,
-	0x61, 0xeb, 0x40, 0x7c, 0x81, 0x43, 0x08, 0x31, 0x21, 0x22, 0xc4, 0xc0, 0x72, 0x72, 0x92, 0x5f,
-	0x8d, 0xc5, 0xc5, 0x0e, 0xb1, 0x13, 0x71, 0x23, 0xdf, 0xa0, 0x33, 0x9f, 0x00, 0x31, 0x75, 0xe2,
-	0x33, 0x74, 0xec, 0xc8, 0x44, 0xd1, 0xdd, 0xd0, 0xaf, 0x81, 0xfc, 0xa7, 0x55, 0xba, 0x24, 0x7e,
-	0xbf, 0xf7, 0x7e, 0xf2, 0x7b, 0x4f, 0xc6, 0xf4, 0xb4, 0x53, 0x55, 0xcb, 0xa5, 0x91, 0x4a, 0xb0,
-	0xe1, 0xb9, 0x5f, 0xb2, 0x1e, 0x8c, 0x95, 0x4a, 0xac, 0xbf, 0x76, 0xd0, 0x01, 0x6d, 0x5a, 0x6d,
-	0x75, 0x92, 0x0d, 0x34, 0xc3, 0x5d, 0xda, 0x2f, 0xb3, 0x87, 0xbc, 0x96, 0x4a, 0x33, 0xff, 0x0d,
-	0xf2, 0x8c, 0x94, 0xda, 0xd4, 0xda, 0xb0, 0x82, 0x1b, 0x60, 0xfd, 0xb2, 0x00, 0xcb, 0x97, 0xac,
-	0xd4, 0x52, 0x45, 0xfe, 0x38, 0xf0, 0x6b, 0x8f, 0x58, 0x00, 0x91, 0x7a, 0x24, 0xb4, 0xd0, 0x61,
-	0xee, 0x4e, 0x71, 0x3a, 0x13, 0x5a, 0x8b, 0x0d, 0x30, 0x8f, 0x8a, 0xee, 0x94, 0x59, 0x59, 0x83,
-	0xb1, 0xbc, 0x6e, 0x82, 0xe0, 0xc9, 0xef, 0x03, 0x3c, 0xfd, 0x18, 0x8c, 0xbf, 0x77, 0xbe, 0x93,
-	0xc7, 0x18, 0xf3, 0xae, 0xb4, 0x52, 0xab, 0xb5, 0xac, 0x52, 0x34, 0x47, 0x8b, 0xc3, 0xfc, 0x28,
-	0x4e, 0xde, 0x56, 0x09, 0xb9, 0xa5, 0x01, 0xda, 0xf4, 0x60, 0x8e, 0x16, 0x47, 0xf9, 0x60, 0x92,
-	0x7c, 0x47, 0x78, 0xd2, 0xf0, 0xad, 0xeb, 0xc1, 0xf9, 0x4e, 0xef, 0xcd, 0xd1, 0x62, 0xf2, 0xfc,
-	0x98, 0x46, 0xaf, 0x2e, 0x18, 0x8d, 0xc1, 0xe8, 0x2b, 0x2d, 0xd5, 0xea, 0xf5, 0xc5, 0xdf, 0xd9,
-	0xe8, 0xd7, 0xd5, 0xec, 0xa9, 0x90, 0xf6, 0x73, 0x57, 0xd0, 0x52, 0xd7, 0x31, 0x58, 0xfc, 0x3d,
-	0x33, 0xd5, 0x17, 0x66, 0xb7, 0x0d, 0x18, 0xbf, 0xf0, 0xe3, 0xfa, 0xfc, 0x64, 0xba, 0x01, 0xc1,
-	0xcb, 0xad, 0xbf, 0xc1, 0xfc, 0xbc, 0x3e, 0x3f, 0x41, 0x39, 0x0e, 0x97, 0x3a, 0x45, 0xf2, 0x06,
-	0x4f, 0x5b, 0xd8, 0x00, 0x37, 0xb0, 0x76, 0x71, 0xd3, 0x43, 0xef, 0x21, 0xa3, 0xa1, 0x0b, 0x7a,
-	0xd3, 0x05, 0xfd, 0x70, 0xd3, 0xc5, 0x6a, 0xec, 0x4c, 0x9c, 0x5d, 0xcd, 0x50, 0x3e, 0x89, 0x9b,
-	0x8e, 0x4b, 0x32, 0x3c, 0x8e, 0xb0, 0x4a, 0xef, 0xcf, 0xd1, 0x62, 0x9c, 0xdf, 0xe2, 0xd5, 0xbb,
-	0x8b, 0x1d, 0x41, 0x97, 0x3b, 0x82, 0xfe, 0xed, 0x08, 0x3a, 0xdb, 0x93, 0xd1, 0xe5, 0x9e, 0x8c,
-	0xfe, 0xec, 0xc9, 0xe8, 0xd3, 0xcb, 0x41, 0x12, 0x0b, 0xaa, 0x82, 0xb6, 0x96, 0xca, 0xde, 0x79,
-	0x2d, 0xdf, 0xee, 0x20, 0x1f, 0xae, 0x78, 0xe0, 0x7d, 0xbd, 0xf8, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x74, 0x50, 0xf6, 0x63, 0x02, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x8f, 0xd3, 0x40,
+	0x10, 0xcd, 0x72, 0xc7, 0x91, 0xdb, 0x84, 0x02, 0x8b, 0xc2, 0x67, 0x09, 0x27, 0xa2, 0x21, 0x3a,
+	0x89, 0x5d, 0x05, 0x44, 0x4b, 0x11, 0x84, 0x10, 0x15, 0xc2, 0x42, 0x14, 0x34, 0xd1, 0xda, 0x9e,
+	0x5b, 0x56, 0xc4, 0xbb, 0x39, 0xef, 0x3a, 0x22, 0x25, 0xff, 0xe0, 0x44, 0xc9, 0x2f, 0x40, 0x54,
+	0xf7, 0x33, 0xae, 0xbc, 0x92, 0x8a, 0x43, 0x49, 0x71, 0x7f, 0x03, 0xed, 0x87, 0x23, 0xa7, 0xa2,
+	0xb1, 0xe7, 0xcd, 0x9b, 0xd1, 0xbc, 0xf7, 0xb4, 0x98, 0x9c, 0x35, 0xb2, 0xac, 0x99, 0xd0, 0x42,
+	0x72, 0xda, 0xad, 0x57, 0x53, 0xba, 0x02, 0x6d, 0x84, 0xe4, 0xf3, 0xf3, 0x06, 0x1a, 0x20, 0xcb,
+	0x5a, 0x19, 0x15, 0x25, 0x9d, 0x99, 0xee, 0x2e, 0x59, 0x4d, 0x93, 0x07, 0xac, 0x12, 0x52, 0x51,
+	0xf7, 0xf5, 0xe3, 0x49, 0x5a, 0x28, 0x5d, 0x29, 0x4d, 0x73, 0xa6, 0x81, 0xae, 0xa6, 0x39, 0x18,
+	0x36, 0xa5, 0x85, 0x12, 0x32, 0xf0, 0x27, 0x9e, 0x9f, 0x3b, 0x44, 0x3d, 0x08, 0xd4, 0x43, 0xae,
+	0xb8, 0xf2, 0x7d, 0x5b, 0x85, 0xee, 0x88, 0x2b, 0xc5, 0x17, 0x40, 0x1d, 0xca, 0x9b, 0x33, 0x6a,
+	0x44, 0x05, 0xda, 0xb0, 0x6a, 0xe9, 0x07, 0x1e, 0x7f, 0x3f, 0xc0, 0xc3, 0x8f, 0x5e, 0xf8, 0x7b,
+	0xab, 0x3b, 0x7a, 0x84, 0x31, 0x6b, 0x0a, 0x23, 0x94, 0x9c, 0x8b, 0x32, 0x46, 0x63, 0x34, 0x39,
+	0xcc, 0x8e, 0x43, 0xe7, 0x6d, 0x19, 0xa5, 0x3b, 0x1a, 0xa0, 0x8e, 0xef, 0x8c, 0xd1, 0xe4, 0x38,
+	0xeb, 0x74, 0xa2, 0x6f, 0x08, 0x0f, 0x96, 0x6c, 0x6d, 0x73, 0xb0, 0xba, 0xe3, 0x83, 0x31, 0x9a,
+	0x0c, 0x9e, 0x9d, 0x90, 0xa0, 0xd5, 0x1a, 0x23, 0xc1, 0x18, 0x79, 0xa5, 0x84, 0x9c, 0xbd, 0xbe,
+	0xfa, 0x33, 0xea, 0xfd, 0xba, 0x19, 0x3d, 0xe1, 0xc2, 0x7c, 0x6e, 0x72, 0x52, 0xa8, 0x2a, 0x18,
+	0x0b, 0xbf, 0xa7, 0xba, 0xfc, 0x42, 0xcd, 0x7a, 0x09, 0xda, 0x2d, 0xfc, 0xb8, 0xbd, 0x3c, 0x1d,
+	0x2e, 0x80, 0xb3, 0x62, 0xed, 0x2e, 0xe8, 0x9f, 0xb7, 0x97, 0xa7, 0x28, 0xc3, 0xfe, 0xa8, 0x9d,
+	0x88, 0xde, 0xe0, 0x61, 0x0d, 0x0b, 0x60, 0x1a, 0xe6, 0xd6, 0x6e, 0x7c, 0xe8, 0x34, 0x24, 0xc4,
+	0x67, 0x41, 0xda, 0x2c, 0xc8, 0x87, 0x36, 0x8b, 0x59, 0xdf, 0x8a, 0xb8, 0xb8, 0x19, 0xa1, 0x6c,
+	0x10, 0x36, 0x2d, 0x17, 0x25, 0xb8, 0x1f, 0x60, 0x19, 0xdf, 0x1d, 0xa3, 0x49, 0x3f, 0xdb, 0xe1,
+	0xe8, 0x25, 0xbe, 0xdf, 0xd6, 0xde, 0xe9, 0xd1, 0x7f, 0x9c, 0x66, 0xad, 0xa8, 0xd2, 0x89, 0x4c,
+	0x70, 0x5f, 0xc3, 0x79, 0x03, 0xb2, 0x80, 0xf8, 0x9e, 0x4b, 0x79, 0x87, 0x67, 0xef, 0xae, 0x36,
+	0x29, 0xba, 0xde, 0xa4, 0xe8, 0xef, 0x26, 0x45, 0x17, 0xdb, 0xb4, 0x77, 0xbd, 0x4d, 0x7b, 0xbf,
+	0xb7, 0x69, 0xef, 0xd3, 0x8b, 0x4e, 0x4a, 0x06, 0x64, 0x09, 0x75, 0x25, 0xa4, 0xd9, 0x7b, 0x89,
+	0x5f, 0xf7, 0x90, 0x0b, 0x2e, 0x3f, 0x72, 0x9e, 0x9f, 0xff, 0x1b, 0x00, 0xd4, 0x6d, 0x25, 0xc5,
+	0xbf, 0x02, 0x00, 0x00,
 }
 
 func (m *VestingQueue) Marshal() (dAtA []byte, err error) {
@@ -170,6 +192,23 @@ func (m *VestingQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintVestingQueue(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReleasedCoin != nil {
+		{
+			size, err := m.ReleasedCoin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVestingQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Released {
 		i--
 		if m.Released {
@@ -180,12 +219,12 @@ func (m *VestingQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintVestingQueue(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintVestingQueue(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -244,6 +283,13 @@ func (m *VestingQueue) Size() (n int) {
 	if m.Released {
 		n += 2
 	}
+	if m.ReleasedCoin != nil {
+		l = m.ReleasedCoin.Size()
+		n += 1 + l + sovVestingQueue(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovVestingQueue(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -419,6 +465,61 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Released = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleasedCoin == nil {
+				m.ReleasedCoin = &types.Coin{}
+			}
+			if err := m.ReleasedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingQueue(dAtA[iNdEx:])
@@ -124,6 +124,15 @@ func TestValidateVestingSchedules(t *testing.T) {
 			types.MustParseRFC3339("2022-03-01T00:00:00Z"),
 			"total vesting weight must be equal to 1: invalid vesting schedules",
 		},
+		{
+			"invalid case #6",
+			[]types.VestingSchedule{
+				{ReleaseTime: types.MustParseRFC3339("2022-05-01T00:00:00Z"), Weight: math.LegacyMustNewDecFromStr("0.5")},
+				{ReleaseTime: types.MustParseRFC3339("2022-05-01T00:00:00Z"), Weight: math.LegacyMustNewDecFromStr("0.5")},
+			},
+			types.MustParseRFC3339("2022-03-01T00:00:00Z"),
+			"duplicate release time 2022-05-01T00:00:00Z: invalid vesting schedules",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := types.ValidateVestingSchedules(tc.schedules, tc.endTime)
@@ -139,6 +148,7 @@ func TestValidateVestingSchedules(t *testing.T) {
 func TestSetReleased(t *testing.T) {
 	vestingQueue := types.NewVestingQueue(
 		1,
+		0,
 		sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))),
 		sdk.NewInt64Coin("denom1", 10000000),
 		types.MustParseRFC3339("2021-11-01T00:00:00Z"),
@@ -149,3 +159,115 @@ func TestSetReleased(t *testing.T) {
 	vestingQueue.SetReleased(true)
 	require.True(t, vestingQueue.Released)
 }
+
+func TestValidateLinearVesting(t *testing.T) {
+	cliffTime := types.MustParseRFC3339("2022-05-01T00:00:00Z")
+	lateCliffTime := types.MustParseRFC3339("2023-05-01T00:00:00Z")
+	for _, tc := range []struct {
+		name          string
+		linearVesting *types.LinearVesting
+		expectedErr   string
+	}{
+		{
+			"happy case",
+			&types.LinearVesting{
+				StartTime: types.MustParseRFC3339("2022-03-01T00:00:00Z"),
+				EndTime:   types.MustParseRFC3339("2023-03-01T00:00:00Z"),
+				CliffTime: &cliffTime,
+			},
+			"",
+		},
+		{
+			"nil linear vesting",
+			nil,
+			"",
+		},
+		{
+			"start time before the end time of the auction",
+			&types.LinearVesting{
+				StartTime: types.MustParseRFC3339("2022-02-01T00:00:00Z"),
+				EndTime:   types.MustParseRFC3339("2023-03-01T00:00:00Z"),
+			},
+			"linear vesting start time must not be before the end time: invalid vesting schedules",
+		},
+		{
+			"end time not after the start time",
+			&types.LinearVesting{
+				StartTime: types.MustParseRFC3339("2022-03-01T00:00:00Z"),
+				EndTime:   types.MustParseRFC3339("2022-03-01T00:00:00Z"),
+			},
+			"linear vesting end time must be set after the start time: invalid vesting schedules",
+		},
+		{
+			"cliff time after the end time",
+			&types.LinearVesting{
+				StartTime: types.MustParseRFC3339("2022-03-01T00:00:00Z"),
+				EndTime:   types.MustParseRFC3339("2023-03-01T00:00:00Z"),
+				CliffTime: &lateCliffTime,
+			},
+			"linear vesting cliff time must be within the vesting period: invalid vesting schedules",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateLinearVesting(tc.linearVesting, types.MustParseRFC3339("2022-03-01T00:00:00Z"))
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestLinearVestingVestedAmount(t *testing.T) {
+	cliffTime := types.MustParseRFC3339("2022-01-26T00:00:00Z")
+	linearVesting := types.LinearVesting{
+		StartTime: types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		EndTime:   types.MustParseRFC3339("2022-04-11T00:00:00Z"), // 100 days after the start time
+		CliffTime: &cliffTime,
+	}
+	total := math.NewInt(1_000_000)
+
+	for _, tc := range []struct {
+		t        string
+		expected math.Int
+	}{
+		{"2021-12-01T00:00:00Z", math.ZeroInt()},
+		{"2022-01-11T00:00:00Z", math.ZeroInt()},
+		{"2022-01-26T00:00:00Z", math.NewInt(250_000)},
+		{"2022-02-20T00:00:00Z", math.NewInt(500_000)},
+		{"2022-04-11T00:00:00Z", total},
+		{"2023-01-01T00:00:00Z", total},
+	} {
+		t.Run(tc.t, func(t *testing.T) {
+			require.True(t, tc.expected.Equal(linearVesting.VestedAmount(total, types.MustParseRFC3339(tc.t))))
+		})
+	}
+}
+
+func TestLinearVestingNextReleaseTime(t *testing.T) {
+	cliffTime := types.MustParseRFC3339("2022-01-26T00:00:00Z")
+	linearVesting := types.LinearVesting{
+		StartTime: types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		EndTime:   types.MustParseRFC3339("2022-04-11T00:00:00Z"),
+		CliffTime: &cliffTime,
+	}
+
+	for _, tc := range []struct {
+		t        string
+		expected string
+	}{
+		{"2021-12-01T00:00:00Z", "2022-01-26T00:00:00Z"},
+		{"2022-01-25T23:59:59Z", "2022-01-26T00:00:00Z"},
+		{"2022-01-26T00:00:00Z", "2022-01-26T01:00:00Z"},
+		{"2022-01-26T00:30:00Z", "2022-01-26T01:00:00Z"},
+		{"2022-02-20T05:00:00Z", "2022-02-20T06:00:00Z"},
+		{"2022-04-10T23:30:00Z", "2022-04-11T00:00:00Z"},
+		{"2023-01-01T00:00:00Z", "2022-04-11T00:00:00Z"},
+	} {
+		t.Run(tc.t, func(t *testing.T) {
+			next := linearVesting.NextReleaseTime(types.MustParseRFC3339(tc.t))
+			require.Equal(t, types.MustParseRFC3339(tc.expected), next)
+		})
+	}
+}